@@ -0,0 +1,32 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"todoapp/internal/infrastructure"
+	"todoapp/internal/tweet/repository"
+)
+
+// ツイートのハッシュタグ・メンション・URLを本文から作り直す。
+// エンティティのテーブルを追加する前のツイートと、シードで直接挿入したツイートに使う
+func main() {
+	dbConfig, err := infrastructure.DBConfigFromEnv()
+	if err != nil {
+		log.Fatal("データベース設定エラー: ", err)
+	}
+
+	db, err := infrastructure.NewDB(dbConfig)
+	if err != nil {
+		log.Fatal("データベース接続エラー: ", err)
+	}
+	defer db.Close()
+
+	exec := infrastructure.DialectExecutor(dbConfig.Driver, db)
+	processed, err := repository.BackfillEntities(context.Background(), exec)
+	if err != nil {
+		log.Fatal("エンティティの作成エラー: ", err)
+	}
+	fmt.Printf("エンティティを作り直したツイート: %d件\n", processed)
+}
@@ -0,0 +1,121 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+
+	"todoapp/internal/infrastructure"
+	"todoapp/internal/migration"
+	"todoapp/migrations"
+)
+
+const usage = `使用方法:
+  migrate up          # 未適用のマイグレーションをすべて適用
+  migrate down N      # 新しい順に N 個のマイグレーションを取り消す
+  migrate goto V      # バージョン V まで up / down する
+  migrate status      # 各マイグレーションの適用状況を表示
+  migrate force V     # SQLを実行せずにバージョンを V にする(dirty の解除)`
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Println(usage)
+		os.Exit(2)
+	}
+
+	dbConfig, err := infrastructure.DBConfigFromEnv()
+	if err != nil {
+		log.Fatal("データベース設定エラー: ", err)
+	}
+	dbConfig.MultiStatements = true
+
+	db, err := infrastructure.NewDB(dbConfig)
+	if err != nil {
+		log.Fatal("データベース接続エラー: ", err)
+	}
+	defer db.Close()
+
+	fsys, err := migrations.ForDriver(dbConfig.Driver)
+	if err != nil {
+		log.Fatal("マイグレーション読み込みエラー: ", err)
+	}
+	migrator, err := migration.New(db, dbConfig.Driver, fsys)
+	if err != nil {
+		log.Fatal("マイグレーション読み込みエラー: ", err)
+	}
+	migrator.Logf = log.Printf
+
+	if err := run(context.Background(), migrator, os.Args[1], os.Args[2:]); err != nil {
+		log.Fatal("マイグレーションエラー: ", err)
+	}
+}
+
+func run(ctx context.Context, m *migration.Migrator, command string, args []string) error {
+	switch command {
+	case "up":
+		return m.Up(ctx)
+	case "down":
+		n, err := intArg(args)
+		if err != nil {
+			return err
+		}
+		return m.Down(ctx, n)
+	case "goto":
+		v, err := intArg(args)
+		if err != nil {
+			return err
+		}
+		return m.Goto(ctx, uint(v))
+	case "force":
+		v, err := intArg(args)
+		if err != nil {
+			return err
+		}
+		return m.Force(ctx, uint(v))
+	case "status":
+		return printStatus(ctx, m)
+	default:
+		fmt.Println(usage)
+		os.Exit(2)
+		return nil
+	}
+}
+
+func printStatus(ctx context.Context, m *migration.Migrator) error {
+	version, dirty, err := m.Version(ctx)
+	if err != nil {
+		return err
+	}
+	statuses, err := m.Status(ctx)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("現在のバージョン: %d", version)
+	if dirty {
+		fmt.Print(" (dirty)")
+	}
+	fmt.Println()
+
+	for _, s := range statuses {
+		mark := "未適用"
+		if s.Applied {
+			mark = "適用済み"
+		}
+		fmt.Printf("  %04d %-40s %s\n", s.Migration.Version, s.Migration.Name, mark)
+	}
+	return nil
+}
+
+func intArg(args []string) (int, error) {
+	if len(args) != 1 {
+		return 0, fmt.Errorf("引数を1つ指定してください")
+	}
+	n, err := strconv.Atoi(args[0])
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("不正な数値です: %s", args[0])
+	}
+	return n, nil
+}
@@ -0,0 +1,33 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"todoapp/internal/infrastructure"
+	"todoapp/internal/user/repository"
+)
+
+// users.followers_count / following_count / tweets_count を follows と tweets から数え直す。
+// 件数はフォローやツイートの操作と同じトランザクションで更新されるが、
+// 外部キーの ON DELETE CASCADE や手作業でのデータ修正でずれた場合の修復に使う
+func main() {
+	dbConfig, err := infrastructure.DBConfigFromEnv()
+	if err != nil {
+		log.Fatal("データベース設定エラー: ", err)
+	}
+
+	db, err := infrastructure.NewDB(dbConfig)
+	if err != nil {
+		log.Fatal("データベース接続エラー: ", err)
+	}
+	defer db.Close()
+
+	exec := infrastructure.DialectExecutor(dbConfig.Driver, db)
+	repaired, err := repository.RecountUserCounts(context.Background(), exec)
+	if err != nil {
+		log.Fatal("件数の集計エラー: ", err)
+	}
+	fmt.Printf("件数を修正したユーザー: %d件\n", repaired)
+}
@@ -0,0 +1,117 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"time"
+
+	"todoapp/internal/infrastructure"
+	"todoapp/internal/migration"
+	"todoapp/internal/schemacheck"
+	"todoapp/migrations"
+
+	"github.com/volatiletech/sqlboiler/v4/drivers"
+)
+
+// マイグレーションを一時データベースに適用し、その構造と
+// internal/schema の生成済みモデルを比較する。差分があれば終了コード1で終わる
+func main() {
+	modelsDir := flag.String("models", "internal/schema", "sqlboilerが生成したモデルのディレクトリ")
+	keep := flag.Bool("keep", false, "確認後も一時データベースを削除しない")
+	flag.Parse()
+
+	dbConfig, err := infrastructure.DBConfigFromEnv()
+	if err != nil {
+		log.Fatal("データベース設定エラー: ", err)
+	}
+	if dbConfig.Driver != infrastructure.DriverMySQL {
+		log.Fatal("スキーマの確認は mysql のみ対応しています")
+	}
+
+	scratchName := fmt.Sprintf("schemacheck_%d", time.Now().UnixNano())
+	diff, err := check(context.Background(), dbConfig, scratchName, *modelsDir, *keep)
+	if err != nil {
+		log.Fatal("スキーマ確認エラー: ", err)
+	}
+
+	if len(diff) == 0 {
+		fmt.Println("生成済みモデルはマイグレーションと一致しています")
+		return
+	}
+
+	fmt.Println("生成済みモデルがマイグレーションと一致しません(make generate-models で再生成してください):")
+	for _, line := range diff {
+		fmt.Println(line)
+	}
+	os.Exit(1)
+}
+
+func check(ctx context.Context, cfg infrastructure.DBConfig, scratchName, modelsDir string, keep bool) ([]string, error) {
+	// データベースを指定せずにサーバーへ接続して一時データベースを作る
+	serverCfg := cfg
+	serverCfg.DBName = ""
+	server, err := infrastructure.NewDB(serverCfg)
+	if err != nil {
+		return nil, err
+	}
+	defer server.Close()
+
+	if _, err := server.ExecContext(ctx, "CREATE DATABASE `"+scratchName+"`"); err != nil {
+		return nil, fmt.Errorf("failed to create scratch database: %w", err)
+	}
+	if !keep {
+		defer func() {
+			if _, err := server.ExecContext(context.Background(), "DROP DATABASE `"+scratchName+"`"); err != nil {
+				log.Printf("一時データベース %s の削除に失敗しました: %v", scratchName, err)
+			}
+		}()
+	}
+
+	scratchCfg := cfg
+	scratchCfg.DBName = scratchName
+	scratchCfg.MultiStatements = true
+	if err := migrate(ctx, scratchCfg); err != nil {
+		return nil, err
+	}
+
+	port, err := strconv.Atoi(cfg.Port)
+	if err != nil {
+		return nil, fmt.Errorf("invalid DB_PORT: %w", err)
+	}
+	dbTables, err := schemacheck.LoadDatabase(drivers.Config{
+		drivers.ConfigUser:    cfg.User,
+		drivers.ConfigPass:    cfg.Password,
+		drivers.ConfigHost:    cfg.Host,
+		drivers.ConfigPort:    port,
+		drivers.ConfigDBName:  scratchName,
+		drivers.ConfigSSLMode: cfg.TLS,
+	}, "schema_migrations")
+	if err != nil {
+		return nil, err
+	}
+
+	modelTables, err := schemacheck.LoadModels(modelsDir)
+	if err != nil {
+		return nil, err
+	}
+
+	return schemacheck.Diff(dbTables, modelTables), nil
+}
+
+func migrate(ctx context.Context, cfg infrastructure.DBConfig) error {
+	db, err := infrastructure.NewDB(cfg)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	migrator, err := migration.New(db, infrastructure.DriverMySQL, migrations.FS)
+	if err != nil {
+		return err
+	}
+	return migrator.Up(ctx)
+}
@@ -0,0 +1,153 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// batchInserter は複数行をまとめて1つの INSERT 文で挿入する
+type batchInserter struct {
+	exec      boil.ContextExecutor
+	table     string
+	columns   []string
+	batchSize int
+
+	// keyColumn を指定すると、挿入した行をこの列で読み直してIDを得る
+	keyColumn string
+
+	rows []interface{}
+	n    int
+	// ids は keyColumn を指定したときの、挿入された行のID(挿入順)
+	ids []int
+}
+
+func newBatchInserter(exec boil.ContextExecutor, table string, columns []string, batchSize int) *batchInserter {
+	return &batchInserter{
+		exec:      exec,
+		table:     table,
+		columns:   columns,
+		batchSize: batchSize,
+	}
+}
+
+// withIDs は挿入した行のIDを IDs で返すようにする。keyColumn は一意でなくてもよいが、
+// 挿入中にほかの接続が同じ値の行を追加した場合は Flush がエラーを返す
+func (b *batchInserter) withIDs(keyColumn string) *batchInserter {
+	b.keyColumn = keyColumn
+	return b
+}
+
+// Add は1行を追加し、バッチサイズに達したら挿入する
+func (b *batchInserter) Add(ctx context.Context, values ...interface{}) error {
+	if len(values) != len(b.columns) {
+		return fmt.Errorf("%s: expected %d values, got %d", b.table, len(b.columns), len(values))
+	}
+
+	b.rows = append(b.rows, values...)
+	b.n++
+	if b.n >= b.batchSize {
+		return b.Flush(ctx)
+	}
+	return nil
+}
+
+// Flush は未挿入の行をすべて挿入する
+func (b *batchInserter) Flush(ctx context.Context) error {
+	if b.n == 0 {
+		return nil
+	}
+
+	placeholder := "(" + strings.TrimSuffix(strings.Repeat("?,", len(b.columns)), ",") + ")"
+	query := fmt.Sprintf("INSERT INTO `%s` (`%s`) VALUES %s",
+		b.table,
+		strings.Join(b.columns, "`, `"),
+		strings.TrimSuffix(strings.Repeat(placeholder+",", b.n), ","),
+	)
+
+	result, err := b.exec.ExecContext(ctx, query, b.rows...)
+	if err != nil {
+		return fmt.Errorf("failed to insert into %s: %w", b.table, err)
+	}
+
+	if b.keyColumn != "" {
+		firstID, err := result.LastInsertId()
+		if err != nil {
+			return fmt.Errorf("failed to get inserted ids of %s: %w", b.table, err)
+		}
+		if err := b.readIDs(ctx, int(firstID)); err != nil {
+			return err
+		}
+	}
+
+	b.rows = b.rows[:0]
+	b.n = 0
+	return nil
+}
+
+// IDs は挿入済みの行のIDを返す
+func (b *batchInserter) IDs() []int {
+	return b.ids
+}
+
+// readIDs は直前に挿入した行のIDを keyColumn の値で読み直し、挿入順に ids に追加する。
+// innodb_autoinc_lock_mode=2 やレプリケーションの auto_increment_increment では
+// 1文で挿入した行のIDが連番になるとは限らないので、先頭のIDから数えずに読み直す。
+// 1文の中でも行のIDは挿入順に大きくなり、先頭の行のID(firstID)が最小になる
+func (b *batchInserter) readIDs(ctx context.Context, firstID int) error {
+	keyIdx := -1
+	for i, column := range b.columns {
+		if column == b.keyColumn {
+			keyIdx = i
+		}
+	}
+	if keyIdx < 0 {
+		return fmt.Errorf("%s: key column %s is not inserted", b.table, b.keyColumn)
+	}
+
+	keys := make([]string, b.n)
+	args := make([]interface{}, 0, b.n+1)
+	args = append(args, firstID)
+	for i := 0; i < b.n; i++ {
+		value := b.rows[i*len(b.columns)+keyIdx]
+		keys[i] = fmt.Sprint(value)
+		args = append(args, value)
+	}
+	query := fmt.Sprintf("SELECT `id`, `%s` FROM `%s` WHERE `id` >= ? AND `%s` IN (%s) ORDER BY `id`",
+		b.keyColumn, b.table, b.keyColumn,
+		strings.TrimSuffix(strings.Repeat("?,", b.n), ","),
+	)
+	rows, err := b.exec.QueryContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to read inserted ids of %s: %w", b.table, err)
+	}
+	defer rows.Close()
+
+	// 同じキーの行が複数あれば、IDの小さい順に挿入順の行に割り当てる
+	idsByKey := make(map[string][]int, b.n)
+	found := 0
+	for rows.Next() {
+		var id int
+		var key []byte
+		if err := rows.Scan(&id, &key); err != nil {
+			return err
+		}
+		idsByKey[string(key)] = append(idsByKey[string(key)], id)
+		found++
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	if found != b.n {
+		return fmt.Errorf("%s: inserted %d rows but found %d; another connection may have inserted rows with the same %s",
+			b.table, b.n, found, b.keyColumn)
+	}
+
+	for _, key := range keys {
+		b.ids = append(b.ids, idsByKey[key][0])
+		idsByKey[key] = idsByKey[key][1:]
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"context"
+	"os"
+	"testing"
+	"todoapp/internal/dbtest"
+	"todoapp/internal/infrastructure"
+	"todoapp/internal/schema"
+)
+
+func TestBatchInserterIDs(t *testing.T) {
+	// シーダーは mysql にだけ対応している(SQLiteの LastInsertId は最後の行のIDを返す)
+	if os.Getenv("DBTEST_SERVER") == infrastructure.DriverSQLite {
+		t.Skip("the seeder only supports mysql")
+	}
+	ctx := context.Background()
+	db := dbtest.New(t)
+
+	users := newBatchInserter(db, schema.TableNames.Users, []string{
+		schema.UserColumns.Username,
+		schema.UserColumns.DisplayName,
+		schema.UserColumns.Email,
+		schema.UserColumns.PasswordHash,
+	}, 2).withIDs(schema.UserColumns.Username)
+	for _, name := range []string{"alice", "bob", "carol"} {
+		if err := users.Add(ctx, name, name, name+"@example.com", "x"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := users.Flush(ctx); err != nil {
+		t.Fatal(err)
+	}
+	userIDs := users.IDs()
+	for i, name := range []string{"alice", "bob", "carol"} {
+		user, err := schema.FindUser(ctx, db, userIDs[i])
+		if err != nil || user.Username != name {
+			t.Errorf("IDs()[%d] = %d (%v, %v), want %s", i, userIDs[i], user, err, name)
+		}
+	}
+
+	// 同じユーザーのツイートが1文に複数あっても、挿入順にIDを割り当てる
+	tweets := newBatchInserter(db, schema.TableNames.Tweets, []string{
+		schema.TweetColumns.UserID,
+		schema.TweetColumns.Content,
+	}, 4).withIDs(schema.TweetColumns.UserID)
+	contents := []string{"a1", "b1", "a2", "a3", "c1"}
+	authors := []int{userIDs[0], userIDs[1], userIDs[0], userIDs[0], userIDs[2]}
+	for i, content := range contents {
+		if err := tweets.Add(ctx, authors[i], content); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tweets.Flush(ctx); err != nil {
+		t.Fatal(err)
+	}
+	tweetIDs := tweets.IDs()
+	if len(tweetIDs) != len(contents) {
+		t.Fatalf("IDs() = %v, want %d ids", tweetIDs, len(contents))
+	}
+	for i, content := range contents {
+		tweet, err := schema.FindTweet(ctx, db, tweetIDs[i])
+		if err != nil || tweet.Content != content {
+			t.Errorf("IDs()[%d] = %d (%v, %v), want %s", i, tweetIDs[i], tweet, err, content)
+		}
+	}
+}
@@ -0,0 +1,301 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"todoapp/internal/infrastructure"
+	"todoapp/internal/schema"
+	"todoapp/internal/user/repository"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"golang.org/x/crypto/bcrypt"
+	"gopkg.in/yaml.v3"
+)
+
+// Fixture はシナリオ用のデータを記述するファイル(YAML / JSON)の形式。
+// ユーザーとツイートは name で参照し、挿入時に生成されたIDに解決する
+type Fixture struct {
+	Users   []FixtureUser   `json:"users,omitempty" yaml:"users,omitempty"`
+	Tweets  []FixtureTweet  `json:"tweets,omitempty" yaml:"tweets,omitempty"`
+	Follows []FixtureFollow `json:"follows,omitempty" yaml:"follows,omitempty"`
+	Likes   []FixtureLike   `json:"likes,omitempty" yaml:"likes,omitempty"`
+}
+
+type FixtureUser struct {
+	// Name は他の要素から参照するための名前(省略時は username)
+	Name            string     `json:"name,omitempty" yaml:"name,omitempty"`
+	Username        string     `json:"username" yaml:"username"`
+	DisplayName     string     `json:"display_name" yaml:"display_name"`
+	Email           string     `json:"email" yaml:"email"`
+	Password        string     `json:"password,omitempty" yaml:"password,omitempty"`
+	PasswordHash    string     `json:"password_hash,omitempty" yaml:"password_hash,omitempty"`
+	Bio             *string    `json:"bio,omitempty" yaml:"bio,omitempty"`
+	ProfileImageURL *string    `json:"profile_image_url,omitempty" yaml:"profile_image_url,omitempty"`
+	CreatedAt       *time.Time `json:"created_at,omitempty" yaml:"created_at,omitempty"`
+}
+
+type FixtureTweet struct {
+	Name      string     `json:"name" yaml:"name"`
+	User      string     `json:"user" yaml:"user"`
+	Content   string     `json:"content" yaml:"content"`
+	ImageURL  *string    `json:"image_url,omitempty" yaml:"image_url,omitempty"`
+	CreatedAt *time.Time `json:"created_at,omitempty" yaml:"created_at,omitempty"`
+}
+
+type FixtureFollow struct {
+	Follower  string     `json:"follower" yaml:"follower"`
+	Following string     `json:"following" yaml:"following"`
+	CreatedAt *time.Time `json:"created_at,omitempty" yaml:"created_at,omitempty"`
+}
+
+type FixtureLike struct {
+	User      string     `json:"user" yaml:"user"`
+	Tweet     string     `json:"tweet" yaml:"tweet"`
+	CreatedAt *time.Time `json:"created_at,omitempty" yaml:"created_at,omitempty"`
+}
+
+// readFixture は拡張子(.yaml / .yml / .json)に応じてフィクスチャを読み込む
+func readFixture(path string) (*Fixture, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var f Fixture
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(data, &f)
+	case ".json":
+		err = json.Unmarshal(data, &f)
+	default:
+		return nil, fmt.Errorf("unsupported fixture format: %s", path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	return &f, nil
+}
+
+func writeFixture(path string, f *Fixture) error {
+	var data []byte
+	var err error
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		data, err = yaml.Marshal(f)
+	case ".json":
+		data, err = json.MarshalIndent(f, "", "  ")
+	default:
+		return fmt.Errorf("unsupported fixture format: %s", path)
+	}
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
+
+// loadFixture はフィクスチャを1つのトランザクションで挿入する。
+// 参照先が見つからない場合は何も挿入せずにエラーを返す
+func loadFixture(ctx context.Context, txManager infrastructure.TxManager, db boil.ContextExecutor, f *Fixture) error {
+	return txManager.RunInTx(ctx, func(ctx context.Context) error {
+		exec := infrastructure.Executor(ctx, db)
+
+		userIDs := make(map[string]int, len(f.Users))
+		hashes := make(map[string]string)
+		for _, u := range f.Users {
+			name := u.Name
+			if name == "" {
+				name = u.Username
+			}
+			if _, ok := userIDs[name]; ok {
+				return fmt.Errorf("duplicate user name: %s", name)
+			}
+
+			passwordHash, err := fixturePasswordHash(u, hashes)
+			if err != nil {
+				return err
+			}
+
+			dbUser := &schema.User{
+				Username:        u.Username,
+				DisplayName:     u.DisplayName,
+				Email:           u.Email,
+				PasswordHash:    passwordHash,
+				Bio:             null.StringFromPtr(u.Bio),
+				ProfileImageURL: null.StringFromPtr(u.ProfileImageURL),
+				CreatedAt:       null.TimeFromPtr(u.CreatedAt),
+			}
+			if err := dbUser.Insert(ctx, exec, boil.Infer()); err != nil {
+				return fmt.Errorf("user %s: %w", name, err)
+			}
+			userIDs[name] = dbUser.ID
+		}
+
+		tweetIDs := make(map[string]int, len(f.Tweets))
+		for _, t := range f.Tweets {
+			if _, ok := tweetIDs[t.Name]; ok {
+				return fmt.Errorf("duplicate tweet name: %s", t.Name)
+			}
+			userID, ok := userIDs[t.User]
+			if !ok {
+				return fmt.Errorf("tweet %s: unknown user %s", t.Name, t.User)
+			}
+
+			dbTweet := &schema.Tweet{
+				UserID:    userID,
+				Content:   t.Content,
+				ImageURL:  null.StringFromPtr(t.ImageURL),
+				CreatedAt: null.TimeFromPtr(t.CreatedAt),
+			}
+			if err := dbTweet.Insert(ctx, exec, boil.Infer()); err != nil {
+				return fmt.Errorf("tweet %s: %w", t.Name, err)
+			}
+			tweetIDs[t.Name] = dbTweet.ID
+		}
+
+		for _, fo := range f.Follows {
+			followerID, ok := userIDs[fo.Follower]
+			if !ok {
+				return fmt.Errorf("follow: unknown user %s", fo.Follower)
+			}
+			followingID, ok := userIDs[fo.Following]
+			if !ok {
+				return fmt.Errorf("follow: unknown user %s", fo.Following)
+			}
+
+			dbFollow := &schema.Follow{
+				FollowerID:  followerID,
+				FollowingID: followingID,
+				CreatedAt:   null.TimeFromPtr(fo.CreatedAt),
+			}
+			if err := dbFollow.Insert(ctx, exec, boil.Infer()); err != nil {
+				return fmt.Errorf("follow %s -> %s: %w", fo.Follower, fo.Following, err)
+			}
+		}
+
+		for _, l := range f.Likes {
+			userID, ok := userIDs[l.User]
+			if !ok {
+				return fmt.Errorf("like: unknown user %s", l.User)
+			}
+			tweetID, ok := tweetIDs[l.Tweet]
+			if !ok {
+				return fmt.Errorf("like: unknown tweet %s", l.Tweet)
+			}
+
+			dbLike := &schema.Like{
+				UserID:    userID,
+				TweetID:   tweetID,
+				CreatedAt: null.TimeFromPtr(l.CreatedAt),
+			}
+			if err := dbLike.Insert(ctx, exec, boil.Infer()); err != nil {
+				return fmt.Errorf("like %s -> %s: %w", l.User, l.Tweet, err)
+			}
+		}
+
+		// ツイートとフォローを直接挿入したので users の件数をまとめて設定する
+		if _, err := repository.RecountUserCounts(ctx, exec); err != nil {
+			return fmt.Errorf("recount: %w", err)
+		}
+		return nil
+	})
+}
+
+// fixturePasswordHash は password_hash があればそれを、なければ password(省略時は既定値)をハッシュ化する。
+// 同じパスワードのハッシュは使い回す
+func fixturePasswordHash(u FixtureUser, cache map[string]string) (string, error) {
+	if u.PasswordHash != "" {
+		return u.PasswordHash, nil
+	}
+
+	password := u.Password
+	if password == "" {
+		password = DefaultPassword
+	}
+	if hash, ok := cache[password]; ok {
+		return hash, nil
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return "", err
+	}
+	cache[password] = string(hash)
+	return string(hash), nil
+}
+
+// exportFixture は現在のデータベースの内容をフィクスチャの形式で返す。
+// ユーザーは username、ツイートは "<username>_<連番>" の名前で参照する
+func exportFixture(ctx context.Context, db boil.ContextExecutor) (*Fixture, error) {
+	users, err := schema.Users(qm.OrderBy(schema.UserColumns.ID)).All(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	tweets, err := schema.Tweets(qm.OrderBy(schema.TweetColumns.ID)).All(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	follows, err := schema.Follows(qm.OrderBy(schema.FollowColumns.FollowerID+", "+schema.FollowColumns.FollowingID)).All(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	likes, err := schema.Likes(qm.OrderBy(schema.LikeColumns.UserID+", "+schema.LikeColumns.TweetID)).All(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+
+	f := &Fixture{}
+	userNames := make(map[int]string, len(users))
+	for _, u := range users {
+		userNames[u.ID] = u.Username
+		f.Users = append(f.Users, FixtureUser{
+			Username:        u.Username,
+			DisplayName:     u.DisplayName,
+			Email:           u.Email,
+			PasswordHash:    u.PasswordHash,
+			Bio:             u.Bio.Ptr(),
+			ProfileImageURL: u.ProfileImageURL.Ptr(),
+			CreatedAt:       u.CreatedAt.Ptr(),
+		})
+	}
+
+	tweetNames := make(map[int]string, len(tweets))
+	tweetCounts := make(map[int]int)
+	for _, t := range tweets {
+		tweetCounts[t.UserID]++
+		name := fmt.Sprintf("%s_%d", userNames[t.UserID], tweetCounts[t.UserID])
+		tweetNames[t.ID] = name
+		f.Tweets = append(f.Tweets, FixtureTweet{
+			Name:      name,
+			User:      userNames[t.UserID],
+			Content:   t.Content,
+			ImageURL:  t.ImageURL.Ptr(),
+			CreatedAt: t.CreatedAt.Ptr(),
+		})
+	}
+
+	for _, fo := range follows {
+		f.Follows = append(f.Follows, FixtureFollow{
+			Follower:  userNames[fo.FollowerID],
+			Following: userNames[fo.FollowingID],
+			CreatedAt: fo.CreatedAt.Ptr(),
+		})
+	}
+
+	for _, l := range likes {
+		f.Likes = append(f.Likes, FixtureLike{
+			User:      userNames[l.UserID],
+			Tweet:     tweetNames[l.TweetID],
+			CreatedAt: l.CreatedAt.Ptr(),
+		})
+	}
+
+	return f, nil
+}
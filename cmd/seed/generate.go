@@ -0,0 +1,318 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"todoapp/internal/schema"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	MaxTweetLen     = 280 // ツイートの最大文字数
+	MaxUsernameLen  = 50  // users.username の最大長
+	DefaultPassword = "password123"
+	MaxReplyChain   = 5 // リプライの連鎖の最大長
+)
+
+type seedUser struct {
+	ID        int
+	Username  string
+	CreatedAt time.Time
+}
+
+type seedTweet struct {
+	ID        int
+	UserIdx   int
+	CreatedAt time.Time
+}
+
+// generator はシード値から決定的にランダムデータを生成して挿入する
+type generator struct {
+	db        *sql.DB
+	faker     *gofakeit.Faker
+	rng       *rand.Rand
+	batchSize int
+	graph     graphConfig
+	timeline  *timeline
+
+	users  []seedUser
+	tweets []seedTweet
+	// tweetsByUser はユーザーのインデックスから tweets のインデックスへの対応
+	tweetsByUser map[int][]int
+
+	activity   picker
+	popularity picker
+}
+
+func newGenerator(db *sql.DB, seed int64, batchSize int, graph graphConfig, now time.Time) *generator {
+	faker := gofakeit.New(seed)
+	return &generator{
+		db:           db,
+		faker:        faker,
+		rng:          faker.Rand,
+		batchSize:    batchSize,
+		graph:        graph,
+		timeline:     newTimeline(faker.Rand, now, graph),
+		tweetsByUser: make(map[int][]int),
+	}
+}
+
+func (g *generator) generateUsers(ctx context.Context, n int, password string) ([]seedUser, error) {
+	// bcrypt は遅いので全ユーザーで同じハッシュを使う
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, err
+	}
+
+	inserter := newBatchInserter(g.db, schema.TableNames.Users, []string{
+		schema.UserColumns.Username,
+		schema.UserColumns.DisplayName,
+		schema.UserColumns.Email,
+		schema.UserColumns.PasswordHash,
+		schema.UserColumns.Bio,
+		schema.UserColumns.ProfileImageURL,
+		schema.UserColumns.CreatedAt,
+	}, g.batchSize).withIDs(schema.UserColumns.Username)
+
+	users := make([]seedUser, 0, n)
+	for i := 0; i < n; i++ {
+		// 連番を付けてユーザー名とメールアドレスを一意にする
+		suffix := fmt.Sprintf("_%d", i+1)
+		base := strings.ToLower(g.faker.Username())
+		if len(base)+len(suffix) > MaxUsernameLen {
+			base = base[:MaxUsernameLen-len(suffix)]
+		}
+		user := seedUser{
+			Username:  base + suffix,
+			CreatedAt: g.timeline.userCreatedAt(),
+		}
+
+		err := inserter.Add(ctx,
+			user.Username,
+			g.faker.Name(),
+			user.Username+"@"+g.faker.DomainName(),
+			string(hashedPassword),
+			g.faker.Sentence(10),
+			g.faker.ImageURL(400, 400),
+			user.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		users = append(users, user)
+	}
+	if err := inserter.Flush(ctx); err != nil {
+		return nil, err
+	}
+
+	for i, id := range inserter.IDs() {
+		users[i].ID = id
+	}
+	g.users = users
+	if n > 0 {
+		g.activity = g.graph.newActivityPicker(g.rng, n)
+		g.popularity = g.graph.newPopularityPicker(g.rng, n)
+	}
+
+	return users, nil
+}
+
+func (g *generator) generateTweets(ctx context.Context, n int) ([]seedTweet, error) {
+	if len(g.users) == 0 {
+		return nil, nil
+	}
+
+	inserter := newBatchInserter(g.db, schema.TableNames.Tweets, []string{
+		schema.TweetColumns.UserID,
+		schema.TweetColumns.Content,
+		schema.TweetColumns.ImageURL,
+		schema.TweetColumns.CreatedAt,
+	}, g.batchSize).withIDs(schema.TweetColumns.UserID)
+
+	tweets := make([]seedTweet, 0, n)
+	add := func(userIdx int, content string, createdAt time.Time) error {
+		// 画像付きツイートの確率(30%)
+		var imageURL interface{}
+		if g.rng.Float32() < 0.3 {
+			imageURL = g.faker.ImageURL(1200, 675) // 16:9のアスペクト比
+		}
+
+		if err := inserter.Add(ctx, g.users[userIdx].ID, content, imageURL, createdAt); err != nil {
+			return err
+		}
+		tweets = append(tweets, seedTweet{UserIdx: userIdx, CreatedAt: createdAt})
+		return nil
+	}
+
+	for len(tweets) < n {
+		userIdx := g.activity.pick()
+		createdAt := g.timeline.tweetCreatedAt(g.users[userIdx].CreatedAt)
+		if err := add(userIdx, g.tweetContent(""), createdAt); err != nil {
+			return nil, err
+		}
+
+		if g.rng.Float64() >= g.graph.ReplyRatio {
+			continue
+		}
+
+		// リプライの連鎖: 直前の投稿者へのメンションを付けて短い間隔で続ける
+		prevIdx, prevAt := userIdx, createdAt
+		for i := g.rng.Intn(MaxReplyChain) + 1; i > 0 && len(tweets) < n; i-- {
+			replierIdx := g.activity.pick()
+			if replierIdx == prevIdx {
+				continue
+			}
+			replyAt := g.timeline.reactionAt(latest(prevAt, g.users[replierIdx].CreatedAt), 30*time.Minute)
+			if err := add(replierIdx, g.tweetContent(g.users[prevIdx].Username), replyAt); err != nil {
+				return nil, err
+			}
+			prevIdx, prevAt = replierIdx, replyAt
+		}
+	}
+	if err := inserter.Flush(ctx); err != nil {
+		return nil, err
+	}
+
+	for i, id := range inserter.IDs() {
+		tweets[i].ID = id
+		g.tweetsByUser[tweets[i].UserIdx] = append(g.tweetsByUser[tweets[i].UserIdx], i)
+	}
+	g.tweets = tweets
+
+	return tweets, nil
+}
+
+// tweetContent は最大280文字のツイート本文を生成する。
+// replyTo を指定するとそのユーザーへのメンションから始める
+func (g *generator) tweetContent(replyTo string) string {
+	content := g.faker.Sentence(g.rng.Intn(20) + 1) // 1-20語の文章
+	if replyTo != "" {
+		content = "@" + replyTo + " " + content
+	}
+	if utf8.RuneCountInString(content) > MaxTweetLen {
+		content = string([]rune(content)[:MaxTweetLen])
+	}
+	return content
+}
+
+func (g *generator) generateFollows(ctx context.Context, n int) (int, error) {
+	inserter := newBatchInserter(g.db, schema.TableNames.Follows, []string{
+		schema.FollowColumns.FollowerID,
+		schema.FollowColumns.FollowingID,
+		schema.FollowColumns.CreatedAt,
+	}, g.batchSize)
+
+	created := 0
+	seen := make(map[[2]int]bool)
+	for attempts := 0; created < n && attempts < n*10 && len(g.users) > 1; attempts++ {
+		follower := g.users[g.activity.pick()]
+		following := g.users[g.popularity.pick()]
+
+		// 自分自身をフォローしない、重複もスキップ
+		key := [2]int{follower.ID, following.ID}
+		if follower.ID == following.ID || seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		createdAt := g.timeline.reactionAt(latest(follower.CreatedAt, following.CreatedAt), 7*24*time.Hour)
+		if err := inserter.Add(ctx, follower.ID, following.ID, createdAt); err != nil {
+			return created, err
+		}
+		created++
+	}
+
+	return created, inserter.Flush(ctx)
+}
+
+func (g *generator) generateLikes(ctx context.Context, n int) (int, error) {
+	inserter := newBatchInserter(g.db, schema.TableNames.Likes, []string{
+		schema.LikeColumns.UserID,
+		schema.LikeColumns.TweetID,
+		schema.LikeColumns.CreatedAt,
+	}, g.batchSize)
+
+	created := 0
+	seen := make(map[[2]int]bool)
+	for attempts := 0; created < n && attempts < n*10 && len(g.tweets) > 0; attempts++ {
+		user := g.users[g.activity.pick()]
+
+		// 人気のあるユーザーのツイートほどいいねされやすくする
+		authorTweets := g.tweetsByUser[g.popularity.pick()]
+		if len(authorTweets) == 0 {
+			continue
+		}
+		tweet := g.tweets[authorTweets[g.rng.Intn(len(authorTweets))]]
+
+		// 重複をスキップ
+		key := [2]int{user.ID, tweet.ID}
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		createdAt := g.timeline.reactionAt(latest(tweet.CreatedAt, user.CreatedAt), 24*time.Hour)
+		if err := inserter.Add(ctx, user.ID, tweet.ID, createdAt); err != nil {
+			return created, err
+		}
+		created++
+	}
+
+	return created, inserter.Flush(ctx)
+}
+
+func latest(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
+
+// truncate は全テーブルのデータを削除し、AUTO_INCREMENT も初期化する
+func truncate(ctx context.Context, db *sql.DB) error {
+	// FOREIGN_KEY_CHECKS はセッション単位なので同じ接続で実行する
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if _, err := conn.ExecContext(ctx, "SET FOREIGN_KEY_CHECKS = 0"); err != nil {
+		return err
+	}
+	defer conn.ExecContext(context.Background(), "SET FOREIGN_KEY_CHECKS = 1")
+
+	for _, table := range []string{
+		schema.TableNames.AuditEvents,
+		schema.TableNames.DataExports,
+		schema.TableNames.DMMessages,
+		schema.TableNames.DMParticipants,
+		schema.TableNames.DMConversations,
+		schema.TableNames.DMOptIns,
+		schema.TableNames.Notifications,
+		schema.TableNames.NotificationOptOuts,
+		schema.TableNames.Retweets,
+		schema.TableNames.TweetHashtags,
+		schema.TableNames.TweetMentions,
+		schema.TableNames.TweetUrls,
+		schema.TableNames.Likes,
+		schema.TableNames.Follows,
+		schema.TableNames.Blocks,
+		schema.TableNames.Mutes,
+		schema.TableNames.Tweets,
+		schema.TableNames.Users,
+	} {
+		if _, err := conn.ExecContext(ctx, "TRUNCATE TABLE `"+table+"`"); err != nil {
+			return fmt.Errorf("failed to truncate %s: %w", table, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+package main
+
+import (
+	"context"
+	"os"
+	"reflect"
+	"testing"
+	"todoapp/internal/dbtest"
+	"todoapp/internal/infrastructure"
+)
+
+func TestGeneratorIsReproducible(t *testing.T) {
+	if os.Getenv("DBTEST_SERVER") == infrastructure.DriverSQLite {
+		t.Skip("the seeder only supports mysql")
+	}
+	ctx := context.Background()
+	now, err := parseNow("2024-01-01T00:00:00Z")
+	if err != nil {
+		t.Fatal(err)
+	}
+	graph := graphConfig{Distribution: DistributionPowerLaw, Alpha: 1.1, Celebrities: 2, CelebrityShare: 0.3, Months: 3, Burstiness: 0.3, ReplyRatio: 0.2}
+
+	generate := func() ([]seedUser, []seedTweet) {
+		g := newGenerator(dbtest.New(t), 42, 10, graph, now)
+		users, err := g.generateUsers(ctx, 5, "password")
+		if err != nil {
+			t.Fatal(err)
+		}
+		tweets, err := g.generateTweets(ctx, 20)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return users, tweets
+	}
+	users1, tweets1 := generate()
+	users2, tweets2 := generate()
+	if !reflect.DeepEqual(users1, users2) {
+		t.Errorf("users differ:\n%v\n%v", users1, users2)
+	}
+	if !reflect.DeepEqual(tweets1, tweets2) {
+		t.Errorf("tweets differ:\n%v\n%v", tweets1, tweets2)
+	}
+}
@@ -0,0 +1,206 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"time"
+)
+
+// 分布の種類
+const (
+	DistributionUniform  = "uniform"
+	DistributionPowerLaw = "powerlaw"
+)
+
+// graphConfig はソーシャルグラフと投稿時刻の分布の設定
+type graphConfig struct {
+	// Distribution が powerlaw の場合、フォロワー数・投稿数・いいね数がべき乗則に従う
+	Distribution string
+	// Alpha はべき乗則の指数(Zipf分布の s)。大きいほど上位のユーザーに集中する
+	Alpha float64
+	// Celebrities は大量のフォロワーを持つアカウントの数
+	Celebrities int
+	// CelebrityShare はフォローのうちセレブリティに向かう割合
+	CelebrityShare float64
+
+	// Months は created_at を分散させる期間(0 の場合は全て現在時刻)
+	Months int
+	// Burstiness はイベント(バースト)の前後に集中して投稿されるツイートの割合
+	Burstiness float64
+	// ReplyRatio はリプライの連鎖を始めるツイートの割合
+	ReplyRatio float64
+}
+
+func (c graphConfig) validate() error {
+	if c.Distribution != DistributionUniform && c.Distribution != DistributionPowerLaw {
+		return fmt.Errorf("unknown distribution: %s", c.Distribution)
+	}
+	if c.Alpha <= 0 {
+		return fmt.Errorf("alpha must be positive")
+	}
+	for name, v := range map[string]float64{
+		"celebrity-share": c.CelebrityShare,
+		"burstiness":      c.Burstiness,
+		"reply-ratio":     c.ReplyRatio,
+	} {
+		if v < 0 || v > 1 {
+			return fmt.Errorf("%s must be between 0 and 1", name)
+		}
+	}
+	if c.Months < 0 || c.Celebrities < 0 {
+		return fmt.Errorf("months and celebrities must not be negative")
+	}
+	return nil
+}
+
+// picker は 0 から n-1 のインデックスを分布に従って選ぶ
+type picker interface {
+	pick() int
+}
+
+type uniformPicker struct {
+	rng *rand.Rand
+	n   int
+}
+
+func (p *uniformPicker) pick() int {
+	return p.rng.Intn(p.n)
+}
+
+// weightedPicker は累積重みの二分探索で重み付きの選択をする
+type weightedPicker struct {
+	rng        *rand.Rand
+	cumulative []float64
+}
+
+func newWeightedPicker(rng *rand.Rand, weights []float64) *weightedPicker {
+	cumulative := make([]float64, len(weights))
+	total := 0.0
+	for i, w := range weights {
+		total += w
+		cumulative[i] = total
+	}
+	return &weightedPicker{rng: rng, cumulative: cumulative}
+}
+
+func (p *weightedPicker) pick() int {
+	total := p.cumulative[len(p.cumulative)-1]
+	return sort.SearchFloat64s(p.cumulative, p.rng.Float64()*total)
+}
+
+// newActivityPicker はツイートやいいねをする側を選ぶ picker を返す
+func (c graphConfig) newActivityPicker(rng *rand.Rand, n int) picker {
+	if c.Distribution == DistributionUniform {
+		return &uniformPicker{rng: rng, n: n}
+	}
+	// 投稿やいいねの多さはフォロワー数とは独立させるため、重みの順序をシャッフルする
+	weights := powerLawWeights(n, c.Alpha)
+	rng.Shuffle(n, func(i, j int) { weights[i], weights[j] = weights[j], weights[i] })
+	return newWeightedPicker(rng, weights)
+}
+
+// newPopularityPicker はフォローされる側を選ぶ picker を返す。
+// powerlaw の場合、先頭の Celebrities 人に CelebrityShare の割合のフォローが集まる
+func (c graphConfig) newPopularityPicker(rng *rand.Rand, n int) picker {
+	if c.Distribution == DistributionUniform {
+		return &uniformPicker{rng: rng, n: n}
+	}
+
+	weights := powerLawWeights(n, c.Alpha)
+	celebrities := c.Celebrities
+	if celebrities > n {
+		celebrities = n
+	}
+	if celebrities == 0 || celebrities == n {
+		return newWeightedPicker(rng, weights)
+	}
+
+	// セレブリティの重みの合計が全体の CelebrityShare になるように調整する
+	var celebrityTotal, otherTotal float64
+	for i, w := range weights {
+		if i < celebrities {
+			celebrityTotal += w
+		} else {
+			otherTotal += w
+		}
+	}
+	for i := range weights {
+		if i < celebrities {
+			weights[i] *= c.CelebrityShare / celebrityTotal
+		} else {
+			weights[i] *= (1 - c.CelebrityShare) / otherTotal
+		}
+	}
+	return newWeightedPicker(rng, weights)
+}
+
+// powerLawWeights は順位 r に 1/r^alpha の重みを割り当てる。
+// 先頭ほど重みが大きい(セレブリティは先頭のインデックスになる)
+func powerLawWeights(n int, alpha float64) []float64 {
+	weights := make([]float64, n)
+	for i := range weights {
+		weights[i] = 1 / math.Pow(float64(i+1), alpha)
+	}
+	return weights
+}
+
+// timeline は created_at の時刻を生成する
+type timeline struct {
+	rng    *rand.Rand
+	start  time.Time
+	end    time.Time
+	bursts []time.Time
+	config graphConfig
+}
+
+func newTimeline(rng *rand.Rand, now time.Time, config graphConfig) *timeline {
+	t := &timeline{
+		rng:    rng,
+		start:  now.AddDate(0, -config.Months, 0),
+		end:    now,
+		config: config,
+	}
+
+	// 1ヶ月あたり4回程度のイベントを期間内に配置する
+	for i := 0; i < config.Months*4; i++ {
+		t.bursts = append(t.bursts, t.between(t.start, t.end))
+	}
+	return t
+}
+
+// between は [from, to] の一様な時刻を返す
+func (t *timeline) between(from, to time.Time) time.Time {
+	if !to.After(from) {
+		return from
+	}
+	return from.Add(time.Duration(t.rng.Int63n(int64(to.Sub(from)))))
+}
+
+// userCreatedAt はユーザーの登録日時を返す
+func (t *timeline) userCreatedAt() time.Time {
+	return t.between(t.start, t.end)
+}
+
+// tweetCreatedAt は after より後の投稿日時を返す。
+// Burstiness の割合でイベントの前後数時間に集中させる
+func (t *timeline) tweetCreatedAt(after time.Time) time.Time {
+	if len(t.bursts) > 0 && t.rng.Float64() < t.config.Burstiness {
+		burst := t.bursts[t.rng.Intn(len(t.bursts))]
+		at := burst.Add(time.Duration(t.rng.NormFloat64() * float64(2*time.Hour)))
+		if at.After(after) && at.Before(t.end) {
+			return at
+		}
+	}
+	return t.between(after, t.end)
+}
+
+// reactionAt は after の後に平均 mean の指数分布で遅れた反応(リプライ・いいね・フォロー)の日時を返す
+func (t *timeline) reactionAt(after time.Time, mean time.Duration) time.Time {
+	at := after.Add(time.Duration(t.rng.ExpFloat64() * float64(mean)))
+	if at.After(t.end) {
+		return t.end
+	}
+	return at
+}
@@ -2,198 +2,149 @@ package main
 
 import (
 	"context"
-	"database/sql"
+	"flag"
 	"fmt"
 	"log"
-	"math/rand"
-	"os"
 	"time"
 
 	"todoapp/internal/infrastructure"
-	"todoapp/internal/schema"
-
-	"github.com/brianvoe/gofakeit/v6"
-	"github.com/volatiletech/null/v8"
-	"github.com/volatiletech/sqlboiler/v4/boil"
-	"golang.org/x/crypto/bcrypt"
-)
-
-const (
-	NumUsers    = 1000  // 生成するユーザー数
-	NumTweets   = 10000 // 生成するツイート数
-	NumFollows  = 5000  // 生成するフォロー関係数
-	NumLikes    = 8000  // 生成するいいね数
-	BatchSize   = 100   // 一度に挿入するレコード数
-	MaxTweetLen = 280   // ツイートの最大文字数
+	"todoapp/internal/user/repository"
 )
 
 func main() {
+	numUsers := flag.Int("users", 1000, "生成するユーザー数")
+	numTweets := flag.Int("tweets", 10000, "生成するツイート数")
+	numFollows := flag.Int("follows", 5000, "生成するフォロー関係数")
+	numLikes := flag.Int("likes", 8000, "生成するいいね数")
+	batchSize := flag.Int("batch", 500, "1つのINSERT文で挿入するレコード数")
+	seed := flag.Int64("seed", 1, "乱数のシード値(同じ値なら同じデータを生成する)")
+	password := flag.String("password", DefaultPassword, "全ユーザー共通のパスワード")
+	truncateTables := flag.Bool("truncate", false, "生成前に既存のデータを全て削除する")
+	var fixturePaths []string
+	flag.Func("fixture", "ランダム生成の代わりに読み込むフィクスチャファイル(YAML / JSON、複数指定可)", func(path string) error {
+		fixturePaths = append(fixturePaths, path)
+		return nil
+	})
+	graph := graphConfig{}
+	flag.StringVar(&graph.Distribution, "distribution", DistributionUniform, "フォロー・投稿・いいねの分布(uniform / powerlaw)")
+	flag.Float64Var(&graph.Alpha, "alpha", 1.1, "powerlaw の指数(大きいほど一部のユーザーに集中する)")
+	flag.IntVar(&graph.Celebrities, "celebrities", 5, "powerlaw で大量のフォロワーを持つアカウントの数")
+	flag.Float64Var(&graph.CelebrityShare, "celebrity-share", 0.3, "powerlaw でフォローのうちセレブリティに向かう割合")
+	flag.IntVar(&graph.Months, "months", 0, "created_at を過去何ヶ月に分散させるか(0 は全て現在時刻)")
+	flag.Float64Var(&graph.Burstiness, "burstiness", 0, "イベントの前後に集中して投稿されるツイートの割合(0-1)")
+	flag.Float64Var(&graph.ReplyRatio, "reply-ratio", 0, "リプライの連鎖を始めるツイートの割合(0-1)")
+	exportPath := flag.String("export", "", "現在のデータをフィクスチャファイルに書き出して終了する")
+	nowFlag := flag.String("now", "", "created_at の基準時刻(RFC3339)。省略すると現在時刻を使う。同じ -seed と -now なら同じデータを生成する")
+	flag.Parse()
+
+	if *batchSize <= 0 {
+		log.Fatal("batch は1以上を指定してください")
+	}
+	if err := graph.validate(); err != nil {
+		log.Fatal("分布の設定エラー: ", err)
+	}
+	now, err := parseNow(*nowFlag)
+	if err != nil {
+		log.Fatal("now の指定エラー: ", err)
+	}
+
 	// データベース接続
-	db, err := infrastructure.NewDB(
-		os.Getenv("DB_HOST"),
-		os.Getenv("DB_PORT"),
-		os.Getenv("DB_USER"),
-		os.Getenv("DB_PASSWORD"),
-		os.Getenv("DB_NAME"),
-	)
+	dbConfig, err := infrastructure.DBConfigFromEnv()
+	if err != nil {
+		log.Fatal("データベース設定エラー: ", err)
+	}
+	if dbConfig.Driver != infrastructure.DriverMySQL {
+		log.Fatal("シードデータの生成は mysql のみ対応しています")
+	}
+	db, err := infrastructure.NewDB(dbConfig)
 	if err != nil {
 		log.Fatal("データベース接続エラー: ", err)
 	}
 	defer db.Close()
 
-	// シード値を設定
-	rand.Seed(time.Now().UnixNano())
-	gofakeit.Seed(time.Now().UnixNano())
-
-	// ユーザーの生成
-	userIDs := generateUsers(db)
-	fmt.Printf("生成されたユーザー: %d件\n", len(userIDs))
-
-	// ツイートの生成
-	tweetIDs := generateTweets(db, userIDs)
-	fmt.Printf("生成されたツイート: %d件\n", len(tweetIDs))
+	ctx := context.Background()
 
-	// フォロー関係の生成
-	generateFollows(db, userIDs)
-	fmt.Printf("生成されたフォロー関係: %d件\n", NumFollows)
-
-	// いいねの生成
-	generateLikes(db, userIDs, tweetIDs)
-	fmt.Printf("生成されたいいね: %d件\n", NumLikes)
-}
-
-func generateUsers(db *sql.DB) []int {
-	userIDs := make([]int, 0, NumUsers)
-	users := make([]*schema.User, 0, BatchSize)
-
-	for i := 0; i < NumUsers; i++ {
-		hashedPassword, _ := bcrypt.GenerateFromPassword([]byte("password123"), bcrypt.DefaultCost)
-		user := &schema.User{
-			Username:        gofakeit.Username(),
-			DisplayName:     gofakeit.Name(),
-			Email:           gofakeit.Email(),
-			PasswordHash:    string(hashedPassword),
-			Bio:             null.StringFrom(gofakeit.Sentence(10)),
-			ProfileImageURL: null.StringFrom(gofakeit.ImageURL(400, 400)),
+	if *exportPath != "" {
+		fixture, err := exportFixture(ctx, db)
+		if err != nil {
+			log.Fatal("エクスポートエラー: ", err)
 		}
-		users = append(users, user)
-
-		// バッチサイズに達したらインサート
-		if len(users) == BatchSize || i == NumUsers-1 {
-			err := user.Insert(context.Background(), db, boil.Infer())
-			if err != nil {
-				log.Printf("ユーザー作成エラー: %v\n", err)
-				continue
-			}
-			userIDs = append(userIDs, user.ID)
-			users = users[:0] // スライスをクリア
+		if err := writeFixture(*exportPath, fixture); err != nil {
+			log.Fatal("エクスポートエラー: ", err)
 		}
+		fmt.Printf("%s に書き出しました\n", *exportPath)
+		return
 	}
 
-	return userIDs
-}
-
-func generateTweets(db *sql.DB, userIDs []int) []int {
-	tweetIDs := make([]int, 0, NumTweets)
-	tweets := make([]*schema.Tweet, 0, BatchSize)
-
-	for i := 0; i < NumTweets; i++ {
-		// ツイート内容の生成(最大280文字)
-		content := gofakeit.Sentence(rand.Intn(20) + 1) // 1-20文の文章
-		if len(content) > MaxTweetLen {
-			content = content[:MaxTweetLen]
-		}
-
-		// 画像付きツイートの確率(30%)
-		var imageURL null.String
-		if rand.Float32() < 0.3 {
-			imageURL = null.StringFrom(gofakeit.ImageURL(1200, 675)) // 16:9のアスペクト比
+	if *truncateTables {
+		if err := truncate(ctx, db); err != nil {
+			log.Fatal("データ削除エラー: ", err)
 		}
+		fmt.Println("既存のデータを削除しました")
+	}
 
-		tweet := &schema.Tweet{
-			UserID:   userIDs[rand.Intn(len(userIDs))],
-			Content:  content,
-			ImageURL: imageURL,
-		}
-		tweets = append(tweets, tweet)
-
-		// バッチサイズに達したらインサート
-		if len(tweets) == BatchSize || i == NumTweets-1 {
-			err := tweet.Insert(context.Background(), db, boil.Infer())
+	if len(fixturePaths) > 0 {
+		txManager := infrastructure.NewTxManager(db, nil)
+		for _, path := range fixturePaths {
+			fixture, err := readFixture(path)
 			if err != nil {
-				log.Printf("ツイート作成エラー: %v\n", err)
-				continue
+				log.Fatal("フィクスチャ読み込みエラー: ", err)
 			}
-			tweetIDs = append(tweetIDs, tweet.ID)
-			tweets = tweets[:0] // スライスをクリア
+			if err := loadFixture(ctx, txManager, db, fixture); err != nil {
+				log.Fatalf("フィクスチャ %s の投入エラー: %v", path, err)
+			}
+			fmt.Printf("%s: ユーザー %d件, ツイート %d件, フォロー %d件, いいね %d件\n",
+				path, len(fixture.Users), len(fixture.Tweets), len(fixture.Follows), len(fixture.Likes))
 		}
+		return
 	}
 
-	return tweetIDs
-}
+	// 同じデータを作り直せるように、使った基準時刻を表示する
+	fmt.Printf("シード値: %d, 基準時刻: %s\n", *seed, now.Format(time.RFC3339))
+	g := newGenerator(db, *seed, *batchSize, graph, now)
 
-func generateFollows(db *sql.DB, userIDs []int) {
-	follows := make([]*schema.Follow, 0, BatchSize)
-	seen := make(map[string]bool)
+	// ユーザーの生成
+	users, err := g.generateUsers(ctx, *numUsers, *password)
+	if err != nil {
+		log.Fatal("ユーザー作成エラー: ", err)
+	}
+	fmt.Printf("生成されたユーザー: %d件\n", len(users))
 
-	for i := 0; i < NumFollows; i++ {
-		followerID := userIDs[rand.Intn(len(userIDs))]
-		followingID := userIDs[rand.Intn(len(userIDs))]
+	// ツイートの生成
+	tweets, err := g.generateTweets(ctx, *numTweets)
+	if err != nil {
+		log.Fatal("ツイート作成エラー: ", err)
+	}
+	fmt.Printf("生成されたツイート: %d件\n", len(tweets))
 
-		// 自分自身をフォローしない、重複もスキップ
-		key := fmt.Sprintf("%d-%d", followerID, followingID)
-		if followerID == followingID || seen[key] {
-			continue
-		}
-		seen[key] = true
+	// フォロー関係の生成
+	follows, err := g.generateFollows(ctx, *numFollows)
+	if err != nil {
+		log.Fatal("フォロー作成エラー: ", err)
+	}
+	fmt.Printf("生成されたフォロー関係: %d件\n", follows)
 
-		follow := &schema.Follow{
-			FollowerID:  followerID,
-			FollowingID: followingID,
-		}
-		follows = append(follows, follow)
+	// いいねの生成
+	likes, err := g.generateLikes(ctx, *numLikes)
+	if err != nil {
+		log.Fatal("いいね作成エラー: ", err)
+	}
+	fmt.Printf("生成されたいいね: %d件\n", likes)
 
-		// バッチサイズに達したらインサート
-		if len(follows) == BatchSize || i == NumFollows-1 {
-			err := follow.Insert(context.Background(), db, boil.Infer())
-			if err != nil {
-				log.Printf("フォロー作成エラー: %v\n", err)
-				continue
-			}
-			follows = follows[:0] // スライスをクリア
-		}
+	// 一括で挿入したツイートとフォローから users の件数を設定する
+	if _, err := repository.RecountUserCounts(ctx, db); err != nil {
+		log.Fatal("件数の集計エラー: ", err)
 	}
 }
 
-func generateLikes(db *sql.DB, userIDs []int, tweetIDs []int) {
-	likes := make([]*schema.Like, 0, BatchSize)
-	seen := make(map[string]bool)
-
-	for i := 0; i < NumLikes; i++ {
-		userID := userIDs[rand.Intn(len(userIDs))]
-		tweetID := tweetIDs[rand.Intn(len(tweetIDs))]
-
-		// 重複をスキップ
-		key := fmt.Sprintf("%d-%d", userID, tweetID)
-		if seen[key] {
-			continue
-		}
-		seen[key] = true
-
-		like := &schema.Like{
-			UserID:  userID,
-			TweetID: tweetID,
-		}
-		likes = append(likes, like)
-
-		// バッチサイズに達したらインサート
-		if len(likes) == BatchSize || i == NumLikes-1 {
-			err := like.Insert(context.Background(), db, boil.Infer())
-			if err != nil {
-				log.Printf("いいね作成エラー: %v\n", err)
-				continue
-			}
-			likes = likes[:0] // スライスをクリア
-		}
+// parseNow は -now の値を解析する。空なら現在時刻を、表示して再指定できるように秒単位に切り捨てて返す
+func parseNow(value string) (time.Time, error) {
+	if value == "" {
+		return time.Now().UTC().Truncate(time.Second), nil
+	}
+	now, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, err
 	}
+	return now.UTC(), nil
 }
@@ -0,0 +1,69 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+	"todoapp/internal/audit/model"
+	"todoapp/internal/audit/usecase"
+
+	"github.com/labstack/echo/v4"
+)
+
+type AuditHandler struct {
+	usecase usecase.AuditUsecase
+	// admins は監査ログを検索できるユーザーのID
+	admins map[int]bool
+}
+
+func NewAuditHandler(u usecase.AuditUsecase, adminUserIDs []int) *AuditHandler {
+	admins := make(map[int]bool, len(adminUserIDs))
+	for _, id := range adminUserIDs {
+		admins[id] = true
+	}
+	return &AuditHandler{
+		usecase: u,
+		admins:  admins,
+	}
+}
+
+// AdminOnly は管理者以外のリクエストを 403 で拒否する。認証ミドルウェアの後に登録すること
+func (h *AuditHandler) AdminOnly(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		userID, ok := c.Get("user_id").(int)
+		if !ok || !h.admins[userID] {
+			return c.JSON(http.StatusForbidden, map[string]string{"error": "Forbidden"})
+		}
+		return next(c)
+	}
+}
+
+// List は監査ログを新しい順に返す。
+// クエリパラメーター: event_type, actor_id, target_type, target_id, since, until(RFC3339), before_id, limit
+func (h *AuditHandler) List(c echo.Context) error {
+	var filter model.ListFilter
+	if err := (&echo.DefaultBinder{}).BindQueryParams(c, &filter); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid query parameters"})
+	}
+
+	var err error
+	if filter.Since, err = parseTime(c.QueryParam("since")); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid since"})
+	}
+	if filter.Until, err = parseTime(c.QueryParam("until")); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid until"})
+	}
+
+	resp, err := h.usecase.List(c.Request().Context(), filter)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+func parseTime(s string) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+	return time.Parse(time.RFC3339, s)
+}
@@ -0,0 +1,180 @@
+package handler_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"todoapp/internal/audit"
+	"todoapp/internal/audit/handler"
+	"todoapp/internal/audit/model"
+	"todoapp/internal/audit/repository"
+	"todoapp/internal/router"
+	"todoapp/internal/testutil"
+	"unicode/utf8"
+
+	"github.com/labstack/echo/v4"
+)
+
+// adminID は newTestServer で最初に登録するユーザーのID
+const adminID = 1
+
+func newTestServer(t *testing.T) *echo.Echo {
+	t.Helper()
+	repository.RegisterModelHooks()
+	env := testutil.NewEnv(t)
+	return env.Server(router.Handlers{Audit: handler.NewAuditHandler(env.Audit, []int{adminID})}, audit.Middleware)
+}
+
+// doRequest はリクエストIDに "req-" とメソッド名を付けてリクエストする
+func doRequest(t *testing.T, e *echo.Echo, method, path, token, body string) *httptest.ResponseRecorder {
+	t.Helper()
+	req := testutil.NewRequest(method, path, token, body)
+	req.Header.Set(echo.HeaderXRequestID, "req-"+method)
+	return testutil.Serve(e, req)
+}
+
+func listEvents(t *testing.T, e *echo.Echo, token, query string) model.ListResponse {
+	t.Helper()
+	rec := testutil.MustDo(t, e, http.MethodGet, "/api/admin/audit-events?"+query, token, "", http.StatusOK)
+	var resp model.ListResponse
+	testutil.Decode(t, rec, &resp)
+	return resp
+}
+
+func TestList(t *testing.T) {
+	e := newTestServer(t)
+	id, adminToken := testutil.RegisterAndLogin(t, e, "admin")
+	if id != adminID {
+		t.Fatalf("admin ID = %d, want %d", id, adminID)
+	}
+	aliceID, aliceToken := testutil.RegisterAndLogin(t, e, "alice")
+
+	if rec := doRequest(t, e, http.MethodPost, "/login", "", `{"email":"alice@example.com","password":"wrong"}`); rec.Code != http.StatusUnauthorized {
+		t.Fatalf("login: status = %d", rec.Code)
+	}
+	if rec := doRequest(t, e, http.MethodPut, "/api/users/me", aliceToken, `{"display_name":"Alice"}`); rec.Code != http.StatusOK {
+		t.Fatalf("update: status = %d, body = %s", rec.Code, rec.Body)
+	}
+
+	t.Run("forbidden for non-admin", func(t *testing.T) {
+		if rec := doRequest(t, e, http.MethodGet, "/api/admin/audit-events", aliceToken, ""); rec.Code != http.StatusForbidden {
+			t.Errorf("status = %d, want %d", rec.Code, http.StatusForbidden)
+		}
+		if rec := doRequest(t, e, http.MethodGet, "/api/admin/audit-events", "", ""); rec.Code != http.StatusUnauthorized {
+			t.Errorf("without token: status = %d, want %d", rec.Code, http.StatusUnauthorized)
+		}
+	})
+
+	t.Run("profile update", func(t *testing.T) {
+		resp := listEvents(t, e, adminToken, "event_type="+model.EventProfileUpdated+"&actor_id="+strconv.Itoa(aliceID))
+		if len(resp.Events) != 1 {
+			t.Fatalf("events = %+v", resp.Events)
+		}
+		got := resp.Events[0]
+		if got.TargetID == nil || *got.TargetID != aliceID || got.RequestID != "req-PUT" || got.IP == "" {
+			t.Errorf("event = %+v", got)
+		}
+		if change := got.Changes["display_name"]; change.Old != "alice" || change.New != "Alice" {
+			t.Errorf("changes = %+v", got.Changes)
+		}
+	})
+
+	t.Run("login failure", func(t *testing.T) {
+		resp := listEvents(t, e, adminToken, "event_type="+model.EventLoginFailed)
+		if len(resp.Events) != 1 || resp.Events[0].Details["reason"] != "wrong_password" {
+			t.Errorf("events = %+v", resp.Events)
+		}
+	})
+
+	t.Run("pagination", func(t *testing.T) {
+		first := listEvents(t, e, adminToken, "target_type=user&target_id="+strconv.Itoa(aliceID)+"&limit=1")
+		if len(first.Events) != 1 || first.NextBeforeID == nil {
+			t.Fatalf("first page = %+v", first)
+		}
+		next := listEvents(t, e, adminToken, "target_type=user&target_id="+strconv.Itoa(aliceID)+"&limit=1&before_id="+strconv.FormatInt(*first.NextBeforeID, 10))
+		if len(next.Events) != 1 || next.Events[0].ID >= first.Events[0].ID {
+			t.Errorf("next page = %+v", next)
+		}
+	})
+
+	t.Run("listing is audited", func(t *testing.T) {
+		resp := listEvents(t, e, adminToken, "event_type="+model.EventAuditListed+"&limit=200")
+		if len(resp.Events) == 0 || resp.Events[0].ActorID == nil || *resp.Events[0].ActorID != adminID {
+			t.Errorf("events = %+v", resp.Events)
+		}
+	})
+
+	t.Run("invalid query", func(t *testing.T) {
+		for _, query := range []string{"since=yesterday", "limit=abc"} {
+			if rec := doRequest(t, e, http.MethodGet, "/api/admin/audit-events?"+query, adminToken, ""); rec.Code != http.StatusBadRequest {
+				t.Errorf("%s: status = %d, want %d", query, rec.Code, http.StatusBadRequest)
+			}
+		}
+	})
+}
+
+// 列の長さを超えるヘッダーは切り詰めて記録し、記録する操作は失敗させない
+func TestLongHeaders(t *testing.T) {
+	e := newTestServer(t)
+	_, adminToken := testutil.RegisterAndLogin(t, e, "admin")
+
+	userAgent := strings.Repeat("あ", 300)
+	requestID := strings.Repeat("r", 100)
+	req := testutil.NewRequest(http.MethodPost, "/register", "", `{"username":"alice","display_name":"alice","email":"alice@example.com","password":"password123"}`)
+	req.Header.Set("User-Agent", userAgent)
+	req.Header.Set(echo.HeaderXRequestID, requestID)
+	if rec := testutil.Serve(e, req); rec.Code != http.StatusCreated {
+		t.Fatalf("register: status = %d, body = %s", rec.Code, rec.Body)
+	}
+
+	resp := listEvents(t, e, adminToken, "event_type="+model.EventUserCreated+"&limit=200")
+	if len(resp.Events) == 0 {
+		t.Fatal("no events")
+	}
+	got := resp.Events[0]
+	if got.UserAgent != strings.Repeat("あ", 255) || got.RequestID != requestID[:64] {
+		t.Errorf("user agent = %q (%d runes), request ID = %q", got.UserAgent, utf8.RuneCountInString(got.UserAgent), got.RequestID)
+	}
+}
+
+func TestClientIP(t *testing.T) {
+	tests := []struct {
+		name           string
+		trustedProxies string
+		want           string
+	}{
+		// httptest のリクエストの接続元は 192.0.2.1
+		{name: "ignores forwarded headers by default", want: "192.0.2.1"},
+		{name: "trusted proxy", trustedProxies: "192.0.2.0/24", want: "203.0.113.7"},
+		{name: "untrusted proxy", trustedProxies: "198.51.100.0/24", want: "192.0.2.1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("TRUSTED_PROXIES", tt.trustedProxies)
+			extractor, err := audit.IPExtractorFromEnv()
+			if err != nil {
+				t.Fatal(err)
+			}
+			e := newTestServer(t)
+			e.IPExtractor = extractor
+			_, adminToken := testutil.RegisterAndLogin(t, e, "admin")
+
+			req := testutil.NewRequest(http.MethodPost, "/login", "", `{"email":"admin@example.com","password":"wrong"}`)
+			req.Header.Set(echo.HeaderXForwardedFor, "203.0.113.7")
+			req.Header.Set(echo.HeaderXRealIP, "203.0.113.8")
+			testutil.Serve(e, req)
+
+			resp := listEvents(t, e, adminToken, "event_type="+model.EventLoginFailed)
+			if len(resp.Events) != 1 || resp.Events[0].IP != tt.want {
+				t.Errorf("events = %+v, want IP %s", resp.Events, tt.want)
+			}
+		})
+	}
+
+	t.Setenv("TRUSTED_PROXIES", "not-a-cidr")
+	if _, err := audit.IPExtractorFromEnv(); err == nil {
+		t.Error("IPExtractorFromEnv accepted an invalid CIDR")
+	}
+}
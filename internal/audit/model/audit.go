@@ -0,0 +1,70 @@
+package model
+
+import "time"
+
+// 監査ログのイベントの種類
+const (
+	EventLoginSucceeded  = "auth.login_succeeded"
+	EventLoginFailed     = "auth.login_failed"
+	EventUserCreated     = "user.created"
+	EventPasswordChanged = "user.password_changed"
+	EventEmailChanged    = "user.email_changed"
+	EventProfileUpdated  = "user.profile_updated"
+	EventUserDeactivated = "user.deactivated"
+	EventUserReactivated = "user.reactivated"
+	EventUserDeleted     = "user.deleted" // 猶予期間後の物理削除
+	EventExportRequested = "user.data_export_requested"
+	EventTweetUpdated    = "tweet.updated"
+	EventTweetDeleted    = "tweet.deleted" // 論理削除(猶予期間後の物理削除は記録しない)
+	EventAuditListed     = "admin.audit_events_listed"
+)
+
+// 操作対象の種類
+const (
+	TargetUser  = "user"
+	TargetTweet = "tweet"
+)
+
+type Event struct {
+	ID         int64             `json:"id"`
+	Type       string            `json:"event_type"`
+	ActorID    *int              `json:"actor_id,omitempty"`
+	TargetType string            `json:"target_type,omitempty"`
+	TargetID   *int              `json:"target_id,omitempty"`
+	IP         string            `json:"ip,omitempty"`
+	UserAgent  string            `json:"user_agent,omitempty"`
+	RequestID  string            `json:"request_id,omitempty"`
+	Changes    map[string]Change `json:"changes,omitempty"`
+	Details    map[string]string `json:"details,omitempty"`
+	CreatedAt  time.Time         `json:"created_at"`
+}
+
+// Change は変更された列の変更前と変更後の値。
+// パスワードハッシュのような値は Redacted に置き換える
+type Change struct {
+	Old interface{} `json:"old"`
+	New interface{} `json:"new"`
+}
+
+// Redacted は監査ログに値を残さない列の代わりに記録する
+const Redacted = "[redacted]"
+
+// ListFilter は監査ログの検索条件。ゼロ値の項目は条件に含めない
+type ListFilter struct {
+	EventType  string `query:"event_type"`
+	ActorID    int    `query:"actor_id"`
+	TargetType string `query:"target_type"`
+	TargetID   int    `query:"target_id"`
+	// Since 以降、Until より前に記録されたイベントに絞り込む
+	Since time.Time `query:"-"`
+	Until time.Time `query:"-"`
+	// BeforeID より古い(ID が小さい)イベントを返す。前のページの NextBeforeID を指定する
+	BeforeID int64 `query:"before_id"`
+	Limit    int   `query:"limit"`
+}
+
+// ListResponse は新しい順のイベントと、続きがある場合に次のページの BeforeID に指定する値
+type ListResponse struct {
+	Events       []*Event `json:"events"`
+	NextBeforeID *int64   `json:"next_before_id,omitempty"`
+}
@@ -0,0 +1,180 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"todoapp/internal/audit/model"
+	"todoapp/internal/infrastructure"
+	"todoapp/internal/schema"
+	"unicode/utf8"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// AuditRepository は監査ログを追記・検索する。監査ログは追記のみなので更新・削除のメソッドは持たない
+type AuditRepository interface {
+	Create(ctx context.Context, event *model.Event) error
+	// List は filter に一致するイベントを新しい順に filter.Limit 件まで返す
+	List(ctx context.Context, filter model.ListFilter) ([]*model.Event, error)
+	// ListByUser は userID が操作したか、userID に対して行われたイベントを古い順に返す(データのエクスポート用)
+	ListByUser(ctx context.Context, userID int) ([]*model.Event, error)
+}
+
+type auditRepository struct {
+	db boil.ContextExecutor
+	// readDB は読み取り専用のメソッドで使う(リードレプリカがなければ db と同じ)
+	readDB boil.ContextExecutor
+}
+
+func NewAuditRepository(db, readDB boil.ContextExecutor) AuditRepository {
+	return &auditRepository{db: db, readDB: readDB}
+}
+
+// Create はトランザクション内で呼ばれた場合、記録対象の変更と一緒にコミットされる
+func (r *auditRepository) Create(ctx context.Context, event *model.Event) error {
+	return insertEvent(ctx, infrastructure.Executor(ctx, r.db), event)
+}
+
+func (r *auditRepository) List(ctx context.Context, filter model.ListFilter) ([]*model.Event, error) {
+	mods := []qm.QueryMod{
+		qm.OrderBy(schema.AuditEventColumns.ID + " DESC"),
+		qm.Limit(filter.Limit),
+	}
+	if filter.EventType != "" {
+		mods = append(mods, schema.AuditEventWhere.EventType.EQ(filter.EventType))
+	}
+	if filter.ActorID != 0 {
+		mods = append(mods, schema.AuditEventWhere.ActorID.EQ(null.IntFrom(filter.ActorID)))
+	}
+	if filter.TargetType != "" {
+		mods = append(mods, schema.AuditEventWhere.TargetType.EQ(null.StringFrom(filter.TargetType)))
+	}
+	if filter.TargetID != 0 {
+		mods = append(mods, schema.AuditEventWhere.TargetID.EQ(null.IntFrom(filter.TargetID)))
+	}
+	if !filter.Since.IsZero() {
+		mods = append(mods, schema.AuditEventWhere.CreatedAt.GTE(null.TimeFrom(filter.Since.UTC())))
+	}
+	if !filter.Until.IsZero() {
+		mods = append(mods, schema.AuditEventWhere.CreatedAt.LT(null.TimeFrom(filter.Until.UTC())))
+	}
+	if filter.BeforeID != 0 {
+		mods = append(mods, schema.AuditEventWhere.ID.LT(filter.BeforeID))
+	}
+
+	dbEvents, err := schema.AuditEvents(mods...).All(ctx, infrastructure.Executor(ctx, r.readDB))
+	if err != nil {
+		return nil, err
+	}
+	return convertAll(dbEvents)
+}
+
+func (r *auditRepository) ListByUser(ctx context.Context, userID int) ([]*model.Event, error) {
+	dbEvents, err := schema.AuditEvents(
+		schema.AuditEventWhere.ActorID.EQ(null.IntFrom(userID)),
+		qm.Or2(qm.Expr(
+			schema.AuditEventWhere.TargetType.EQ(null.StringFrom(model.TargetUser)),
+			schema.AuditEventWhere.TargetID.EQ(null.IntFrom(userID)),
+		)),
+		qm.OrderBy(schema.AuditEventColumns.ID),
+	).All(ctx, infrastructure.Executor(ctx, r.readDB))
+	if err != nil {
+		return nil, err
+	}
+	return convertAll(dbEvents)
+}
+
+func convertAll(dbEvents schema.AuditEventSlice) ([]*model.Event, error) {
+	events := make([]*model.Event, 0, len(dbEvents))
+	for _, dbEvent := range dbEvents {
+		event, err := convertToModel(dbEvent)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+// insertEvent は exec で監査ログを挿入する。sqlboiler のフックからは、
+// 記録対象の変更と同じ exec(トランザクション)で呼ばれる
+func insertEvent(ctx context.Context, exec boil.ContextExecutor, event *model.Event) error {
+	dbEvent := &schema.AuditEvent{
+		EventType:  event.Type,
+		ActorID:    null.IntFromPtr(event.ActorID),
+		TargetType: nullString(event.TargetType),
+		TargetID:   null.IntFromPtr(event.TargetID),
+		IP:         nullString(truncate(event.IP, maxIPLength)),
+		UserAgent:  nullString(truncate(event.UserAgent, maxUserAgentLength)),
+		RequestID:  nullString(truncate(event.RequestID, maxRequestIDLength)),
+	}
+	if len(event.Changes) > 0 {
+		changes, err := json.Marshal(event.Changes)
+		if err != nil {
+			return err
+		}
+		dbEvent.Changes = null.JSONFrom(changes)
+	}
+	if len(event.Details) > 0 {
+		details, err := json.Marshal(event.Details)
+		if err != nil {
+			return err
+		}
+		dbEvent.Details = null.JSONFrom(details)
+	}
+
+	if err := dbEvent.Insert(ctx, exec, boil.Infer()); err != nil {
+		return err
+	}
+
+	event.ID = dbEvent.ID
+	event.CreatedAt = dbEvent.CreatedAt.Time
+	return nil
+}
+
+func convertToModel(dbEvent *schema.AuditEvent) (*model.Event, error) {
+	event := &model.Event{
+		ID:         dbEvent.ID,
+		Type:       dbEvent.EventType,
+		ActorID:    dbEvent.ActorID.Ptr(),
+		TargetType: dbEvent.TargetType.String,
+		TargetID:   dbEvent.TargetID.Ptr(),
+		IP:         dbEvent.IP.String,
+		UserAgent:  dbEvent.UserAgent.String,
+		RequestID:  dbEvent.RequestID.String,
+		CreatedAt:  dbEvent.CreatedAt.Time,
+	}
+	if dbEvent.Changes.Valid {
+		if err := json.Unmarshal(dbEvent.Changes.JSON, &event.Changes); err != nil {
+			return nil, err
+		}
+	}
+	if dbEvent.Details.Valid {
+		if err := json.Unmarshal(dbEvent.Details.JSON, &event.Details); err != nil {
+			return nil, err
+		}
+	}
+	return event, nil
+}
+
+// リクエストのヘッダーから記録する値の列の長さ(文字数)。
+// 長いヘッダーで記録対象の操作まで失敗しないよう、超える分は切り詰める
+const (
+	maxIPLength        = 45
+	maxUserAgentLength = 255
+	maxRequestIDLength = 64
+)
+
+// truncate は s を先頭から最大 n 文字にする
+func truncate(s string, n int) string {
+	if utf8.RuneCountInString(s) <= n {
+		return s
+	}
+	return string([]rune(s)[:n])
+}
+
+func nullString(s string) null.String {
+	return null.NewString(s, s != "")
+}
@@ -0,0 +1,193 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+	"todoapp/internal/audit"
+	"todoapp/internal/audit/model"
+	"todoapp/internal/dbtest"
+	"todoapp/internal/schema"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestAuditRepositoryList(t *testing.T) {
+	ctx := context.Background()
+	db := dbtest.New(t)
+	repo := NewAuditRepository(db, db)
+
+	actor, target := 1, 2
+	events := []*model.Event{
+		{Type: model.EventLoginFailed, Details: map[string]string{"email": "a@example.com"}},
+		{Type: model.EventLoginSucceeded, ActorID: &actor, TargetType: model.TargetUser, TargetID: &actor, IP: "192.0.2.1"},
+		{Type: model.EventProfileUpdated, ActorID: &actor, TargetType: model.TargetUser, TargetID: &target,
+			Changes: map[string]model.Change{"bio": {Old: nil, New: "hello"}}},
+	}
+	for _, e := range events {
+		if err := repo.Create(ctx, e); err != nil {
+			t.Fatalf("Create: %v", err)
+		}
+		if e.ID == 0 {
+			t.Fatal("ID is not assigned")
+		}
+	}
+
+	tests := []struct {
+		name    string
+		filter  model.ListFilter
+		wantIDs []int64
+	}{
+		{"all newest first", model.ListFilter{Limit: 10}, []int64{events[2].ID, events[1].ID, events[0].ID}},
+		{"limit", model.ListFilter{Limit: 2}, []int64{events[2].ID, events[1].ID}},
+		{"before id", model.ListFilter{Limit: 10, BeforeID: events[2].ID}, []int64{events[1].ID, events[0].ID}},
+		{"event type", model.ListFilter{Limit: 10, EventType: model.EventLoginFailed}, []int64{events[0].ID}},
+		{"actor", model.ListFilter{Limit: 10, ActorID: actor}, []int64{events[2].ID, events[1].ID}},
+		{"target", model.ListFilter{Limit: 10, TargetType: model.TargetUser, TargetID: target}, []int64{events[2].ID}},
+		{"since", model.ListFilter{Limit: 10, Since: time.Now().Add(time.Hour)}, nil},
+		{"until", model.ListFilter{Limit: 10, Until: time.Now().Add(-time.Hour)}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := repo.List(ctx, tt.filter)
+			if err != nil {
+				t.Fatalf("List: %v", err)
+			}
+			var ids []int64
+			for _, e := range got {
+				ids = append(ids, e.ID)
+			}
+			if len(ids) != len(tt.wantIDs) {
+				t.Fatalf("List IDs = %v, want %v", ids, tt.wantIDs)
+			}
+			for i := range ids {
+				if ids[i] != tt.wantIDs[i] {
+					t.Fatalf("List IDs = %v, want %v", ids, tt.wantIDs)
+				}
+			}
+		})
+	}
+
+	got, err := repo.List(ctx, model.ListFilter{Limit: 1})
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if change := got[0].Changes["bio"]; change.New != "hello" || change.Old != nil {
+		t.Errorf("Changes = %+v, want bio nil -> hello", got[0].Changes)
+	}
+}
+
+func TestModelHooks(t *testing.T) {
+	RegisterModelHooks()
+
+	db := dbtest.New(t)
+	repo := NewAuditRepository(db, db)
+	ctx := audit.WithRequestInfo(context.Background(), &audit.RequestInfo{ActorID: 7, IP: "192.0.2.1", RequestID: "req-1"})
+
+	user := &schema.User{Username: "alice", DisplayName: "Alice", Email: "alice@example.com", PasswordHash: "hash1"}
+	if err := user.Insert(ctx, db, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	user.DisplayName = "Alice Liddell"
+	if _, err := user.Update(ctx, db, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	user.PasswordHash = "hash2"
+	user.Bio.SetValid("hello")
+	if _, err := user.Update(ctx, db, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	// 変更がなければ記録しない
+	if _, err := user.Update(ctx, db, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	events, err := repo.List(ctx, model.ListFilter{Limit: 10, TargetType: model.TargetUser, TargetID: user.ID})
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if len(events) != 4 {
+		t.Fatalf("got %d events, want 4: %+v", len(events), events)
+	}
+
+	// パスワードと一緒に変更した列は、プロフィールの更新として別に記録する
+	bio, password, profile, created := events[0], events[1], events[2], events[3]
+	if created.Type != model.EventUserCreated || created.Changes["username"].New != "alice" {
+		t.Errorf("created = %+v", created)
+	}
+	if profile.Type != model.EventProfileUpdated || len(profile.Changes) != 1 ||
+		profile.Changes["display_name"] != (model.Change{Old: "Alice", New: "Alice Liddell"}) {
+		t.Errorf("profile update = %+v", profile)
+	}
+	if password.Type != model.EventPasswordChanged || len(password.Changes) != 1 ||
+		password.Changes["password_hash"] != (model.Change{Old: model.Redacted, New: model.Redacted}) {
+		t.Errorf("password change = %+v", password)
+	}
+	if bio.Type != model.EventProfileUpdated || len(bio.Changes) != 1 ||
+		bio.Changes["bio"] != (model.Change{Old: nil, New: "hello"}) {
+		t.Errorf("profile update with the password = %+v", bio)
+	}
+	if profile.ActorID == nil || *profile.ActorID != 7 || profile.IP != "192.0.2.1" || profile.RequestID != "req-1" {
+		t.Errorf("request info = actor %v, ip %q, request id %q", profile.ActorID, profile.IP, profile.RequestID)
+	}
+
+	// 退会と復帰は deleted_at の更新として記録する
+	for _, deletedAt := range []null.Time{null.TimeFrom(time.Now()), {}} {
+		user.DeletedAt = deletedAt
+		if _, err := user.Update(ctx, db, boil.Whitelist(schema.UserColumns.DeletedAt)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	events, err = repo.List(ctx, model.ListFilter{Limit: 2, TargetType: model.TargetUser, TargetID: user.ID})
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if len(events) != 2 || events[0].Type != model.EventUserReactivated || events[1].Type != model.EventUserDeactivated {
+		t.Errorf("deactivate and reactivate = %+v", events)
+	}
+
+	// 退会・パスワード・メールアドレス・プロフィールを1回で変更すると、それぞれのイベントを記録する
+	user.DeletedAt = null.TimeFrom(time.Now())
+	user.PasswordHash = "hash3"
+	user.Email = "liddell@example.com"
+	user.DisplayName = "Alice L."
+	if _, err := user.Update(ctx, db, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	events, err = repo.List(ctx, model.ListFilter{Limit: 4, TargetType: model.TargetUser, TargetID: user.ID})
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	wantChanges := map[string]string{
+		model.EventProfileUpdated:  "display_name",
+		model.EventEmailChanged:    "email",
+		model.EventPasswordChanged: "password_hash",
+		model.EventUserDeactivated: "deleted_at",
+	}
+	if len(events) != len(wantChanges) {
+		t.Fatalf("combined update = %+v, want %d events", events, len(wantChanges))
+	}
+	for _, event := range events {
+		col, ok := wantChanges[event.Type]
+		if _, changed := event.Changes[col]; !ok || !changed || len(event.Changes) != 1 {
+			t.Errorf("combined update event = %+v", event)
+		}
+		delete(wantChanges, event.Type)
+	}
+	if email := events[1].Changes["email"]; email != (model.Change{Old: "alice@example.com", New: "liddell@example.com"}) {
+		t.Errorf("email change = %+v", email)
+	}
+
+	if _, err := user.Delete(ctx, db, true); err != nil {
+		t.Fatal(err)
+	}
+	events, err = repo.List(ctx, model.ListFilter{Limit: 1, EventType: model.EventUserDeleted})
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if len(events) != 1 || events[0].Changes["email"].Old != "liddell@example.com" {
+		t.Errorf("deleted = %+v", events)
+	}
+}
@@ -0,0 +1,213 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"sync"
+	"todoapp/internal/audit"
+	"todoapp/internal/audit/model"
+	"todoapp/internal/schema"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+var registerHooksOnce sync.Once
+
+// RegisterModelHooks はsqlboilerのフックを登録し、users と tweets の作成・更新・削除を
+// 変更された列の差分と一緒に監査ログに記録する。監査ログは変更と同じ exec で挿入するので、
+// 変更がロールバックされれば監査ログも残らない。
+// フックは UpdateAll / DeleteAll や生のSQLでは実行されないので、それらの変更は記録されない
+func RegisterModelHooks() {
+	registerHooksOnce.Do(func() {
+		schema.AddUserHook(boil.AfterInsertHook, userCreated)
+		schema.AddUserHook(boil.BeforeUpdateHook, userUpdated)
+		schema.AddUserHook(boil.BeforeDeleteHook, userDeleted)
+		schema.AddTweetHook(boil.BeforeUpdateHook, tweetUpdated)
+		schema.AddTweetHook(boil.BeforeDeleteHook, tweetDeleted)
+	})
+}
+
+// userRedacted は値を監査ログに残さない列
+var userRedacted = map[string]bool{
+	schema.UserColumns.PasswordHash: true,
+}
+
+// userIgnored は変更されても監査ログに記録しない列(自動で更新される日時と件数)
+var userIgnored = map[string]bool{
+	schema.UserColumns.CreatedAt:      true,
+	schema.UserColumns.UpdatedAt:      true,
+	schema.UserColumns.FollowersCount: true,
+	schema.UserColumns.FollowingCount: true,
+	schema.UserColumns.TweetsCount:    true,
+}
+
+var tweetIgnored = map[string]bool{
+	schema.TweetColumns.CreatedAt: true,
+	schema.TweetColumns.UpdatedAt: true,
+}
+
+func userCreated(ctx context.Context, exec boil.ContextExecutor, o *schema.User) error {
+	changes, err := diffColumns(nil, o, userRedacted, userIgnored)
+	if err != nil {
+		return err
+	}
+	return record(ctx, exec, model.EventUserCreated, model.TargetUser, o.ID, changes)
+}
+
+// userEventColumns は他の列と一緒に変更されても、列ごとに別の種類のイベントとして記録する列
+var userEventColumns = []string{
+	schema.UserColumns.DeletedAt,
+	schema.UserColumns.PasswordHash,
+	schema.UserColumns.Email,
+}
+
+// userUpdated は更新前の行を読み込んで差分を記録する。
+// 退会と復帰、パスワードとメールアドレスの変更はそれぞれの種類のイベントに、
+// 残りの列の変更はまとめてプロフィールの更新として記録する
+func userUpdated(ctx context.Context, exec boil.ContextExecutor, o *schema.User) error {
+	// 退会中のユーザーの復帰も記録するため、論理削除した行も読み込む
+	old, err := schema.Users(qm.WithDeleted(), schema.UserWhere.ID.EQ(o.ID)).One(ctx, exec)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	changes, err := diffColumns(old, o, userRedacted, userIgnored)
+	if err != nil {
+		return err
+	}
+
+	for _, col := range userEventColumns {
+		change, ok := changes[col]
+		if !ok {
+			continue
+		}
+		delete(changes, col)
+		if err := record(ctx, exec, userEventType(col, o), model.TargetUser, o.ID, map[string]model.Change{col: change}); err != nil {
+			return err
+		}
+	}
+	if len(changes) == 0 {
+		return nil
+	}
+	return record(ctx, exec, model.EventProfileUpdated, model.TargetUser, o.ID, changes)
+}
+
+// userEventType は userEventColumns の列 col の変更を記録するイベントの種類を返す
+func userEventType(col string, o *schema.User) string {
+	switch col {
+	case schema.UserColumns.DeletedAt:
+		if o.DeletedAt.Valid {
+			return model.EventUserDeactivated
+		}
+		return model.EventUserReactivated
+	case schema.UserColumns.PasswordHash:
+		return model.EventPasswordChanged
+	}
+	return model.EventEmailChanged
+}
+
+// userDeleted は削除される行の値を記録する。
+// 退会(論理削除)は deleted_at の更新として userUpdated で記録する
+func userDeleted(ctx context.Context, exec boil.ContextExecutor, o *schema.User) error {
+	changes, err := diffColumns(o, nil, userRedacted, userIgnored)
+	if err != nil {
+		return err
+	}
+	return record(ctx, exec, model.EventUserDeleted, model.TargetUser, o.ID, changes)
+}
+
+func tweetUpdated(ctx context.Context, exec boil.ContextExecutor, o *schema.Tweet) error {
+	old, err := schema.Tweets(qm.WithDeleted(), schema.TweetWhere.ID.EQ(o.ID)).One(ctx, exec)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	changes, err := diffColumns(old, o, nil, tweetIgnored)
+	if err != nil || len(changes) == 0 {
+		return err
+	}
+	return record(ctx, exec, model.EventTweetUpdated, model.TargetTweet, o.ID, changes)
+}
+
+func tweetDeleted(ctx context.Context, exec boil.ContextExecutor, o *schema.Tweet) error {
+	changes, err := diffColumns(o, nil, nil, tweetIgnored)
+	if err != nil {
+		return err
+	}
+	return record(ctx, exec, model.EventTweetDeleted, model.TargetTweet, o.ID, changes)
+}
+
+func record(ctx context.Context, exec boil.ContextExecutor, eventType, targetType string, targetID int, changes map[string]model.Change) error {
+	event := &model.Event{
+		Type:       eventType,
+		TargetType: targetType,
+		TargetID:   &targetID,
+		Changes:    changes,
+	}
+	audit.FillRequestInfo(ctx, event)
+	return insertEvent(ctx, exec, event)
+}
+
+// diffColumns は old と new(sqlboilerのモデル、nil は行がないことを表す)の列の差分を返す。
+// 列名と値はモデルのJSON表現を使う(NULL の列は含まれない)
+func diffColumns(old, new interface{}, redacted, ignored map[string]bool) (map[string]model.Change, error) {
+	oldCols, err := columnValues(old)
+	if err != nil {
+		return nil, err
+	}
+	newCols, err := columnValues(new)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make(map[string]model.Change)
+	for _, cols := range []map[string]interface{}{oldCols, newCols} {
+		for name := range cols {
+			if ignored[name] {
+				continue
+			}
+			if _, done := changes[name]; done {
+				continue
+			}
+			oldValue, newValue := oldCols[name], newCols[name]
+			if reflect.DeepEqual(oldValue, newValue) {
+				continue
+			}
+			if redacted[name] {
+				oldValue, newValue = redact(oldValue), redact(newValue)
+			}
+			changes[name] = model.Change{Old: oldValue, New: newValue}
+		}
+	}
+	return changes, nil
+}
+
+func columnValues(row interface{}) (map[string]interface{}, error) {
+	if row == nil || reflect.ValueOf(row).IsNil() {
+		return nil, nil
+	}
+	data, err := json.Marshal(row)
+	if err != nil {
+		return nil, err
+	}
+	var cols map[string]interface{}
+	err = json.Unmarshal(data, &cols)
+	return cols, err
+}
+
+func redact(v interface{}) interface{} {
+	if v == nil {
+		return nil
+	}
+	return model.Redacted
+}
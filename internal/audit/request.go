@@ -0,0 +1,136 @@
+// Package audit は監査ログに記録するリクエストの情報(操作者、IP、User-Agent、リクエストID)を
+// コンテキストで受け渡す。記録は internal/audit/usecase と、sqlboilerのフックを登録する
+// internal/audit/repository が行う
+package audit
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"todoapp/internal/audit/model"
+
+	"github.com/labstack/echo/v4"
+)
+
+// RequestInfo は監査ログに記録するリクエストの情報
+type RequestInfo struct {
+	// ActorID は操作したユーザーのID(未ログインの場合は0)
+	ActorID   int
+	IP        string
+	UserAgent string
+	RequestID string
+}
+
+type requestInfoKey struct{}
+
+// WithRequestInfo は info を格納したコンテキストを返す
+func WithRequestInfo(ctx context.Context, info *RequestInfo) context.Context {
+	return context.WithValue(ctx, requestInfoKey{}, info)
+}
+
+// FromContext は ctx のリクエスト情報を返す。HTTPリクエスト以外(コマンドなど)では空の値を返す
+func FromContext(ctx context.Context) RequestInfo {
+	if info, ok := ctx.Value(requestInfoKey{}).(*RequestInfo); ok {
+		return *info
+	}
+	return RequestInfo{}
+}
+
+// Middleware はリクエストの情報をコンテキストに格納する。
+// リクエストIDを記録するには echo の middleware.RequestID より後に登録すること。
+// IPは e.IPExtractor で取り出す。未設定の echo はクライアントが送った X-Forwarded-For などを
+// そのまま使うので、IPExtractorFromEnv の値を設定すること
+func Middleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		req := c.Request()
+		requestID := req.Header.Get(echo.HeaderXRequestID)
+		if requestID == "" {
+			requestID = c.Response().Header().Get(echo.HeaderXRequestID)
+		}
+
+		info := &RequestInfo{
+			IP:        c.RealIP(),
+			UserAgent: req.UserAgent(),
+			RequestID: requestID,
+		}
+		c.Set(requestInfoContextKey, info)
+		c.SetRequest(req.WithContext(WithRequestInfo(req.Context(), info)))
+		return next(c)
+	}
+}
+
+// requestInfoContextKey は echo.Context に格納した *RequestInfo のキー
+const requestInfoContextKey = "audit.request_info"
+
+// ActorMiddleware は認証済みのユーザーIDを操作者として記録する。
+// 認証ミドルウェアが "user_id" を設定した後に実行されるよう登録すること
+func ActorMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		if info, ok := c.Get(requestInfoContextKey).(*RequestInfo); ok {
+			if userID, ok := c.Get("user_id").(int); ok {
+				info.ActorID = userID
+			}
+		}
+		return next(c)
+	}
+}
+
+// FillRequestInfo は e の操作者とリクエストの情報を ctx から補う。
+// 操作者が設定済みの場合(ログインの成功など)はそのままにする
+func FillRequestInfo(ctx context.Context, e *model.Event) {
+	info := FromContext(ctx)
+	if e.ActorID == nil && info.ActorID != 0 {
+		actorID := info.ActorID
+		e.ActorID = &actorID
+	}
+	e.IP = info.IP
+	e.UserAgent = info.UserAgent
+	e.RequestID = info.RequestID
+}
+
+// IPExtractorFromEnv は TRUSTED_PROXIES(カンマ区切りのCIDR)からクライアントのIPの取り出し方を返す。
+// 未設定の場合は接続元のアドレスを使う。設定した場合は、そのプロキシから届いたリクエストに限り
+// X-Forwarded-For を信頼し、信頼しないアドレスのうち最も近いものを使う
+func IPExtractorFromEnv() (echo.IPExtractor, error) {
+	v := os.Getenv("TRUSTED_PROXIES")
+	if v == "" {
+		return echo.ExtractIPDirect(), nil
+	}
+
+	// ループバックやプライベートのアドレスも、指定しない限り信頼しない
+	options := []echo.TrustOption{
+		echo.TrustLoopback(false),
+		echo.TrustLinkLocal(false),
+		echo.TrustPrivateNet(false),
+	}
+	for _, s := range strings.Split(v, ",") {
+		_, ipNet, err := net.ParseCIDR(strings.TrimSpace(s))
+		if err != nil {
+			return nil, fmt.Errorf("invalid TRUSTED_PROXIES: %q", v)
+		}
+		options = append(options, echo.TrustIPRange(ipNet))
+	}
+	return echo.ExtractIPFromXFFHeader(options...), nil
+}
+
+// AdminUserIDsFromEnv は AUDIT_ADMIN_USER_IDS(カンマ区切りのユーザーID)から
+// 監査ログを検索できる管理者を読み込む
+func AdminUserIDsFromEnv() ([]int, error) {
+	v := os.Getenv("AUDIT_ADMIN_USER_IDS")
+	if v == "" {
+		return nil, nil
+	}
+
+	var ids []int
+	for _, s := range strings.Split(v, ",") {
+		id, err := strconv.Atoi(strings.TrimSpace(s))
+		if err != nil || id <= 0 {
+			return nil, fmt.Errorf("invalid AUDIT_ADMIN_USER_IDS: %q", v)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
@@ -0,0 +1,101 @@
+package usecase
+
+import (
+	"context"
+	"strconv"
+	"time"
+	"todoapp/internal/audit"
+	"todoapp/internal/audit/model"
+	"todoapp/internal/audit/repository"
+)
+
+const (
+	defaultListLimit = 50
+	maxListLimit     = 200
+)
+
+// Recorder は監査ログを記録する。他の機能のユースケースはこのインターフェースに依存する
+type Recorder interface {
+	// Record は event にリクエストの情報を補って記録する
+	Record(ctx context.Context, event *model.Event) error
+}
+
+type AuditUsecase interface {
+	Recorder
+	// List は管理者による監査ログの検索。検索したこと自体も記録する
+	List(ctx context.Context, filter model.ListFilter) (*model.ListResponse, error)
+}
+
+type auditUsecase struct {
+	repo repository.AuditRepository
+}
+
+func NewAuditUsecase(repo repository.AuditRepository) AuditUsecase {
+	return &auditUsecase{repo: repo}
+}
+
+func (u *auditUsecase) Record(ctx context.Context, event *model.Event) error {
+	audit.FillRequestInfo(ctx, event)
+	return u.repo.Create(ctx, event)
+}
+
+func (u *auditUsecase) List(ctx context.Context, filter model.ListFilter) (*model.ListResponse, error) {
+	if filter.Limit <= 0 {
+		filter.Limit = defaultListLimit
+	}
+	if filter.Limit > maxListLimit {
+		filter.Limit = maxListLimit
+	}
+
+	// 1件多く読み、続きがあるかを判定する
+	limit := filter.Limit
+	filter.Limit++
+	events, err := u.repo.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	filter.Limit = limit
+
+	resp := &model.ListResponse{Events: events}
+	if len(events) > limit {
+		resp.Events = events[:limit]
+		next := resp.Events[limit-1].ID
+		resp.NextBeforeID = &next
+	}
+
+	err = u.Record(ctx, &model.Event{
+		Type:    model.EventAuditListed,
+		Details: filterDetails(filter),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// filterDetails は検索条件のうち指定されたものを記録用の文字列に変換する
+func filterDetails(filter model.ListFilter) map[string]string {
+	details := map[string]string{"limit": strconv.Itoa(filter.Limit)}
+	if filter.EventType != "" {
+		details["event_type"] = filter.EventType
+	}
+	if filter.ActorID != 0 {
+		details["actor_id"] = strconv.Itoa(filter.ActorID)
+	}
+	if filter.TargetType != "" {
+		details["target_type"] = filter.TargetType
+	}
+	if filter.TargetID != 0 {
+		details["target_id"] = strconv.Itoa(filter.TargetID)
+	}
+	if !filter.Since.IsZero() {
+		details["since"] = filter.Since.UTC().Format(time.RFC3339)
+	}
+	if !filter.Until.IsZero() {
+		details["until"] = filter.Until.UTC().Format(time.RFC3339)
+	}
+	if filter.BeforeID != 0 {
+		details["before_id"] = strconv.FormatInt(filter.BeforeID, 10)
+	}
+	return details
+}
@@ -0,0 +1,81 @@
+// Package blob はエクスポートしたファイルなどのバイナリを保存するストアを提供する。
+//
+// 実装はローカルのファイルシステム(NewLocal)だけだが、呼び出し側は Store にだけ依存するので、
+// オブジェクトストレージの実装を追加すれば設定で切り替えられる。
+// ダウンロードには期限付きの署名付きURL(SignedURL)を使う
+package blob
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+)
+
+// ErrNotFound はキーが存在しない場合に Open が返す
+var ErrNotFound = errors.New("blob: not found")
+
+type Store interface {
+	// Put は r の内容を key に保存する。同じキーがあれば置き換える
+	Put(ctx context.Context, key string, r io.Reader) error
+	// Open は key の内容を返す。ない場合は ErrNotFound を返す
+	Open(ctx context.Context, key string) (io.ReadCloser, error)
+	// Delete は key を削除する。存在しないキーは無視する
+	Delete(ctx context.Context, key string) error
+	// SignedURL は expires まで key をダウンロードできるURLを返す
+	SignedURL(ctx context.Context, key string, expires time.Time) (string, error)
+}
+
+const DriverLocal = "local"
+
+type Config struct {
+	// Driver は local のみ
+	Driver string
+	// Dir は local で保存するディレクトリ
+	Dir string
+	// BaseURL は署名付きURLの前に付けるURL(例: https://api.example.com)。空の場合はパスだけを返す
+	BaseURL string
+	// Secret は local の署名付きURLの署名に使う鍵
+	Secret []byte
+}
+
+// ConfigFromEnv は BLOB_DRIVER, BLOB_DIR, BLOB_BASE_URL, BLOB_URL_SECRET から設定を読み込む。
+// BLOB_URL_SECRET がない場合は起動ごとに鍵を生成するので、発行したURLは再起動すると使えなくなり、
+// 複数のインスタンスでは発行したインスタンス以外で使えない
+func ConfigFromEnv() (Config, error) {
+	cfg := Config{
+		Driver:  os.Getenv("BLOB_DRIVER"),
+		Dir:     os.Getenv("BLOB_DIR"),
+		BaseURL: os.Getenv("BLOB_BASE_URL"),
+		Secret:  []byte(os.Getenv("BLOB_URL_SECRET")),
+	}
+	if cfg.Driver == "" {
+		cfg.Driver = DriverLocal
+	}
+	if cfg.Dir == "" {
+		cfg.Dir = "data/blobs"
+	}
+	if len(cfg.Secret) == 0 {
+		cfg.Secret = make([]byte, 32)
+		if _, err := rand.Read(cfg.Secret); err != nil {
+			return Config{}, err
+		}
+	}
+
+	if cfg.Driver != DriverLocal {
+		return Config{}, fmt.Errorf("unsupported BLOB_DRIVER: %q", cfg.Driver)
+	}
+	return cfg, nil
+}
+
+// New は cfg.Driver のストアを返す
+func New(cfg Config) (Store, error) {
+	switch cfg.Driver {
+	case DriverLocal:
+		return NewLocal(cfg.Dir, cfg.BaseURL, cfg.Secret)
+	}
+	return nil, fmt.Errorf("unsupported blob driver: %q", cfg.Driver)
+}
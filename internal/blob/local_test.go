@@ -0,0 +1,131 @@
+package blob
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLocal(t *testing.T) {
+	ctx := context.Background()
+	store, err := NewLocal(t.TempDir(), "", []byte("secret"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	read := func(key string) string {
+		t.Helper()
+		r, err := store.Open(ctx, key)
+		if errors.Is(err, ErrNotFound) {
+			return "<not found>"
+		}
+		if err != nil {
+			t.Fatalf("Open(%s): %v", key, err)
+		}
+		defer r.Close()
+		b, err := io.ReadAll(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(b)
+	}
+
+	if err := store.Put(ctx, "exports/1/a.zip", strings.NewReader("first")); err != nil {
+		t.Fatalf("Put: %v", err)
+	}
+	if err := store.Put(ctx, "exports/1/a.zip", strings.NewReader("second")); err != nil {
+		t.Fatalf("Put: %v", err)
+	}
+	if got := read("exports/1/a.zip"); got != "second" {
+		t.Errorf("Open = %q, want %q", got, "second")
+	}
+
+	if err := store.Delete(ctx, "exports/1/a.zip"); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if got := read("exports/1/a.zip"); got != "<not found>" {
+		t.Errorf("Open after Delete = %q", got)
+	}
+	if err := store.Delete(ctx, "exports/1/a.zip"); err != nil {
+		t.Errorf("Delete(missing): %v", err)
+	}
+
+	for _, key := range []string{"../escape", "/abs", "a/../../b", "", `a\b`} {
+		if err := store.Put(ctx, key, strings.NewReader("x")); err == nil {
+			t.Errorf("Put(%q) succeeded, want error", key)
+		}
+	}
+}
+
+func TestLocalSignedURL(t *testing.T) {
+	ctx := context.Background()
+	store, err := NewLocal(t.TempDir(), "https://api.example.com/", []byte("secret"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Put(ctx, "exports/1/my data.zip", strings.NewReader("zip")); err != nil {
+		t.Fatal(err)
+	}
+
+	signed, err := store.SignedURL(ctx, "exports/1/my data.zip", time.Now().Add(time.Hour))
+	if err != nil {
+		t.Fatalf("SignedURL: %v", err)
+	}
+	if !strings.HasPrefix(signed, "https://api.example.com"+URLPrefix+"exports/1/my%20data.zip?") {
+		t.Fatalf("SignedURL = %q", signed)
+	}
+	expired, err := store.SignedURL(ctx, "exports/1/my data.zip", time.Now().Add(-time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := store.SignedURL(ctx, "exports/1/other.zip", time.Now().Add(time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	u, _ := url.Parse(signed)
+	tampered := *u
+	q := tampered.Query()
+	q.Set("expires", "9999999999")
+	tampered.RawQuery = q.Encode()
+	otherURL, _ := url.Parse(other)
+
+	tests := []struct {
+		name       string
+		target     string
+		wantStatus int
+	}{
+		{"valid", u.RequestURI(), http.StatusOK},
+		{"expired", mustRequestURI(t, expired), http.StatusForbidden},
+		{"tampered expiry", tampered.RequestURI(), http.StatusForbidden},
+		{"signature for another key", u.EscapedPath() + "?" + otherURL.RawQuery, http.StatusForbidden},
+		{"missing file", otherURL.RequestURI(), http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			store.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, tt.target, nil))
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d, body = %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+			if rec.Code == http.StatusOK && rec.Body.String() != "zip" {
+				t.Errorf("body = %q", rec.Body)
+			}
+		})
+	}
+}
+
+func mustRequestURI(t *testing.T, rawURL string) string {
+	t.Helper()
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return u.RequestURI()
+}
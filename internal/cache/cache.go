@@ -0,0 +1,102 @@
+// Package cache はリポジトリの読み取り結果を保持するキャッシュを提供する。
+//
+// 実装はプロセス内のLRU(NewLRU)とRedisプロトコルのサーバー(NewRedis)がある。
+// LRUはプロセスごとに独立しているので、複数のインスタンスで動かす場合の削除は
+// そのインスタンスにしか反映されない(他のインスタンスはTTLまで古い値を返しうる)
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+)
+
+// KeyPrefix はキャッシュのキーの接頭辞。保存する値の形式を変えた場合はバージョンを上げる
+const KeyPrefix = "todoapp:v1:"
+
+// ErrMiss はキーがキャッシュにない場合に Get が返す
+var ErrMiss = errors.New("cache: miss")
+
+type Cache interface {
+	// Get は key の値を返す。ない場合は ErrMiss を返す
+	Get(ctx context.Context, key string) ([]byte, error)
+	// Set は key に value を ttl の間保存する
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+	// Delete は keys を削除する。存在しないキーは無視する
+	Delete(ctx context.Context, keys ...string) error
+}
+
+const (
+	DriverNone   = "none"
+	DriverMemory = "memory"
+	DriverRedis  = "redis"
+)
+
+type Config struct {
+	// Driver は none / memory / redis のいずれか
+	Driver string
+	// TTL はキャッシュした値の有効期間
+	TTL time.Duration
+	// Size は memory で保持する最大の件数
+	Size int
+	// RedisAddr は redis の接続先(host:port)
+	RedisAddr string
+}
+
+// ConfigFromEnv は CACHE_DRIVER, CACHE_TTL, CACHE_SIZE, CACHE_REDIS_ADDR から設定を読み込む
+func ConfigFromEnv() (Config, error) {
+	cfg := Config{
+		Driver:    os.Getenv("CACHE_DRIVER"),
+		TTL:       time.Minute,
+		Size:      10000,
+		RedisAddr: os.Getenv("CACHE_REDIS_ADDR"),
+	}
+	if cfg.Driver == "" {
+		cfg.Driver = DriverNone
+	}
+	if cfg.RedisAddr == "" {
+		cfg.RedisAddr = "localhost:6379"
+	}
+
+	if v := os.Getenv("CACHE_TTL"); v != "" {
+		ttl, err := time.ParseDuration(v)
+		if err != nil || ttl <= 0 {
+			return Config{}, fmt.Errorf("invalid CACHE_TTL: %q", v)
+		}
+		cfg.TTL = ttl
+	}
+	if v := os.Getenv("CACHE_SIZE"); v != "" {
+		size, err := strconv.Atoi(v)
+		if err != nil || size <= 0 {
+			return Config{}, fmt.Errorf("invalid CACHE_SIZE: %q", v)
+		}
+		cfg.Size = size
+	}
+
+	switch cfg.Driver {
+	case DriverNone, DriverMemory, DriverRedis:
+	default:
+		return Config{}, fmt.Errorf("unsupported CACHE_DRIVER: %q", cfg.Driver)
+	}
+	return cfg, nil
+}
+
+// New は cfg.Driver のキャッシュを返す。none の場合は nil を返す
+func New(ctx context.Context, cfg Config) (Cache, error) {
+	switch cfg.Driver {
+	case DriverMemory:
+		return NewLRU(cfg.Size), nil
+	case DriverRedis:
+		c := NewRedis(cfg.RedisAddr)
+		if err := c.Ping(ctx); err != nil {
+			c.Close()
+			return nil, fmt.Errorf("failed to connect to redis: %w", err)
+		}
+		return c, nil
+	default:
+		return nil, nil
+	}
+}
@@ -0,0 +1,60 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestLRU(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	c := NewLRU(2)
+	c.now = func() time.Time { return now }
+
+	get := func(key string) string {
+		t.Helper()
+		v, err := c.Get(ctx, key)
+		if errors.Is(err, ErrMiss) {
+			return "<miss>"
+		}
+		if err != nil {
+			t.Fatalf("Get(%s): %v", key, err)
+		}
+		return string(v)
+	}
+
+	c.Set(ctx, "a", []byte("1"), time.Minute)
+	c.Set(ctx, "b", []byte("2"), time.Minute)
+	get("a") // b が最も長く使われていない値になる
+	c.Set(ctx, "c", []byte("3"), time.Minute)
+
+	if got := get("b"); got != "<miss>" {
+		t.Errorf("b = %s, want evicted", got)
+	}
+	if got := get("a"); got != "1" {
+		t.Errorf("a = %s, want 1", got)
+	}
+
+	c.Set(ctx, "a", []byte("10"), 2*time.Minute)
+	if got := get("a"); got != "10" {
+		t.Errorf("a = %s, want 10 after overwrite", got)
+	}
+
+	now = now.Add(90 * time.Second)
+	if got := get("c"); got != "<miss>" {
+		t.Errorf("c = %s, want expired", got)
+	}
+	if got := get("a"); got != "10" {
+		t.Errorf("a = %s, want 10 before its TTL", got)
+	}
+
+	c.Delete(ctx, "a", "missing")
+	if got := get("a"); got != "<miss>" {
+		t.Errorf("a = %s, want deleted", got)
+	}
+	if c.Len() != 0 {
+		t.Errorf("Len = %d, want 0", c.Len())
+	}
+}
@@ -0,0 +1,156 @@
+package cache
+
+import (
+	"bytes"
+	"context"
+	"encoding/gob"
+	"errors"
+	"hash/maphash"
+	"math/rand"
+	"sync/atomic"
+	"time"
+	"todoapp/internal/infrastructure"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// ReadThrough はキャッシュにない値を読み込んで保存する。
+// キャッシュの障害時はエラーにせず、毎回読み込む
+type ReadThrough struct {
+	cache Cache
+	ttl   time.Duration
+	// group は同じキーの読み込みをまとめ、期限切れの直後にデータベースへ
+	// 同じクエリが殺到するのを防ぐ(キャッシュスタンピード対策)
+	group singleflight.Group
+	// generations はキーのハッシュごとの世代で、Invalidate のたびに増える。
+	// 読み込みの前後で世代が変わっていれば、読み込んだ値は古い可能性があるので保存しない。
+	// キーごとに持つと削除したキーの分だけ増え続けるので、固定の数に分散させる
+	// (別のキーの削除で保存を見送ることがあるが、次の読み込みで保存される)
+	generations [generationShards]atomic.Uint64
+	seed        maphash.Seed
+}
+
+const generationShards = 256
+
+func NewReadThrough(c Cache, ttl time.Duration) *ReadThrough {
+	return &ReadThrough{cache: c, ttl: ttl, seed: maphash.MakeSeed()}
+}
+
+// Fetch は key の値をキャッシュから返し、なければ load で読み込んで保存する。
+// 値は gob でエンコードして保存し、呼び出しごとにデコードするので、
+// 返した値を呼び出し側で変更しても他の呼び出しには影響しない
+func Fetch[T any](ctx context.Context, rt *ReadThrough, key string, load func(ctx context.Context) (T, error)) (T, error) {
+	var v T
+	if data, err := rt.cache.Get(ctx, key); err == nil {
+		if err := decode(data, &v); err == nil {
+			return v, nil
+		}
+	}
+
+	data, err, _ := rt.group.Do(key, func() (interface{}, error) {
+		// 最初の呼び出し元がキャンセルしても、待っている他の呼び出し元の読み込みは続ける
+		loadCtx := context.WithoutCancel(ctx)
+		gen := rt.Generation(key)
+		loaded, err := load(loadCtx)
+		if err != nil {
+			return nil, err
+		}
+		data, err := encode(loaded)
+		if err != nil {
+			return nil, err
+		}
+		rt.set(loadCtx, key, gen, data)
+		return data, nil
+	})
+	if err != nil {
+		return v, err
+	}
+
+	err = decode(data.([]byte), &v)
+	return v, err
+}
+
+// Store は key に value を保存する。まとめて読み込んだ値を個別のキーに入れる場合に使う。
+// gen は読み込む前に Generation で取得した世代で、その後に Invalidate されていれば保存しない
+func Store[T any](ctx context.Context, rt *ReadThrough, key string, gen uint64, value T) {
+	if data, err := encode(value); err == nil {
+		rt.set(ctx, key, gen, data)
+	}
+}
+
+// Lookup は key の値をキャッシュから返す。なければ false を返す
+func Lookup[T any](ctx context.Context, rt *ReadThrough, key string) (T, bool) {
+	var v T
+	data, err := rt.cache.Get(ctx, key)
+	if err != nil {
+		return v, false
+	}
+	if err := decode(data, &v); err != nil {
+		return v, false
+	}
+	return v, true
+}
+
+// Invalidate は keys を削除する。実行中の読み込みは古い値を返しうるので、
+// 以降の Fetch がそれに合流しないようにし、読み込んだ値も保存させない
+func (rt *ReadThrough) Invalidate(ctx context.Context, keys ...string) error {
+	for _, key := range keys {
+		rt.generation(key).Add(1)
+		rt.group.Forget(key)
+	}
+	return rt.cache.Delete(ctx, keys...)
+}
+
+// InvalidateAfterCommit はトランザクションのコミット後に keys を削除する。
+// コミット前に削除すると、その間に読まれた変更前の値が再びキャッシュされるため。
+// 削除に失敗しても書き込みは成功しているので、値はTTLが切れるまで古いままになる
+func (rt *ReadThrough) InvalidateAfterCommit(ctx context.Context, keys ...string) {
+	infrastructure.AfterCommit(ctx, func() {
+		_ = rt.Invalidate(context.WithoutCancel(ctx), keys...)
+	})
+}
+
+// Generation は key の現在の世代を返す
+func (rt *ReadThrough) Generation(key string) uint64 {
+	return rt.generation(key).Load()
+}
+
+func (rt *ReadThrough) generation(key string) *atomic.Uint64 {
+	return &rt.generations[maphash.String(rt.seed, key)%generationShards]
+}
+
+// set は gen の後に key が Invalidate されていなければ data を保存する。
+// 確認と保存の間に Invalidate された場合は、その削除より先に保存したとは限らないので自分で削除する
+func (rt *ReadThrough) set(ctx context.Context, key string, gen uint64, data []byte) {
+	if rt.Generation(key) != gen {
+		return
+	}
+	_ = rt.cache.Set(ctx, key, data, rt.jitteredTTL())
+	if rt.Generation(key) != gen {
+		_ = rt.cache.Delete(ctx, key)
+	}
+}
+
+// jitteredTTL は多数のキーが同時に期限切れにならないよう、TTLを±10%ずらす
+func (rt *ReadThrough) jitteredTTL() time.Duration {
+	spread := int64(rt.ttl / 5)
+	if spread <= 0 {
+		return rt.ttl
+	}
+	return rt.ttl - time.Duration(spread/2) + time.Duration(rand.Int63n(spread))
+}
+
+func encode(v interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(v); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func decode(data []byte, v interface{}) error {
+	if len(data) == 0 {
+		return errors.New("cache: empty value")
+	}
+	return gob.NewDecoder(bytes.NewReader(data)).Decode(v)
+}
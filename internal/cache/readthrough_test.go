@@ -0,0 +1,155 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type item struct {
+	Name  string
+	Count int
+}
+
+func TestFetch(t *testing.T) {
+	ctx := context.Background()
+	rt := NewReadThrough(NewLRU(10), time.Minute)
+
+	var loads atomic.Int32
+	load := func(ctx context.Context) (*item, error) {
+		loads.Add(1)
+		return &item{Name: "a", Count: 1}, nil
+	}
+
+	got, err := Fetch(ctx, rt, "a", load)
+	if err != nil || got.Name != "a" {
+		t.Fatalf("Fetch = %+v, %v", got, err)
+	}
+	got.Count = 100 // 返した値を変更してもキャッシュには影響しない
+
+	got, err = Fetch(ctx, rt, "a", load)
+	if err != nil || got.Count != 1 {
+		t.Errorf("cached Fetch = %+v, %v, want Count 1", got, err)
+	}
+	if n := loads.Load(); n != 1 {
+		t.Errorf("loads = %d, want 1", n)
+	}
+
+	rt.Invalidate(ctx, "a")
+	Fetch(ctx, rt, "a", load)
+	if n := loads.Load(); n != 2 {
+		t.Errorf("loads after Invalidate = %d, want 2", n)
+	}
+}
+
+func TestFetchDoesNotCacheErrors(t *testing.T) {
+	ctx := context.Background()
+	rt := NewReadThrough(NewLRU(10), time.Minute)
+	errNotFound := errors.New("not found")
+
+	calls := 0
+	load := func(ctx context.Context) (bool, error) {
+		calls++
+		if calls == 1 {
+			return false, errNotFound
+		}
+		return true, nil
+	}
+
+	if _, err := Fetch(ctx, rt, "a", load); !errors.Is(err, errNotFound) {
+		t.Fatalf("Fetch err = %v, want errNotFound", err)
+	}
+	if got, err := Fetch(ctx, rt, "a", load); err != nil || !got {
+		t.Errorf("Fetch after error = %v, %v, want true", got, err)
+	}
+}
+
+func TestFetchCoalescesConcurrentLoads(t *testing.T) {
+	ctx := context.Background()
+	rt := NewReadThrough(NewLRU(10), time.Minute)
+
+	var loads atomic.Int32
+	release := make(chan struct{})
+	load := func(ctx context.Context) (int, error) {
+		loads.Add(1)
+		<-release
+		return 42, nil
+	}
+
+	const callers = 20
+	var started, done sync.WaitGroup
+	started.Add(callers)
+	done.Add(callers)
+	for i := 0; i < callers; i++ {
+		go func() {
+			defer done.Done()
+			started.Done()
+			if v, err := Fetch(ctx, rt, "hot", load); err != nil || v != 42 {
+				t.Errorf("Fetch = %d, %v", v, err)
+			}
+		}()
+	}
+	started.Wait()
+	time.Sleep(10 * time.Millisecond) // 全ての呼び出しが読み込みを待つまで待つ
+	close(release)
+	done.Wait()
+
+	if n := loads.Load(); n != 1 {
+		t.Errorf("loads = %d, want 1", n)
+	}
+}
+
+func TestFetchDoesNotStoreAfterInvalidate(t *testing.T) {
+	ctx := context.Background()
+	rt := NewReadThrough(NewLRU(10), time.Minute)
+
+	// 読み込みが古い値を返す前に、値が変更されてキャッシュが削除される
+	loading := make(chan struct{})
+	invalidated := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		v, err := Fetch(ctx, rt, "a", func(ctx context.Context) (string, error) {
+			close(loading)
+			<-invalidated
+			return "old", nil
+		})
+		if err != nil || v != "old" {
+			t.Errorf("Fetch = %q, %v", v, err)
+		}
+	}()
+	<-loading
+	rt.Invalidate(ctx, "a")
+	close(invalidated)
+	<-done
+
+	if v, ok := Lookup[string](ctx, rt, "a"); ok {
+		t.Errorf("Lookup after Invalidate = %q, want miss", v)
+	}
+	v, err := Fetch(ctx, rt, "a", func(ctx context.Context) (string, error) {
+		return "new", nil
+	})
+	if err != nil || v != "new" {
+		t.Errorf("Fetch after Invalidate = %q, %v, want new", v, err)
+	}
+}
+
+func TestStoreDoesNotStoreAfterInvalidate(t *testing.T) {
+	ctx := context.Background()
+	rt := NewReadThrough(NewLRU(10), time.Minute)
+
+	gen := rt.Generation("a")
+	rt.Invalidate(ctx, "a")
+	Store(ctx, rt, "a", gen, "old")
+	if v, ok := Lookup[string](ctx, rt, "a"); ok {
+		t.Errorf("Lookup = %q, want miss", v)
+	}
+
+	Store(ctx, rt, "a", rt.Generation("a"), "new")
+	if v, ok := Lookup[string](ctx, rt, "a"); !ok || v != "new" {
+		t.Errorf("Lookup = %q, %v, want new", v, ok)
+	}
+}
@@ -0,0 +1,48 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+)
+
+func TestRedis(t *testing.T) {
+	ctx := context.Background()
+	server := miniredis.RunT(t)
+	c := NewRedis(server.Addr())
+	t.Cleanup(func() { c.Close() })
+
+	if err := c.Ping(ctx); err != nil {
+		t.Fatalf("Ping: %v", err)
+	}
+	if _, err := c.Get(ctx, "a"); !errors.Is(err, ErrMiss) {
+		t.Errorf("Get(missing) err = %v, want ErrMiss", err)
+	}
+
+	if err := c.Set(ctx, "a", []byte("1"), time.Minute); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+	if err := c.Set(ctx, "b", []byte("2"), time.Minute); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+	if v, err := c.Get(ctx, "a"); err != nil || string(v) != "1" {
+		t.Errorf("Get(a) = %q, %v, want 1", v, err)
+	}
+
+	server.FastForward(2 * time.Minute)
+	if _, err := c.Get(ctx, "a"); !errors.Is(err, ErrMiss) {
+		t.Errorf("Get(expired) err = %v, want ErrMiss", err)
+	}
+
+	c.Set(ctx, "a", []byte("1"), time.Minute)
+	c.Set(ctx, "b", []byte("2"), time.Minute)
+	if err := c.Delete(ctx, "a", "b", "missing"); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if server.Exists("a") || server.Exists("b") {
+		t.Error("keys are not deleted")
+	}
+}
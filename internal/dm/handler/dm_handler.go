@@ -0,0 +1,172 @@
+package handler
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"strconv"
+	"todoapp/internal/dm/model"
+	"todoapp/internal/dm/usecase"
+	"todoapp/internal/domain"
+
+	"github.com/labstack/echo/v4"
+)
+
+type DMHandler struct {
+	usecase usecase.DMUsecase
+}
+
+func NewDMHandler(u usecase.DMUsecase) *DMHandler {
+	return &DMHandler{
+		usecase: u,
+	}
+}
+
+// ListConversations はログイン中のユーザーの会話を最後のメッセージが新しい順に返す。
+// クエリパラメーター: cursor(前のページの next_cursor), limit
+func (h *DMHandler) ListConversations(c echo.Context) error {
+	var req model.ListRequest
+	if err := (&echo.DefaultBinder{}).BindQueryParams(c, &req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid query parameters"})
+	}
+
+	resp, err := h.usecase.ListConversations(c.Request().Context(), getUserID(c), req)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// CreateConversation は会話を作る。同じ相手との1対1の会話が既にあれば、201 ではなく 200 でそれを返す
+func (h *DMHandler) CreateConversation(c echo.Context) error {
+	var req model.CreateConversationRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request body"})
+	}
+
+	conversation, created, err := h.usecase.CreateConversation(c.Request().Context(), getUserID(c), req)
+	if errors.Is(err, sql.ErrNoRows) {
+		return c.JSON(http.StatusNotFound, map[string]string{"error": "User not found"})
+	}
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	if !created {
+		return c.JSON(http.StatusOK, conversation)
+	}
+	return c.JSON(http.StatusCreated, conversation)
+}
+
+func (h *DMHandler) GetConversation(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid conversation ID"})
+	}
+
+	conversation, err := h.usecase.GetConversation(c.Request().Context(), getUserID(c), id)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, conversation)
+}
+
+// ListMessages は会話のメッセージを新しい順に返す。
+// クエリパラメーター: cursor(前のページの next_cursor), limit
+func (h *DMHandler) ListMessages(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid conversation ID"})
+	}
+	var req model.ListRequest
+	if err := (&echo.DefaultBinder{}).BindQueryParams(c, &req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid query parameters"})
+	}
+
+	resp, err := h.usecase.ListMessages(c.Request().Context(), getUserID(c), id, req)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+func (h *DMHandler) SendMessage(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid conversation ID"})
+	}
+	var req model.SendMessageRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request body"})
+	}
+
+	message, err := h.usecase.SendMessage(c.Request().Context(), getUserID(c), id, req)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusCreated, message)
+}
+
+// MarkRead は会話のメッセージを up_to_id まで既読にする。本文を省略すると最後のメッセージまで既読にする
+func (h *DMHandler) MarkRead(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid conversation ID"})
+	}
+	var req model.MarkReadRequest
+	if c.Request().ContentLength != 0 {
+		if err := c.Bind(&req); err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request body"})
+		}
+	}
+
+	if err := h.usecase.MarkRead(c.Request().Context(), getUserID(c), id, req); err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (h *DMHandler) GetSettings(c echo.Context) error {
+	settings, err := h.usecase.GetSettings(c.Request().Context(), getUserID(c))
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, settings)
+}
+
+func (h *DMHandler) UpdateSettings(c echo.Context) error {
+	var req model.Settings
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request body"})
+	}
+
+	settings, err := h.usecase.UpdateSettings(c.Request().Context(), getUserID(c), req)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, settings)
+}
+
+func errorResponse(c echo.Context, err error) error {
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return c.JSON(http.StatusNotFound, map[string]string{"error": "Conversation not found"})
+	case errors.Is(err, domain.ErrInvalid):
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	case errors.Is(err, domain.ErrForbidden):
+		return c.JSON(http.StatusForbidden, map[string]string{"error": err.Error()})
+	}
+	return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+}
+
+func getUserID(c echo.Context) int {
+	userID, _ := c.Get("user_id").(int)
+	return userID
+}
@@ -0,0 +1,291 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+	"todoapp/internal/dm/handler"
+	"todoapp/internal/dm/model"
+	"todoapp/internal/dm/repository"
+	"todoapp/internal/dm/usecase"
+	"todoapp/internal/router"
+	"todoapp/internal/testutil"
+	userrepository "todoapp/internal/user/repository"
+
+	"github.com/labstack/echo/v4"
+)
+
+func newTestServer(t *testing.T) *echo.Echo {
+	t.Helper()
+	env := testutil.NewEnv(t)
+	dmUsecase := usecase.NewDMUsecase(repository.NewDMRepository(env.DB, env.DB), env.TxManager)
+	return env.Server(router.Handlers{DM: handler.NewDMHandler(dmUsecase)})
+}
+
+func follow(t *testing.T, e *echo.Echo, token string, userID int) {
+	t.Helper()
+	testutil.MustDo(t, e, http.MethodPost, "/api/users/"+strconv.Itoa(userID)+"/follow", token, "", http.StatusCreated)
+}
+
+func createConversation(t *testing.T, e *echo.Echo, token string, want int, userIDs ...int) *model.Conversation {
+	t.Helper()
+	body, _ := json.Marshal(model.CreateConversationRequest{UserIDs: userIDs})
+	rec := testutil.MustDo(t, e, http.MethodPost, "/api/dm/conversations", token, string(body), want)
+	var conversation model.Conversation
+	testutil.Decode(t, rec, &conversation)
+	return &conversation
+}
+
+func send(t *testing.T, e *echo.Echo, token string, conversationID int, content string) *model.Message {
+	t.Helper()
+	rec := testutil.MustDo(t, e, http.MethodPost, "/api/dm/conversations/"+strconv.Itoa(conversationID)+"/messages", token,
+		`{"content":"`+content+`"}`, http.StatusCreated)
+	var message model.Message
+	testutil.Decode(t, rec, &message)
+	return &message
+}
+
+func listConversations(t *testing.T, e *echo.Echo, token string) []*model.Conversation {
+	t.Helper()
+	rec := testutil.MustDo(t, e, http.MethodGet, "/api/dm/conversations", token, "", http.StatusOK)
+	var resp model.Conversations
+	testutil.Decode(t, rec, &resp)
+	return resp.Conversations
+}
+
+func TestConversations(t *testing.T) {
+	e := newTestServer(t)
+	aliceID, alice := testutil.RegisterAndLogin(t, e, "alice")
+	bobID, bob := testutil.RegisterAndLogin(t, e, "bob")
+	carolID, carol := testutil.RegisterAndLogin(t, e, "carol")
+	_, dave := testutil.RegisterAndLogin(t, e, "dave")
+
+	// 相互にフォローしていなければ送れない
+	follow(t, e, alice, bobID)
+	rec := testutil.Do(t, e, http.MethodPost, "/api/dm/conversations", alice, `{"user_ids":[`+strconv.Itoa(bobID)+`]}`)
+	if rec.Code != http.StatusForbidden {
+		t.Fatalf("without mutual follow: status = %d, want 403, body = %s", rec.Code, rec.Body)
+	}
+	follow(t, e, bob, aliceID)
+	direct := createConversation(t, e, alice, http.StatusCreated, bobID)
+	if direct.IsGroup || len(direct.Participants) != 2 || direct.LastMessage != nil {
+		t.Errorf("conversation = %+v, want 1:1 without messages", direct)
+	}
+	// 同じ2人の会話はどちらから作っても同じ
+	if again := createConversation(t, e, bob, http.StatusOK, aliceID); again.ID != direct.ID {
+		t.Errorf("conversation ID = %d, want %d", again.ID, direct.ID)
+	}
+
+	// フォローしていないユーザーからも受け取る設定
+	rec = testutil.MustDo(t, e, http.MethodPut, "/api/dm/settings", carol, `{"allow_from_anyone":true}`, http.StatusOK)
+	var settings model.Settings
+	testutil.Decode(t, rec, &settings)
+	if !settings.AllowFromAnyone {
+		t.Errorf("settings = %+v", settings)
+	}
+	group := createConversation(t, e, alice, http.StatusCreated, bobID, carolID, bobID)
+	if !group.IsGroup || len(group.Participants) != 3 {
+		t.Errorf("conversation = %+v, want a group of 3", group)
+	}
+	// carol は alice に送れない(alice は受け取る設定にしていない)
+	if rec := testutil.Do(t, e, http.MethodPost, "/api/dm/conversations", carol, `{"user_ids":[`+strconv.Itoa(aliceID)+`]}`); rec.Code != http.StatusForbidden {
+		t.Errorf("to alice: status = %d, want 403", rec.Code)
+	}
+
+	for _, tt := range []struct {
+		body string
+		want int
+	}{
+		{`{"user_ids":[]}`, http.StatusBadRequest},
+		{`{"user_ids":[` + strconv.Itoa(aliceID) + `]}`, http.StatusBadRequest},
+		{`{"user_ids":[999999]}`, http.StatusNotFound},
+	} {
+		if rec := testutil.Do(t, e, http.MethodPost, "/api/dm/conversations", alice, tt.body); rec.Code != tt.want {
+			t.Errorf("%s: status = %d, want %d, body = %s", tt.body, rec.Code, tt.want, rec.Body)
+		}
+	}
+
+	// 一覧はメッセージのある会話だけを、最後のメッセージが新しい順に返す
+	if got := listConversations(t, e, alice); len(got) != 0 {
+		t.Errorf("conversations = %d, want 0", len(got))
+	}
+	send(t, e, alice, direct.ID, "hi bob")
+	send(t, e, carol, group.ID, "hi all")
+	got := listConversations(t, e, bob)
+	if len(got) != 2 || got[0].ID != group.ID || got[1].ID != direct.ID {
+		t.Fatalf("conversations = %+v", got)
+	}
+	if got[0].UnreadCount != 1 || got[1].UnreadCount != 1 || got[1].LastMessage.Content != "hi bob" {
+		t.Errorf("unread = %d, %d, last message = %+v", got[0].UnreadCount, got[1].UnreadCount, got[1].LastMessage)
+	}
+
+	// 既読にすると未読の数が減り、ほかの参加者にも既読が見える
+	testutil.MustDo(t, e, http.MethodPost, "/api/dm/conversations/"+strconv.Itoa(direct.ID)+"/read", bob, "", http.StatusNoContent)
+	if got := listConversations(t, e, bob); got[1].UnreadCount != 0 {
+		t.Errorf("unread after read = %d, want 0", got[1].UnreadCount)
+	}
+	rec = testutil.MustDo(t, e, http.MethodGet, "/api/dm/conversations/"+strconv.Itoa(direct.ID), alice, "", http.StatusOK)
+	var conversation model.Conversation
+	testutil.Decode(t, rec, &conversation)
+	for _, p := range conversation.Participants {
+		if p.LastReadMessageID != conversation.LastMessage.ID {
+			t.Errorf("participant %s last read = %d, want %d", p.Username, p.LastReadMessageID, conversation.LastMessage.ID)
+		}
+	}
+
+	// 参加していない会話は見えない
+	if rec := testutil.Do(t, e, http.MethodGet, "/api/dm/conversations/"+strconv.Itoa(direct.ID), dave, ""); rec.Code != http.StatusNotFound {
+		t.Errorf("non-participant: status = %d, want 404", rec.Code)
+	}
+}
+
+func TestMessages(t *testing.T) {
+	e := newTestServer(t)
+	aliceID, alice := testutil.RegisterAndLogin(t, e, "alice")
+	bobID, bob := testutil.RegisterAndLogin(t, e, "bob")
+	_, carol := testutil.RegisterAndLogin(t, e, "carol")
+
+	follow(t, e, alice, bobID)
+	follow(t, e, bob, aliceID)
+	conversation := createConversation(t, e, alice, http.StatusCreated, bobID)
+	path := "/api/dm/conversations/" + strconv.Itoa(conversation.ID) + "/messages"
+
+	var sent []int
+	for i := 0; i < 5; i++ {
+		sent = append(sent, send(t, e, alice, conversation.ID, "message "+strconv.Itoa(i)).ID)
+	}
+
+	// 新しい順にカーソルで読む
+	var got []int
+	for cursor := ""; ; {
+		rec := testutil.MustDo(t, e, http.MethodGet, path+"?limit=2&cursor="+cursor, bob, "", http.StatusOK)
+		var resp model.Messages
+		testutil.Decode(t, rec, &resp)
+		for _, m := range resp.Messages {
+			got = append(got, m.ID)
+		}
+		if resp.NextCursor == nil {
+			break
+		}
+		cursor = *resp.NextCursor
+	}
+	if len(got) != 5 || got[0] != sent[4] || got[4] != sent[0] {
+		t.Errorf("messages = %v, want %v in reverse", got, sent)
+	}
+
+	for _, tt := range []struct {
+		name, token, body string
+		want              int
+	}{
+		{"empty content", alice, `{"content":"  "}`, http.StatusBadRequest},
+		{"too long", alice, `{"content":"` + strings.Repeat("あ", model.MaxContentLength+1) + `"}`, http.StatusBadRequest},
+		{"non-participant", carol, `{"content":"hi"}`, http.StatusNotFound},
+	} {
+		if rec := testutil.Do(t, e, http.MethodPost, path, tt.token, tt.body); rec.Code != tt.want {
+			t.Errorf("%s: status = %d, want %d, body = %s", tt.name, rec.Code, tt.want, rec.Body)
+		}
+	}
+	if rec := testutil.Do(t, e, http.MethodGet, path, carol, ""); rec.Code != http.StatusNotFound {
+		t.Errorf("non-participant list: status = %d, want 404", rec.Code)
+	}
+
+	// フォローを外すと1対1の会話にも送れなくなる
+	testutil.MustDo(t, e, http.MethodDelete, "/api/users/"+strconv.Itoa(aliceID)+"/follow", bob, "", http.StatusNoContent)
+	if rec := testutil.Do(t, e, http.MethodPost, path, alice, `{"content":"still there?"}`); rec.Code != http.StatusForbidden {
+		t.Errorf("after unfollow: status = %d, want 403, body = %s", rec.Code, rec.Body)
+	}
+	// 相手が受け取る設定にすれば送れる
+	testutil.MustDo(t, e, http.MethodPut, "/api/dm/settings", bob, `{"allow_from_anyone":true}`, http.StatusOK)
+	send(t, e, alice, conversation.ID, "still there?")
+
+	// 受け取る設定にしていても、ブロックしていれば送れない
+	testutil.MustDo(t, e, http.MethodPost, "/api/users/"+strconv.Itoa(aliceID)+"/block", bob, "", http.StatusCreated)
+	if rec := testutil.Do(t, e, http.MethodPost, path, alice, `{"content":"hello?"}`); rec.Code != http.StatusForbidden {
+		t.Errorf("after block: status = %d, want 403, body = %s", rec.Code, rec.Body)
+	}
+}
+
+// グループの会話でも、ほかの参加者のだれかとブロックの関係があれば送れない
+func TestGroupMessagesBlocked(t *testing.T) {
+	e := newTestServer(t)
+	aliceID, alice := testutil.RegisterAndLogin(t, e, "alice")
+	bobID, bob := testutil.RegisterAndLogin(t, e, "bob")
+	carolID, carol := testutil.RegisterAndLogin(t, e, "carol")
+
+	testutil.MustDo(t, e, http.MethodPut, "/api/dm/settings", bob, `{"allow_from_anyone":true}`, http.StatusOK)
+	testutil.MustDo(t, e, http.MethodPut, "/api/dm/settings", carol, `{"allow_from_anyone":true}`, http.StatusOK)
+	group := createConversation(t, e, alice, http.StatusCreated, bobID, carolID)
+	path := "/api/dm/conversations/" + strconv.Itoa(group.ID) + "/messages"
+	send(t, e, alice, group.ID, "hi all")
+
+	// carol が alice をブロックすると、alice は送れない。ブロックの関係がない bob は送れる
+	testutil.MustDo(t, e, http.MethodPost, "/api/users/"+strconv.Itoa(aliceID)+"/block", carol, "", http.StatusCreated)
+	if rec := testutil.Do(t, e, http.MethodPost, path, alice, `{"content":"hello?"}`); rec.Code != http.StatusForbidden {
+		t.Errorf("blocked by a participant: status = %d, want 403, body = %s", rec.Code, rec.Body)
+	}
+	send(t, e, bob, group.ID, "hi")
+
+	// ブロックした側からも送れない
+	if rec := testutil.Do(t, e, http.MethodPost, path, carol, `{"content":"hello?"}`); rec.Code != http.StatusForbidden {
+		t.Errorf("blocking a participant: status = %d, want 403, body = %s", rec.Code, rec.Body)
+	}
+
+	testutil.MustDo(t, e, http.MethodDelete, "/api/users/"+strconv.Itoa(aliceID)+"/block", carol, "", http.StatusNoContent)
+	send(t, e, alice, group.ID, "hello again")
+}
+
+// 物理削除したユーザーのメッセージが会話の最後のメッセージでも、一覧のページをたどれる
+func TestConversationsAfterPurge(t *testing.T) {
+	ctx := context.Background()
+	env := testutil.NewEnv(t)
+	dmUsecase := usecase.NewDMUsecase(repository.NewDMRepository(env.DB, env.DB), env.TxManager)
+	e := env.Server(router.Handlers{DM: handler.NewDMHandler(dmUsecase)})
+
+	aliceID, alice := testutil.RegisterAndLogin(t, e, "alice")
+	conversations := make(map[string]*model.Conversation)
+	tokens := make(map[string]string)
+	for _, name := range []string{"bob", "carol", "dave"} {
+		id, token := testutil.RegisterAndLogin(t, e, name)
+		follow(t, e, alice, id)
+		follow(t, e, token, aliceID)
+		conversations[name] = createConversation(t, e, alice, http.StatusCreated, id)
+		tokens[name] = token
+	}
+	send(t, e, alice, conversations["bob"].ID, "to bob")
+	send(t, e, alice, conversations["carol"].ID, "to carol")
+	send(t, e, alice, conversations["dave"].ID, "to dave")
+	send(t, e, tokens["bob"], conversations["bob"].ID, "from bob")
+
+	if _, err := env.DB.ExecContext(ctx, "UPDATE users SET deleted_at = ? WHERE username = ?", time.Now().Add(-time.Hour), "bob"); err != nil {
+		t.Fatal(err)
+	}
+	if users, _, err := userrepository.PurgeDeleted(ctx, env.TxManager, env.DB, time.Now()); err != nil || users != 1 {
+		t.Fatalf("PurgeDeleted = %d, %v, want 1", users, err)
+	}
+
+	// bob の会話は残った alice のメッセージの位置に並ぶ
+	var got []string
+	for cursor := ""; ; {
+		rec := testutil.MustDo(t, e, http.MethodGet, "/api/dm/conversations?limit=1&cursor="+cursor, alice, "", http.StatusOK)
+		var resp model.Conversations
+		testutil.Decode(t, rec, &resp)
+		for _, c := range resp.Conversations {
+			if c.LastMessage == nil {
+				t.Fatalf("conversation %d has no last message", c.ID)
+			}
+			got = append(got, c.LastMessage.Content)
+		}
+		if resp.NextCursor == nil {
+			break
+		}
+		cursor = *resp.NextCursor
+	}
+	if want := []string{"to dave", "to carol", "to bob"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("conversations = %v, want %v", got, want)
+	}
+}
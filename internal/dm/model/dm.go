@@ -0,0 +1,85 @@
+package model
+
+import "time"
+
+const (
+	// MaxContentLength はメッセージ本文の最大文字数。ツイートと同じく、
+	// NFCに正規化した本文のUnicodeのコードポイント数で数える
+	MaxContentLength = 1000
+	// MaxParticipants は自分を含めた会話の参加者の最大人数
+	MaxParticipants = 20
+)
+
+// Conversation はダイレクトメッセージの会話。参加者が2人なら1対1の会話で、
+// 同じ2人の1対1の会話は1つだけ作られる
+type Conversation struct {
+	ID      int  `json:"id"`
+	IsGroup bool `json:"is_group"`
+	// Participants は自分を含む参加者。退会中のユーザーは含めない
+	Participants []*Participant `json:"participants"`
+	// LastMessage は最後のメッセージ。メッセージがなければ nil
+	LastMessage *Message `json:"last_message"`
+	// LastMessageID は一覧の並び順とカーソルに使う dm_conversations.last_message_id。メッセージがなければ0
+	LastMessageID int `json:"-"`
+	// UnreadCount はほかの参加者のメッセージのうち、自分が既読にしていないものの数
+	UnreadCount int       `json:"unread_count"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+type Participant struct {
+	ID              int     `json:"id"`
+	Username        string  `json:"username"`
+	DisplayName     string  `json:"display_name"`
+	ProfileImageURL *string `json:"profile_image_url,omitempty"`
+	// LastReadMessageID はこの参加者が既読にした最後のメッセージ(既読の表示に使う)。
+	// 何も読んでいなければ0
+	LastReadMessageID int `json:"last_read_message_id"`
+}
+
+type Message struct {
+	ID             int       `json:"id"`
+	ConversationID int       `json:"conversation_id"`
+	SenderID       int       `json:"sender_id"`
+	Content        string    `json:"content"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+// CreateConversationRequest の UserIDs は自分以外の参加者。1人なら1対1の会話になる
+type CreateConversationRequest struct {
+	UserIDs []int `json:"user_ids"`
+}
+
+type SendMessageRequest struct {
+	Content string `json:"content"`
+}
+
+// ListRequest は会話とメッセージの一覧のページ。Cursor は前のページの NextCursor
+type ListRequest struct {
+	Cursor string `query:"cursor"`
+	Limit  int    `query:"limit"`
+}
+
+// Conversations は最後のメッセージが新しい順の会話。メッセージのない会話は含めない
+type Conversations struct {
+	Conversations []*Conversation `json:"conversations"`
+	// NextCursor は次のページのカーソル。続きがなければ nil
+	NextCursor *string `json:"next_cursor"`
+}
+
+// Messages は新しい順のメッセージ
+type Messages struct {
+	Messages []*Message `json:"messages"`
+	// NextCursor は次の(より古い)ページのカーソル。続きがなければ nil
+	NextCursor *string `json:"next_cursor"`
+}
+
+// MarkReadRequest の UpToID は既読にする最後のメッセージ。0なら最後のメッセージまで既読にする
+type MarkReadRequest struct {
+	UpToID int `json:"up_to_id"`
+}
+
+// Settings はダイレクトメッセージの受信設定
+type Settings struct {
+	// AllowFromAnyone が true なら、相互にフォローしていないユーザーからのメッセージも受け取る
+	AllowFromAnyone bool `json:"allow_from_anyone"`
+}
@@ -0,0 +1,397 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"todoapp/internal/dm/model"
+	"todoapp/internal/domain"
+	"todoapp/internal/infrastructure"
+	"todoapp/internal/schema"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// DMRepository はダイレクトメッセージの会話・メッセージ・受信設定を読み書きする
+type DMRepository interface {
+	// CreateConversation は userIDs を参加者とする会話を作り、IDを返す。
+	// directKey は1対1の会話の2人を表すキーで、同じキーの会話が既にあれば domain.ErrConflict を返す。
+	// グループの会話では空にする
+	CreateConversation(ctx context.Context, directKey string, userIDs []int) (int, error)
+	// FindDirectConversation は directKey の1対1の会話のIDを返す。なければ sql.ErrNoRows を返す
+	FindDirectConversation(ctx context.Context, directKey string) (int, error)
+	// GetConversation は userID が参加している会話を返す。
+	// 会話がないか、userID が参加していなければ sql.ErrNoRows を返す
+	GetConversation(ctx context.Context, id, userID int) (*model.Conversation, error)
+	// ListConversations は userID が参加している、メッセージのある会話を最後のメッセージが新しい順に、
+	// 最後のメッセージのIDが beforeMessageID より小さいものから最大 limit 件返す。beforeMessageID が0なら先頭から返す
+	ListConversations(ctx context.Context, userID, beforeMessageID, limit int) ([]*model.Conversation, error)
+	// IsParticipant は userID が会話に参加しているかを返す
+	IsParticipant(ctx context.Context, conversationID, userID int) (bool, error)
+	// ListMessages は会話のメッセージを新しい順に、beforeID より古いものから最大 limit 件返す。
+	// beforeID が0なら先頭から返す
+	ListMessages(ctx context.Context, conversationID, beforeID, limit int) ([]*model.Message, error)
+	// CreateMessage はメッセージを追加し、会話の最後のメッセージを更新する。
+	// 自分のメッセージは読んでいるので、送信者の既読も更新する
+	CreateMessage(ctx context.Context, conversationID, senderID int, content string) (*model.Message, error)
+	// MarkRead は userID が会話のメッセージを upToID まで既読にする。upToID が0なら最後のメッセージまで既読にする。
+	// 既読は戻さず、最後のメッセージより後にも進めない
+	MarkRead(ctx context.Context, conversationID, userID, upToID int) error
+	// CountActiveUsers は userIDs のうち、退会中でないユーザーの数を返す
+	CountActiveUsers(ctx context.Context, userIDs []int) (int, error)
+	// IsMutualFollow は a と b が互いにフォローしているかを返す
+	IsMutualFollow(ctx context.Context, a, b int) (bool, error)
+	// IsBlocked は a と b のどちらかがもう一方をブロックしているかを返す
+	IsBlocked(ctx context.Context, a, b int) (bool, error)
+	// IsOptedIn は userID がフォローしていないユーザーからのメッセージも受け取るかを返す
+	IsOptedIn(ctx context.Context, userID int) (bool, error)
+	// SetOptIn は userID がフォローしていないユーザーからのメッセージも受け取る(optIn が true)かを設定する
+	SetOptIn(ctx context.Context, userID int, optIn bool) error
+}
+
+type dmRepository struct {
+	db boil.ContextExecutor
+	// readDB は読み取り専用のメソッドで使う(リードレプリカがなければ db と同じ)
+	readDB boil.ContextExecutor
+}
+
+func NewDMRepository(db, readDB boil.ContextExecutor) DMRepository {
+	return &dmRepository{db: db, readDB: readDB}
+}
+
+func (r *dmRepository) exec(ctx context.Context) boil.ContextExecutor {
+	return infrastructure.Executor(ctx, r.db)
+}
+
+func (r *dmRepository) readExec(ctx context.Context) boil.ContextExecutor {
+	return infrastructure.Executor(ctx, r.readDB)
+}
+
+// conversationColumns は conversationRow に読み込む列。参加者の行と結合して読む
+const conversationColumns = `dm_conversations.id AS id,
+dm_conversations.direct_key AS direct_key,
+dm_conversations.last_message_id AS last_message_id,
+dm_conversations.created_at AS created_at`
+
+// participantJoin は会話を userID が参加しているものに絞る結合。引数は userID
+const participantJoin = "dm_participants ON dm_participants.conversation_id = dm_conversations.id AND dm_participants.user_id = ?"
+
+// participantsQuery は会話の退会中でない参加者を返す。%s は会話のIDの IN 句
+const participantsQuery = `SELECT dm_participants.conversation_id, dm_participants.last_read_message_id,
+    users.id, users.username, users.display_name, users.profile_image_url
+FROM dm_participants
+JOIN users ON users.id = dm_participants.user_id AND users.deleted_at IS NULL
+WHERE dm_participants.conversation_id IN (%s)
+ORDER BY dm_participants.conversation_id, users.id`
+
+// unreadQuery は会話ごとに、userID が既読にしていないほかの参加者のメッセージを数える。
+// 引数は userID、会話のID、userID の順で、%s は会話のIDの IN 句
+const unreadQuery = `SELECT dm_messages.conversation_id, COUNT(*) AS count
+FROM dm_messages
+JOIN dm_participants ON dm_participants.conversation_id = dm_messages.conversation_id AND dm_participants.user_id = ?
+WHERE dm_messages.conversation_id IN (%s)
+    AND dm_messages.id > dm_participants.last_read_message_id
+    AND dm_messages.sender_id <> ?
+GROUP BY dm_messages.conversation_id`
+
+type conversationRow struct {
+	ID            int         `boil:"id"`
+	DirectKey     null.String `boil:"direct_key"`
+	LastMessageID null.Int    `boil:"last_message_id"`
+	CreatedAt     null.Time   `boil:"created_at"`
+}
+
+type participantRow struct {
+	ConversationID    int         `boil:"conversation_id"`
+	LastReadMessageID int         `boil:"last_read_message_id"`
+	ID                int         `boil:"id"`
+	Username          string      `boil:"username"`
+	DisplayName       string      `boil:"display_name"`
+	ProfileImageURL   null.String `boil:"profile_image_url"`
+}
+
+type unreadRow struct {
+	ConversationID int `boil:"conversation_id"`
+	Count          int `boil:"count"`
+}
+
+func (r *dmRepository) CreateConversation(ctx context.Context, directKey string, userIDs []int) (int, error) {
+	exec := r.exec(ctx)
+
+	conversation := &schema.DMConversation{}
+	if directKey != "" {
+		conversation.DirectKey = null.StringFrom(directKey)
+	}
+	if err := conversation.Insert(ctx, exec, boil.Infer()); err != nil {
+		return 0, infrastructure.TranslateError(err)
+	}
+	for _, userID := range userIDs {
+		participant := &schema.DMParticipant{ConversationID: conversation.ID, UserID: userID}
+		if err := participant.Insert(ctx, exec, boil.Infer()); err != nil {
+			return 0, err
+		}
+	}
+	return conversation.ID, nil
+}
+
+func (r *dmRepository) FindDirectConversation(ctx context.Context, directKey string) (int, error) {
+	conversation, err := schema.DMConversations(
+		schema.DMConversationWhere.DirectKey.EQ(null.StringFrom(directKey)),
+	).One(ctx, r.exec(ctx))
+	if err != nil {
+		return 0, err
+	}
+	return conversation.ID, nil
+}
+
+func (r *dmRepository) GetConversation(ctx context.Context, id, userID int) (*model.Conversation, error) {
+	exec := r.readExec(ctx)
+
+	var rows []*conversationRow
+	err := schema.DMConversations(
+		qm.Select(conversationColumns),
+		qm.InnerJoin(participantJoin, userID),
+		schema.DMConversationWhere.ID.EQ(id),
+	).Bind(ctx, exec, &rows)
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, sql.ErrNoRows
+	}
+
+	conversations, err := r.convertRows(ctx, exec, rows, userID)
+	if err != nil {
+		return nil, err
+	}
+	return conversations[0], nil
+}
+
+func (r *dmRepository) ListConversations(ctx context.Context, userID, beforeMessageID, limit int) ([]*model.Conversation, error) {
+	exec := r.readExec(ctx)
+
+	mods := []qm.QueryMod{
+		qm.Select(conversationColumns),
+		qm.InnerJoin(participantJoin, userID),
+		schema.DMConversationWhere.LastMessageID.IsNotNull(),
+		qm.OrderBy(schema.DMConversationTableColumns.LastMessageID + " DESC"),
+		qm.Limit(limit),
+	}
+	if beforeMessageID > 0 {
+		mods = append(mods, schema.DMConversationWhere.LastMessageID.LT(null.IntFrom(beforeMessageID)))
+	}
+
+	var rows []*conversationRow
+	if err := schema.DMConversations(mods...).Bind(ctx, exec, &rows); err != nil {
+		return nil, err
+	}
+	return r.convertRows(ctx, exec, rows, userID)
+}
+
+// convertRows は rows に参加者・最後のメッセージ・userID の未読の数を読み込んで、rows の順に返す
+func (r *dmRepository) convertRows(ctx context.Context, exec boil.ContextExecutor, rows []*conversationRow, userID int) ([]*model.Conversation, error) {
+	conversations := make([]*model.Conversation, len(rows))
+	if len(rows) == 0 {
+		return conversations, nil
+	}
+
+	ids := make([]interface{}, len(rows))
+	var lastMessageIDs []interface{}
+	for i, row := range rows {
+		ids[i] = row.ID
+		if row.LastMessageID.Valid {
+			lastMessageIDs = append(lastMessageIDs, row.LastMessageID.Int)
+		}
+	}
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(ids)), ", ")
+
+	var participants []*participantRow
+	if err := queries.Raw(fmt.Sprintf(participantsQuery, placeholders), ids...).Bind(ctx, exec, &participants); err != nil {
+		return nil, err
+	}
+	participantsByConversation := make(map[int][]*model.Participant)
+	for _, p := range participants {
+		participantsByConversation[p.ConversationID] = append(participantsByConversation[p.ConversationID], &model.Participant{
+			ID:                p.ID,
+			Username:          p.Username,
+			DisplayName:       p.DisplayName,
+			ProfileImageURL:   p.ProfileImageURL.Ptr(),
+			LastReadMessageID: p.LastReadMessageID,
+		})
+	}
+
+	lastMessages := make(map[int]*model.Message)
+	if len(lastMessageIDs) > 0 {
+		messages, err := schema.DMMessages(qm.WhereIn(schema.DMMessageColumns.ID+" IN ?", lastMessageIDs...)).All(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+		for _, m := range messages {
+			lastMessages[m.ConversationID] = convertMessage(m)
+		}
+	}
+
+	var unread []*unreadRow
+	args := append(append([]interface{}{userID}, ids...), userID)
+	if err := queries.Raw(fmt.Sprintf(unreadQuery, placeholders), args...).Bind(ctx, exec, &unread); err != nil {
+		return nil, err
+	}
+	unreadByConversation := make(map[int]int, len(unread))
+	for _, u := range unread {
+		unreadByConversation[u.ConversationID] = u.Count
+	}
+
+	for i, row := range rows {
+		participants := participantsByConversation[row.ID]
+		if participants == nil {
+			participants = []*model.Participant{}
+		}
+		conversations[i] = &model.Conversation{
+			ID:            row.ID,
+			IsGroup:       !row.DirectKey.Valid,
+			Participants:  participants,
+			LastMessage:   lastMessages[row.ID],
+			LastMessageID: row.LastMessageID.Int,
+			UnreadCount:   unreadByConversation[row.ID],
+			CreatedAt:     row.CreatedAt.Time,
+		}
+	}
+	return conversations, nil
+}
+
+func (r *dmRepository) IsParticipant(ctx context.Context, conversationID, userID int) (bool, error) {
+	return schema.DMParticipantExists(ctx, r.readExec(ctx), conversationID, userID)
+}
+
+func (r *dmRepository) ListMessages(ctx context.Context, conversationID, beforeID, limit int) ([]*model.Message, error) {
+	mods := []qm.QueryMod{
+		schema.DMMessageWhere.ConversationID.EQ(conversationID),
+		qm.OrderBy(schema.DMMessageColumns.ID + " DESC"),
+		qm.Limit(limit),
+	}
+	if beforeID > 0 {
+		mods = append(mods, schema.DMMessageWhere.ID.LT(beforeID))
+	}
+
+	rows, err := schema.DMMessages(mods...).All(ctx, r.readExec(ctx))
+	if err != nil {
+		return nil, err
+	}
+	messages := make([]*model.Message, len(rows))
+	for i, row := range rows {
+		messages[i] = convertMessage(row)
+	}
+	return messages, nil
+}
+
+func (r *dmRepository) CreateMessage(ctx context.Context, conversationID, senderID int, content string) (*model.Message, error) {
+	exec := r.exec(ctx)
+
+	message := &schema.DMMessage{ConversationID: conversationID, SenderID: senderID, Content: content}
+	if err := message.Insert(ctx, exec, boil.Infer()); err != nil {
+		return nil, err
+	}
+	// 同時に送られた場合も、最後のメッセージが前のメッセージに戻らないようにする
+	_, err := schema.DMConversations(
+		schema.DMConversationWhere.ID.EQ(conversationID),
+		qm.Where("(last_message_id IS NULL OR last_message_id < ?)", message.ID),
+	).UpdateAll(ctx, exec, schema.M{schema.DMConversationColumns.LastMessageID: message.ID})
+	if err != nil {
+		return nil, err
+	}
+	if err := r.updateLastRead(ctx, exec, conversationID, senderID, message.ID); err != nil {
+		return nil, err
+	}
+	return convertMessage(message), nil
+}
+
+func (r *dmRepository) MarkRead(ctx context.Context, conversationID, userID, upToID int) error {
+	exec := r.exec(ctx)
+
+	conversation, err := schema.FindDMConversation(ctx, exec, conversationID)
+	if err != nil {
+		return err
+	}
+	if !conversation.LastMessageID.Valid {
+		return nil
+	}
+	if upToID <= 0 || upToID > conversation.LastMessageID.Int {
+		upToID = conversation.LastMessageID.Int
+	}
+	return r.updateLastRead(ctx, exec, conversationID, userID, upToID)
+}
+
+// updateLastRead は userID の既読を messageID まで進める。既に進んでいれば何もしない
+func (r *dmRepository) updateLastRead(ctx context.Context, exec boil.ContextExecutor, conversationID, userID, messageID int) error {
+	_, err := schema.DMParticipants(
+		schema.DMParticipantWhere.ConversationID.EQ(conversationID),
+		schema.DMParticipantWhere.UserID.EQ(userID),
+		schema.DMParticipantWhere.LastReadMessageID.LT(messageID),
+	).UpdateAll(ctx, exec, schema.M{schema.DMParticipantColumns.LastReadMessageID: messageID})
+	return err
+}
+
+func (r *dmRepository) CountActiveUsers(ctx context.Context, userIDs []int) (int, error) {
+	if len(userIDs) == 0 {
+		return 0, nil
+	}
+	ids := make([]interface{}, len(userIDs))
+	for i, id := range userIDs {
+		ids[i] = id
+	}
+	count, err := schema.Users(qm.WhereIn(schema.UserColumns.ID+" IN ?", ids...)).Count(ctx, r.readExec(ctx))
+	return int(count), err
+}
+
+func (r *dmRepository) IsMutualFollow(ctx context.Context, a, b int) (bool, error) {
+	exec := r.readExec(ctx)
+	following, err := schema.FollowExists(ctx, exec, a, b)
+	if err != nil || !following {
+		return false, err
+	}
+	return schema.FollowExists(ctx, exec, b, a)
+}
+
+func (r *dmRepository) IsBlocked(ctx context.Context, a, b int) (bool, error) {
+	return schema.Blocks(
+		qm.Where("(blocker_id = ? AND blocked_id = ?) OR (blocker_id = ? AND blocked_id = ?)", a, b, b, a),
+	).Exists(ctx, r.readExec(ctx))
+}
+
+func (r *dmRepository) IsOptedIn(ctx context.Context, userID int) (bool, error) {
+	return schema.DMOptInExists(ctx, r.readExec(ctx), userID)
+}
+
+func (r *dmRepository) SetOptIn(ctx context.Context, userID int, optIn bool) error {
+	exec := r.exec(ctx)
+	exists, err := schema.DMOptInExists(ctx, exec, userID)
+	if err != nil || exists == optIn {
+		return err
+	}
+	if !optIn {
+		_, err := schema.DMOptIns(schema.DMOptInWhere.UserID.EQ(userID)).DeleteAll(ctx, exec)
+		return err
+	}
+	row := &schema.DMOptIn{UserID: userID}
+	err = infrastructure.TranslateError(row.Insert(ctx, exec, boil.Infer()))
+	// 同時に設定された場合も、受け取る設定になっていればよい
+	if errors.Is(err, domain.ErrConflict) {
+		return nil
+	}
+	return err
+}
+
+func convertMessage(m *schema.DMMessage) *model.Message {
+	return &model.Message{
+		ID:             m.ID,
+		ConversationID: m.ConversationID,
+		SenderID:       m.SenderID,
+		Content:        m.Content,
+		CreatedAt:      m.CreatedAt.Time,
+	}
+}
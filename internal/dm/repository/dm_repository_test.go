@@ -0,0 +1,118 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+	"todoapp/internal/dbtest"
+	"todoapp/internal/domain"
+	"todoapp/internal/schema"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestDMRepository(t *testing.T) {
+	ctx := context.Background()
+	db := dbtest.New(t)
+	repo := NewDMRepository(db, db)
+
+	var users []*schema.User
+	for _, name := range []string{"alice", "bob", "carol"} {
+		u := &schema.User{Username: name, DisplayName: name, Email: name + "@example.com", PasswordHash: "hash"}
+		if err := u.Insert(ctx, db, boil.Infer()); err != nil {
+			t.Fatal(err)
+		}
+		users = append(users, u)
+	}
+	alice, bob, carol := users[0], users[1], users[2]
+
+	var ids []int
+	for _, participants := range [][]int{{alice.ID, bob.ID}, {alice.ID, carol.ID}, {alice.ID, bob.ID, carol.ID}} {
+		id, err := repo.CreateConversation(ctx, "", participants)
+		if err != nil {
+			t.Fatalf("CreateConversation: %v", err)
+		}
+		ids = append(ids, id)
+	}
+	if _, err := repo.CreateConversation(ctx, "1:2", []int{alice.ID, bob.ID}); err != nil {
+		t.Fatalf("CreateConversation: %v", err)
+	}
+	if _, err := repo.CreateConversation(ctx, "1:2", []int{alice.ID, bob.ID}); !errors.Is(err, domain.ErrConflict) {
+		t.Errorf("CreateConversation with the same direct key: err = %v, want conflict", err)
+	}
+
+	send := func(id int, sender *schema.User) {
+		t.Helper()
+		if _, err := repo.CreateMessage(ctx, id, sender.ID, "hello from "+sender.Username); err != nil {
+			t.Fatalf("CreateMessage: %v", err)
+		}
+	}
+	send(ids[0], bob)
+	send(ids[1], carol)
+	send(ids[2], carol)
+	send(ids[0], bob)
+
+	// alice の会話をカーソルで読むと、最後のメッセージが新しい順になる(メッセージのない会話は含めない)
+	var got []int
+	for before := 0; ; {
+		page, err := repo.ListConversations(ctx, alice.ID, before, 2)
+		if err != nil {
+			t.Fatalf("ListConversations: %v", err)
+		}
+		for _, c := range page {
+			got = append(got, c.ID)
+		}
+		if len(page) < 2 {
+			break
+		}
+		before = page[len(page)-1].LastMessage.ID
+	}
+	if want := []int{ids[0], ids[2], ids[1]}; len(got) != len(want) || got[0] != want[0] || got[1] != want[1] || got[2] != want[2] {
+		t.Errorf("conversations = %v, want %v", got, want)
+	}
+
+	// 既読は最後のメッセージより先に進めず、戻しもしない
+	conversation, err := repo.GetConversation(ctx, ids[0], alice.ID)
+	if err != nil {
+		t.Fatalf("GetConversation: %v", err)
+	}
+	if conversation.UnreadCount != 2 {
+		t.Errorf("unread = %d, want 2", conversation.UnreadCount)
+	}
+	lastID := conversation.LastMessage.ID
+	for _, upTo := range []int{lastID + 100, 1} {
+		if err := repo.MarkRead(ctx, ids[0], alice.ID, upTo); err != nil {
+			t.Fatalf("MarkRead: %v", err)
+		}
+	}
+	conversation, err = repo.GetConversation(ctx, ids[0], alice.ID)
+	if err != nil {
+		t.Fatalf("GetConversation: %v", err)
+	}
+	for _, p := range conversation.Participants {
+		if p.ID == alice.ID && p.LastReadMessageID != lastID {
+			t.Errorf("last read = %d, want %d", p.LastReadMessageID, lastID)
+		}
+	}
+	if conversation.UnreadCount != 0 {
+		t.Errorf("unread after read = %d, want 0", conversation.UnreadCount)
+	}
+
+	// 退会中のユーザーは参加者に含めず、参加していない会話は読めない
+	bob.DeletedAt = null.TimeFrom(time.Now())
+	if _, err := bob.Update(ctx, db, boil.Whitelist(schema.UserColumns.DeletedAt)); err != nil {
+		t.Fatal(err)
+	}
+	conversation, err = repo.GetConversation(ctx, ids[0], alice.ID)
+	if err != nil {
+		t.Fatalf("GetConversation: %v", err)
+	}
+	if len(conversation.Participants) != 1 {
+		t.Errorf("participants = %d, want 1", len(conversation.Participants))
+	}
+	if _, err := repo.GetConversation(ctx, ids[1], bob.ID); err == nil {
+		t.Error("GetConversation by non-participant: want error")
+	}
+}
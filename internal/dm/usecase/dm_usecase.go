@@ -0,0 +1,315 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+	"todoapp/internal/dm/model"
+	"todoapp/internal/dm/repository"
+	"todoapp/internal/domain"
+	"todoapp/internal/infrastructure"
+	"todoapp/internal/tweet/entity"
+)
+
+const (
+	defaultListLimit = 20
+	maxListLimit     = 100
+)
+
+// 送信できるのは、相互にフォローしているユーザーか、フォローしていないユーザーからのメッセージも
+// 受け取る設定(Settings.AllowFromAnyone)にしているユーザーだけ。どちらかがブロックしていれば送れない
+type DMUsecase interface {
+	// CreateConversation は userID と req.UserIDs の会話を作る。1対1の会話が既にあればそれを返し、created は false になる。
+	// req.UserIDs のユーザーがいないか退会中なら sql.ErrNoRows を、メッセージを送れないユーザーが含まれていれば
+	// domain.ErrForbidden を返す
+	CreateConversation(ctx context.Context, userID int, req model.CreateConversationRequest) (conversation *model.Conversation, created bool, err error)
+	// GetConversation は userID が参加している会話を返す。参加していなければ sql.ErrNoRows を返す
+	GetConversation(ctx context.Context, userID, id int) (*model.Conversation, error)
+	// ListConversations は userID が参加している、メッセージのある会話を最後のメッセージが新しい順に返す
+	ListConversations(ctx context.Context, userID int, req model.ListRequest) (*model.Conversations, error)
+	// ListMessages は会話のメッセージを新しい順に返す
+	ListMessages(ctx context.Context, userID, id int, req model.ListRequest) (*model.Messages, error)
+	// SendMessage は会話にメッセージを送る。1対1の会話では送るたびに相手に送れるかを確認する。
+	// グループの会話はフォローと受信設定を作成したときにだけ確認するが、ブロックは送るたびに確認し、
+	// ほかの参加者のだれかとどちらかがブロックしていれば domain.ErrForbidden を返す
+	SendMessage(ctx context.Context, userID, id int, req model.SendMessageRequest) (*model.Message, error)
+	// MarkRead は会話のメッセージを req.UpToID まで既読にする
+	MarkRead(ctx context.Context, userID, id int, req model.MarkReadRequest) error
+	GetSettings(ctx context.Context, userID int) (*model.Settings, error)
+	UpdateSettings(ctx context.Context, userID int, settings model.Settings) (*model.Settings, error)
+}
+
+type dmUsecase struct {
+	repo      repository.DMRepository
+	txManager infrastructure.TxManager
+}
+
+func NewDMUsecase(repo repository.DMRepository, txManager infrastructure.TxManager) DMUsecase {
+	return &dmUsecase{
+		repo:      repo,
+		txManager: txManager,
+	}
+}
+
+func (u *dmUsecase) CreateConversation(ctx context.Context, userID int, req model.CreateConversationRequest) (*model.Conversation, bool, error) {
+	recipients, err := normalizeRecipients(userID, req.UserIDs)
+	if err != nil {
+		return nil, false, err
+	}
+
+	var id int
+	created := false
+	err = u.txManager.RunInTx(ctx, func(ctx context.Context) error {
+		count, err := u.repo.CountActiveUsers(ctx, recipients)
+		if err != nil {
+			return err
+		}
+		if count != len(recipients) {
+			return sql.ErrNoRows
+		}
+		for _, recipientID := range recipients {
+			if err := u.checkCanMessage(ctx, userID, recipientID); err != nil {
+				return err
+			}
+		}
+
+		participants := append([]int{userID}, recipients...)
+		if len(recipients) > 1 {
+			id, err = u.repo.CreateConversation(ctx, "", participants)
+			created = err == nil
+			return err
+		}
+
+		key := directKey(userID, recipients[0])
+		id, err = u.repo.FindDirectConversation(ctx, key)
+		if !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+		id, err = u.repo.CreateConversation(ctx, key, participants)
+		created = err == nil
+		return err
+	})
+	// 同じ2人の会話が同時に作られた場合は、先に作られた会話を返す
+	if errors.Is(err, domain.ErrConflict) && len(recipients) == 1 {
+		id, err = u.repo.FindDirectConversation(ctx, directKey(userID, recipients[0]))
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	conversation, err := u.repo.GetConversation(ctx, id, userID)
+	if err != nil {
+		return nil, false, err
+	}
+	return conversation, created, nil
+}
+
+// normalizeRecipients は自分以外の参加者を重複を除いて昇順に並べ、人数を確認する
+func normalizeRecipients(userID int, userIDs []int) ([]int, error) {
+	if len(userIDs) == 0 {
+		return nil, domain.Invalid("user_ids is required")
+	}
+	seen := make(map[int]bool, len(userIDs))
+	recipients := make([]int, 0, len(userIDs))
+	for _, id := range userIDs {
+		if id == userID {
+			return nil, domain.Invalid("user_ids must not include yourself")
+		}
+		if id <= 0 {
+			return nil, domain.Invalid("invalid user ID: " + strconv.Itoa(id))
+		}
+		if !seen[id] {
+			seen[id] = true
+			recipients = append(recipients, id)
+		}
+	}
+	if len(recipients)+1 > model.MaxParticipants {
+		return nil, domain.Invalid("a conversation can have at most " + strconv.Itoa(model.MaxParticipants) + " participants")
+	}
+	sort.Ints(recipients)
+	return recipients, nil
+}
+
+// directKey は a と b の1対1の会話のキー。IDを小さい順につなぎ、どちらから作っても同じキーにする
+func directKey(a, b int) string {
+	if a > b {
+		a, b = b, a
+	}
+	return strconv.Itoa(a) + ":" + strconv.Itoa(b)
+}
+
+// checkCanMessage は senderID が recipientID にメッセージを送れなければ domain.ErrForbidden を返す
+func (u *dmUsecase) checkCanMessage(ctx context.Context, senderID, recipientID int) error {
+	if err := u.checkNotBlocked(ctx, senderID, recipientID); err != nil {
+		return err
+	}
+	mutual, err := u.repo.IsMutualFollow(ctx, senderID, recipientID)
+	if err != nil || mutual {
+		return err
+	}
+	optedIn, err := u.repo.IsOptedIn(ctx, recipientID)
+	if err != nil || optedIn {
+		return err
+	}
+	return domain.Forbidden("you can only message user " + strconv.Itoa(recipientID) + " if you follow each other")
+}
+
+// checkNotBlocked は senderID と recipientID のどちらかがブロックしていれば domain.ErrForbidden を返す
+func (u *dmUsecase) checkNotBlocked(ctx context.Context, senderID, recipientID int) error {
+	blocked, err := u.repo.IsBlocked(ctx, senderID, recipientID)
+	if err != nil {
+		return err
+	}
+	if blocked {
+		return domain.Forbidden("you cannot message user " + strconv.Itoa(recipientID))
+	}
+	return nil
+}
+
+func (u *dmUsecase) GetConversation(ctx context.Context, userID, id int) (*model.Conversation, error) {
+	return u.repo.GetConversation(ctx, id, userID)
+}
+
+func (u *dmUsecase) ListConversations(ctx context.Context, userID int, req model.ListRequest) (*model.Conversations, error) {
+	beforeID, err := parseListRequest(&req)
+	if err != nil {
+		return nil, err
+	}
+
+	// 1件多く読み、続きがあるかを判定する
+	conversations, err := u.repo.ListConversations(ctx, userID, beforeID, req.Limit+1)
+	if err != nil {
+		return nil, err
+	}
+	resp := &model.Conversations{Conversations: conversations}
+	if len(conversations) > req.Limit {
+		resp.Conversations = conversations[:req.Limit]
+		// 一覧の並び順と同じ last_message_id から作る
+		next := strconv.Itoa(resp.Conversations[req.Limit-1].LastMessageID)
+		resp.NextCursor = &next
+	}
+	return resp, nil
+}
+
+func (u *dmUsecase) ListMessages(ctx context.Context, userID, id int, req model.ListRequest) (*model.Messages, error) {
+	beforeID, err := parseListRequest(&req)
+	if err != nil {
+		return nil, err
+	}
+	if err := u.checkParticipant(ctx, id, userID); err != nil {
+		return nil, err
+	}
+
+	messages, err := u.repo.ListMessages(ctx, id, beforeID, req.Limit+1)
+	if err != nil {
+		return nil, err
+	}
+	resp := &model.Messages{Messages: messages}
+	if len(messages) > req.Limit {
+		resp.Messages = messages[:req.Limit]
+		next := strconv.Itoa(resp.Messages[req.Limit-1].ID)
+		resp.NextCursor = &next
+	}
+	return resp, nil
+}
+
+func (u *dmUsecase) SendMessage(ctx context.Context, userID, id int, req model.SendMessageRequest) (*model.Message, error) {
+	content := entity.Normalize(req.Content)
+	if strings.TrimSpace(content) == "" {
+		return nil, domain.Invalid("content is required")
+	}
+	if entity.Length(content) > model.MaxContentLength {
+		return nil, domain.Invalid("content must be at most " + strconv.Itoa(model.MaxContentLength) + " characters")
+	}
+
+	var message *model.Message
+	err := u.txManager.RunInTx(ctx, func(ctx context.Context) error {
+		conversation, err := u.repo.GetConversation(ctx, id, userID)
+		if err != nil {
+			return err
+		}
+		// 相手が退会中なら参加者に含まれない
+		if !conversation.IsGroup && len(conversation.Participants) != 2 {
+			return domain.Forbidden("the other participant is not available")
+		}
+		for _, p := range conversation.Participants {
+			if p.ID == userID {
+				continue
+			}
+			if conversation.IsGroup {
+				err = u.checkNotBlocked(ctx, userID, p.ID)
+			} else {
+				err = u.checkCanMessage(ctx, userID, p.ID)
+			}
+			if err != nil {
+				return err
+			}
+		}
+
+		message, err = u.repo.CreateMessage(ctx, id, userID, content)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return message, nil
+}
+
+func (u *dmUsecase) MarkRead(ctx context.Context, userID, id int, req model.MarkReadRequest) error {
+	if req.UpToID < 0 {
+		return domain.Invalid("up_to_id must not be negative")
+	}
+	if err := u.checkParticipant(ctx, id, userID); err != nil {
+		return err
+	}
+	return u.repo.MarkRead(ctx, id, userID, req.UpToID)
+}
+
+func (u *dmUsecase) GetSettings(ctx context.Context, userID int) (*model.Settings, error) {
+	optedIn, err := u.repo.IsOptedIn(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return &model.Settings{AllowFromAnyone: optedIn}, nil
+}
+
+func (u *dmUsecase) UpdateSettings(ctx context.Context, userID int, settings model.Settings) (*model.Settings, error) {
+	if err := u.repo.SetOptIn(ctx, userID, settings.AllowFromAnyone); err != nil {
+		return nil, err
+	}
+	return u.GetSettings(ctx, userID)
+}
+
+// checkParticipant は userID が会話に参加していなければ sql.ErrNoRows を返す
+// (会話があることも知らせないため、会話がない場合と区別しない)
+func (u *dmUsecase) checkParticipant(ctx context.Context, id, userID int) error {
+	ok, err := u.repo.IsParticipant(ctx, id, userID)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+// parseListRequest はカーソルを解析し、req.Limit を範囲内に収める
+func parseListRequest(req *model.ListRequest) (int, error) {
+	if req.Limit <= 0 {
+		req.Limit = defaultListLimit
+	}
+	if req.Limit > maxListLimit {
+		req.Limit = maxListLimit
+	}
+	if req.Cursor == "" {
+		return 0, nil
+	}
+	id, err := strconv.Atoi(req.Cursor)
+	if err != nil || id <= 0 {
+		return 0, domain.Invalid("invalid cursor")
+	}
+	return id, nil
+}
@@ -0,0 +1,45 @@
+package domain
+
+import "errors"
+
+// エラーの種類。errors.Is で判定する
+var (
+	ErrConflict  = errors.New("conflict")
+	ErrForbidden = errors.New("forbidden")
+	ErrGone      = errors.New("gone")
+	ErrInvalid   = errors.New("invalid")
+)
+
+// Error は種類とクライアントに返すメッセージを持つドメインエラー
+type Error struct {
+	Kind    error
+	Message string
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+func (e *Error) Unwrap() error {
+	return e.Kind
+}
+
+// Conflict は一意制約違反などの競合を表すエラーを返す
+func Conflict(message string) error {
+	return &Error{Kind: ErrConflict, Message: message}
+}
+
+// Forbidden は認証はできたが操作が許可されていないことを表すエラーを返す
+func Forbidden(message string) error {
+	return &Error{Kind: ErrForbidden, Message: message}
+}
+
+// Gone は対象が削除されていて、もう元に戻せないことを表すエラーを返す
+func Gone(message string) error {
+	return &Error{Kind: ErrGone, Message: message}
+}
+
+// Invalid はリクエストの内容が不正であることを表すエラーを返す
+func Invalid(message string) error {
+	return &Error{Kind: ErrInvalid, Message: message}
+}
@@ -0,0 +1,56 @@
+package handler
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"strconv"
+	"todoapp/internal/domain"
+	"todoapp/internal/export/usecase"
+
+	"github.com/labstack/echo/v4"
+)
+
+type ExportHandler struct {
+	usecase usecase.ExportUsecase
+}
+
+func NewExportHandler(u usecase.ExportUsecase) *ExportHandler {
+	return &ExportHandler{
+		usecase: u,
+	}
+}
+
+// Request はログイン中のユーザーのデータのエクスポートを受け付ける。
+// 作成状況とダウンロードURLは Get で確認する
+func (h *ExportHandler) Request(c echo.Context) error {
+	userID, _ := c.Get("user_id").(int)
+	export, err := h.usecase.Request(c.Request().Context(), userID)
+	if err != nil {
+		if errors.Is(err, domain.ErrConflict) {
+			return c.JSON(http.StatusConflict, map[string]string{"error": err.Error()})
+		}
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	c.Response().Header().Set(echo.HeaderLocation, "/api/users/me/exports/"+strconv.Itoa(export.ID))
+	return c.JSON(http.StatusAccepted, export)
+}
+
+func (h *ExportHandler) Get(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid export ID"})
+	}
+
+	userID, _ := c.Get("user_id").(int)
+	export, err := h.usecase.Get(c.Request().Context(), userID, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return c.JSON(http.StatusNotFound, map[string]string{"error": "Export not found"})
+		}
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, export)
+}
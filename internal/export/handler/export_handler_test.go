@@ -0,0 +1,283 @@
+package handler_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+	"todoapp/internal/audit"
+	auditmodel "todoapp/internal/audit/model"
+	"todoapp/internal/blob"
+	"todoapp/internal/export/handler"
+	"todoapp/internal/export/model"
+	"todoapp/internal/export/repository"
+	"todoapp/internal/export/usecase"
+	notificationhandler "todoapp/internal/notification/handler"
+	notificationmodel "todoapp/internal/notification/model"
+	notificationrepository "todoapp/internal/notification/repository"
+	notificationusecase "todoapp/internal/notification/usecase"
+	"todoapp/internal/router"
+	"todoapp/internal/schema"
+	"todoapp/internal/stream"
+	"todoapp/internal/testutil"
+	tweetrepository "todoapp/internal/tweet/repository"
+
+	"github.com/labstack/echo/v4"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+type testServer struct {
+	e       *echo.Echo
+	db      boil.ContextExecutor
+	exports usecase.ExportUsecase
+	hub     *stream.Hub
+}
+
+func newTestServer(t *testing.T) *testServer {
+	t.Helper()
+	env := testutil.NewEnv(t)
+	store, err := blob.NewLocal(t.TempDir(), "", []byte("secret"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	hub, err := stream.NewHub(stream.NewLocal(), stream.Config{BufferSize: 16, HistorySize: 100})
+	if err != nil {
+		t.Fatalf("NewHub: %v", err)
+	}
+	t.Cleanup(hub.Close)
+	stream.RegisterPublishHooks(hub, tweetrepository.NewTweetRepository(env.DB, env.DB))
+
+	exports := usecase.NewExportUsecase(
+		repository.NewExportRepository(env.DB, env.DB), env.AuditRepo, env.TxManager, env.Audit, store,
+		notificationrepository.NewExportNotifier(env.DB),
+		usecase.Config{URLTTL: time.Hour, Retention: 24 * time.Hour})
+
+	e := env.Server(router.Handlers{
+		Export: handler.NewExportHandler(exports),
+		Notification: notificationhandler.NewNotificationHandler(
+			notificationusecase.NewNotificationUsecase(notificationrepository.NewNotificationRepository(env.DB, env.DB), env.TxManager)),
+		Blobs: store,
+	}, audit.Middleware)
+	return &testServer{e: e, db: env.DB, exports: exports, hub: hub}
+}
+
+// ServeHTTP はセッションの記録を確かめるため、User-Agent を付けてリクエストする
+func (s *testServer) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	req.Header.Set("User-Agent", "export-test")
+	s.e.ServeHTTP(w, req)
+}
+
+func (s *testServer) do(t *testing.T, method, path, token, body string) *httptest.ResponseRecorder {
+	t.Helper()
+	return testutil.Do(t, s, method, path, token, body)
+}
+
+func decode(t *testing.T, data []byte, v interface{}) {
+	t.Helper()
+	if err := json.Unmarshal(data, v); err != nil {
+		t.Fatalf("invalid JSON %q: %v", data, err)
+	}
+}
+
+func insert(t *testing.T, db boil.ContextExecutor, rows ...interface {
+	Insert(context.Context, boil.ContextExecutor, boil.Columns) error
+}) {
+	t.Helper()
+	for _, row := range rows {
+		if err := row.Insert(context.Background(), db, boil.Infer()); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestExport(t *testing.T) {
+	s := newTestServer(t)
+	alice, token := testutil.RegisterAndLogin(t, s, "alice")
+	bob, bobToken := testutil.RegisterAndLogin(t, s, "bob")
+
+	tweet := &schema.Tweet{UserID: alice, Content: "hello"}
+	insert(t, s.db, tweet)
+	insert(t, s.db,
+		&schema.Tweet{UserID: alice, Content: "deleted", DeletedAt: null.TimeFrom(time.Now())},
+		&schema.Like{UserID: alice, TweetID: tweet.ID},
+		&schema.Retweet{UserID: alice, TweetID: tweet.ID},
+		&schema.Follow{FollowerID: bob, FollowingID: alice},
+	)
+
+	rec := s.do(t, http.MethodPost, "/api/users/me/export", token, "")
+	if rec.Code != http.StatusAccepted {
+		t.Fatalf("export: status = %d, body = %s", rec.Code, rec.Body)
+	}
+	var export model.Export
+	decode(t, rec.Body.Bytes(), &export)
+	location := "/api/users/me/exports/" + strconv.Itoa(export.ID)
+	if export.Status != model.StatusPending || rec.Header().Get(echo.HeaderLocation) != location {
+		t.Errorf("export = %+v, Location = %q", export, rec.Header().Get(echo.HeaderLocation))
+	}
+
+	// 作成待ちの間は重複して受け付けない
+	if rec := s.do(t, http.MethodPost, "/api/users/me/export", token, ""); rec.Code != http.StatusConflict {
+		t.Errorf("second export: status = %d, want 409", rec.Code)
+	}
+
+	sub := s.hub.Subscribe(alice, 0)
+	defer sub.Close()
+	processed, err := s.exports.ProcessPending(context.Background())
+	if err != nil || !processed {
+		t.Fatalf("ProcessPending = %v, %v, want true", processed, err)
+	}
+	if processed, err := s.exports.ProcessPending(context.Background()); err != nil || processed {
+		t.Errorf("ProcessPending with nothing pending = %v, %v, want false", processed, err)
+	}
+	// 完了は通知として作成し、ストリームにも配る
+	select {
+	case e := <-sub.Events:
+		var notification stream.NotificationEvent
+		if err := json.Unmarshal(e.Data, &notification); err != nil || e.Type != stream.EventNotification ||
+			notification.Type != notificationmodel.TypeExportReady || notification.ActorID != alice {
+			t.Errorf("event = %s %s, want an export_ready notification", e.Type, e.Data)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the export_ready notification")
+	}
+	var notifications notificationmodel.Notifications
+	decode(t, s.do(t, http.MethodGet, "/api/notifications", token, "").Body.Bytes(), &notifications)
+	if len(notifications.Notifications) != 1 || notifications.Notifications[0].Type != notificationmodel.TypeExportReady ||
+		notifications.Notifications[0].Message != "Your data export is ready" || notifications.UnreadCount != 1 {
+		t.Errorf("notifications = %+v, want one unread export_ready", notifications)
+	}
+
+	// 他のユーザーのエクスポートは見えない
+	if rec := s.do(t, http.MethodGet, location, bobToken, ""); rec.Code != http.StatusNotFound {
+		t.Errorf("get by other user: status = %d, want 404", rec.Code)
+	}
+
+	rec = s.do(t, http.MethodGet, location, token, "")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("get: status = %d, body = %s", rec.Code, rec.Body)
+	}
+	decode(t, rec.Body.Bytes(), &export)
+	if export.Status != model.StatusReady || export.DownloadURL == "" || export.ExpiresAt == nil {
+		t.Fatalf("export = %+v, want ready with a download URL", export)
+	}
+
+	// 署名を改ざんしたURLではダウンロードできない
+	if rec := s.do(t, http.MethodGet, export.DownloadURL+"0", "", ""); rec.Code != http.StatusForbidden {
+		t.Errorf("tampered URL: status = %d, want 403", rec.Code)
+	}
+	rec = s.do(t, http.MethodGet, export.DownloadURL, "", "")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("download: status = %d, body = %s", rec.Code, rec.Body)
+	}
+
+	files := readZip(t, rec.Body.Bytes())
+	var profile model.Profile
+	decode(t, files["profile.json"], &profile)
+	if profile.ID != alice || profile.Email != "alice@example.com" {
+		t.Errorf("profile.json = %+v", profile)
+	}
+	var tweets []model.Tweet
+	decode(t, files["tweets.json"], &tweets)
+	if len(tweets) != 2 || tweets[0].Content != "hello" || tweets[1].DeletedAt == nil {
+		t.Errorf("tweets.json = %+v, want both tweets including the deleted one", tweets)
+	}
+	var likes []model.Like
+	decode(t, files["likes.json"], &likes)
+	if len(likes) != 1 || likes[0].TweetID != tweet.ID {
+		t.Errorf("likes.json = %+v", likes)
+	}
+	var retweets []model.Like
+	decode(t, files["retweets.json"], &retweets)
+	if len(retweets) != 1 || retweets[0].TweetID != tweet.ID {
+		t.Errorf("retweets.json = %+v", retweets)
+	}
+	var followers, following []model.Follow
+	decode(t, files["followers.json"], &followers)
+	decode(t, files["following.json"], &following)
+	if len(followers) != 1 || followers[0].Username != "bob" || len(following) != 0 {
+		t.Errorf("followers.json = %+v, following.json = %+v", followers, following)
+	}
+	var sessions []model.Session
+	decode(t, files["sessions.json"], &sessions)
+	if len(sessions) != 1 || sessions[0].UserAgent != "export-test" {
+		t.Errorf("sessions.json = %+v, want one login", sessions)
+	}
+	var events []*auditmodel.Event
+	decode(t, files["audit_events.json"], &events)
+	types := map[string]bool{}
+	for _, e := range events {
+		types[e.Type] = true
+	}
+	if !types[auditmodel.EventLoginSucceeded] || !types[auditmodel.EventExportRequested] {
+		t.Errorf("audit_events.json types = %v", types)
+	}
+
+	// 作成が終われば新しいエクスポートを受け付ける
+	if rec := s.do(t, http.MethodPost, "/api/users/me/export", token, ""); rec.Code != http.StatusAccepted {
+		t.Errorf("export after ready: status = %d, want 202", rec.Code)
+	}
+}
+
+func TestExportCleanupExpired(t *testing.T) {
+	s := newTestServer(t)
+	_, token := testutil.RegisterAndLogin(t, s, "alice")
+
+	rec := s.do(t, http.MethodPost, "/api/users/me/export", token, "")
+	if rec.Code != http.StatusAccepted {
+		t.Fatalf("export: status = %d, body = %s", rec.Code, rec.Body)
+	}
+	var export model.Export
+	decode(t, rec.Body.Bytes(), &export)
+	if _, err := s.exports.ProcessPending(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	location := "/api/users/me/exports/" + strconv.Itoa(export.ID)
+	decode(t, s.do(t, http.MethodGet, location, token, "").Body.Bytes(), &export)
+
+	if n, err := s.exports.CleanupExpired(context.Background()); err != nil || n != 0 {
+		t.Fatalf("CleanupExpired before expiry = %d, %v, want 0", n, err)
+	}
+	_, err := schema.DataExports(schema.DataExportWhere.ID.EQ(export.ID)).UpdateAll(context.Background(), s.db,
+		schema.M{schema.DataExportColumns.ExpiresAt: time.Now().Add(-time.Minute)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n, err := s.exports.CleanupExpired(context.Background()); err != nil || n != 1 {
+		t.Fatalf("CleanupExpired = %d, %v, want 1", n, err)
+	}
+
+	var expired model.Export
+	decode(t, s.do(t, http.MethodGet, location, token, "").Body.Bytes(), &expired)
+	if expired.Status != model.StatusExpired || expired.DownloadURL != "" {
+		t.Errorf("export after cleanup = %+v, want expired", expired)
+	}
+}
+
+func readZip(t *testing.T, data []byte) map[string][]byte {
+	t.Helper()
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatalf("invalid zip: %v", err)
+	}
+	files := map[string][]byte{}
+	for _, f := range zr.File {
+		r, err := f.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		files[f.Name], err = io.ReadAll(r)
+		r.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	return files
+}
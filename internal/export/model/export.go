@@ -0,0 +1,90 @@
+package model
+
+import (
+	"time"
+	auditmodel "todoapp/internal/audit/model"
+)
+
+// エクスポートの状態
+const (
+	StatusPending = "pending"
+	StatusRunning = "running"
+	StatusReady   = "ready"
+	StatusFailed  = "failed"
+	// StatusExpired は保存期間を過ぎてファイルを削除した状態
+	StatusExpired = "expired"
+)
+
+type Export struct {
+	ID          int        `json:"id"`
+	UserID      int        `json:"user_id"`
+	Status      string     `json:"status"`
+	BlobKey     string     `json:"-"`
+	Error       string     `json:"error,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+	// ExpiresAt はファイルを削除する日時
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	// DownloadURL は Status が ready の場合の期限付きの署名付きURL
+	DownloadURL string `json:"download_url,omitempty"`
+}
+
+// Archive はエクスポートするデータ。フィールドごとにZIPの1ファイルになる
+type Archive struct {
+	Profile   Profile  `json:"profile"`
+	Tweets    []Tweet  `json:"tweets"`
+	Likes     []Like   `json:"likes"`
+	Retweets  []Like   `json:"retweets"`
+	Followers []Follow `json:"followers"`
+	Following []Follow `json:"following"`
+	// Sessions はログインの履歴。トークンはサーバーに保存しないので、監査ログのログイン成功から作る
+	Sessions    []Session           `json:"sessions"`
+	AuditEvents []*auditmodel.Event `json:"audit_events"`
+}
+
+type Profile struct {
+	ID              int       `json:"id"`
+	Username        string    `json:"username"`
+	DisplayName     string    `json:"display_name"`
+	Email           string    `json:"email"`
+	Bio             *string   `json:"bio"`
+	ProfileImageURL *string   `json:"profile_image_url"`
+	FollowersCount  int       `json:"followers_count"`
+	FollowingCount  int       `json:"following_count"`
+	TweetsCount     int       `json:"tweets_count"`
+	CreatedAt       time.Time `json:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
+
+// Tweet は削除済み(保存期間中)のツイートも含む
+type Tweet struct {
+	ID       int     `json:"id"`
+	Content  string  `json:"content"`
+	ImageURL *string `json:"image_url"`
+	// ReplyToTweetID はリプライの場合の親ツイート
+	ReplyToTweetID *int `json:"reply_to_tweet_id,omitempty"`
+	// QuoteTweetID は引用ツイートの場合の引用元
+	QuoteTweetID *int       `json:"quote_tweet_id,omitempty"`
+	CreatedAt    time.Time  `json:"created_at"`
+	UpdatedAt    time.Time  `json:"updated_at"`
+	DeletedAt    *time.Time `json:"deleted_at,omitempty"`
+}
+
+// Like はいいね、またはリツイートしたツイート
+type Like struct {
+	TweetID   int       `json:"tweet_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Follow はフォロー関係の相手
+type Follow struct {
+	UserID    int       `json:"user_id"`
+	Username  string    `json:"username"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type Session struct {
+	LoggedInAt time.Time `json:"logged_in_at"`
+	IP         string    `json:"ip,omitempty"`
+	UserAgent  string    `json:"user_agent,omitempty"`
+}
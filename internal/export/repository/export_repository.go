@@ -0,0 +1,293 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+	"todoapp/internal/export/model"
+	"todoapp/internal/infrastructure"
+	"todoapp/internal/schema"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// maxErrorLength は error_message の列の長さ
+const maxErrorLength = 255
+
+// claimAttempts は他のワーカーと同じエクスポートを取り合った場合に Claim をやり直す回数
+const claimAttempts = 3
+
+type ExportRepository interface {
+	Create(ctx context.Context, userID int) (*model.Export, error)
+	GetByID(ctx context.Context, id int) (*model.Export, error)
+	// HasActive は userID の作成待ちまたは作成中のエクスポートがあるかを返す
+	HasActive(ctx context.Context, userID int) (bool, error)
+	// Claim は作成待ちのエクスポートを古い順に1件取り出して作成中にする。
+	// staleBefore より前に作成を始めて終わっていないもの(ワーカーが停止したもの)も取り出す。
+	// 取り出すものがない場合は sql.ErrNoRows を返す
+	Claim(ctx context.Context, staleBefore time.Time) (*model.Export, error)
+	// Complete は作成したファイルのキーを保存し、ダウンロードできる状態にする
+	Complete(ctx context.Context, id int, blobKey string, expiresAt time.Time) error
+	Fail(ctx context.Context, id int, message string) error
+	// ListExpired は now までに保存期間が切れたエクスポートを返す
+	ListExpired(ctx context.Context, now time.Time) ([]*model.Export, error)
+	MarkExpired(ctx context.Context, id int) error
+	// LoadArchive は userID のエクスポートするデータを読み込む。
+	// 監査ログ(Sessions と AuditEvents)は監査ログのリポジトリから読み込むので含まない
+	LoadArchive(ctx context.Context, userID int) (*model.Archive, error)
+}
+
+type exportRepository struct {
+	db boil.ContextExecutor
+	// readDB は読み取り専用のメソッドで使う(リードレプリカがなければ db と同じ)
+	readDB boil.ContextExecutor
+}
+
+func NewExportRepository(db, readDB boil.ContextExecutor) ExportRepository {
+	return &exportRepository{db: db, readDB: readDB}
+}
+
+func (r *exportRepository) exec(ctx context.Context) boil.ContextExecutor {
+	return infrastructure.Executor(ctx, r.db)
+}
+
+func (r *exportRepository) readExec(ctx context.Context) boil.ContextExecutor {
+	return infrastructure.Executor(ctx, r.readDB)
+}
+
+func convertToModel(dbExport *schema.DataExport) *model.Export {
+	return &model.Export{
+		ID:          dbExport.ID,
+		UserID:      dbExport.UserID,
+		Status:      dbExport.Status,
+		BlobKey:     dbExport.BlobKey.String,
+		Error:       dbExport.ErrorMessage.String,
+		CreatedAt:   dbExport.CreatedAt.Time,
+		CompletedAt: dbExport.CompletedAt.Ptr(),
+		ExpiresAt:   dbExport.ExpiresAt.Ptr(),
+	}
+}
+
+func (r *exportRepository) Create(ctx context.Context, userID int) (*model.Export, error) {
+	dbExport := &schema.DataExport{
+		UserID: userID,
+		Status: model.StatusPending,
+	}
+	if err := dbExport.Insert(ctx, r.exec(ctx), boil.Infer()); err != nil {
+		return nil, err
+	}
+	return convertToModel(dbExport), nil
+}
+
+// GetByID は状態を確認する直後に読まれるので、リードレプリカの遅れを避けてプライマリから読む
+func (r *exportRepository) GetByID(ctx context.Context, id int) (*model.Export, error) {
+	dbExport, err := schema.FindDataExport(ctx, r.exec(ctx), id)
+	if err != nil {
+		return nil, err
+	}
+	return convertToModel(dbExport), nil
+}
+
+func (r *exportRepository) HasActive(ctx context.Context, userID int) (bool, error) {
+	return schema.DataExports(
+		schema.DataExportWhere.UserID.EQ(userID),
+		schema.DataExportWhere.Status.IN([]string{model.StatusPending, model.StatusRunning}),
+	).Exists(ctx, r.exec(ctx))
+}
+
+func (r *exportRepository) Claim(ctx context.Context, staleBefore time.Time) (*model.Export, error) {
+	for i := 0; i < claimAttempts; i++ {
+		candidate, err := schema.DataExports(
+			schema.DataExportWhere.Status.EQ(model.StatusPending),
+			qm.Or2(qm.Expr(
+				schema.DataExportWhere.Status.EQ(model.StatusRunning),
+				schema.DataExportWhere.StartedAt.LT(null.TimeFrom(staleBefore)),
+			)),
+			qm.OrderBy(schema.DataExportColumns.ID),
+		).One(ctx, r.exec(ctx))
+		if err != nil {
+			return nil, err
+		}
+
+		// 読んだときと状態が変わっていなければ取り出す(他のワーカーが先に取り出した場合は0行になる)
+		now := time.Now()
+		claimed, err := schema.DataExports(
+			schema.DataExportWhere.ID.EQ(candidate.ID),
+			schema.DataExportWhere.Status.EQ(candidate.Status),
+			schema.DataExportWhere.StartedAt.EQ(candidate.StartedAt),
+		).UpdateAll(ctx, r.exec(ctx), schema.M{
+			schema.DataExportColumns.Status:    model.StatusRunning,
+			schema.DataExportColumns.StartedAt: now,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if claimed == 1 {
+			candidate.Status = model.StatusRunning
+			candidate.StartedAt = null.TimeFrom(now)
+			return convertToModel(candidate), nil
+		}
+	}
+	return nil, sql.ErrNoRows
+}
+
+func (r *exportRepository) Complete(ctx context.Context, id int, blobKey string, expiresAt time.Time) error {
+	_, err := schema.DataExports(schema.DataExportWhere.ID.EQ(id)).UpdateAll(ctx, r.exec(ctx), schema.M{
+		schema.DataExportColumns.Status:       model.StatusReady,
+		schema.DataExportColumns.BlobKey:      blobKey,
+		schema.DataExportColumns.CompletedAt:  time.Now(),
+		schema.DataExportColumns.ExpiresAt:    expiresAt,
+		schema.DataExportColumns.ErrorMessage: nil,
+	})
+	return err
+}
+
+func (r *exportRepository) Fail(ctx context.Context, id int, message string) error {
+	if len(message) > maxErrorLength {
+		message = message[:maxErrorLength]
+	}
+	_, err := schema.DataExports(schema.DataExportWhere.ID.EQ(id)).UpdateAll(ctx, r.exec(ctx), schema.M{
+		schema.DataExportColumns.Status:       model.StatusFailed,
+		schema.DataExportColumns.ErrorMessage: message,
+		schema.DataExportColumns.CompletedAt:  time.Now(),
+	})
+	return err
+}
+
+func (r *exportRepository) ListExpired(ctx context.Context, now time.Time) ([]*model.Export, error) {
+	dbExports, err := schema.DataExports(
+		schema.DataExportWhere.Status.EQ(model.StatusReady),
+		schema.DataExportWhere.ExpiresAt.LT(null.TimeFrom(now)),
+		qm.OrderBy(schema.DataExportColumns.ID),
+	).All(ctx, r.exec(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	exports := make([]*model.Export, 0, len(dbExports))
+	for _, dbExport := range dbExports {
+		exports = append(exports, convertToModel(dbExport))
+	}
+	return exports, nil
+}
+
+func (r *exportRepository) MarkExpired(ctx context.Context, id int) error {
+	_, err := schema.DataExports(schema.DataExportWhere.ID.EQ(id)).UpdateAll(ctx, r.exec(ctx), schema.M{
+		schema.DataExportColumns.Status:  model.StatusExpired,
+		schema.DataExportColumns.BlobKey: nil,
+	})
+	return err
+}
+
+// followRow は LoadArchive のフォロー関係のクエリの1行
+type followRow struct {
+	UserID    int       `boil:"user_id"`
+	Username  string    `boil:"username"`
+	CreatedAt null.Time `boil:"created_at"`
+}
+
+func (r *exportRepository) LoadArchive(ctx context.Context, userID int) (*model.Archive, error) {
+	exec := r.readExec(ctx)
+
+	dbUser, err := schema.FindUser(ctx, exec, userID)
+	if err != nil {
+		return nil, err
+	}
+	archive := &model.Archive{
+		Profile: model.Profile{
+			ID:              dbUser.ID,
+			Username:        dbUser.Username,
+			DisplayName:     dbUser.DisplayName,
+			Email:           dbUser.Email,
+			Bio:             dbUser.Bio.Ptr(),
+			ProfileImageURL: dbUser.ProfileImageURL.Ptr(),
+			FollowersCount:  dbUser.FollowersCount,
+			FollowingCount:  dbUser.FollowingCount,
+			TweetsCount:     dbUser.TweetsCount,
+			CreatedAt:       dbUser.CreatedAt.Time,
+			UpdatedAt:       dbUser.UpdatedAt.Time,
+		},
+		Tweets:    []model.Tweet{},
+		Likes:     []model.Like{},
+		Retweets:  []model.Like{},
+		Followers: []model.Follow{},
+		Following: []model.Follow{},
+	}
+
+	// 削除済み(保存期間中)のツイートもまだ保持しているデータなので含める
+	tweets, err := schema.Tweets(
+		qm.WithDeleted(),
+		schema.TweetWhere.UserID.EQ(userID),
+		qm.OrderBy(schema.TweetColumns.ID),
+	).All(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range tweets {
+		archive.Tweets = append(archive.Tweets, model.Tweet{
+			ID:             t.ID,
+			Content:        t.Content,
+			ImageURL:       t.ImageURL.Ptr(),
+			ReplyToTweetID: t.ReplyToTweetID.Ptr(),
+			QuoteTweetID:   t.QuoteTweetID.Ptr(),
+			CreatedAt:      t.CreatedAt.Time,
+			UpdatedAt:      t.UpdatedAt.Time,
+			DeletedAt:      t.DeletedAt.Ptr(),
+		})
+	}
+
+	likes, err := schema.Likes(
+		schema.LikeWhere.UserID.EQ(userID),
+		qm.OrderBy(schema.LikeColumns.CreatedAt+", "+schema.LikeColumns.TweetID),
+	).All(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+	for _, l := range likes {
+		archive.Likes = append(archive.Likes, model.Like{TweetID: l.TweetID, CreatedAt: l.CreatedAt.Time})
+	}
+
+	retweets, err := schema.Retweets(
+		schema.RetweetWhere.UserID.EQ(userID),
+		qm.OrderBy(schema.RetweetColumns.CreatedAt+", "+schema.RetweetColumns.TweetID),
+	).All(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+	for _, rt := range retweets {
+		archive.Retweets = append(archive.Retweets, model.Like{TweetID: rt.TweetID, CreatedAt: rt.CreatedAt.Time})
+	}
+
+	if archive.Followers, err = r.loadFollows(ctx, exec, schema.FollowColumns.FollowingID, schema.FollowColumns.FollowerID, userID); err != nil {
+		return nil, err
+	}
+	if archive.Following, err = r.loadFollows(ctx, exec, schema.FollowColumns.FollowerID, schema.FollowColumns.FollowingID, userID); err != nil {
+		return nil, err
+	}
+
+	return archive, nil
+}
+
+// loadFollows は follows.<column> が userID の行の相手(follows.<otherColumn>)を返す。
+// 退会中の相手は他のユーザーからも見えないので含めない
+func (r *exportRepository) loadFollows(ctx context.Context, exec boil.ContextExecutor, column, otherColumn string, userID int) ([]model.Follow, error) {
+	var rows []*followRow
+	err := schema.Follows(
+		qm.Select("users.id AS user_id", "users.username AS username", "follows.created_at AS created_at"),
+		qm.InnerJoin("users ON users.id = follows."+otherColumn),
+		qm.Where("follows."+column+" = ?", userID),
+		qm.Where("users.deleted_at IS NULL"),
+		qm.OrderBy("follows.created_at, users.id"),
+	).Bind(ctx, exec, &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	follows := make([]model.Follow, 0, len(rows))
+	for _, row := range rows {
+		follows = append(follows, model.Follow{UserID: row.UserID, Username: row.Username, CreatedAt: row.CreatedAt.Time})
+	}
+	return follows, nil
+}
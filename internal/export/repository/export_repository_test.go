@@ -0,0 +1,90 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+	"todoapp/internal/dbtest"
+	"todoapp/internal/export/model"
+	"todoapp/internal/schema"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestExportRepositoryClaim(t *testing.T) {
+	ctx := context.Background()
+	db := dbtest.New(t)
+	repo := NewExportRepository(db, db)
+
+	user := &schema.User{Username: "alice", DisplayName: "Alice", Email: "alice@example.com", PasswordHash: "hash"}
+	if err := user.Insert(ctx, db, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := repo.Claim(ctx, time.Now().Add(-time.Hour)); !errors.Is(err, sql.ErrNoRows) {
+		t.Fatalf("Claim with nothing pending = %v, want sql.ErrNoRows", err)
+	}
+
+	first, err := repo.Create(ctx, user.ID)
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	second, err := repo.Create(ctx, user.ID)
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if active, err := repo.HasActive(ctx, user.ID); err != nil || !active {
+		t.Fatalf("HasActive = %v, %v, want true", active, err)
+	}
+
+	// 古い順に取り出し、作成中のものは staleBefore より前に始めたものだけ取り出し直す
+	claimed, err := repo.Claim(ctx, time.Now().Add(-time.Hour))
+	if err != nil || claimed.ID != first.ID || claimed.Status != model.StatusRunning {
+		t.Fatalf("Claim = %+v, %v, want export %d running", claimed, err, first.ID)
+	}
+	claimed, err = repo.Claim(ctx, time.Now().Add(-time.Hour))
+	if err != nil || claimed.ID != second.ID {
+		t.Fatalf("second Claim = %+v, %v, want export %d", claimed, err, second.ID)
+	}
+	if _, err := repo.Claim(ctx, time.Now().Add(-time.Hour)); !errors.Is(err, sql.ErrNoRows) {
+		t.Fatalf("Claim with everything running = %v, want sql.ErrNoRows", err)
+	}
+	// ワーカーが停止して2時間経ったものとする
+	_, err = schema.DataExports(schema.DataExportWhere.ID.EQ(first.ID)).UpdateAll(ctx, db,
+		schema.M{schema.DataExportColumns.StartedAt: time.Now().Add(-2 * time.Hour)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	claimed, err = repo.Claim(ctx, time.Now().Add(-time.Hour))
+	if err != nil || claimed.ID != first.ID {
+		t.Fatalf("Claim stale = %+v, %v, want export %d", claimed, err, first.ID)
+	}
+
+	if err := repo.Fail(ctx, first.ID, strings.Repeat("x", 300)); err != nil {
+		t.Fatalf("Fail: %v", err)
+	}
+	if err := repo.Complete(ctx, second.ID, "exports/1/a.zip", time.Now().Add(-time.Minute)); err != nil {
+		t.Fatalf("Complete: %v", err)
+	}
+	failed, err := repo.GetByID(ctx, first.ID)
+	if err != nil || failed.Status != model.StatusFailed || len(failed.Error) != maxErrorLength {
+		t.Errorf("failed export = %+v, %v", failed, err)
+	}
+	if active, err := repo.HasActive(ctx, user.ID); err != nil || active {
+		t.Errorf("HasActive after completion = %v, %v, want false", active, err)
+	}
+
+	expired, err := repo.ListExpired(ctx, time.Now())
+	if err != nil || len(expired) != 1 || expired[0].ID != second.ID || expired[0].BlobKey != "exports/1/a.zip" {
+		t.Fatalf("ListExpired = %+v, %v, want export %d", expired, err, second.ID)
+	}
+	if err := repo.MarkExpired(ctx, second.ID); err != nil {
+		t.Fatalf("MarkExpired: %v", err)
+	}
+	if expired, err := repo.ListExpired(ctx, time.Now()); err != nil || len(expired) != 0 {
+		t.Errorf("ListExpired after MarkExpired = %+v, %v, want none", expired, err)
+	}
+}
@@ -0,0 +1,43 @@
+package usecase
+
+import (
+	"fmt"
+	"os"
+	"time"
+)
+
+// 設定の既定値
+const (
+	DefaultURLTTL    = time.Hour
+	DefaultRetention = 7 * 24 * time.Hour
+)
+
+type Config struct {
+	// URLTTL は発行するダウンロードURLの有効期間
+	URLTTL time.Duration
+	// Retention は作成したファイルを保存しておく期間。過ぎると削除して状態を expired にする
+	Retention time.Duration
+}
+
+// ConfigFromEnv は EXPORT_URL_TTL(例: 1h)と EXPORT_RETENTION(例: 168h)から設定を読み込む
+func ConfigFromEnv() (Config, error) {
+	cfg := Config{URLTTL: DefaultURLTTL, Retention: DefaultRetention}
+	for _, v := range []struct {
+		name string
+		dst  *time.Duration
+	}{
+		{"EXPORT_URL_TTL", &cfg.URLTTL},
+		{"EXPORT_RETENTION", &cfg.Retention},
+	} {
+		s := os.Getenv(v.name)
+		if s == "" {
+			continue
+		}
+		d, err := time.ParseDuration(s)
+		if err != nil || d <= 0 {
+			return Config{}, fmt.Errorf("invalid %s: %q", v.name, s)
+		}
+		*v.dst = d
+	}
+	return cfg, nil
+}
@@ -0,0 +1,293 @@
+package usecase
+
+import (
+	"archive/zip"
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"time"
+	auditmodel "todoapp/internal/audit/model"
+	auditusecase "todoapp/internal/audit/usecase"
+	"todoapp/internal/blob"
+	"todoapp/internal/domain"
+	"todoapp/internal/export/model"
+	"todoapp/internal/export/repository"
+	"todoapp/internal/infrastructure"
+)
+
+const (
+	// pollInterval はワーカーが作成待ちのエクスポートと保存期間の切れたファイルを確認する間隔
+	pollInterval = time.Minute
+	// staleAfter を過ぎても作成中のままのエクスポートは、ワーカーが停止したとみなして作り直す
+	staleAfter = 30 * time.Minute
+)
+
+// AuditSource はエクスポートに含める監査ログを読み込む(auditrepository.AuditRepository が満たす)
+type AuditSource interface {
+	ListByUser(ctx context.Context, userID int) ([]*auditmodel.Event, error)
+}
+
+type ExportUsecase interface {
+	// Request はエクスポートの作成を受け付ける。作成は Run のワーカーが非同期に行う。
+	// 作成待ちか作成中のエクスポートがある場合は Conflict を返す
+	Request(ctx context.Context, userID int) (*model.Export, error)
+	// Get は userID のエクスポートを返す。作成済みの場合は DownloadURL を設定する。
+	// 他のユーザーのエクスポートは存在しないものとして sql.ErrNoRows を返す
+	Get(ctx context.Context, userID, id int) (*model.Export, error)
+	// ProcessPending は作成待ちのエクスポートを1件作成する。作成待ちがなければ false を返す
+	ProcessPending(ctx context.Context) (bool, error)
+	// CleanupExpired は保存期間の切れたファイルを削除し、削除した件数を返す
+	CleanupExpired(ctx context.Context) (int, error)
+	// Run は ctx が終わるまで ProcessPending と CleanupExpired を繰り返す
+	Run(ctx context.Context)
+}
+
+type exportUsecase struct {
+	repo      repository.ExportRepository
+	audits    AuditSource
+	txManager infrastructure.TxManager
+	auditor   auditusecase.Recorder
+	store     blob.Store
+	notifier  Notifier
+	cfg       Config
+	// wake は Request から Run のワーカーを起こす
+	wake chan struct{}
+}
+
+func NewExportUsecase(repo repository.ExportRepository, audits AuditSource, txManager infrastructure.TxManager, auditor auditusecase.Recorder, store blob.Store, notifier Notifier, cfg Config) ExportUsecase {
+	return &exportUsecase{
+		repo:      repo,
+		audits:    audits,
+		txManager: txManager,
+		auditor:   auditor,
+		store:     store,
+		notifier:  notifier,
+		cfg:       cfg,
+		wake:      make(chan struct{}, 1),
+	}
+}
+
+func (u *exportUsecase) Request(ctx context.Context, userID int) (*model.Export, error) {
+	var export *model.Export
+	// 同時に受け付けて2件作られることはあり得るが、同じ内容のファイルが2つできるだけなのでロックはしない
+	err := u.txManager.RunInTx(ctx, func(ctx context.Context) error {
+		active, err := u.repo.HasActive(ctx, userID)
+		if err != nil {
+			return err
+		}
+		if active {
+			return domain.Conflict("export already in progress")
+		}
+
+		export, err = u.repo.Create(ctx, userID)
+		if err != nil {
+			return err
+		}
+		return u.auditor.Record(ctx, &auditmodel.Event{
+			Type:       auditmodel.EventExportRequested,
+			ActorID:    &userID,
+			TargetType: auditmodel.TargetUser,
+			TargetID:   &userID,
+			Details:    map[string]string{"export_id": fmt.Sprint(export.ID)},
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	select {
+	case u.wake <- struct{}{}:
+	default:
+	}
+	return export, nil
+}
+
+func (u *exportUsecase) Get(ctx context.Context, userID, id int) (*model.Export, error) {
+	export, err := u.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if export.UserID != userID {
+		return nil, sql.ErrNoRows
+	}
+
+	if export.Status == model.StatusReady {
+		// URLはファイルを削除する日時より後まで有効にしない
+		expires := time.Now().Add(u.cfg.URLTTL)
+		if export.ExpiresAt != nil && export.ExpiresAt.Before(expires) {
+			expires = *export.ExpiresAt
+		}
+		export.DownloadURL, err = u.store.SignedURL(ctx, export.BlobKey, expires)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return export, nil
+}
+
+func (u *exportUsecase) ProcessPending(ctx context.Context) (bool, error) {
+	export, err := u.repo.Claim(ctx, time.Now().Add(-staleAfter))
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	key, err := u.build(ctx, export.UserID)
+	if err != nil {
+		if failErr := u.repo.Fail(ctx, export.ID, err.Error()); failErr != nil {
+			return true, failErr
+		}
+		return true, fmt.Errorf("export %d: %w", export.ID, err)
+	}
+
+	expiresAt := time.Now().Add(u.cfg.Retention)
+	if err := u.repo.Complete(ctx, export.ID, key, expiresAt); err != nil {
+		u.store.Delete(ctx, key)
+		return true, err
+	}
+	export.Status = model.StatusReady
+	export.BlobKey = key
+	export.ExpiresAt = &expiresAt
+
+	// 通知に失敗してもエクスポートは GET で取得できるので、エラーにはしない
+	if err := u.notifier.ExportReady(ctx, export); err != nil {
+		log.Println("エクスポート完了の通知エラー: ", err)
+	}
+	return true, nil
+}
+
+// build は userID のデータをZIPにしてストアに保存し、キーを返す
+func (u *exportUsecase) build(ctx context.Context, userID int) (string, error) {
+	archive, err := u.repo.LoadArchive(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+	events, err := u.audits.ListByUser(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+	archive.AuditEvents = events
+	archive.Sessions = sessionsFromEvents(userID, events)
+
+	key, err := newBlobKey(userID)
+	if err != nil {
+		return "", err
+	}
+
+	// ZIPをメモリに溜めずにストアへ書き込む
+	pr, pw := io.Pipe()
+	go func() {
+		pw.CloseWithError(writeArchive(pw, archive))
+	}()
+	err = u.store.Put(ctx, key, pr)
+	// Put が途中で失敗した場合に書き込み側を終わらせる
+	pr.CloseWithError(err)
+	if err != nil {
+		return "", err
+	}
+	return key, nil
+}
+
+// sessionsFromEvents はユーザー本人のログイン成功のイベントをログインの履歴にする
+func sessionsFromEvents(userID int, events []*auditmodel.Event) []model.Session {
+	sessions := []model.Session{}
+	for _, e := range events {
+		if e.Type != auditmodel.EventLoginSucceeded || e.ActorID == nil || *e.ActorID != userID {
+			continue
+		}
+		sessions = append(sessions, model.Session{LoggedInAt: e.CreatedAt, IP: e.IP, UserAgent: e.UserAgent})
+	}
+	return sessions
+}
+
+// writeArchive は archive の項目ごとに1つのJSONファイルを持つZIPを w に書き込む
+func writeArchive(w io.Writer, archive *model.Archive) error {
+	zw := zip.NewWriter(w)
+	files := []struct {
+		name string
+		v    interface{}
+	}{
+		{"profile.json", archive.Profile},
+		{"tweets.json", archive.Tweets},
+		{"likes.json", archive.Likes},
+		{"retweets.json", archive.Retweets},
+		{"followers.json", archive.Followers},
+		{"following.json", archive.Following},
+		{"sessions.json", archive.Sessions},
+		{"audit_events.json", archive.AuditEvents},
+	}
+	for _, f := range files {
+		fw, err := zw.Create(f.name)
+		if err != nil {
+			return err
+		}
+		enc := json.NewEncoder(fw)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(f.v); err != nil {
+			return err
+		}
+	}
+	return zw.Close()
+}
+
+// newBlobKey は推測できないキーを返す(署名付きURLが漏れても他のエクスポートのキーはわからない)
+func newBlobKey(userID int) (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("exports/%d/%s.zip", userID, hex.EncodeToString(b)), nil
+}
+
+func (u *exportUsecase) CleanupExpired(ctx context.Context) (int, error) {
+	exports, err := u.repo.ListExpired(ctx, time.Now())
+	if err != nil {
+		return 0, err
+	}
+	for i, export := range exports {
+		if err := u.store.Delete(ctx, export.BlobKey); err != nil {
+			return i, err
+		}
+		if err := u.repo.MarkExpired(ctx, export.ID); err != nil {
+			return i, err
+		}
+	}
+	return len(exports), nil
+}
+
+func (u *exportUsecase) Run(ctx context.Context) {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		for {
+			processed, err := u.ProcessPending(ctx)
+			if err != nil {
+				log.Println("データエクスポート作成エラー: ", err)
+			}
+			if !processed {
+				break
+			}
+		}
+		if n, err := u.CleanupExpired(ctx); err != nil {
+			log.Println("データエクスポート削除エラー: ", err)
+		} else if n > 0 {
+			log.Printf("データエクスポート削除: %d件", n)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-u.wake:
+		}
+	}
+}
@@ -0,0 +1,11 @@
+package usecase
+
+import (
+	"context"
+	"todoapp/internal/export/model"
+)
+
+// Notifier はエクスポートの作成が終わったことをユーザーに知らせる(notificationrepository.ExportNotifier が満たす)
+type Notifier interface {
+	ExportReady(ctx context.Context, export *model.Export) error
+}
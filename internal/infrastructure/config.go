@@ -0,0 +1,132 @@
+package infrastructure
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+)
+
+// DB_DRIVER に指定できるドライバー
+const (
+	DriverMySQL    = "mysql"
+	DriverPostgres = "postgres"
+	DriverSQLite   = "sqlite"
+)
+
+// DBConfig はデータベース接続とコネクションプールの設定
+type DBConfig struct {
+	// Driver は mysql(既定)、postgres、sqlite のいずれか。
+	// sqlite の場合は SQLitePath のファイルを使い、ホストやTLS、レプリカの設定は使わない
+	Driver     string
+	SQLitePath string
+
+	Host     string
+	Port     string
+	User     string
+	Password string
+	DBName   string
+
+	// コネクションプール
+	MaxOpenConns    int
+	MaxIdleConns    int
+	ConnMaxLifetime time.Duration
+	ConnMaxIdleTime time.Duration
+
+	// タイムアウト
+	Timeout      time.Duration
+	ReadTimeout  time.Duration
+	WriteTimeout time.Duration
+
+	// TLS は go-sql-driver/mysql の tls パラメーター(true, false, skip-verify, preferred)。
+	// TLSCAFile を指定した場合はそのCA証明書で検証するカスタム設定を使う。
+	// postgres では同じ意味の sslmode に読み替える
+	TLS       string
+	TLSCAFile string
+
+	// MultiStatements はマイグレーションのように複数のSQL文を1回で実行する場合に有効にする
+	MultiStatements bool
+
+	// ReplicaHost を指定するとリードレプリカ用のプールを別に作成する
+	ReplicaHost string
+	ReplicaPort string
+}
+
+// DBConfigFromEnv は DB_* 環境変数から設定を読み込む
+func DBConfigFromEnv() (DBConfig, error) {
+	cfg := DBConfig{
+		Driver:      getEnv("DB_DRIVER", DriverMySQL),
+		SQLitePath:  getEnv("DB_SQLITE_PATH", "todoapp.db"),
+		Host:        os.Getenv("DB_HOST"),
+		Port:        os.Getenv("DB_PORT"),
+		User:        os.Getenv("DB_USER"),
+		Password:    os.Getenv("DB_PASSWORD"),
+		DBName:      os.Getenv("DB_NAME"),
+		TLS:         getEnv("DB_TLS", "false"),
+		TLSCAFile:   os.Getenv("DB_TLS_CA_FILE"),
+		ReplicaHost: os.Getenv("DB_REPLICA_HOST"),
+		ReplicaPort: os.Getenv("DB_REPLICA_PORT"),
+	}
+	if cfg.Driver != DriverMySQL && cfg.Driver != DriverPostgres && cfg.Driver != DriverSQLite {
+		return DBConfig{}, fmt.Errorf("invalid DB_DRIVER: %q", cfg.Driver)
+	}
+	if cfg.ReplicaPort == "" {
+		cfg.ReplicaPort = cfg.Port
+	}
+
+	var err error
+	if cfg.MaxOpenConns, err = getEnvInt("DB_MAX_OPEN_CONNS", 25); err != nil {
+		return DBConfig{}, err
+	}
+	if cfg.MaxIdleConns, err = getEnvInt("DB_MAX_IDLE_CONNS", 10); err != nil {
+		return DBConfig{}, err
+	}
+	if cfg.ConnMaxLifetime, err = getEnvDuration("DB_CONN_MAX_LIFETIME", 5*time.Minute); err != nil {
+		return DBConfig{}, err
+	}
+	if cfg.ConnMaxIdleTime, err = getEnvDuration("DB_CONN_MAX_IDLE_TIME", time.Minute); err != nil {
+		return DBConfig{}, err
+	}
+	if cfg.Timeout, err = getEnvDuration("DB_TIMEOUT", 5*time.Second); err != nil {
+		return DBConfig{}, err
+	}
+	if cfg.ReadTimeout, err = getEnvDuration("DB_READ_TIMEOUT", 30*time.Second); err != nil {
+		return DBConfig{}, err
+	}
+	if cfg.WriteTimeout, err = getEnvDuration("DB_WRITE_TIMEOUT", 30*time.Second); err != nil {
+		return DBConfig{}, err
+	}
+
+	return cfg, nil
+}
+
+func getEnv(key, defaultValue string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return defaultValue
+}
+
+func getEnvInt(key string, defaultValue int) (int, error) {
+	v := os.Getenv(key)
+	if v == "" {
+		return defaultValue, nil
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s: %w", key, err)
+	}
+	return n, nil
+}
+
+func getEnvDuration(key string, defaultValue time.Duration) (time.Duration, error) {
+	v := os.Getenv(key)
+	if v == "" {
+		return defaultValue, nil
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s: %w", key, err)
+	}
+	return d, nil
+}
@@ -1,24 +1,202 @@
 package infrastructure
 
 import (
+	"crypto/tls"
+	"crypto/x509"
 	"database/sql"
 	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"strconv"
+	"time"
 
-	_ "github.com/go-sql-driver/mysql"
+	"github.com/go-sql-driver/mysql"
+	_ "github.com/jackc/pgx/v5/stdlib"
+	_ "modernc.org/sqlite"
 )
 
-func NewDB(host, port, user, password, dbname string) (*sql.DB, error) {
-	dsn := fmt.Sprintf("%s:%s@tcp(%s:%s)/%s?parseTime=true&loc=Local",
-		user, password, host, port, dbname)
+// DBCluster はプライマリとリードレプリカのコネクションプールをまとめたもの
+type DBCluster struct {
+	Primary *sql.DB
+	// Replica はレプリカ未設定の場合 nil
+	Replica *sql.DB
+}
+
+// Reader は読み取り専用クエリに使うプールを返す。
+// レプリカが設定されていない場合はプライマリを返す
+func (c *DBCluster) Reader() *sql.DB {
+	if c.Replica != nil {
+		return c.Replica
+	}
+	return c.Primary
+}
+
+func (c *DBCluster) Close() error {
+	err := c.Primary.Close()
+	if c.Replica != nil {
+		if rerr := c.Replica.Close(); err == nil {
+			err = rerr
+		}
+	}
+	return err
+}
 
-	db, err := sql.Open("mysql", dsn)
+// NewDBCluster はプライマリと、設定されていればリードレプリカのプールを開く
+func NewDBCluster(cfg DBConfig) (*DBCluster, error) {
+	primary, err := NewDB(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	cluster := &DBCluster{Primary: primary}
+	if cfg.ReplicaHost == "" {
+		return cluster, nil
+	}
+	if cfg.Driver == DriverSQLite {
+		primary.Close()
+		return nil, fmt.Errorf("read replicas are not supported with sqlite")
+	}
+
+	replicaCfg := cfg
+	replicaCfg.Host = cfg.ReplicaHost
+	replicaCfg.Port = cfg.ReplicaPort
+	cluster.Replica, err = NewDB(replicaCfg)
+	if err != nil {
+		primary.Close()
+		return nil, fmt.Errorf("replica: %w", err)
+	}
+
+	return cluster, nil
+}
+
+// NewDB は単一のコネクションプールを開く
+func NewDB(cfg DBConfig) (*sql.DB, error) {
+	driverName, dsn := "mysql", ""
+	switch cfg.Driver {
+	case DriverSQLite:
+		driverName, dsn = "sqlite", buildSQLiteDSN(cfg)
+	case DriverPostgres:
+		driverName, dsn = "pgx", buildPostgresDSN(cfg)
+	default:
+		var err error
+		if dsn, err = buildDSN(cfg); err != nil {
+			return nil, err
+		}
+	}
+
+	db, err := sql.Open(driverName, dsn)
 	if err != nil {
 		return nil, fmt.Errorf("failed to open database: %w", err)
 	}
 
+	db.SetMaxOpenConns(cfg.MaxOpenConns)
+	db.SetMaxIdleConns(cfg.MaxIdleConns)
+	db.SetConnMaxLifetime(cfg.ConnMaxLifetime)
+	db.SetConnMaxIdleTime(cfg.ConnMaxIdleTime)
+
 	if err := db.Ping(); err != nil {
+		db.Close()
 		return nil, fmt.Errorf("failed to ping database: %w", err)
 	}
 
 	return db, nil
 }
+
+func buildDSN(cfg DBConfig) (string, error) {
+	c := mysql.NewConfig()
+	c.User = cfg.User
+	c.Passwd = cfg.Password
+	c.Net = "tcp"
+	c.Addr = net.JoinHostPort(cfg.Host, cfg.Port)
+	c.DBName = cfg.DBName
+	c.ParseTime = true
+	c.Timeout = cfg.Timeout
+	c.ReadTimeout = cfg.ReadTimeout
+	c.WriteTimeout = cfg.WriteTimeout
+	c.MultiStatements = cfg.MultiStatements
+
+	// アプリケーションとDBの時刻はUTCで統一する
+	c.Loc = time.UTC
+	c.Params = map[string]string{"time_zone": "'+00:00'"}
+
+	c.TLSConfig = cfg.TLS
+	if cfg.TLSCAFile != "" {
+		// サーバー名の検証のためホストごとに別名で登録する
+		name := "custom-" + cfg.Host
+		if err := registerTLSConfig(name, cfg); err != nil {
+			return "", err
+		}
+		c.TLSConfig = name
+	}
+
+	return c.FormatDSN(), nil
+}
+
+// postgresSSLModes は DB_TLS の値を libpq の sslmode に読み替える
+var postgresSSLModes = map[string]string{
+	"":            "disable",
+	"false":       "disable",
+	"preferred":   "prefer",
+	"skip-verify": "require",
+	"true":        "verify-full",
+}
+
+// buildPostgresDSN は pgx 用の接続文字列を作る。
+// MySQLと同じくセッションのタイムゾーンをUTCにする
+func buildPostgresDSN(cfg DBConfig) string {
+	params := url.Values{}
+	params.Set("timezone", "UTC")
+	if mode, ok := postgresSSLModes[cfg.TLS]; ok {
+		params.Set("sslmode", mode)
+	} else {
+		params.Set("sslmode", cfg.TLS)
+	}
+	if cfg.TLSCAFile != "" {
+		params.Set("sslmode", "verify-full")
+		params.Set("sslrootcert", cfg.TLSCAFile)
+	}
+	if cfg.Timeout > 0 {
+		params.Set("connect_timeout", strconv.Itoa(int(cfg.Timeout.Seconds())))
+	}
+
+	u := url.URL{
+		Scheme:   "postgres",
+		User:     url.UserPassword(cfg.User, cfg.Password),
+		Host:     net.JoinHostPort(cfg.Host, cfg.Port),
+		Path:     "/" + cfg.DBName,
+		RawQuery: params.Encode(),
+	}
+	return u.String()
+}
+
+// buildSQLiteDSN は modernc.org/sqlite 用の接続文字列を作る。
+// MySQLと同じく外部キーを有効にし、書き込みのトランザクションはロック待ちで失敗しないよう
+// 開始時に書き込みロックを取る
+func buildSQLiteDSN(cfg DBConfig) string {
+	params := url.Values{}
+	params.Add("_pragma", "foreign_keys(1)")
+	params.Add("_pragma", "busy_timeout(5000)")
+	params.Add("_pragma", "journal_mode(WAL)")
+	params.Set("_time_format", "sqlite")
+	params.Set("_txlock", "immediate")
+	return "file:" + cfg.SQLitePath + "?" + params.Encode()
+}
+
+func registerTLSConfig(name string, cfg DBConfig) error {
+	pem, err := os.ReadFile(cfg.TLSCAFile)
+	if err != nil {
+		return fmt.Errorf("failed to read CA file: %w", err)
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		return fmt.Errorf("failed to parse CA file: %s", cfg.TLSCAFile)
+	}
+
+	return mysql.RegisterTLSConfig(name, &tls.Config{
+		RootCAs:    pool,
+		ServerName: cfg.Host,
+		MinVersion: tls.VersionTLS12,
+	})
+}
@@ -0,0 +1,139 @@
+package infrastructure
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// DialectExecutor は internal/schema(MySQL方言で生成したsqlboilerのモデル)のクエリを
+// driver のデータベースで実行できるようにする。
+// MySQLとSQLiteはそのまま実行できるので exec をそのまま返す
+func DialectExecutor(driver string, exec boil.ContextExecutor) boil.ContextExecutor {
+	if driver != DriverPostgres {
+		return exec
+	}
+	return &postgresExecutor{exec: exec}
+}
+
+// postgresExecutor は識別子のバッククォートをダブルクォートに、? を $1, $2... に書き換える。
+// PostgreSQLのドライバーは LastInsertId に対応していないため、INSERT は RETURNING で
+// 挿入した行の id を受け取り、MySQLと同じく先頭の行の id を LastInsertId として返す
+type postgresExecutor struct {
+	exec boil.ContextExecutor
+}
+
+func (e *postgresExecutor) Exec(query string, args ...interface{}) (sql.Result, error) {
+	return e.ExecContext(context.Background(), query, args...)
+}
+
+func (e *postgresExecutor) Query(query string, args ...interface{}) (*sql.Rows, error) {
+	return e.QueryContext(context.Background(), query, args...)
+}
+
+func (e *postgresExecutor) QueryRow(query string, args ...interface{}) *sql.Row {
+	return e.QueryRowContext(context.Background(), query, args...)
+}
+
+func (e *postgresExecutor) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	query = rewritePostgres(query)
+	insertQuery, ok := returningID(query)
+	if !ok {
+		return e.exec.ExecContext(ctx, query, args...)
+	}
+
+	rows, err := e.exec.QueryContext(ctx, insertQuery, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result insertResult
+	for rows.Next() {
+		var id sql.NullInt64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		if result.rowsAffected == 0 {
+			result.lastInsertID = id.Int64
+		}
+		result.rowsAffected++
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (e *postgresExecutor) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	return e.exec.QueryContext(ctx, rewritePostgres(query), args...)
+}
+
+func (e *postgresExecutor) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	return e.exec.QueryRowContext(ctx, rewritePostgres(query), args...)
+}
+
+// insertPattern は INSERT 文のテーブル名までに一致する
+var insertPattern = regexp.MustCompile(`(?i)^(\s*INSERT\s+INTO\s+"[^"]+")`)
+
+var returningPattern = regexp.MustCompile(`(?i)\bRETURNING\b`)
+
+// returningID は INSERT 文に挿入した行の id を返す RETURNING 句を付ける。
+// id 列がないテーブル(follows など)では NULL が返る。INSERT 以外と、
+// すでに RETURNING がある場合は false を返す
+func returningID(query string) (string, bool) {
+	if !insertPattern.MatchString(query) || returningPattern.MatchString(query) {
+		return "", false
+	}
+	query = strings.TrimRight(strings.TrimSpace(query), ";")
+	query = insertPattern.ReplaceAllString(query, `${1} AS "_row"`)
+	return query + ` RETURNING (to_jsonb("_row") ->> 'id')::bigint`, true
+}
+
+type insertResult struct {
+	lastInsertID int64
+	rowsAffected int64
+}
+
+func (r insertResult) LastInsertId() (int64, error) { return r.lastInsertID, nil }
+func (r insertResult) RowsAffected() (int64, error) { return r.rowsAffected, nil }
+
+// rewritePostgres はMySQL方言のSQLをPostgreSQL方言に書き換える。
+// 文字列リテラルとダブルクォートで囲まれた部分は書き換えない
+func rewritePostgres(query string) string {
+	var b strings.Builder
+	b.Grow(len(query) + 8)
+
+	n := 0
+	var quote byte
+	for i := 0; i < len(query); i++ {
+		c := query[i]
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+				if c == '`' {
+					c = '"'
+				}
+			}
+			b.WriteByte(c)
+		case c == '\'' || c == '"' || c == '`':
+			quote = c
+			if c == '`' {
+				c = '"'
+			}
+			b.WriteByte(c)
+		case c == '?':
+			n++
+			b.WriteByte('$')
+			b.WriteString(strconv.Itoa(n))
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return b.String()
+}
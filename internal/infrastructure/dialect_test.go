@@ -0,0 +1,66 @@
+package infrastructure
+
+import "testing"
+
+func TestRewritePostgres(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		want  string
+	}{
+		{
+			name:  "identifiers and placeholders",
+			query: "SELECT `users`.* FROM `users` WHERE (email = ?) AND `id`=? LIMIT 1;",
+			want:  `SELECT "users".* FROM "users" WHERE (email = $1) AND "id"=$2 LIMIT 1;`,
+		},
+		{
+			name:  "string literals are kept",
+			query: "SELECT * FROM `users` WHERE bio = 'why? `not`' AND id = ?",
+			want:  `SELECT * FROM "users" WHERE bio = 'why? ` + "`not`" + `' AND id = $1`,
+		},
+		{
+			name:  "quoted identifiers are kept",
+			query: `SELECT "a?b" FROM users WHERE id = ?`,
+			want:  `SELECT "a?b" FROM users WHERE id = $1`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := rewritePostgres(tt.query); got != tt.want {
+				t.Errorf("rewritePostgres() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReturningID(t *testing.T) {
+	tests := []struct {
+		name   string
+		query  string
+		want   string
+		wantOK bool
+	}{
+		{
+			name:   "insert",
+			query:  `INSERT INTO "users" ("username","email") VALUES ($1,$2)`,
+			want:   `INSERT INTO "users" AS "_row" ("username","email") VALUES ($1,$2) RETURNING (to_jsonb("_row") ->> 'id')::bigint`,
+			wantOK: true,
+		},
+		{
+			name:   "multi-row insert with trailing semicolon",
+			query:  `insert into "follows" ("follower_id","following_id") values ($1,$2),($3,$4);`,
+			want:   `insert into "follows" AS "_row" ("follower_id","following_id") values ($1,$2),($3,$4) RETURNING (to_jsonb("_row") ->> 'id')::bigint`,
+			wantOK: true,
+		},
+		{name: "already returning", query: `INSERT INTO "users" ("username") VALUES ($1) RETURNING id`},
+		{name: "update", query: `UPDATE "users" SET "bio"=$1 WHERE "id"=$2`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := returningID(tt.query)
+			if ok != tt.wantOK || got != tt.want {
+				t.Errorf("returningID() = %q, %v, want %q, %v", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
@@ -0,0 +1,228 @@
+package infrastructure
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+	"todoapp/internal/domain"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
+)
+
+// MySQLのエラー番号
+const (
+	mysqlErrDuplicateEntry  = 1062
+	mysqlErrLockWaitTimeout = 1205
+	mysqlErrDeadlock        = 1213
+)
+
+// PostgreSQLのSQLSTATE
+const (
+	pgErrUniqueViolation      = "23505"
+	pgErrSerializationFailure = "40001"
+	pgErrDeadlockDetected     = "40P01"
+)
+
+const (
+	maxTxAttempts = 3
+	txRetryDelay  = 50 * time.Millisecond
+)
+
+// TxManager は複数のリポジトリ呼び出しを1つのトランザクションで実行する
+type TxManager interface {
+	// RunInTx は fn をトランザクション内で実行する。
+	// fn がエラーを返すかパニックした場合はロールバックし、
+	// デッドロックとロック待ちタイムアウトの場合は fn ごと再試行する。
+	// ctx が既にトランザクションを持っている場合はそのトランザクションに参加する
+	RunInTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+type txKey struct{}
+
+// afterCommitKey はトランザクションのコミット後に実行する関数の一覧を格納する
+type afterCommitKey struct{}
+
+type txManager struct {
+	db   *sql.DB
+	wrap func(boil.ContextExecutor) boil.ContextExecutor
+}
+
+// NewTxManager は db 上でトランザクションを開始する TxManager を返す。
+// wrap を指定するとトランザクションをコンテキストに格納する前に適用する(トレーシングなど)
+func NewTxManager(db *sql.DB, wrap func(boil.ContextExecutor) boil.ContextExecutor) TxManager {
+	return &txManager{db: db, wrap: wrap}
+}
+
+func (m *txManager) RunInTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if InTx(ctx) {
+		return fn(ctx)
+	}
+
+	var err error
+	for attempt := 1; attempt <= maxTxAttempts; attempt++ {
+		err = m.runOnce(ctx, fn)
+		if err == nil || !isRetryable(err) || attempt == maxTxAttempts {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(txRetryDelay * time.Duration(attempt)):
+		}
+	}
+
+	return TranslateError(err)
+}
+
+func (m *txManager) runOnce(ctx context.Context, fn func(ctx context.Context) error) (err error) {
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			tx.Rollback()
+			panic(p)
+		}
+		if err != nil {
+			if rerr := tx.Rollback(); rerr != nil && !errors.Is(rerr, sql.ErrTxDone) {
+				err = fmt.Errorf("%w (rollback failed: %v)", err, rerr)
+			}
+		}
+	}()
+
+	var exec boil.ContextExecutor = tx
+	if m.wrap != nil {
+		exec = m.wrap(tx)
+	}
+
+	var afterCommit []func()
+	txCtx := context.WithValue(ctx, txKey{}, exec)
+	txCtx = context.WithValue(txCtx, afterCommitKey{}, &afterCommit)
+	if err = fn(txCtx); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+	for _, f := range afterCommit {
+		f()
+	}
+	return nil
+}
+
+// AfterCommit は ctx のトランザクションがコミットされた後に f を実行する。
+// トランザクションがなければすぐに実行し、ロールバックされた場合は実行しない。
+// キャッシュの削除のように、コミット前に行うと古い値が読まれうる処理に使う
+func AfterCommit(ctx context.Context, f func()) {
+	if funcs, ok := ctx.Value(afterCommitKey{}).(*[]func()); ok {
+		*funcs = append(*funcs, f)
+		return
+	}
+	f()
+}
+
+// WithoutTx は ctx の値を引き継ぎ、トランザクションだけを持たないコンテキストを返す。
+// AfterCommit の f でデータベースを読む場合は、コミット済みのトランザクションを使わないようにこれを通す
+func WithoutTx(ctx context.Context) context.Context {
+	return withoutTxContext{ctx}
+}
+
+type withoutTxContext struct {
+	context.Context
+}
+
+func (c withoutTxContext) Value(key interface{}) interface{} {
+	switch key.(type) {
+	case txKey, afterCommitKey:
+		return nil
+	}
+	return c.Context.Value(key)
+}
+
+// InTx は ctx がトランザクションを持っているかを返す
+func InTx(ctx context.Context) bool {
+	_, ok := ctx.Value(txKey{}).(boil.ContextExecutor)
+	return ok
+}
+
+// Executor は ctx にトランザクションがあればそれを、なければ db を返す。
+// リポジトリは各クエリの実行前にこれで実行先を決める
+func Executor(ctx context.Context, db boil.ContextExecutor) boil.ContextExecutor {
+	if tx, ok := ctx.Value(txKey{}).(boil.ContextExecutor); ok {
+		return tx
+	}
+	return db
+}
+
+func isRetryable(err error) bool {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return pgErr.Code == pgErrSerializationFailure || pgErr.Code == pgErrDeadlockDetected
+	}
+
+	var mysqlErr *mysql.MySQLError
+	if !errors.As(err, &mysqlErr) {
+		return false
+	}
+	return mysqlErr.Number == mysqlErrDeadlock || mysqlErr.Number == mysqlErrLockWaitTimeout
+}
+
+var duplicateKeyPattern = regexp.MustCompile(`for key '(?:[^.']+\.)?([^']+)'`)
+
+// postgresKeyPattern は "Key (email)=(alice@example.com) already exists." から列名を取り出す
+var postgresKeyPattern = regexp.MustCompile(`^Key \((\w+)\)=`)
+
+// sqliteUniquePattern は "UNIQUE constraint failed: users.email" から列名を取り出す
+var sqliteUniquePattern = regexp.MustCompile(`UNIQUE constraint failed: [^.\s]+\.(\w+) `)
+
+// TranslateError はMySQL、PostgreSQL、SQLiteの重複キーエラーを domain.ErrConflict に変換する。
+// それ以外のエラーはそのまま返す
+func TranslateError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		if pgErr.Code != pgErrUniqueViolation {
+			return err
+		}
+		if m := postgresKeyPattern.FindStringSubmatch(pgErr.Detail); m != nil && !strings.HasSuffix(pgErr.ConstraintName, "_pkey") {
+			return domain.Conflict(m[1] + " already exists")
+		}
+		return domain.Conflict("record already exists")
+	}
+
+	var sqliteErr *sqlite.Error
+	if errors.As(err, &sqliteErr) {
+		switch sqliteErr.Code() {
+		case sqlite3.SQLITE_CONSTRAINT_UNIQUE:
+			if m := sqliteUniquePattern.FindStringSubmatch(sqliteErr.Error()); m != nil {
+				return domain.Conflict(m[1] + " already exists")
+			}
+			return domain.Conflict("record already exists")
+		case sqlite3.SQLITE_CONSTRAINT_PRIMARYKEY:
+			return domain.Conflict("record already exists")
+		}
+		return err
+	}
+
+	var mysqlErr *mysql.MySQLError
+	if !errors.As(err, &mysqlErr) || mysqlErr.Number != mysqlErrDuplicateEntry {
+		return err
+	}
+
+	m := duplicateKeyPattern.FindStringSubmatch(mysqlErr.Message)
+	if m == nil || m[1] == "PRIMARY" {
+		return domain.Conflict("record already exists")
+	}
+	return domain.Conflict(m[1] + " already exists")
+}
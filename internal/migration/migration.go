@@ -0,0 +1,397 @@
+package migration
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io/fs"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"todoapp/internal/infrastructure"
+)
+
+// versionTable は適用済みバージョンを記録するテーブル。
+// golang-migrate と同じ形式なので、既存の環境をそのまま引き継げる
+const versionTable = "schema_migrations"
+
+// lockName は複数のアプリケーションが同時にマイグレーションしないためのアドバイザリーロック名
+const lockName = "todoapp_schema_migrations"
+
+// lockPollInterval はPostgreSQLでロックの取得を再試行する間隔
+const lockPollInterval = 100 * time.Millisecond
+
+// NilVersion はマイグレーションが1つも適用されていない状態を表す
+const NilVersion = 0
+
+// Migration は1つのバージョンの up / down のSQL
+type Migration struct {
+	Version uint
+	Name    string
+	Up      string
+	Down    string
+}
+
+// Status は各マイグレーションの適用状況
+type Status struct {
+	Migration Migration
+	Applied   bool
+}
+
+type Migrator struct {
+	db          *sql.DB
+	driver      string
+	migrations  []Migration
+	lockTimeout int
+
+	// Logf を設定すると適用したマイグレーションを出力する
+	Logf func(format string, args ...interface{})
+}
+
+// ErrDirty は前回のマイグレーションが途中で失敗していることを表す
+var ErrDirty = errors.New("database is dirty; fix the schema manually and run force")
+
+var fileNamePattern = regexp.MustCompile(`^(\d+)_(.+)\.(up|down)\.sql$`)
+
+// New は fsys 直下の NNNN_name.up.sql / NNNN_name.down.sql を読み込む。
+// driver は infrastructure.DriverMySQL、DriverPostgres、DriverSQLite のいずれか。
+// MySQLの db は複数のSQL文を1回で実行できるよう multiStatements を有効にしておくこと
+func New(db *sql.DB, driver string, fsys fs.FS) (*Migrator, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read migrations: %w", err)
+	}
+
+	byVersion := make(map[uint]*Migration)
+	for _, entry := range entries {
+		m := fileNamePattern.FindStringSubmatch(entry.Name())
+		if entry.IsDir() || m == nil {
+			continue
+		}
+
+		version, err := strconv.ParseUint(m[1], 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid migration version %s: %w", entry.Name(), err)
+		}
+		body, err := fs.ReadFile(fsys, entry.Name())
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", entry.Name(), err)
+		}
+
+		mig, ok := byVersion[uint(version)]
+		if !ok {
+			mig = &Migration{Version: uint(version), Name: m[2]}
+			byVersion[uint(version)] = mig
+		}
+		if m[3] == "up" {
+			mig.Up = string(body)
+		} else {
+			mig.Down = string(body)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, mig := range byVersion {
+		if mig.Up == "" {
+			return nil, fmt.Errorf("migration %d_%s has no up file", mig.Version, mig.Name)
+		}
+		migrations = append(migrations, *mig)
+	}
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+
+	return &Migrator{db: db, driver: driver, migrations: migrations, lockTimeout: 60}, nil
+}
+
+// Up は未適用のマイグレーションをすべて適用する
+func (m *Migrator) Up(ctx context.Context) error {
+	return m.withLock(ctx, func(conn *sql.Conn) error {
+		current, err := m.checkedVersion(ctx, conn)
+		if err != nil {
+			return err
+		}
+		return m.migrateTo(ctx, conn, current, m.latestVersion())
+	})
+}
+
+// Down は適用済みのマイグレーションを新しい順に n 個取り消す
+func (m *Migrator) Down(ctx context.Context, n int) error {
+	if n <= 0 {
+		return fmt.Errorf("down requires a positive number of steps")
+	}
+
+	return m.withLock(ctx, func(conn *sql.Conn) error {
+		current, err := m.checkedVersion(ctx, conn)
+		if err != nil {
+			return err
+		}
+
+		if current == NilVersion {
+			return nil
+		}
+		idx := m.indexOf(current)
+		if idx < 0 {
+			// 取り消す範囲が決められないので、すべて取り消してしまわないようにする
+			return fmt.Errorf("unknown current migration version: %d", current)
+		}
+		target := uint(NilVersion)
+		if idx-n >= 0 {
+			target = m.migrations[idx-n].Version
+		}
+		return m.migrateTo(ctx, conn, current, target)
+	})
+}
+
+// Goto は指定したバージョンまで up または down する
+func (m *Migrator) Goto(ctx context.Context, version uint) error {
+	if version != NilVersion && m.indexOf(version) < 0 {
+		return fmt.Errorf("unknown migration version: %d", version)
+	}
+
+	return m.withLock(ctx, func(conn *sql.Conn) error {
+		current, err := m.checkedVersion(ctx, conn)
+		if err != nil {
+			return err
+		}
+		return m.migrateTo(ctx, conn, current, version)
+	})
+}
+
+// Force はSQLを実行せずにバージョンを書き換え、dirty 状態を解除する
+func (m *Migrator) Force(ctx context.Context, version uint) error {
+	if version != NilVersion && m.indexOf(version) < 0 {
+		return fmt.Errorf("unknown migration version: %d", version)
+	}
+
+	return m.withLock(ctx, func(conn *sql.Conn) error {
+		return m.setVersion(ctx, conn, version, false)
+	})
+}
+
+// Version は現在のバージョンと dirty 状態を返す
+func (m *Migrator) Version(ctx context.Context) (uint, bool, error) {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return 0, false, err
+	}
+	defer conn.Close()
+
+	if err := ensureVersionTable(ctx, conn); err != nil {
+		return 0, false, err
+	}
+	return readVersion(ctx, conn)
+}
+
+// Status はすべてのマイグレーションの適用状況を返す
+func (m *Migrator) Status(ctx context.Context) ([]Status, error) {
+	current, _, err := m.Version(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]Status, len(m.migrations))
+	for i, mig := range m.migrations {
+		statuses[i] = Status{Migration: mig, Applied: mig.Version <= current}
+	}
+	return statuses, nil
+}
+
+func (m *Migrator) migrateTo(ctx context.Context, conn *sql.Conn, current, target uint) error {
+	if target > current {
+		for _, mig := range m.migrations {
+			if mig.Version <= current || mig.Version > target {
+				continue
+			}
+			if err := m.apply(ctx, conn, mig.Version, mig.Up); err != nil {
+				return fmt.Errorf("migration %d_%s up: %w", mig.Version, mig.Name, err)
+			}
+			m.logf("%d/u %s", mig.Version, mig.Name)
+		}
+		return nil
+	}
+
+	for i := len(m.migrations) - 1; i >= 0; i-- {
+		mig := m.migrations[i]
+		if mig.Version > current || mig.Version <= target {
+			continue
+		}
+		if mig.Down == "" {
+			return fmt.Errorf("migration %d_%s has no down file", mig.Version, mig.Name)
+		}
+
+		prev := uint(NilVersion)
+		if i > 0 {
+			prev = m.migrations[i-1].Version
+		}
+		if err := m.apply(ctx, conn, prev, mig.Down); err != nil {
+			return fmt.Errorf("migration %d_%s down: %w", mig.Version, mig.Name, err)
+		}
+		m.logf("%d/d %s", mig.Version, mig.Name)
+	}
+	return nil
+}
+
+// apply はSQLを実行し、成功したらバージョンを newVersion にする。
+// MySQLのDDLはトランザクションで巻き戻せないため、実行中は dirty にしておく
+func (m *Migrator) apply(ctx context.Context, conn *sql.Conn, newVersion uint, query string) error {
+	if err := m.setVersion(ctx, conn, newVersion, true); err != nil {
+		return err
+	}
+	if _, err := conn.ExecContext(ctx, query); err != nil {
+		return err
+	}
+	return m.setVersion(ctx, conn, newVersion, false)
+}
+
+func (m *Migrator) withLock(ctx context.Context, fn func(conn *sql.Conn) error) (err error) {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	unlock, err := m.lock(ctx, conn)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if rerr := unlock(); err == nil && rerr != nil {
+			err = fmt.Errorf("failed to release migration lock: %w", rerr)
+		}
+	}()
+
+	if err := ensureVersionTable(ctx, conn); err != nil {
+		return err
+	}
+	return fn(conn)
+}
+
+// lock はドライバーごとのアドバイザリーロックを取り、解放する関数を返す
+func (m *Migrator) lock(ctx context.Context, conn *sql.Conn) (func() error, error) {
+	switch m.driver {
+	case infrastructure.DriverSQLite:
+		// SQLiteにはアドバイザリーロックがない。ローカル開発用で同時に複数のプロセスから
+		// マイグレーションすることはない前提とする
+		return func() error { return nil }, nil
+
+	case infrastructure.DriverPostgres:
+		// pg_advisory_lock にはタイムアウトがないので、pg_try_advisory_lock を繰り返す
+		deadline := time.Now().Add(time.Duration(m.lockTimeout) * time.Second)
+		for {
+			var acquired bool
+			if err := conn.QueryRowContext(ctx, "SELECT pg_try_advisory_lock(hashtext($1))", lockName).Scan(&acquired); err != nil {
+				return nil, fmt.Errorf("failed to acquire migration lock: %w", err)
+			}
+			if acquired {
+				break
+			}
+			if time.Now().After(deadline) {
+				return nil, fmt.Errorf("timed out waiting for migration lock")
+			}
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(lockPollInterval):
+			}
+		}
+		return func() error {
+			_, err := conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock(hashtext($1))", lockName)
+			return err
+		}, nil
+	}
+
+	var acquired sql.NullInt64
+	if err := conn.QueryRowContext(ctx, "SELECT GET_LOCK(?, ?)", lockName, m.lockTimeout).Scan(&acquired); err != nil {
+		return nil, fmt.Errorf("failed to acquire migration lock: %w", err)
+	}
+	if acquired.Int64 != 1 {
+		return nil, fmt.Errorf("timed out waiting for migration lock")
+	}
+	return func() error {
+		_, err := conn.ExecContext(context.Background(), "SELECT RELEASE_LOCK(?)", lockName)
+		return err
+	}, nil
+}
+
+func (m *Migrator) checkedVersion(ctx context.Context, conn *sql.Conn) (uint, error) {
+	version, dirty, err := readVersion(ctx, conn)
+	if err != nil {
+		return 0, err
+	}
+	if dirty {
+		return 0, fmt.Errorf("version %d: %w", version, ErrDirty)
+	}
+	return version, nil
+}
+
+func (m *Migrator) latestVersion() uint {
+	if len(m.migrations) == 0 {
+		return NilVersion
+	}
+	return m.migrations[len(m.migrations)-1].Version
+}
+
+func (m *Migrator) indexOf(version uint) int {
+	for i, mig := range m.migrations {
+		if mig.Version == version {
+			return i
+		}
+	}
+	return -1
+}
+
+func (m *Migrator) logf(format string, args ...interface{}) {
+	if m.Logf != nil {
+		m.Logf(format, args...)
+	}
+}
+
+func ensureVersionTable(ctx context.Context, conn *sql.Conn) error {
+	_, err := conn.ExecContext(ctx, "CREATE TABLE IF NOT EXISTS "+versionTable+" (version BIGINT NOT NULL PRIMARY KEY, dirty BOOLEAN NOT NULL)")
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", versionTable, err)
+	}
+	return nil
+}
+
+func readVersion(ctx context.Context, conn *sql.Conn) (uint, bool, error) {
+	var version int64
+	var dirty bool
+	err := conn.QueryRowContext(ctx, "SELECT version, dirty FROM "+versionTable+" LIMIT 1").Scan(&version, &dirty)
+	if errors.Is(err, sql.ErrNoRows) {
+		return NilVersion, false, nil
+	}
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to read schema version: %w", err)
+	}
+	return uint(version), dirty, nil
+}
+
+func (m *Migrator) setVersion(ctx context.Context, conn *sql.Conn, version uint, dirty bool) error {
+	if _, err := conn.ExecContext(ctx, "DELETE FROM "+versionTable); err != nil {
+		return fmt.Errorf("failed to update schema version: %w", err)
+	}
+	if version == NilVersion && !dirty {
+		return nil
+	}
+	if _, err := conn.ExecContext(ctx, "INSERT INTO "+versionTable+" (version, dirty) VALUES "+m.placeholders(2), version, dirty); err != nil {
+		return fmt.Errorf("failed to update schema version: %w", err)
+	}
+	return nil
+}
+
+// placeholders は n 個のプレースホルダーを括弧で囲んで返す
+func (m *Migrator) placeholders(n int) string {
+	ps := make([]string, n)
+	for i := range ps {
+		ps[i] = "?"
+		if m.driver == infrastructure.DriverPostgres {
+			ps[i] = "$" + strconv.Itoa(i+1)
+		}
+	}
+	return "(" + strings.Join(ps, ", ") + ")"
+}
@@ -0,0 +1,57 @@
+package migration
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+	"todoapp/internal/infrastructure"
+)
+
+func TestDown(t *testing.T) {
+	ctx := context.Background()
+	db, err := infrastructure.NewDB(infrastructure.DBConfig{
+		Driver:       infrastructure.DriverSQLite,
+		SQLitePath:   filepath.Join(t.TempDir(), "test.db"),
+		MaxOpenConns: 1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	fsys := fstest.MapFS{
+		"0001_create_a.up.sql":   {Data: []byte("CREATE TABLE a (id INTEGER)")},
+		"0001_create_a.down.sql": {Data: []byte("DROP TABLE a")},
+		"0002_create_b.up.sql":   {Data: []byte("CREATE TABLE b (id INTEGER)")},
+		"0002_create_b.down.sql": {Data: []byte("DROP TABLE b")},
+	}
+	m, err := New(db, infrastructure.DriverSQLite, fsys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := m.Up(ctx); err != nil {
+		t.Fatalf("Up: %v", err)
+	}
+
+	if err := m.Down(ctx, 1); err != nil {
+		t.Fatalf("Down: %v", err)
+	}
+	if version, _, _ := m.Version(ctx); version != 1 {
+		t.Errorf("version after Down = %d, want 1", version)
+	}
+
+	// 適用中のバージョンがこのバイナリの知らないものなら、何も取り消さない
+	if _, err := db.Exec("UPDATE " + versionTable + " SET version = 5"); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.Down(ctx, 1); err == nil {
+		t.Fatal("Down with an unknown current version: want error")
+	}
+	if _, err := db.Exec("SELECT id FROM a"); err != nil {
+		t.Errorf("table a was dropped: %v", err)
+	}
+	if version, _, _ := m.Version(ctx); version != 5 {
+		t.Errorf("version after failed Down = %d, want 5", version)
+	}
+}
@@ -0,0 +1,88 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"todoapp/internal/domain"
+	"todoapp/internal/notification/model"
+	"todoapp/internal/notification/usecase"
+
+	"github.com/labstack/echo/v4"
+)
+
+type NotificationHandler struct {
+	usecase usecase.NotificationUsecase
+}
+
+func NewNotificationHandler(u usecase.NotificationUsecase) *NotificationHandler {
+	return &NotificationHandler{
+		usecase: u,
+	}
+}
+
+// List はログイン中のユーザーの通知を新しい順に、未読の数と一緒に返す。
+// クエリパラメーター: cursor(前のページの next_cursor), limit
+func (h *NotificationHandler) List(c echo.Context) error {
+	var req model.ListRequest
+	if err := (&echo.DefaultBinder{}).BindQueryParams(c, &req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid query parameters"})
+	}
+
+	userID, _ := c.Get("user_id").(int)
+	resp, err := h.usecase.List(c.Request().Context(), userID, req)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// MarkRead は up_to_id までの通知を既読にする。本文を省略するとすべての通知を既読にする
+func (h *NotificationHandler) MarkRead(c echo.Context) error {
+	var req model.MarkReadRequest
+	if c.Request().ContentLength != 0 {
+		if err := c.Bind(&req); err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request body"})
+		}
+	}
+
+	userID, _ := c.Get("user_id").(int)
+	if err := h.usecase.MarkRead(c.Request().Context(), userID, req); err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (h *NotificationHandler) GetPreferences(c echo.Context) error {
+	userID, _ := c.Get("user_id").(int)
+	prefs, err := h.usecase.GetPreferences(c.Request().Context(), userID)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, prefs)
+}
+
+// UpdatePreferences は本文の {"種類": true/false} で指定した種類の設定だけを変更する
+func (h *NotificationHandler) UpdatePreferences(c echo.Context) error {
+	var prefs model.Preferences
+	if err := c.Bind(&prefs); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request body"})
+	}
+
+	userID, _ := c.Get("user_id").(int)
+	prefs, err := h.usecase.UpdatePreferences(c.Request().Context(), userID, prefs)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, prefs)
+}
+
+func errorResponse(c echo.Context, err error) error {
+	if errors.Is(err, domain.ErrInvalid) {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+	return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+}
@@ -0,0 +1,209 @@
+package handler_test
+
+import (
+	"net/http"
+	"strconv"
+	"testing"
+	"todoapp/internal/notification/handler"
+	"todoapp/internal/notification/model"
+	"todoapp/internal/notification/repository"
+	"todoapp/internal/notification/usecase"
+	"todoapp/internal/router"
+	"todoapp/internal/testutil"
+	tweethandler "todoapp/internal/tweet/handler"
+	tweetmodel "todoapp/internal/tweet/model"
+	tweetrepository "todoapp/internal/tweet/repository"
+	tweetusecase "todoapp/internal/tweet/usecase"
+
+	"github.com/labstack/echo/v4"
+)
+
+func newTestServer(t *testing.T) *echo.Echo {
+	t.Helper()
+	env := testutil.NewEnv(t)
+	repository.RegisterModelHooks()
+
+	tweetRepo := tweetrepository.NewTweetRepository(env.DB, env.DB)
+	return env.Server(router.Handlers{
+		Tweet: tweethandler.NewTweetHandler(tweetusecase.NewTweetUsecase(tweetRepo, env.UserRepo, env.TxManager)),
+		Notification: handler.NewNotificationHandler(
+			usecase.NewNotificationUsecase(repository.NewNotificationRepository(env.DB, env.DB), env.TxManager),
+		),
+	})
+}
+
+func post(t *testing.T, e *echo.Echo, token, path, content string) int {
+	t.Helper()
+	rec := testutil.MustDo(t, e, http.MethodPost, path, token, `{"content":"`+content+`"}`, http.StatusCreated)
+	var tweet tweetmodel.Tweet
+	testutil.Decode(t, rec, &tweet)
+	return tweet.ID
+}
+
+func list(t *testing.T, e *echo.Echo, token, query string) *model.Notifications {
+	t.Helper()
+	rec := testutil.MustDo(t, e, http.MethodGet, "/api/notifications"+query, token, "", http.StatusOK)
+	var resp model.Notifications
+	testutil.Decode(t, rec, &resp)
+	return &resp
+}
+
+func messages(resp *model.Notifications) []string {
+	var msgs []string
+	for _, n := range resp.Notifications {
+		msgs = append(msgs, n.Message)
+	}
+	return msgs
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestNotifications(t *testing.T) {
+	e := newTestServer(t)
+	aliceID, alice := testutil.RegisterAndLoginAs(t, e, "alice", "Alice")
+	_, bob := testutil.RegisterAndLoginAs(t, e, "bob", "Bob")
+	_, carol := testutil.RegisterAndLoginAs(t, e, "carol", "Carol")
+	_, dave := testutil.RegisterAndLoginAs(t, e, "dave", "Dave")
+
+	tweetID := post(t, e, alice, "/api/tweets", "hello")
+	tweetPath := "/api/tweets/" + strconv.Itoa(tweetID)
+	for _, token := range []string{bob, carol, dave, alice} {
+		testutil.MustDo(t, e, http.MethodPost, tweetPath+"/like", token, "", http.StatusCreated)
+	}
+	testutil.MustDo(t, e, http.MethodPost, "/api/users/"+strconv.Itoa(aliceID)+"/follow", bob, "", http.StatusCreated)
+	// 親ツイートの投稿者へのメンションはリプライの通知だけにする
+	post(t, e, bob, tweetPath+"/replies", "@alice hi")
+	post(t, e, carol, "/api/tweets", "hey @alice")
+
+	resp := list(t, e, alice, "")
+	want := []string{
+		"Carol mentioned you",
+		"Bob replied to your tweet",
+		"Bob followed you",
+		"Dave and 2 others liked your tweet",
+	}
+	if !equalStrings(messages(resp), want) || resp.UnreadCount != 4 {
+		t.Fatalf("notifications = %q (unread %d), want %q (unread 4)", messages(resp), resp.UnreadCount, want)
+	}
+	liked := resp.Notifications[3]
+	if liked.ActorCount != 3 || len(liked.Actors) != 3 || liked.Actors[0].Username != "dave" ||
+		liked.TweetID == nil || *liked.TweetID != tweetID || liked.Read {
+		t.Errorf("liked = %+v", liked)
+	}
+
+	// ページ分割
+	page := list(t, e, alice, "?limit=3")
+	if len(page.Notifications) != 3 || page.NextCursor == nil {
+		t.Fatalf("first page = %q, next = %v", messages(page), page.NextCursor)
+	}
+	page = list(t, e, alice, "?limit=3&cursor="+*page.NextCursor)
+	if !equalStrings(messages(page), want[3:]) || page.NextCursor != nil {
+		t.Errorf("second page = %q, next = %v", messages(page), page.NextCursor)
+	}
+	if rec := testutil.Do(t, e, http.MethodGet, "/api/notifications?cursor=bad", alice, ""); rec.Code != http.StatusBadRequest {
+		t.Errorf("invalid cursor: status = %d, want 400", rec.Code)
+	}
+
+	// いいねの取り消しで通知も消える
+	testutil.MustDo(t, e, http.MethodDelete, tweetPath+"/like", carol, "", http.StatusNoContent)
+	if resp := list(t, e, alice, ""); resp.Notifications[3].Message != "Dave and 1 other liked your tweet" {
+		t.Errorf("after unlike = %q", messages(resp))
+	}
+
+	// 既読にした後のいいねは別の通知になる
+	testutil.MustDo(t, e, http.MethodPost, "/api/notifications/read", alice, `{"up_to_id":`+strconv.Itoa(resp.Notifications[1].ID)+`}`, http.StatusNoContent)
+	if resp := list(t, e, alice, ""); resp.UnreadCount != 1 || resp.Notifications[0].Read || !resp.Notifications[1].Read {
+		t.Errorf("after partial read: unread = %d, notifications = %+v", resp.UnreadCount, resp.Notifications)
+	}
+	testutil.MustDo(t, e, http.MethodPost, "/api/notifications/read", alice, "", http.StatusNoContent)
+	testutil.MustDo(t, e, http.MethodPost, tweetPath+"/like", carol, "", http.StatusCreated)
+	resp = list(t, e, alice, "")
+	want = []string{
+		"Carol liked your tweet",
+		"Carol mentioned you",
+		"Bob replied to your tweet",
+		"Bob followed you",
+		"Dave and 1 other liked your tweet",
+	}
+	if !equalStrings(messages(resp), want) || resp.UnreadCount != 1 {
+		t.Errorf("after read = %q (unread %d), want %q (unread 1)", messages(resp), resp.UnreadCount, want)
+	}
+}
+
+func TestMuteAndBlock(t *testing.T) {
+	e := newTestServer(t)
+	_, alice := testutil.RegisterAndLoginAs(t, e, "alice", "Alice")
+	_, bob := testutil.RegisterAndLoginAs(t, e, "bob", "Bob")
+	carolID, carol := testutil.RegisterAndLoginAs(t, e, "carol", "Carol")
+	daveID, dave := testutil.RegisterAndLoginAs(t, e, "dave", "Dave")
+
+	tweetID := post(t, e, alice, "/api/tweets", "hello")
+	for _, token := range []string{bob, carol, dave} {
+		testutil.MustDo(t, e, http.MethodPost, "/api/tweets/"+strconv.Itoa(tweetID)+"/like", token, "", http.StatusCreated)
+	}
+
+	// ミュートしたユーザーの通知は、ミュートする前のものも表示せず、ミュート中は作らない
+	mutePath := "/api/users/" + strconv.Itoa(carolID) + "/mute"
+	testutil.MustDo(t, e, http.MethodPost, mutePath, alice, "", http.StatusCreated)
+	post(t, e, carol, "/api/tweets", "hey @alice")
+	resp := list(t, e, alice, "")
+	if want := []string{"Dave and 1 other liked your tweet"}; !equalStrings(messages(resp), want) || resp.UnreadCount != 1 {
+		t.Errorf("notifications while muting = %q (unread %d), want %q", messages(resp), resp.UnreadCount, want)
+	}
+	for _, actor := range resp.Notifications[0].Actors {
+		if actor.ID == carolID {
+			t.Errorf("actors include a muted user: %+v", resp.Notifications[0].Actors)
+		}
+	}
+	testutil.MustDo(t, e, http.MethodDelete, mutePath, alice, "", http.StatusNoContent)
+	if got, want := messages(list(t, e, alice, "")), []string{"Dave and 2 others liked your tweet"}; !equalStrings(got, want) {
+		t.Errorf("notifications after unmute = %q, want %q", got, want)
+	}
+
+	// ブロックしたユーザーの通知も表示しない
+	testutil.MustDo(t, e, http.MethodPost, "/api/users/"+strconv.Itoa(daveID)+"/block", alice, "", http.StatusCreated)
+	if got, want := messages(list(t, e, alice, "")), []string{"Carol and 1 other liked your tweet"}; !equalStrings(got, want) {
+		t.Errorf("notifications after block = %q, want %q", got, want)
+	}
+}
+
+func TestPreferences(t *testing.T) {
+	e := newTestServer(t)
+	aliceID, alice := testutil.RegisterAndLoginAs(t, e, "alice", "Alice")
+	_, bob := testutil.RegisterAndLoginAs(t, e, "bob", "Bob")
+
+	rec := testutil.MustDo(t, e, http.MethodPut, "/api/notifications/preferences", alice, `{"follow":false}`, http.StatusOK)
+	var prefs model.Preferences
+	testutil.Decode(t, rec, &prefs)
+	if len(prefs) != len(model.Types) || prefs[model.TypeFollow] || !prefs[model.TypeLike] {
+		t.Errorf("preferences = %v, want all but follow", prefs)
+	}
+	if rec := testutil.Do(t, e, http.MethodPut, "/api/notifications/preferences", alice, `{"retweet":false}`); rec.Code != http.StatusBadRequest {
+		t.Errorf("unknown type: status = %d, want 400", rec.Code)
+	}
+
+	tweetID := post(t, e, alice, "/api/tweets", "hello")
+	testutil.MustDo(t, e, http.MethodPost, "/api/users/"+strconv.Itoa(aliceID)+"/follow", bob, "", http.StatusCreated)
+	testutil.MustDo(t, e, http.MethodPost, "/api/tweets/"+strconv.Itoa(tweetID)+"/like", bob, "", http.StatusCreated)
+	if resp := list(t, e, alice, ""); !equalStrings(messages(resp), []string{"Bob liked your tweet"}) {
+		t.Errorf("notifications = %q, want only the like", messages(resp))
+	}
+
+	testutil.MustDo(t, e, http.MethodPut, "/api/notifications/preferences", alice, `{"follow":true}`, http.StatusOK)
+	rec = testutil.MustDo(t, e, http.MethodGet, "/api/notifications/preferences", alice, "", http.StatusOK)
+	prefs = nil
+	testutil.Decode(t, rec, &prefs)
+	if !prefs[model.TypeFollow] {
+		t.Errorf("preferences = %v, want follow enabled", prefs)
+	}
+}
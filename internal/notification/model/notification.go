@@ -0,0 +1,75 @@
+package model
+
+import "time"
+
+// 通知の種類(notifications.type と notification_opt_outs.type の値)
+const (
+	TypeFollow  = "follow"
+	TypeLike    = "like"
+	TypeReply   = "reply"
+	TypeMention = "mention"
+	// TypeExportReady はデータエクスポートの完了。操作したユーザーは受け取るユーザー本人
+	TypeExportReady = "export_ready"
+)
+
+// Types は通知の種類の一覧(設定の表示順)
+var Types = []string{TypeFollow, TypeLike, TypeReply, TypeMention, TypeExportReady}
+
+// IsType は t が通知の種類かを返す
+func IsType(t string) bool {
+	for _, typ := range Types {
+		if t == typ {
+			return true
+		}
+	}
+	return false
+}
+
+// Notification は種類と対象のツイートが同じ通知を1件にまとめたもの
+// (「Aliceさんと他4人があなたのツイートをいいねしました」)。
+// 既読にした通知と未読の通知は別々にまとめる
+type Notification struct {
+	// ID はまとめた通知のうち最も新しいもののID
+	ID   int    `json:"id"`
+	Type string `json:"type"`
+	// TweetID はいいねされたツイート、リプライ、メンションしたツイート。フォローでは nil
+	TweetID *int `json:"tweet_id,omitempty"`
+	// Actors は新しい順に最大 MaxActors 人。ActorCount は重複を除いた全員の数
+	Actors     []*Actor `json:"actors"`
+	ActorCount int      `json:"actor_count"`
+	Message    string   `json:"message"`
+	Read       bool     `json:"read"`
+	// CreatedAt は最も新しい通知の日時
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// MaxActors は1件の通知に含めるユーザーの最大数
+const MaxActors = 3
+
+type Actor struct {
+	ID              int     `json:"id"`
+	Username        string  `json:"username"`
+	DisplayName     string  `json:"display_name"`
+	ProfileImageURL *string `json:"profile_image_url,omitempty"`
+}
+
+// ListRequest の Cursor は前のページの next_cursor
+type ListRequest struct {
+	Cursor string `query:"cursor"`
+	Limit  int    `query:"limit"`
+}
+
+type Notifications struct {
+	Notifications []*Notification `json:"notifications"`
+	// UnreadCount は未読の通知を含む(まとめた後の)通知の数
+	UnreadCount int     `json:"unread_count"`
+	NextCursor  *string `json:"next_cursor"`
+}
+
+// MarkReadRequest の UpToID は既読にする最後の通知のID。0ならすべての通知を既読にする
+type MarkReadRequest struct {
+	UpToID int `json:"up_to_id"`
+}
+
+// Preferences は通知の種類ごとに受け取るかどうか
+type Preferences map[string]bool
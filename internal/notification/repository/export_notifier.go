@@ -0,0 +1,32 @@
+package repository
+
+import (
+	"context"
+	exportmodel "todoapp/internal/export/model"
+	"todoapp/internal/infrastructure"
+	"todoapp/internal/notification/model"
+	"todoapp/internal/schema"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// ExportNotifier はデータエクスポートの完了を、エクスポートしたユーザーへの通知として作成する
+// (exportusecase.Notifier を満たす)。操作したユーザーは本人にする。
+// ストリームへの配信は通知の挿入で stream.RegisterPublishHooks のフックが行う
+type ExportNotifier struct {
+	db boil.ContextExecutor
+}
+
+func NewExportNotifier(db boil.ContextExecutor) *ExportNotifier {
+	return &ExportNotifier{db: db}
+}
+
+func (n *ExportNotifier) ExportReady(ctx context.Context, export *exportmodel.Export) error {
+	exec := infrastructure.Executor(ctx, n.db)
+	optedOut, err := schema.NotificationOptOutExists(ctx, exec, export.UserID, model.TypeExportReady)
+	if err != nil || optedOut {
+		return err
+	}
+	row := &schema.Notification{UserID: export.UserID, ActorID: export.UserID, Type: model.TypeExportReady}
+	return row.Insert(ctx, exec, boil.Infer())
+}
@@ -0,0 +1,141 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"sync"
+	"todoapp/internal/notification/model"
+	"todoapp/internal/schema"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+var registerHooksOnce sync.Once
+
+// RegisterModelHooks はsqlboilerのフックを登録し、フォロー・いいね・リプライ・メンションの通知を作成する。
+// 通知は操作と同じ exec で挿入するので、操作がロールバックされれば通知も残らない。
+// フォローといいねを取り消すと、その通知も削除する。
+// 自分自身への操作と、受け取るユーザーが受け取らない設定にしている種類は通知しない
+func RegisterModelHooks() {
+	registerHooksOnce.Do(func() {
+		schema.AddFollowHook(boil.AfterInsertHook, followCreated)
+		schema.AddFollowHook(boil.AfterDeleteHook, followDeleted)
+		schema.AddLikeHook(boil.AfterInsertHook, likeCreated)
+		schema.AddLikeHook(boil.AfterDeleteHook, likeDeleted)
+		schema.AddTweetHook(boil.AfterInsertHook, tweetCreated)
+		schema.AddTweetMentionHook(boil.AfterInsertHook, mentionCreated)
+	})
+}
+
+func followCreated(ctx context.Context, exec boil.ContextExecutor, o *schema.Follow) error {
+	return notify(ctx, exec, o.FollowingID, o.FollowerID, model.TypeFollow, null.Int{})
+}
+
+func followDeleted(ctx context.Context, exec boil.ContextExecutor, o *schema.Follow) error {
+	return unnotify(ctx, exec, o.FollowingID, o.FollowerID, model.TypeFollow, null.Int{})
+}
+
+func likeCreated(ctx context.Context, exec boil.ContextExecutor, o *schema.Like) error {
+	authorID, err := tweetAuthor(ctx, exec, o.TweetID)
+	if err != nil {
+		return err
+	}
+	return notify(ctx, exec, authorID, o.UserID, model.TypeLike, null.IntFrom(o.TweetID))
+}
+
+func likeDeleted(ctx context.Context, exec boil.ContextExecutor, o *schema.Like) error {
+	authorID, err := tweetAuthor(ctx, exec, o.TweetID)
+	if err != nil {
+		return err
+	}
+	return unnotify(ctx, exec, authorID, o.UserID, model.TypeLike, null.IntFrom(o.TweetID))
+}
+
+// tweetCreated はリプライを親ツイートの投稿者に通知する。tweet_id はリプライのツイート
+func tweetCreated(ctx context.Context, exec boil.ContextExecutor, o *schema.Tweet) error {
+	if !o.ReplyToTweetID.Valid {
+		return nil
+	}
+	authorID, err := tweetAuthor(ctx, exec, o.ReplyToTweetID.Int)
+	if err != nil {
+		return err
+	}
+	return notify(ctx, exec, authorID, o.UserID, model.TypeReply, null.IntFrom(o.ID))
+}
+
+// mentionCreated はメンションされたユーザーに通知する。
+// リプライの本文で親ツイートの投稿者にメンションしている場合は、リプライの通知だけにする
+func mentionCreated(ctx context.Context, exec boil.ContextExecutor, o *schema.TweetMention) error {
+	authorID, err := tweetAuthor(ctx, exec, o.TweetID)
+	if err != nil {
+		return err
+	}
+	replied, err := schema.Notifications(
+		schema.NotificationWhere.UserID.EQ(o.UserID),
+		schema.NotificationWhere.Type.EQ(model.TypeReply),
+		schema.NotificationWhere.TweetID.EQ(null.IntFrom(o.TweetID)),
+	).Exists(ctx, exec)
+	if err != nil || replied {
+		return err
+	}
+	return notify(ctx, exec, o.UserID, authorID, model.TypeMention, null.IntFrom(o.TweetID))
+}
+
+// tweetAuthor は削除済みのツイートも含めて投稿者を返す。ツイートが存在しなければ0を返す
+func tweetAuthor(ctx context.Context, exec boil.ContextExecutor, tweetID int) (int, error) {
+	tweet, err := schema.Tweets(
+		qm.WithDeleted(),
+		qm.Select(schema.TweetColumns.UserID),
+		schema.TweetWhere.ID.EQ(tweetID),
+	).One(ctx, exec)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return tweet.UserID, nil
+}
+
+func notify(ctx context.Context, exec boil.ContextExecutor, userID, actorID int, typ string, tweetID null.Int) error {
+	if userID == 0 || userID == actorID {
+		return nil
+	}
+	optedOut, err := schema.NotificationOptOutExists(ctx, exec, userID, typ)
+	if err != nil || optedOut {
+		return err
+	}
+	// ミュートしているユーザーと、どちらかがブロックしているユーザーからの通知は作らない
+	hidden, err := schema.MuteExists(ctx, exec, userID, actorID)
+	if err != nil || hidden {
+		return err
+	}
+	hidden, err = schema.Blocks(
+		qm.Where("(blocker_id = ? AND blocked_id = ?) OR (blocker_id = ? AND blocked_id = ?)", userID, actorID, actorID, userID),
+	).Exists(ctx, exec)
+	if err != nil || hidden {
+		return err
+	}
+	n := &schema.Notification{UserID: userID, ActorID: actorID, Type: typ, TweetID: tweetID}
+	return n.Insert(ctx, exec, boil.Infer())
+}
+
+// unnotify は取り消された操作の通知を、既読かどうかにかかわらず削除する
+func unnotify(ctx context.Context, exec boil.ContextExecutor, userID, actorID int, typ string, tweetID null.Int) error {
+	if userID == 0 || userID == actorID {
+		return nil
+	}
+	mods := []qm.QueryMod{
+		schema.NotificationWhere.ActorID.EQ(actorID),
+		schema.NotificationWhere.Type.EQ(typ),
+		schema.NotificationWhere.UserID.EQ(userID),
+	}
+	if tweetID.Valid {
+		mods = append(mods, schema.NotificationWhere.TweetID.EQ(tweetID))
+	}
+	_, err := schema.Notifications(mods...).DeleteAll(ctx, exec)
+	return err
+}
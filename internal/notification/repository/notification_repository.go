@@ -0,0 +1,251 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"todoapp/internal/domain"
+	"todoapp/internal/infrastructure"
+	"todoapp/internal/notification/model"
+	"todoapp/internal/schema"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// NotificationRepository は通知を読み取り、既読にする。
+// 通知の作成と削除はフォローやいいねと同じ exec で RegisterModelHooks のフックが行う
+type NotificationRepository interface {
+	// List は userID の通知を種類・対象のツイート・既読にした日時ごとにまとめ、
+	// 新しい順に beforeID より古いものから最大 limit 件返す。beforeID が0なら先頭から返す。
+	// 退会中のユーザー、userID がミュートしているユーザー、どちらかがブロックしているユーザーの通知と、
+	// 削除済みのツイートの通知は含めない。Message は空のまま返す
+	List(ctx context.Context, userID, beforeID, limit int) ([]*model.Notification, error)
+	// CountUnread は List でまとめた通知のうち、未読のものの数を返す
+	CountUnread(ctx context.Context, userID int) (int, error)
+	// MarkRead は userID の未読の通知のうち、IDが upToID 以下のものを既読にする。
+	// upToID が0ならすべてを既読にする
+	MarkRead(ctx context.Context, userID, upToID int) error
+	// ListOptOuts は userID が受け取らない通知の種類を返す
+	ListOptOuts(ctx context.Context, userID int) ([]string, error)
+	// SetOptOut は userID が typ の通知を受け取らない(optOut が true)か、受け取るかを設定する
+	SetOptOut(ctx context.Context, userID int, typ string, optOut bool) error
+}
+
+type notificationRepository struct {
+	db boil.ContextExecutor
+	// readDB は読み取り専用のメソッドで使う(リードレプリカがなければ db と同じ)
+	readDB boil.ContextExecutor
+}
+
+func NewNotificationRepository(db, readDB boil.ContextExecutor) NotificationRepository {
+	return &notificationRepository{db: db, readDB: readDB}
+}
+
+func (r *notificationRepository) exec(ctx context.Context) boil.ContextExecutor {
+	return infrastructure.Executor(ctx, r.db)
+}
+
+func (r *notificationRepository) readExec(ctx context.Context) boil.ContextExecutor {
+	return infrastructure.Executor(ctx, r.readDB)
+}
+
+// hiddenActors は、受け取るユーザーがミュートしているユーザーと、どちらかがブロックしているユーザーに
+// col が含まれない条件。引数は受け取るユーザーのIDを3回
+func hiddenActors(col string) string {
+	return fmt.Sprintf(`NOT EXISTS (SELECT 1 FROM mutes WHERE mutes.muter_id = ? AND mutes.muted_id = %[1]s)
+    AND NOT EXISTS (SELECT 1 FROM blocks WHERE blocks.blocker_id = ? AND blocks.blocked_id = %[1]s)
+    AND NOT EXISTS (SELECT 1 FROM blocks WHERE blocks.blocked_id = ? AND blocks.blocker_id = %[1]s)`, col)
+}
+
+// visibleNotifications は表示する通知の FROM 句。引数は受け取るユーザーのIDを4回。
+// 操作したユーザーが退会中か hiddenActors の通知と、対象のツイートが削除済みの通知は表示しない
+var visibleNotifications = `notifications
+JOIN users AS actors ON actors.id = notifications.actor_id AND actors.deleted_at IS NULL
+LEFT JOIN tweets ON tweets.id = notifications.tweet_id
+WHERE notifications.user_id = ? AND (notifications.tweet_id IS NULL OR tweets.deleted_at IS NULL)
+    AND ` + hiddenActors("notifications.actor_id")
+
+// groupsQuery は通知をまとめ、それぞれの最も新しい通知のIDと操作したユーザーの数を返す。
+// 既読にした日時もまとめる単位に含め、既読にした後の通知が前の通知と1件にまとまらないようにする
+// (MarkRead は1回の呼び出しで同じ日時を設定する)。%s は beforeID の条件
+var groupsQuery = `SELECT MAX(notifications.id) AS id, COUNT(DISTINCT notifications.actor_id) AS actor_count
+FROM ` + visibleNotifications + `
+GROUP BY notifications.type, notifications.tweet_id, notifications.read_at
+%s
+ORDER BY id DESC
+LIMIT ?`
+
+// actorsQuery は latest(まとめた通知の最も新しい通知)と同じまとまりの通知を操作したユーザーを、
+// まとまりごとに最後に操作した順に MaxActors 人まで返す。%s は latest.id の IN 句で、
+// その後の引数は hiddenActors の受け取るユーザーのID
+var actorsQuery = `SELECT ranked.group_id, users.id, users.username, users.display_name, users.profile_image_url
+FROM (
+    SELECT acted.group_id, acted.actor_id,
+        ROW_NUMBER() OVER (PARTITION BY acted.group_id ORDER BY acted.last_id DESC) AS actor_rank
+    FROM (
+        SELECT latest.id AS group_id, notifications.actor_id, MAX(notifications.id) AS last_id
+        FROM notifications AS latest
+        JOIN notifications ON notifications.user_id = latest.user_id AND notifications.type = latest.type
+            AND (notifications.tweet_id = latest.tweet_id OR (notifications.tweet_id IS NULL AND latest.tweet_id IS NULL))
+            AND (notifications.read_at = latest.read_at OR (notifications.read_at IS NULL AND latest.read_at IS NULL))
+        WHERE latest.id IN (%s) AND ` + hiddenActors("notifications.actor_id") + `
+        GROUP BY latest.id, notifications.actor_id
+    ) AS acted
+    JOIN users AS actors ON actors.id = acted.actor_id AND actors.deleted_at IS NULL
+) AS ranked
+JOIN users ON users.id = ranked.actor_id
+WHERE ranked.actor_rank <= ?
+ORDER BY ranked.group_id DESC, ranked.actor_rank`
+
+// unreadQuery はまとめた通知のうち、未読のものの数を数える
+var unreadQuery = `SELECT COUNT(*) AS count FROM (
+    SELECT 1 AS one FROM ` + visibleNotifications + ` AND notifications.read_at IS NULL
+    GROUP BY notifications.type, notifications.tweet_id
+) AS unread`
+
+type groupRow struct {
+	ID         int `boil:"id"`
+	ActorCount int `boil:"actor_count"`
+}
+
+type actorRow struct {
+	GroupID         int         `boil:"group_id"`
+	ID              int         `boil:"id"`
+	Username        string      `boil:"username"`
+	DisplayName     string      `boil:"display_name"`
+	ProfileImageURL null.String `boil:"profile_image_url"`
+}
+
+func (r *notificationRepository) List(ctx context.Context, userID, beforeID, limit int) ([]*model.Notification, error) {
+	exec := r.readExec(ctx)
+
+	args := []interface{}{userID, userID, userID, userID}
+	having := ""
+	if beforeID > 0 {
+		having = "HAVING MAX(notifications.id) < ?"
+		args = append(args, beforeID)
+	}
+	args = append(args, limit)
+	var groups []*groupRow
+	if err := queries.Raw(fmt.Sprintf(groupsQuery, having), args...).Bind(ctx, exec, &groups); err != nil {
+		return nil, err
+	}
+	if len(groups) == 0 {
+		return []*model.Notification{}, nil
+	}
+
+	// 種類・対象のツイート・日時は最も新しい通知の行から読む
+	ids := make([]interface{}, len(groups))
+	for i, g := range groups {
+		ids[i] = g.ID
+	}
+	latest, err := schema.Notifications(qm.WhereIn(schema.NotificationColumns.ID+" IN ?", ids...)).All(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[int]*schema.Notification, len(latest))
+	for _, n := range latest {
+		byID[n.ID] = n
+	}
+
+	var actors []*actorRow
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(ids)), ", ")
+	args = append(ids, userID, userID, userID, model.MaxActors)
+	if err := queries.Raw(fmt.Sprintf(actorsQuery, placeholders), args...).Bind(ctx, exec, &actors); err != nil {
+		return nil, err
+	}
+	actorsByGroup := make(map[int][]*model.Actor)
+	for _, a := range actors {
+		actorsByGroup[a.GroupID] = append(actorsByGroup[a.GroupID], &model.Actor{
+			ID:              a.ID,
+			Username:        a.Username,
+			DisplayName:     a.DisplayName,
+			ProfileImageURL: a.ProfileImageURL.Ptr(),
+		})
+	}
+
+	notifications := make([]*model.Notification, 0, len(groups))
+	for _, g := range groups {
+		n, ok := byID[g.ID]
+		if !ok {
+			continue
+		}
+		notifications = append(notifications, &model.Notification{
+			ID:         n.ID,
+			Type:       n.Type,
+			TweetID:    n.TweetID.Ptr(),
+			Actors:     actorsByGroup[n.ID],
+			ActorCount: g.ActorCount,
+			Read:       n.ReadAt.Valid,
+			CreatedAt:  n.CreatedAt.Time,
+		})
+	}
+	return notifications, nil
+}
+
+func (r *notificationRepository) CountUnread(ctx context.Context, userID int) (int, error) {
+	var row struct {
+		Count int `boil:"count"`
+	}
+	if err := queries.Raw(unreadQuery, userID, userID, userID, userID).Bind(ctx, r.readExec(ctx), &row); err != nil {
+		return 0, err
+	}
+	return row.Count, nil
+}
+
+func (r *notificationRepository) MarkRead(ctx context.Context, userID, upToID int) error {
+	mods := []qm.QueryMod{
+		schema.NotificationWhere.UserID.EQ(userID),
+		schema.NotificationWhere.ReadAt.IsNull(),
+	}
+	if upToID > 0 {
+		mods = append(mods, schema.NotificationWhere.ID.LTE(upToID))
+	}
+	// まとめる単位に使うので、秒未満を保存しないデータベースでも同じ値になるように切り捨てる
+	now := time.Now().UTC().Truncate(time.Second)
+	_, err := schema.Notifications(mods...).UpdateAll(ctx, r.exec(ctx), schema.M{
+		schema.NotificationColumns.ReadAt: null.TimeFrom(now),
+	})
+	return err
+}
+
+func (r *notificationRepository) ListOptOuts(ctx context.Context, userID int) ([]string, error) {
+	optOuts, err := schema.NotificationOptOuts(
+		schema.NotificationOptOutWhere.UserID.EQ(userID),
+	).All(ctx, r.readExec(ctx))
+	if err != nil {
+		return nil, err
+	}
+	types := make([]string, len(optOuts))
+	for i, o := range optOuts {
+		types[i] = o.Type
+	}
+	return types, nil
+}
+
+func (r *notificationRepository) SetOptOut(ctx context.Context, userID int, typ string, optOut bool) error {
+	exec := r.exec(ctx)
+	exists, err := schema.NotificationOptOutExists(ctx, exec, userID, typ)
+	if err != nil || exists == optOut {
+		return err
+	}
+	if !optOut {
+		_, err := schema.NotificationOptOuts(
+			schema.NotificationOptOutWhere.UserID.EQ(userID),
+			schema.NotificationOptOutWhere.Type.EQ(typ),
+		).DeleteAll(ctx, exec)
+		return err
+	}
+	row := &schema.NotificationOptOut{UserID: userID, Type: typ}
+	err = infrastructure.TranslateError(row.Insert(ctx, exec, boil.Infer()))
+	// 同時に設定された場合も、受け取らない設定になっていればよい
+	if errors.Is(err, domain.ErrConflict) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,126 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+	"todoapp/internal/dbtest"
+	"todoapp/internal/notification/model"
+	"todoapp/internal/schema"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestNotificationRepository(t *testing.T) {
+	RegisterModelHooks()
+
+	ctx := context.Background()
+	db := dbtest.New(t)
+	repo := NewNotificationRepository(db, db)
+
+	var users []*schema.User
+	for _, name := range []string{"alice", "bob", "carol"} {
+		u := &schema.User{Username: name, DisplayName: name, Email: name + "@example.com", PasswordHash: "hash"}
+		if err := u.Insert(ctx, db, boil.Infer()); err != nil {
+			t.Fatal(err)
+		}
+		users = append(users, u)
+	}
+	alice, bob, carol := users[0], users[1], users[2]
+
+	insert := func(o interface {
+		Insert(context.Context, boil.ContextExecutor, boil.Columns) error
+	}) {
+		t.Helper()
+		if err := o.Insert(ctx, db, boil.Infer()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	kept := &schema.Tweet{UserID: alice.ID, Content: "kept"}
+	deleted := &schema.Tweet{UserID: alice.ID, Content: "deleted"}
+	insert(kept)
+	insert(deleted)
+	insert(&schema.Like{UserID: bob.ID, TweetID: kept.ID})
+	insert(&schema.Like{UserID: bob.ID, TweetID: deleted.ID})
+	insert(&schema.Like{UserID: alice.ID, TweetID: kept.ID})
+	insert(&schema.Follow{FollowerID: bob.ID, FollowingID: alice.ID})
+	insert(&schema.Follow{FollowerID: carol.ID, FollowingID: alice.ID})
+
+	types := func() []string {
+		t.Helper()
+		notifications, err := repo.List(ctx, alice.ID, 0, 10)
+		if err != nil {
+			t.Fatalf("List: %v", err)
+		}
+		var got []string
+		for _, n := range notifications {
+			got = append(got, n.Type+":"+n.Actors[0].Username)
+		}
+		return got
+	}
+	equal := func(a, b []string) bool {
+		if len(a) != len(b) {
+			return false
+		}
+		for i := range a {
+			if a[i] != b[i] {
+				return false
+			}
+		}
+		return true
+	}
+
+	// 自分のいいねは通知しない
+	if got, want := types(), []string{"follow:carol", "like:bob", "like:bob"}; !equal(got, want) {
+		t.Fatalf("notifications = %v, want %v", got, want)
+	}
+
+	// 削除済みのツイートと退会中のユーザーの通知は表示しない
+	if _, err := schema.Tweets(schema.TweetWhere.ID.EQ(deleted.ID)).UpdateAll(ctx, db, schema.M{schema.TweetColumns.DeletedAt: null.TimeFrom(time.Now())}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := schema.Users(schema.UserWhere.ID.EQ(carol.ID)).UpdateAll(ctx, db, schema.M{schema.UserColumns.DeletedAt: null.TimeFrom(time.Now())}); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := types(), []string{"follow:bob", "like:bob"}; !equal(got, want) {
+		t.Fatalf("after deletion = %v, want %v", got, want)
+	}
+	if n, err := repo.CountUnread(ctx, alice.ID); err != nil || n != 2 {
+		t.Errorf("CountUnread = %d, %v, want 2", n, err)
+	}
+
+	// フォローの取り消しで通知を削除する
+	follow, err := schema.FindFollow(ctx, db, bob.ID, alice.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := follow.Delete(ctx, db); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := types(), []string{"like:bob"}; !equal(got, want) {
+		t.Fatalf("after unfollow = %v, want %v", got, want)
+	}
+
+	// 受け取らない設定の種類は通知しない
+	if err := repo.SetOptOut(ctx, alice.ID, model.TypeFollow, true); err != nil {
+		t.Fatalf("SetOptOut: %v", err)
+	}
+	if err := repo.SetOptOut(ctx, alice.ID, model.TypeFollow, true); err != nil {
+		t.Fatalf("SetOptOut twice: %v", err)
+	}
+	insert(&schema.Follow{FollowerID: bob.ID, FollowingID: alice.ID})
+	if got, want := types(), []string{"like:bob"}; !equal(got, want) {
+		t.Fatalf("after opt-out = %v, want %v", got, want)
+	}
+	if optOuts, err := repo.ListOptOuts(ctx, alice.ID); err != nil || len(optOuts) != 1 || optOuts[0] != model.TypeFollow {
+		t.Errorf("ListOptOuts = %v, %v, want [follow]", optOuts, err)
+	}
+
+	if err := repo.MarkRead(ctx, alice.ID, 0); err != nil {
+		t.Fatalf("MarkRead: %v", err)
+	}
+	if n, err := repo.CountUnread(ctx, alice.ID); err != nil || n != 0 {
+		t.Errorf("CountUnread after MarkRead = %d, %v, want 0", n, err)
+	}
+}
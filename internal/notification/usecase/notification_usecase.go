@@ -0,0 +1,153 @@
+package usecase
+
+import (
+	"context"
+	"strconv"
+	"todoapp/internal/domain"
+	"todoapp/internal/infrastructure"
+	"todoapp/internal/notification/model"
+	"todoapp/internal/notification/repository"
+)
+
+const (
+	defaultListLimit = 20
+	maxListLimit     = 100
+)
+
+type NotificationUsecase interface {
+	// List は userID の通知をまとめて新しい順に返す。未読の数も一緒に返す
+	List(ctx context.Context, userID int, req model.ListRequest) (*model.Notifications, error)
+	// MarkRead は req.UpToID までの通知を既読にする
+	MarkRead(ctx context.Context, userID int, req model.MarkReadRequest) error
+	// GetPreferences はすべての種類について、通知を受け取るかどうかを返す
+	GetPreferences(ctx context.Context, userID int) (model.Preferences, error)
+	// UpdatePreferences は prefs に含まれる種類の設定だけを変更し、変更後の設定を返す。
+	// 設定は変更した後の通知から適用し、作成済みの通知は削除しない
+	UpdatePreferences(ctx context.Context, userID int, prefs model.Preferences) (model.Preferences, error)
+}
+
+type notificationUsecase struct {
+	repo      repository.NotificationRepository
+	txManager infrastructure.TxManager
+}
+
+func NewNotificationUsecase(repo repository.NotificationRepository, txManager infrastructure.TxManager) NotificationUsecase {
+	return &notificationUsecase{
+		repo:      repo,
+		txManager: txManager,
+	}
+}
+
+func (u *notificationUsecase) List(ctx context.Context, userID int, req model.ListRequest) (*model.Notifications, error) {
+	if req.Limit <= 0 {
+		req.Limit = defaultListLimit
+	}
+	if req.Limit > maxListLimit {
+		req.Limit = maxListLimit
+	}
+	beforeID := 0
+	if req.Cursor != "" {
+		id, err := strconv.Atoi(req.Cursor)
+		if err != nil || id <= 0 {
+			return nil, domain.Invalid("invalid cursor")
+		}
+		beforeID = id
+	}
+
+	// 1件多く読み、続きがあるかを判定する
+	notifications, err := u.repo.List(ctx, userID, beforeID, req.Limit+1)
+	if err != nil {
+		return nil, err
+	}
+	unread, err := u.repo.CountUnread(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &model.Notifications{Notifications: notifications, UnreadCount: unread}
+	if len(notifications) > req.Limit {
+		resp.Notifications = notifications[:req.Limit]
+		next := strconv.Itoa(resp.Notifications[req.Limit-1].ID)
+		resp.NextCursor = &next
+	}
+	for _, n := range resp.Notifications {
+		n.Message = message(n)
+	}
+	return resp, nil
+}
+
+func (u *notificationUsecase) MarkRead(ctx context.Context, userID int, req model.MarkReadRequest) error {
+	if req.UpToID < 0 {
+		return domain.Invalid("up_to_id must not be negative")
+	}
+	return u.repo.MarkRead(ctx, userID, req.UpToID)
+}
+
+func (u *notificationUsecase) GetPreferences(ctx context.Context, userID int) (model.Preferences, error) {
+	optOuts, err := u.repo.ListOptOuts(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	prefs := make(model.Preferences, len(model.Types))
+	for _, typ := range model.Types {
+		prefs[typ] = true
+	}
+	for _, typ := range optOuts {
+		prefs[typ] = false
+	}
+	return prefs, nil
+}
+
+func (u *notificationUsecase) UpdatePreferences(ctx context.Context, userID int, prefs model.Preferences) (model.Preferences, error) {
+	for typ := range prefs {
+		if !model.IsType(typ) {
+			return nil, domain.Invalid("unknown notification type: " + typ)
+		}
+	}
+
+	err := u.txManager.RunInTx(ctx, func(ctx context.Context) error {
+		for typ, enabled := range prefs {
+			if err := u.repo.SetOptOut(ctx, userID, typ, !enabled); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return u.GetPreferences(ctx, userID)
+}
+
+// message は通知の文面を返す。最後に操作したユーザーの表示名と、ほかのユーザーの人数で表す。
+// エクスポートの完了は本人の操作なので固定の文面にする
+func message(n *model.Notification) string {
+	if n.Type == model.TypeExportReady {
+		return "Your data export is ready"
+	}
+	if len(n.Actors) == 0 {
+		return ""
+	}
+	subject := n.Actors[0].DisplayName
+	if subject == "" {
+		subject = n.Actors[0].Username
+	}
+	switch others := n.ActorCount - 1; {
+	case others == 1:
+		subject += " and 1 other"
+	case others > 1:
+		subject += " and " + strconv.Itoa(others) + " others"
+	}
+
+	switch n.Type {
+	case model.TypeFollow:
+		return subject + " followed you"
+	case model.TypeLike:
+		return subject + " liked your tweet"
+	case model.TypeReply:
+		return subject + " replied to your tweet"
+	case model.TypeMention:
+		return subject + " mentioned you"
+	}
+	return ""
+}
@@ -0,0 +1,109 @@
+package router
+
+import (
+	"net/http"
+	"todoapp/internal/audit"
+	audithandler "todoapp/internal/audit/handler"
+	"todoapp/internal/blob"
+	dmhandler "todoapp/internal/dm/handler"
+	exporthandler "todoapp/internal/export/handler"
+	notificationhandler "todoapp/internal/notification/handler"
+	searchhandler "todoapp/internal/search/handler"
+	streamhandler "todoapp/internal/stream/handler"
+	tweethandler "todoapp/internal/tweet/handler"
+	"todoapp/internal/user/handler"
+
+	"github.com/labstack/echo/v4"
+)
+
+// Handlers はルーティングに登録するハンドラー
+type Handlers struct {
+	User         *handler.UserHandler
+	Audit        *audithandler.AuditHandler
+	Export       *exporthandler.ExportHandler
+	Tweet        *tweethandler.TweetHandler
+	Search       *searchhandler.SearchHandler
+	Notification *notificationhandler.NotificationHandler
+	DM           *dmhandler.DMHandler
+	Stream       *streamhandler.StreamHandler
+	// Blobs は署名付きURLのダウンロードを処理する(ローカルのストアの場合)。nil なら登録しない
+	Blobs http.Handler
+}
+
+// Register はエンドポイントを e に登録する。
+// ミドルウェアは呼び出し側(main やテスト)で設定する。
+// 監査ログにIPやリクエストIDを記録するには audit.Middleware も設定すること
+func Register(e *echo.Echo, h Handlers) {
+	// 認証不要のエンドポイント
+	e.POST("/register", h.User.Register)
+	e.POST("/login", h.User.Login)
+	e.POST("/reactivate", h.User.Reactivate)
+	// 署名付きURLなので認証ヘッダーなしでダウンロードできる
+	if h.Blobs != nil {
+		e.GET(blob.URLPrefix+"*", echo.WrapHandler(h.Blobs))
+	}
+
+	// リアルタイム配信。ブラウザーはヘッダーを設定できないので、クエリパラメーターのトークンも受け付ける
+	e.GET("/api/stream", h.Stream.Stream, streamhandler.TokenFromQuery, h.User.AuthMiddleware, audit.ActorMiddleware)
+
+	// 認証が必要なエンドポイント
+	api := e.Group("/api")
+	api.Use(h.User.AuthMiddleware, audit.ActorMiddleware)
+
+	// ユーザー関連
+	users := api.Group("/users")
+	users.GET("/:id", h.User.GetProfile)
+	users.POST("/:id/follow", h.User.Follow)
+	users.DELETE("/:id/follow", h.User.Unfollow)
+	users.POST("/:id/block", h.User.Block)
+	users.DELETE("/:id/block", h.User.Unblock)
+	users.POST("/:id/mute", h.User.Mute)
+	users.DELETE("/:id/mute", h.User.Unmute)
+	users.PUT("/me", h.User.UpdateProfile)
+	users.DELETE("/me", h.User.Deactivate)
+	users.GET("/me/blocks", h.User.ListBlocks)
+	users.GET("/me/mutes", h.User.ListMutes)
+	users.POST("/me/export", h.Export.Request)
+	users.GET("/me/exports/:id", h.Export.Get)
+
+	// ツイート関連
+	tweets := api.Group("/tweets")
+	tweets.POST("", h.Tweet.Create)
+	tweets.GET("/timeline", h.Tweet.GetTimeline)
+	tweets.GET("/:id", h.Tweet.Get)
+	tweets.DELETE("/:id", h.Tweet.Delete)
+	tweets.POST("/:id/replies", h.Tweet.Reply)
+	tweets.GET("/:id/thread", h.Tweet.GetThread)
+	tweets.POST("/:id/retweet", h.Tweet.Retweet)
+	tweets.DELETE("/:id/retweet", h.Tweet.Unretweet)
+	tweets.POST("/:id/like", h.Tweet.Like)
+	tweets.DELETE("/:id/like", h.Tweet.Unlike)
+
+	// ハッシュタグ
+	api.GET("/hashtags/:tag/tweets", h.Tweet.ListByHashtag)
+
+	// 検索
+	api.GET("/search", h.Search.Search)
+
+	// 通知
+	notifications := api.Group("/notifications")
+	notifications.GET("", h.Notification.List)
+	notifications.POST("/read", h.Notification.MarkRead)
+	notifications.GET("/preferences", h.Notification.GetPreferences)
+	notifications.PUT("/preferences", h.Notification.UpdatePreferences)
+
+	// ダイレクトメッセージ
+	dm := api.Group("/dm")
+	dm.GET("/conversations", h.DM.ListConversations)
+	dm.POST("/conversations", h.DM.CreateConversation)
+	dm.GET("/conversations/:id", h.DM.GetConversation)
+	dm.GET("/conversations/:id/messages", h.DM.ListMessages)
+	dm.POST("/conversations/:id/messages", h.DM.SendMessage)
+	dm.POST("/conversations/:id/read", h.DM.MarkRead)
+	dm.GET("/settings", h.DM.GetSettings)
+	dm.PUT("/settings", h.DM.UpdateSettings)
+
+	// 管理者用
+	admin := api.Group("/admin", h.Audit.AdminOnly)
+	admin.GET("/audit-events", h.Audit.List)
+}
@@ -0,0 +1,1384 @@
+// Code generated by SQLBoiler 4.18.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package schema
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// Block is an object representing the database table.
+type Block struct {
+	BlockerID int       `boil:"blocker_id" json:"blocker_id" toml:"blocker_id" yaml:"blocker_id"`
+	BlockedID int       `boil:"blocked_id" json:"blocked_id" toml:"blocked_id" yaml:"blocked_id"`
+	CreatedAt null.Time `boil:"created_at" json:"created_at,omitempty" toml:"created_at" yaml:"created_at,omitempty"`
+
+	R *blockR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L blockL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var BlockColumns = struct {
+	BlockerID string
+	BlockedID string
+	CreatedAt string
+}{
+	BlockerID: "blocker_id",
+	BlockedID: "blocked_id",
+	CreatedAt: "created_at",
+}
+
+var BlockTableColumns = struct {
+	BlockerID string
+	BlockedID string
+	CreatedAt string
+}{
+	BlockerID: "blocks.blocker_id",
+	BlockedID: "blocks.blocked_id",
+	CreatedAt: "blocks.created_at",
+}
+
+// Generated where
+
+type whereHelperint struct{ field string }
+
+func (w whereHelperint) EQ(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperint) NEQ(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperint) LT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperint) LTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperint) GT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperint) GTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+func (w whereHelperint) IN(slice []int) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperint) NIN(slice []int) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+var BlockWhere = struct {
+	BlockerID whereHelperint
+	BlockedID whereHelperint
+	CreatedAt whereHelpernull_Time
+}{
+	BlockerID: whereHelperint{field: "`blocks`.`blocker_id`"},
+	BlockedID: whereHelperint{field: "`blocks`.`blocked_id`"},
+	CreatedAt: whereHelpernull_Time{field: "`blocks`.`created_at`"},
+}
+
+// BlockRels is where relationship names are stored.
+var BlockRels = struct {
+	Blocker string
+	Blocked string
+}{
+	Blocker: "Blocker",
+	Blocked: "Blocked",
+}
+
+// blockR is where relationships are stored.
+type blockR struct {
+	Blocker *User `boil:"Blocker" json:"Blocker" toml:"Blocker" yaml:"Blocker"`
+	Blocked *User `boil:"Blocked" json:"Blocked" toml:"Blocked" yaml:"Blocked"`
+}
+
+// NewStruct creates a new relationship struct
+func (*blockR) NewStruct() *blockR {
+	return &blockR{}
+}
+
+func (r *blockR) GetBlocker() *User {
+	if r == nil {
+		return nil
+	}
+	return r.Blocker
+}
+
+func (r *blockR) GetBlocked() *User {
+	if r == nil {
+		return nil
+	}
+	return r.Blocked
+}
+
+// blockL is where Load methods for each relationship are stored.
+type blockL struct{}
+
+var (
+	blockAllColumns            = []string{"blocker_id", "blocked_id", "created_at"}
+	blockColumnsWithoutDefault = []string{"blocker_id", "blocked_id"}
+	blockColumnsWithDefault    = []string{"created_at"}
+	blockPrimaryKeyColumns     = []string{"blocker_id", "blocked_id"}
+	blockGeneratedColumns      = []string{}
+)
+
+type (
+	// BlockSlice is an alias for a slice of pointers to Block.
+	// This should almost always be used instead of []Block.
+	BlockSlice []*Block
+	// BlockHook is the signature for custom Block hook methods
+	BlockHook func(context.Context, boil.ContextExecutor, *Block) error
+
+	blockQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	blockType                 = reflect.TypeOf(&Block{})
+	blockMapping              = queries.MakeStructMapping(blockType)
+	blockPrimaryKeyMapping, _ = queries.BindMapping(blockType, blockMapping, blockPrimaryKeyColumns)
+	blockInsertCacheMut       sync.RWMutex
+	blockInsertCache          = make(map[string]insertCache)
+	blockUpdateCacheMut       sync.RWMutex
+	blockUpdateCache          = make(map[string]updateCache)
+	blockUpsertCacheMut       sync.RWMutex
+	blockUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var blockAfterSelectMu sync.Mutex
+var blockAfterSelectHooks []BlockHook
+
+var blockBeforeInsertMu sync.Mutex
+var blockBeforeInsertHooks []BlockHook
+var blockAfterInsertMu sync.Mutex
+var blockAfterInsertHooks []BlockHook
+
+var blockBeforeUpdateMu sync.Mutex
+var blockBeforeUpdateHooks []BlockHook
+var blockAfterUpdateMu sync.Mutex
+var blockAfterUpdateHooks []BlockHook
+
+var blockBeforeDeleteMu sync.Mutex
+var blockBeforeDeleteHooks []BlockHook
+var blockAfterDeleteMu sync.Mutex
+var blockAfterDeleteHooks []BlockHook
+
+var blockBeforeUpsertMu sync.Mutex
+var blockBeforeUpsertHooks []BlockHook
+var blockAfterUpsertMu sync.Mutex
+var blockAfterUpsertHooks []BlockHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *Block) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range blockAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *Block) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range blockBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *Block) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range blockAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *Block) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range blockBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *Block) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range blockAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *Block) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range blockBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *Block) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range blockAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *Block) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range blockBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *Block) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range blockAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddBlockHook registers your hook function for all future operations.
+func AddBlockHook(hookPoint boil.HookPoint, blockHook BlockHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		blockAfterSelectMu.Lock()
+		blockAfterSelectHooks = append(blockAfterSelectHooks, blockHook)
+		blockAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		blockBeforeInsertMu.Lock()
+		blockBeforeInsertHooks = append(blockBeforeInsertHooks, blockHook)
+		blockBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		blockAfterInsertMu.Lock()
+		blockAfterInsertHooks = append(blockAfterInsertHooks, blockHook)
+		blockAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		blockBeforeUpdateMu.Lock()
+		blockBeforeUpdateHooks = append(blockBeforeUpdateHooks, blockHook)
+		blockBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		blockAfterUpdateMu.Lock()
+		blockAfterUpdateHooks = append(blockAfterUpdateHooks, blockHook)
+		blockAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		blockBeforeDeleteMu.Lock()
+		blockBeforeDeleteHooks = append(blockBeforeDeleteHooks, blockHook)
+		blockBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		blockAfterDeleteMu.Lock()
+		blockAfterDeleteHooks = append(blockAfterDeleteHooks, blockHook)
+		blockAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		blockBeforeUpsertMu.Lock()
+		blockBeforeUpsertHooks = append(blockBeforeUpsertHooks, blockHook)
+		blockBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		blockAfterUpsertMu.Lock()
+		blockAfterUpsertHooks = append(blockAfterUpsertHooks, blockHook)
+		blockAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single block record from the query.
+func (q blockQuery) One(ctx context.Context, exec boil.ContextExecutor) (*Block, error) {
+	o := &Block{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "schema: failed to execute a one query for blocks")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all Block records from the query.
+func (q blockQuery) All(ctx context.Context, exec boil.ContextExecutor) (BlockSlice, error) {
+	var o []*Block
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "schema: failed to assign all query results to Block slice")
+	}
+
+	if len(blockAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all Block records in the query.
+func (q blockQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: failed to count blocks rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q blockQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "schema: failed to check if blocks exists")
+	}
+
+	return count > 0, nil
+}
+
+// Blocker pointed to by the foreign key.
+func (o *Block) Blocker(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`id` = ?", o.BlockerID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// Blocked pointed to by the foreign key.
+func (o *Block) Blocked(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`id` = ?", o.BlockedID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadBlocker allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (blockL) LoadBlocker(ctx context.Context, e boil.ContextExecutor, singular bool, maybeBlock interface{}, mods queries.Applicator) error {
+	var slice []*Block
+	var object *Block
+
+	if singular {
+		var ok bool
+		object, ok = maybeBlock.(*Block)
+		if !ok {
+			object = new(Block)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeBlock)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeBlock))
+			}
+		}
+	} else {
+		s, ok := maybeBlock.(*[]*Block)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeBlock)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeBlock))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &blockR{}
+		}
+		args[object.BlockerID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &blockR{}
+			}
+
+			args[obj.BlockerID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`users.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Blocker = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.BlockerBlocks = append(foreign.R.BlockerBlocks, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.BlockerID == foreign.ID {
+				local.R.Blocker = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.BlockerBlocks = append(foreign.R.BlockerBlocks, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadBlocked allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (blockL) LoadBlocked(ctx context.Context, e boil.ContextExecutor, singular bool, maybeBlock interface{}, mods queries.Applicator) error {
+	var slice []*Block
+	var object *Block
+
+	if singular {
+		var ok bool
+		object, ok = maybeBlock.(*Block)
+		if !ok {
+			object = new(Block)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeBlock)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeBlock))
+			}
+		}
+	} else {
+		s, ok := maybeBlock.(*[]*Block)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeBlock)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeBlock))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &blockR{}
+		}
+		args[object.BlockedID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &blockR{}
+			}
+
+			args[obj.BlockedID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`users.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Blocked = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.BlockedBlocks = append(foreign.R.BlockedBlocks, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.BlockedID == foreign.ID {
+				local.R.Blocked = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.BlockedBlocks = append(foreign.R.BlockedBlocks, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetBlocker of the block to the related item.
+// Sets o.R.Blocker to related.
+// Adds o to related.R.BlockerBlocks.
+func (o *Block) SetBlocker(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `blocks` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"blocker_id"}),
+		strmangle.WhereClause("`", "`", 0, blockPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.BlockerID, o.BlockedID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.BlockerID = related.ID
+	if o.R == nil {
+		o.R = &blockR{
+			Blocker: related,
+		}
+	} else {
+		o.R.Blocker = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			BlockerBlocks: BlockSlice{o},
+		}
+	} else {
+		related.R.BlockerBlocks = append(related.R.BlockerBlocks, o)
+	}
+
+	return nil
+}
+
+// SetBlocked of the block to the related item.
+// Sets o.R.Blocked to related.
+// Adds o to related.R.BlockedBlocks.
+func (o *Block) SetBlocked(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `blocks` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"blocked_id"}),
+		strmangle.WhereClause("`", "`", 0, blockPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.BlockerID, o.BlockedID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.BlockedID = related.ID
+	if o.R == nil {
+		o.R = &blockR{
+			Blocked: related,
+		}
+	} else {
+		o.R.Blocked = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			BlockedBlocks: BlockSlice{o},
+		}
+	} else {
+		related.R.BlockedBlocks = append(related.R.BlockedBlocks, o)
+	}
+
+	return nil
+}
+
+// Blocks retrieves all the records using an executor.
+func Blocks(mods ...qm.QueryMod) blockQuery {
+	mods = append(mods, qm.From("`blocks`"))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"`blocks`.*"})
+	}
+
+	return blockQuery{q}
+}
+
+// FindBlock retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindBlock(ctx context.Context, exec boil.ContextExecutor, blockerID int, blockedID int, selectCols ...string) (*Block, error) {
+	blockObj := &Block{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `blocks` where `blocker_id`=? AND `blocked_id`=?", sel,
+	)
+
+	q := queries.Raw(query, blockerID, blockedID)
+
+	err := q.Bind(ctx, exec, blockObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "schema: unable to select from blocks")
+	}
+
+	if err = blockObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return blockObj, err
+	}
+
+	return blockObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *Block) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("schema: no blocks provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(blockColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	blockInsertCacheMut.RLock()
+	cache, cached := blockInsertCache[key]
+	blockInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			blockAllColumns,
+			blockColumnsWithDefault,
+			blockColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(blockType, blockMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(blockType, blockMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `blocks` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `blocks` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `blocks` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, blockPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "schema: unable to insert into blocks")
+	}
+
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.BlockerID,
+		o.BlockedID,
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, identifierCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "schema: unable to populate default values for blocks")
+	}
+
+CacheNoHooks:
+	if !cached {
+		blockInsertCacheMut.Lock()
+		blockInsertCache[key] = cache
+		blockInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the Block.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *Block) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	blockUpdateCacheMut.RLock()
+	cache, cached := blockUpdateCache[key]
+	blockUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			blockAllColumns,
+			blockPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("schema: unable to update blocks, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `blocks` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, blockPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(blockType, blockMapping, append(wl, blockPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to update blocks row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: failed to get rows affected by update for blocks")
+	}
+
+	if !cached {
+		blockUpdateCacheMut.Lock()
+		blockUpdateCache[key] = cache
+		blockUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q blockQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to update all for blocks")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to retrieve rows affected for blocks")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o BlockSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("schema: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), blockPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `blocks` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, blockPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to update all in block slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to retrieve rows affected all in update all block")
+	}
+	return rowsAff, nil
+}
+
+var mySQLBlockUniqueColumns = []string{}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *Block) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("schema: no blocks provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(blockColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLBlockUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	blockUpsertCacheMut.RLock()
+	cache, cached := blockUpsertCache[key]
+	blockUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			blockAllColumns,
+			blockColumnsWithDefault,
+			blockColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			blockAllColumns,
+			blockPrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("schema: unable to upsert blocks, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(blockAllColumns, strmangle.SetIntersect(insert, update))
+
+		cache.query = buildUpsertQueryMySQL(dialect, "`blocks`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `blocks` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(blockType, blockMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(blockType, blockMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "schema: unable to upsert for blocks")
+	}
+
+	var uniqueMap []uint64
+	var nzUniqueCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(blockType, blockMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "schema: unable to retrieve unique values for blocks")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, nzUniqueCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "schema: unable to populate default values for blocks")
+	}
+
+CacheNoHooks:
+	if !cached {
+		blockUpsertCacheMut.Lock()
+		blockUpsertCache[key] = cache
+		blockUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single Block record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *Block) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("schema: no Block provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), blockPrimaryKeyMapping)
+	sql := "DELETE FROM `blocks` WHERE `blocker_id`=? AND `blocked_id`=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to delete from blocks")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: failed to get rows affected by delete for blocks")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q blockQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("schema: no blockQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to delete all from blocks")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: failed to get rows affected by deleteall for blocks")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o BlockSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(blockBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), blockPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `blocks` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, blockPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to delete all from block slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: failed to get rows affected by deleteall for blocks")
+	}
+
+	if len(blockAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *Block) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindBlock(ctx, exec, o.BlockerID, o.BlockedID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *BlockSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := BlockSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), blockPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `blocks`.* FROM `blocks` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, blockPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "schema: unable to reload all in BlockSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// BlockExists checks if the Block row exists.
+func BlockExists(ctx context.Context, exec boil.ContextExecutor, blockerID int, blockedID int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `blocks` where `blocker_id`=? AND `blocked_id`=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, blockerID, blockedID)
+	}
+	row := exec.QueryRowContext(ctx, sql, blockerID, blockedID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "schema: unable to check if blocks exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the Block row exists.
+func (o *Block) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return BlockExists(ctx, exec, o.BlockerID, o.BlockedID)
+}
@@ -4,13 +4,41 @@
 package schema
 
 var TableNames = struct {
-	Follows string
-	Likes   string
-	Tweets  string
-	Users   string
+	AuditEvents         string
+	Blocks              string
+	DataExports         string
+	DMConversations     string
+	DMMessages          string
+	DMOptIns            string
+	DMParticipants      string
+	Follows             string
+	Likes               string
+	Mutes               string
+	NotificationOptOuts string
+	Notifications       string
+	Retweets            string
+	TweetHashtags       string
+	TweetMentions       string
+	TweetUrls           string
+	Tweets              string
+	Users               string
 }{
-	Follows: "follows",
-	Likes:   "likes",
-	Tweets:  "tweets",
-	Users:   "users",
+	AuditEvents:         "audit_events",
+	Blocks:              "blocks",
+	DataExports:         "data_exports",
+	DMConversations:     "dm_conversations",
+	DMMessages:          "dm_messages",
+	DMOptIns:            "dm_opt_ins",
+	DMParticipants:      "dm_participants",
+	Follows:             "follows",
+	Likes:               "likes",
+	Mutes:               "mutes",
+	NotificationOptOuts: "notification_opt_outs",
+	Notifications:       "notifications",
+	Retweets:            "retweets",
+	TweetHashtags:       "tweet_hashtags",
+	TweetMentions:       "tweet_mentions",
+	TweetUrls:           "tweet_urls",
+	Tweets:              "tweets",
+	Users:               "users",
 }
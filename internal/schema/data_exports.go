@@ -0,0 +1,1237 @@
+// Code generated by SQLBoiler 4.18.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package schema
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// DataExport is an object representing the database table.
+type DataExport struct {
+	ID           int         `boil:"id" json:"id" toml:"id" yaml:"id"`
+	UserID       int         `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	Status       string      `boil:"status" json:"status" toml:"status" yaml:"status"`
+	BlobKey      null.String `boil:"blob_key" json:"blob_key,omitempty" toml:"blob_key" yaml:"blob_key,omitempty"`
+	ErrorMessage null.String `boil:"error_message" json:"error_message,omitempty" toml:"error_message" yaml:"error_message,omitempty"`
+	CreatedAt    null.Time   `boil:"created_at" json:"created_at,omitempty" toml:"created_at" yaml:"created_at,omitempty"`
+	StartedAt    null.Time   `boil:"started_at" json:"started_at,omitempty" toml:"started_at" yaml:"started_at,omitempty"`
+	CompletedAt  null.Time   `boil:"completed_at" json:"completed_at,omitempty" toml:"completed_at" yaml:"completed_at,omitempty"`
+	ExpiresAt    null.Time   `boil:"expires_at" json:"expires_at,omitempty" toml:"expires_at" yaml:"expires_at,omitempty"`
+
+	R *dataExportR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L dataExportL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var DataExportColumns = struct {
+	ID           string
+	UserID       string
+	Status       string
+	BlobKey      string
+	ErrorMessage string
+	CreatedAt    string
+	StartedAt    string
+	CompletedAt  string
+	ExpiresAt    string
+}{
+	ID:           "id",
+	UserID:       "user_id",
+	Status:       "status",
+	BlobKey:      "blob_key",
+	ErrorMessage: "error_message",
+	CreatedAt:    "created_at",
+	StartedAt:    "started_at",
+	CompletedAt:  "completed_at",
+	ExpiresAt:    "expires_at",
+}
+
+var DataExportTableColumns = struct {
+	ID           string
+	UserID       string
+	Status       string
+	BlobKey      string
+	ErrorMessage string
+	CreatedAt    string
+	StartedAt    string
+	CompletedAt  string
+	ExpiresAt    string
+}{
+	ID:           "data_exports.id",
+	UserID:       "data_exports.user_id",
+	Status:       "data_exports.status",
+	BlobKey:      "data_exports.blob_key",
+	ErrorMessage: "data_exports.error_message",
+	CreatedAt:    "data_exports.created_at",
+	StartedAt:    "data_exports.started_at",
+	CompletedAt:  "data_exports.completed_at",
+	ExpiresAt:    "data_exports.expires_at",
+}
+
+// Generated where
+
+var DataExportWhere = struct {
+	ID           whereHelperint
+	UserID       whereHelperint
+	Status       whereHelperstring
+	BlobKey      whereHelpernull_String
+	ErrorMessage whereHelpernull_String
+	CreatedAt    whereHelpernull_Time
+	StartedAt    whereHelpernull_Time
+	CompletedAt  whereHelpernull_Time
+	ExpiresAt    whereHelpernull_Time
+}{
+	ID:           whereHelperint{field: "`data_exports`.`id`"},
+	UserID:       whereHelperint{field: "`data_exports`.`user_id`"},
+	Status:       whereHelperstring{field: "`data_exports`.`status`"},
+	BlobKey:      whereHelpernull_String{field: "`data_exports`.`blob_key`"},
+	ErrorMessage: whereHelpernull_String{field: "`data_exports`.`error_message`"},
+	CreatedAt:    whereHelpernull_Time{field: "`data_exports`.`created_at`"},
+	StartedAt:    whereHelpernull_Time{field: "`data_exports`.`started_at`"},
+	CompletedAt:  whereHelpernull_Time{field: "`data_exports`.`completed_at`"},
+	ExpiresAt:    whereHelpernull_Time{field: "`data_exports`.`expires_at`"},
+}
+
+// DataExportRels is where relationship names are stored.
+var DataExportRels = struct {
+	User string
+}{
+	User: "User",
+}
+
+// dataExportR is where relationships are stored.
+type dataExportR struct {
+	User *User `boil:"User" json:"User" toml:"User" yaml:"User"`
+}
+
+// NewStruct creates a new relationship struct
+func (*dataExportR) NewStruct() *dataExportR {
+	return &dataExportR{}
+}
+
+func (r *dataExportR) GetUser() *User {
+	if r == nil {
+		return nil
+	}
+	return r.User
+}
+
+// dataExportL is where Load methods for each relationship are stored.
+type dataExportL struct{}
+
+var (
+	dataExportAllColumns            = []string{"id", "user_id", "status", "blob_key", "error_message", "created_at", "started_at", "completed_at", "expires_at"}
+	dataExportColumnsWithoutDefault = []string{"user_id", "blob_key", "error_message", "started_at", "completed_at", "expires_at"}
+	dataExportColumnsWithDefault    = []string{"id", "status", "created_at"}
+	dataExportPrimaryKeyColumns     = []string{"id"}
+	dataExportGeneratedColumns      = []string{}
+)
+
+type (
+	// DataExportSlice is an alias for a slice of pointers to DataExport.
+	// This should almost always be used instead of []DataExport.
+	DataExportSlice []*DataExport
+	// DataExportHook is the signature for custom DataExport hook methods
+	DataExportHook func(context.Context, boil.ContextExecutor, *DataExport) error
+
+	dataExportQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	dataExportType                 = reflect.TypeOf(&DataExport{})
+	dataExportMapping              = queries.MakeStructMapping(dataExportType)
+	dataExportPrimaryKeyMapping, _ = queries.BindMapping(dataExportType, dataExportMapping, dataExportPrimaryKeyColumns)
+	dataExportInsertCacheMut       sync.RWMutex
+	dataExportInsertCache          = make(map[string]insertCache)
+	dataExportUpdateCacheMut       sync.RWMutex
+	dataExportUpdateCache          = make(map[string]updateCache)
+	dataExportUpsertCacheMut       sync.RWMutex
+	dataExportUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var dataExportAfterSelectMu sync.Mutex
+var dataExportAfterSelectHooks []DataExportHook
+
+var dataExportBeforeInsertMu sync.Mutex
+var dataExportBeforeInsertHooks []DataExportHook
+var dataExportAfterInsertMu sync.Mutex
+var dataExportAfterInsertHooks []DataExportHook
+
+var dataExportBeforeUpdateMu sync.Mutex
+var dataExportBeforeUpdateHooks []DataExportHook
+var dataExportAfterUpdateMu sync.Mutex
+var dataExportAfterUpdateHooks []DataExportHook
+
+var dataExportBeforeDeleteMu sync.Mutex
+var dataExportBeforeDeleteHooks []DataExportHook
+var dataExportAfterDeleteMu sync.Mutex
+var dataExportAfterDeleteHooks []DataExportHook
+
+var dataExportBeforeUpsertMu sync.Mutex
+var dataExportBeforeUpsertHooks []DataExportHook
+var dataExportAfterUpsertMu sync.Mutex
+var dataExportAfterUpsertHooks []DataExportHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *DataExport) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dataExportAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *DataExport) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dataExportBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *DataExport) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dataExportAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *DataExport) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dataExportBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *DataExport) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dataExportAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *DataExport) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dataExportBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *DataExport) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dataExportAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *DataExport) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dataExportBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *DataExport) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dataExportAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddDataExportHook registers your hook function for all future operations.
+func AddDataExportHook(hookPoint boil.HookPoint, dataExportHook DataExportHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		dataExportAfterSelectMu.Lock()
+		dataExportAfterSelectHooks = append(dataExportAfterSelectHooks, dataExportHook)
+		dataExportAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		dataExportBeforeInsertMu.Lock()
+		dataExportBeforeInsertHooks = append(dataExportBeforeInsertHooks, dataExportHook)
+		dataExportBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		dataExportAfterInsertMu.Lock()
+		dataExportAfterInsertHooks = append(dataExportAfterInsertHooks, dataExportHook)
+		dataExportAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		dataExportBeforeUpdateMu.Lock()
+		dataExportBeforeUpdateHooks = append(dataExportBeforeUpdateHooks, dataExportHook)
+		dataExportBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		dataExportAfterUpdateMu.Lock()
+		dataExportAfterUpdateHooks = append(dataExportAfterUpdateHooks, dataExportHook)
+		dataExportAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		dataExportBeforeDeleteMu.Lock()
+		dataExportBeforeDeleteHooks = append(dataExportBeforeDeleteHooks, dataExportHook)
+		dataExportBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		dataExportAfterDeleteMu.Lock()
+		dataExportAfterDeleteHooks = append(dataExportAfterDeleteHooks, dataExportHook)
+		dataExportAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		dataExportBeforeUpsertMu.Lock()
+		dataExportBeforeUpsertHooks = append(dataExportBeforeUpsertHooks, dataExportHook)
+		dataExportBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		dataExportAfterUpsertMu.Lock()
+		dataExportAfterUpsertHooks = append(dataExportAfterUpsertHooks, dataExportHook)
+		dataExportAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single dataExport record from the query.
+func (q dataExportQuery) One(ctx context.Context, exec boil.ContextExecutor) (*DataExport, error) {
+	o := &DataExport{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "schema: failed to execute a one query for data_exports")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all DataExport records from the query.
+func (q dataExportQuery) All(ctx context.Context, exec boil.ContextExecutor) (DataExportSlice, error) {
+	var o []*DataExport
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "schema: failed to assign all query results to DataExport slice")
+	}
+
+	if len(dataExportAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all DataExport records in the query.
+func (q dataExportQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: failed to count data_exports rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q dataExportQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "schema: failed to check if data_exports exists")
+	}
+
+	return count > 0, nil
+}
+
+// User pointed to by the foreign key.
+func (o *DataExport) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`id` = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (dataExportL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeDataExport interface{}, mods queries.Applicator) error {
+	var slice []*DataExport
+	var object *DataExport
+
+	if singular {
+		var ok bool
+		object, ok = maybeDataExport.(*DataExport)
+		if !ok {
+			object = new(DataExport)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeDataExport)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeDataExport))
+			}
+		}
+	} else {
+		s, ok := maybeDataExport.(*[]*DataExport)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeDataExport)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeDataExport))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &dataExportR{}
+		}
+		args[object.UserID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &dataExportR{}
+			}
+
+			args[obj.UserID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`users.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.DataExports = append(foreign.R.DataExports, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.DataExports = append(foreign.R.DataExports, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetUser of the dataExport to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.DataExports.
+func (o *DataExport) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `data_exports` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"user_id"}),
+		strmangle.WhereClause("`", "`", 0, dataExportPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &dataExportR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			DataExports: DataExportSlice{o},
+		}
+	} else {
+		related.R.DataExports = append(related.R.DataExports, o)
+	}
+
+	return nil
+}
+
+// DataExports retrieves all the records using an executor.
+func DataExports(mods ...qm.QueryMod) dataExportQuery {
+	mods = append(mods, qm.From("`data_exports`"))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"`data_exports`.*"})
+	}
+
+	return dataExportQuery{q}
+}
+
+// FindDataExport retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindDataExport(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*DataExport, error) {
+	dataExportObj := &DataExport{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `data_exports` where `id`=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, dataExportObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "schema: unable to select from data_exports")
+	}
+
+	if err = dataExportObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return dataExportObj, err
+	}
+
+	return dataExportObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *DataExport) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("schema: no data_exports provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(dataExportColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	dataExportInsertCacheMut.RLock()
+	cache, cached := dataExportInsertCache[key]
+	dataExportInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			dataExportAllColumns,
+			dataExportColumnsWithDefault,
+			dataExportColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(dataExportType, dataExportMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(dataExportType, dataExportMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `data_exports` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `data_exports` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `data_exports` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, dataExportPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "schema: unable to insert into data_exports")
+	}
+
+	var lastID int64
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = int(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == dataExportMapping["id"] {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.ID,
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, identifierCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "schema: unable to populate default values for data_exports")
+	}
+
+CacheNoHooks:
+	if !cached {
+		dataExportInsertCacheMut.Lock()
+		dataExportInsertCache[key] = cache
+		dataExportInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the DataExport.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *DataExport) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	dataExportUpdateCacheMut.RLock()
+	cache, cached := dataExportUpdateCache[key]
+	dataExportUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			dataExportAllColumns,
+			dataExportPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("schema: unable to update data_exports, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `data_exports` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, dataExportPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(dataExportType, dataExportMapping, append(wl, dataExportPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to update data_exports row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: failed to get rows affected by update for data_exports")
+	}
+
+	if !cached {
+		dataExportUpdateCacheMut.Lock()
+		dataExportUpdateCache[key] = cache
+		dataExportUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q dataExportQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to update all for data_exports")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to retrieve rows affected for data_exports")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o DataExportSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("schema: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), dataExportPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `data_exports` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, dataExportPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to update all in dataExport slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to retrieve rows affected all in update all dataExport")
+	}
+	return rowsAff, nil
+}
+
+var mySQLDataExportUniqueColumns = []string{
+	"id",
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *DataExport) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("schema: no data_exports provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(dataExportColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLDataExportUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	dataExportUpsertCacheMut.RLock()
+	cache, cached := dataExportUpsertCache[key]
+	dataExportUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			dataExportAllColumns,
+			dataExportColumnsWithDefault,
+			dataExportColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			dataExportAllColumns,
+			dataExportPrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("schema: unable to upsert data_exports, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(dataExportAllColumns, strmangle.SetIntersect(insert, update))
+
+		cache.query = buildUpsertQueryMySQL(dialect, "`data_exports`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `data_exports` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(dataExportType, dataExportMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(dataExportType, dataExportMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "schema: unable to upsert for data_exports")
+	}
+
+	var lastID int64
+	var uniqueMap []uint64
+	var nzUniqueCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = int(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == dataExportMapping["id"] {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(dataExportType, dataExportMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "schema: unable to retrieve unique values for data_exports")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, nzUniqueCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "schema: unable to populate default values for data_exports")
+	}
+
+CacheNoHooks:
+	if !cached {
+		dataExportUpsertCacheMut.Lock()
+		dataExportUpsertCache[key] = cache
+		dataExportUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single DataExport record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *DataExport) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("schema: no DataExport provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), dataExportPrimaryKeyMapping)
+	sql := "DELETE FROM `data_exports` WHERE `id`=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to delete from data_exports")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: failed to get rows affected by delete for data_exports")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q dataExportQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("schema: no dataExportQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to delete all from data_exports")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: failed to get rows affected by deleteall for data_exports")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o DataExportSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(dataExportBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), dataExportPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `data_exports` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, dataExportPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to delete all from dataExport slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: failed to get rows affected by deleteall for data_exports")
+	}
+
+	if len(dataExportAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *DataExport) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindDataExport(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *DataExportSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := DataExportSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), dataExportPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `data_exports`.* FROM `data_exports` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, dataExportPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "schema: unable to reload all in DataExportSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// DataExportExists checks if the DataExport row exists.
+func DataExportExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `data_exports` where `id`=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "schema: unable to check if data_exports exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the DataExport row exists.
+func (o *DataExport) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return DataExportExists(ctx, exec, o.ID)
+}
@@ -0,0 +1,1394 @@
+// Code generated by SQLBoiler 4.18.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package schema
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// DMConversation is an object representing the database table.
+type DMConversation struct {
+	ID            int         `boil:"id" json:"id" toml:"id" yaml:"id"`
+	DirectKey     null.String `boil:"direct_key" json:"direct_key,omitempty" toml:"direct_key" yaml:"direct_key,omitempty"`
+	LastMessageID null.Int    `boil:"last_message_id" json:"last_message_id,omitempty" toml:"last_message_id" yaml:"last_message_id,omitempty"`
+	CreatedAt     null.Time   `boil:"created_at" json:"created_at,omitempty" toml:"created_at" yaml:"created_at,omitempty"`
+
+	R *dmConversationR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L dmConversationL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var DMConversationColumns = struct {
+	ID            string
+	DirectKey     string
+	LastMessageID string
+	CreatedAt     string
+}{
+	ID:            "id",
+	DirectKey:     "direct_key",
+	LastMessageID: "last_message_id",
+	CreatedAt:     "created_at",
+}
+
+var DMConversationTableColumns = struct {
+	ID            string
+	DirectKey     string
+	LastMessageID string
+	CreatedAt     string
+}{
+	ID:            "dm_conversations.id",
+	DirectKey:     "dm_conversations.direct_key",
+	LastMessageID: "dm_conversations.last_message_id",
+	CreatedAt:     "dm_conversations.created_at",
+}
+
+// Generated where
+
+var DMConversationWhere = struct {
+	ID            whereHelperint
+	DirectKey     whereHelpernull_String
+	LastMessageID whereHelpernull_Int
+	CreatedAt     whereHelpernull_Time
+}{
+	ID:            whereHelperint{field: "`dm_conversations`.`id`"},
+	DirectKey:     whereHelpernull_String{field: "`dm_conversations`.`direct_key`"},
+	LastMessageID: whereHelpernull_Int{field: "`dm_conversations`.`last_message_id`"},
+	CreatedAt:     whereHelpernull_Time{field: "`dm_conversations`.`created_at`"},
+}
+
+// DMConversationRels is where relationship names are stored.
+var DMConversationRels = struct {
+	ConversationDMMessages     string
+	ConversationDMParticipants string
+}{
+	ConversationDMMessages:     "ConversationDMMessages",
+	ConversationDMParticipants: "ConversationDMParticipants",
+}
+
+// dmConversationR is where relationships are stored.
+type dmConversationR struct {
+	ConversationDMMessages     DMMessageSlice     `boil:"ConversationDMMessages" json:"ConversationDMMessages" toml:"ConversationDMMessages" yaml:"ConversationDMMessages"`
+	ConversationDMParticipants DMParticipantSlice `boil:"ConversationDMParticipants" json:"ConversationDMParticipants" toml:"ConversationDMParticipants" yaml:"ConversationDMParticipants"`
+}
+
+// NewStruct creates a new relationship struct
+func (*dmConversationR) NewStruct() *dmConversationR {
+	return &dmConversationR{}
+}
+
+func (r *dmConversationR) GetConversationDMMessages() DMMessageSlice {
+	if r == nil {
+		return nil
+	}
+	return r.ConversationDMMessages
+}
+
+func (r *dmConversationR) GetConversationDMParticipants() DMParticipantSlice {
+	if r == nil {
+		return nil
+	}
+	return r.ConversationDMParticipants
+}
+
+// dmConversationL is where Load methods for each relationship are stored.
+type dmConversationL struct{}
+
+var (
+	dmConversationAllColumns            = []string{"id", "direct_key", "last_message_id", "created_at"}
+	dmConversationColumnsWithoutDefault = []string{"direct_key", "last_message_id"}
+	dmConversationColumnsWithDefault    = []string{"id", "created_at"}
+	dmConversationPrimaryKeyColumns     = []string{"id"}
+	dmConversationGeneratedColumns      = []string{}
+)
+
+type (
+	// DMConversationSlice is an alias for a slice of pointers to DMConversation.
+	// This should almost always be used instead of []DMConversation.
+	DMConversationSlice []*DMConversation
+	// DMConversationHook is the signature for custom DMConversation hook methods
+	DMConversationHook func(context.Context, boil.ContextExecutor, *DMConversation) error
+
+	dmConversationQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	dmConversationType                 = reflect.TypeOf(&DMConversation{})
+	dmConversationMapping              = queries.MakeStructMapping(dmConversationType)
+	dmConversationPrimaryKeyMapping, _ = queries.BindMapping(dmConversationType, dmConversationMapping, dmConversationPrimaryKeyColumns)
+	dmConversationInsertCacheMut       sync.RWMutex
+	dmConversationInsertCache          = make(map[string]insertCache)
+	dmConversationUpdateCacheMut       sync.RWMutex
+	dmConversationUpdateCache          = make(map[string]updateCache)
+	dmConversationUpsertCacheMut       sync.RWMutex
+	dmConversationUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var dmConversationAfterSelectMu sync.Mutex
+var dmConversationAfterSelectHooks []DMConversationHook
+
+var dmConversationBeforeInsertMu sync.Mutex
+var dmConversationBeforeInsertHooks []DMConversationHook
+var dmConversationAfterInsertMu sync.Mutex
+var dmConversationAfterInsertHooks []DMConversationHook
+
+var dmConversationBeforeUpdateMu sync.Mutex
+var dmConversationBeforeUpdateHooks []DMConversationHook
+var dmConversationAfterUpdateMu sync.Mutex
+var dmConversationAfterUpdateHooks []DMConversationHook
+
+var dmConversationBeforeDeleteMu sync.Mutex
+var dmConversationBeforeDeleteHooks []DMConversationHook
+var dmConversationAfterDeleteMu sync.Mutex
+var dmConversationAfterDeleteHooks []DMConversationHook
+
+var dmConversationBeforeUpsertMu sync.Mutex
+var dmConversationBeforeUpsertHooks []DMConversationHook
+var dmConversationAfterUpsertMu sync.Mutex
+var dmConversationAfterUpsertHooks []DMConversationHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *DMConversation) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dmConversationAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *DMConversation) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dmConversationBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *DMConversation) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dmConversationAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *DMConversation) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dmConversationBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *DMConversation) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dmConversationAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *DMConversation) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dmConversationBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *DMConversation) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dmConversationAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *DMConversation) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dmConversationBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *DMConversation) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dmConversationAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddDMConversationHook registers your hook function for all future operations.
+func AddDMConversationHook(hookPoint boil.HookPoint, dmConversationHook DMConversationHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		dmConversationAfterSelectMu.Lock()
+		dmConversationAfterSelectHooks = append(dmConversationAfterSelectHooks, dmConversationHook)
+		dmConversationAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		dmConversationBeforeInsertMu.Lock()
+		dmConversationBeforeInsertHooks = append(dmConversationBeforeInsertHooks, dmConversationHook)
+		dmConversationBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		dmConversationAfterInsertMu.Lock()
+		dmConversationAfterInsertHooks = append(dmConversationAfterInsertHooks, dmConversationHook)
+		dmConversationAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		dmConversationBeforeUpdateMu.Lock()
+		dmConversationBeforeUpdateHooks = append(dmConversationBeforeUpdateHooks, dmConversationHook)
+		dmConversationBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		dmConversationAfterUpdateMu.Lock()
+		dmConversationAfterUpdateHooks = append(dmConversationAfterUpdateHooks, dmConversationHook)
+		dmConversationAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		dmConversationBeforeDeleteMu.Lock()
+		dmConversationBeforeDeleteHooks = append(dmConversationBeforeDeleteHooks, dmConversationHook)
+		dmConversationBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		dmConversationAfterDeleteMu.Lock()
+		dmConversationAfterDeleteHooks = append(dmConversationAfterDeleteHooks, dmConversationHook)
+		dmConversationAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		dmConversationBeforeUpsertMu.Lock()
+		dmConversationBeforeUpsertHooks = append(dmConversationBeforeUpsertHooks, dmConversationHook)
+		dmConversationBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		dmConversationAfterUpsertMu.Lock()
+		dmConversationAfterUpsertHooks = append(dmConversationAfterUpsertHooks, dmConversationHook)
+		dmConversationAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single dmConversation record from the query.
+func (q dmConversationQuery) One(ctx context.Context, exec boil.ContextExecutor) (*DMConversation, error) {
+	o := &DMConversation{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "schema: failed to execute a one query for dm_conversations")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all DMConversation records from the query.
+func (q dmConversationQuery) All(ctx context.Context, exec boil.ContextExecutor) (DMConversationSlice, error) {
+	var o []*DMConversation
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "schema: failed to assign all query results to DMConversation slice")
+	}
+
+	if len(dmConversationAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all DMConversation records in the query.
+func (q dmConversationQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: failed to count dm_conversations rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q dmConversationQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "schema: failed to check if dm_conversations exists")
+	}
+
+	return count > 0, nil
+}
+
+// ConversationDMMessages retrieves all the dm_message's DMMessages with an executor via conversation_id column.
+func (o *DMConversation) ConversationDMMessages(mods ...qm.QueryMod) dmMessageQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`dm_messages`.`conversation_id`=?", o.ID),
+	)
+
+	return DMMessages(queryMods...)
+}
+
+// ConversationDMParticipants retrieves all the dm_participant's DMParticipants with an executor via conversation_id column.
+func (o *DMConversation) ConversationDMParticipants(mods ...qm.QueryMod) dmParticipantQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`dm_participants`.`conversation_id`=?", o.ID),
+	)
+
+	return DMParticipants(queryMods...)
+}
+
+// LoadConversationDMMessages allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (dmConversationL) LoadConversationDMMessages(ctx context.Context, e boil.ContextExecutor, singular bool, maybeDMConversation interface{}, mods queries.Applicator) error {
+	var slice []*DMConversation
+	var object *DMConversation
+
+	if singular {
+		var ok bool
+		object, ok = maybeDMConversation.(*DMConversation)
+		if !ok {
+			object = new(DMConversation)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeDMConversation)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeDMConversation))
+			}
+		}
+	} else {
+		s, ok := maybeDMConversation.(*[]*DMConversation)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeDMConversation)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeDMConversation))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &dmConversationR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &dmConversationR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`dm_messages`),
+		qm.WhereIn(`dm_messages.conversation_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load dm_messages")
+	}
+
+	var resultSlice []*DMMessage
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice dm_messages")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on dm_messages")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for dm_messages")
+	}
+
+	if len(dmMessageAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ConversationDMMessages = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &dmMessageR{}
+			}
+			foreign.R.Conversation = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ConversationID {
+				local.R.ConversationDMMessages = append(local.R.ConversationDMMessages, foreign)
+				if foreign.R == nil {
+					foreign.R = &dmMessageR{}
+				}
+				foreign.R.Conversation = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadConversationDMParticipants allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (dmConversationL) LoadConversationDMParticipants(ctx context.Context, e boil.ContextExecutor, singular bool, maybeDMConversation interface{}, mods queries.Applicator) error {
+	var slice []*DMConversation
+	var object *DMConversation
+
+	if singular {
+		var ok bool
+		object, ok = maybeDMConversation.(*DMConversation)
+		if !ok {
+			object = new(DMConversation)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeDMConversation)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeDMConversation))
+			}
+		}
+	} else {
+		s, ok := maybeDMConversation.(*[]*DMConversation)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeDMConversation)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeDMConversation))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &dmConversationR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &dmConversationR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`dm_participants`),
+		qm.WhereIn(`dm_participants.conversation_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load dm_participants")
+	}
+
+	var resultSlice []*DMParticipant
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice dm_participants")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on dm_participants")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for dm_participants")
+	}
+
+	if len(dmParticipantAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ConversationDMParticipants = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &dmParticipantR{}
+			}
+			foreign.R.Conversation = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ConversationID {
+				local.R.ConversationDMParticipants = append(local.R.ConversationDMParticipants, foreign)
+				if foreign.R == nil {
+					foreign.R = &dmParticipantR{}
+				}
+				foreign.R.Conversation = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// AddConversationDMMessages adds the given related objects to the existing relationships
+// of the dm_conversation, optionally inserting them as new records.
+// Appends related to o.R.ConversationDMMessages.
+// Sets related.R.Conversation appropriately.
+func (o *DMConversation) AddConversationDMMessages(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*DMMessage) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ConversationID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `dm_messages` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"conversation_id"}),
+				strmangle.WhereClause("`", "`", 0, dmMessagePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ConversationID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &dmConversationR{
+			ConversationDMMessages: related,
+		}
+	} else {
+		o.R.ConversationDMMessages = append(o.R.ConversationDMMessages, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &dmMessageR{
+				Conversation: o,
+			}
+		} else {
+			rel.R.Conversation = o
+		}
+	}
+	return nil
+}
+
+// AddConversationDMParticipants adds the given related objects to the existing relationships
+// of the dm_conversation, optionally inserting them as new records.
+// Appends related to o.R.ConversationDMParticipants.
+// Sets related.R.Conversation appropriately.
+func (o *DMConversation) AddConversationDMParticipants(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*DMParticipant) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ConversationID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `dm_participants` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"conversation_id"}),
+				strmangle.WhereClause("`", "`", 0, dmParticipantPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ConversationID, rel.UserID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ConversationID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &dmConversationR{
+			ConversationDMParticipants: related,
+		}
+	} else {
+		o.R.ConversationDMParticipants = append(o.R.ConversationDMParticipants, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &dmParticipantR{
+				Conversation: o,
+			}
+		} else {
+			rel.R.Conversation = o
+		}
+	}
+	return nil
+}
+
+// DMConversations retrieves all the records using an executor.
+func DMConversations(mods ...qm.QueryMod) dmConversationQuery {
+	mods = append(mods, qm.From("`dm_conversations`"))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"`dm_conversations`.*"})
+	}
+
+	return dmConversationQuery{q}
+}
+
+// FindDMConversation retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindDMConversation(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*DMConversation, error) {
+	dmConversationObj := &DMConversation{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `dm_conversations` where `id`=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, dmConversationObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "schema: unable to select from dm_conversations")
+	}
+
+	if err = dmConversationObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return dmConversationObj, err
+	}
+
+	return dmConversationObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *DMConversation) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("schema: no dm_conversations provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(dmConversationColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	dmConversationInsertCacheMut.RLock()
+	cache, cached := dmConversationInsertCache[key]
+	dmConversationInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			dmConversationAllColumns,
+			dmConversationColumnsWithDefault,
+			dmConversationColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(dmConversationType, dmConversationMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(dmConversationType, dmConversationMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `dm_conversations` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `dm_conversations` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `dm_conversations` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, dmConversationPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "schema: unable to insert into dm_conversations")
+	}
+
+	var lastID int64
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = int(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == dmConversationMapping["id"] {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.ID,
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, identifierCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "schema: unable to populate default values for dm_conversations")
+	}
+
+CacheNoHooks:
+	if !cached {
+		dmConversationInsertCacheMut.Lock()
+		dmConversationInsertCache[key] = cache
+		dmConversationInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the DMConversation.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *DMConversation) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	dmConversationUpdateCacheMut.RLock()
+	cache, cached := dmConversationUpdateCache[key]
+	dmConversationUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			dmConversationAllColumns,
+			dmConversationPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("schema: unable to update dm_conversations, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `dm_conversations` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, dmConversationPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(dmConversationType, dmConversationMapping, append(wl, dmConversationPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to update dm_conversations row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: failed to get rows affected by update for dm_conversations")
+	}
+
+	if !cached {
+		dmConversationUpdateCacheMut.Lock()
+		dmConversationUpdateCache[key] = cache
+		dmConversationUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q dmConversationQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to update all for dm_conversations")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to retrieve rows affected for dm_conversations")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o DMConversationSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("schema: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), dmConversationPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `dm_conversations` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, dmConversationPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to update all in dmConversation slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to retrieve rows affected all in update all dmConversation")
+	}
+	return rowsAff, nil
+}
+
+var mySQLDMConversationUniqueColumns = []string{
+	"id",
+	"direct_key",
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *DMConversation) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("schema: no dm_conversations provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(dmConversationColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLDMConversationUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	dmConversationUpsertCacheMut.RLock()
+	cache, cached := dmConversationUpsertCache[key]
+	dmConversationUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			dmConversationAllColumns,
+			dmConversationColumnsWithDefault,
+			dmConversationColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			dmConversationAllColumns,
+			dmConversationPrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("schema: unable to upsert dm_conversations, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(dmConversationAllColumns, strmangle.SetIntersect(insert, update))
+
+		cache.query = buildUpsertQueryMySQL(dialect, "`dm_conversations`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `dm_conversations` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(dmConversationType, dmConversationMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(dmConversationType, dmConversationMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "schema: unable to upsert for dm_conversations")
+	}
+
+	var lastID int64
+	var uniqueMap []uint64
+	var nzUniqueCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = int(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == dmConversationMapping["id"] {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(dmConversationType, dmConversationMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "schema: unable to retrieve unique values for dm_conversations")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, nzUniqueCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "schema: unable to populate default values for dm_conversations")
+	}
+
+CacheNoHooks:
+	if !cached {
+		dmConversationUpsertCacheMut.Lock()
+		dmConversationUpsertCache[key] = cache
+		dmConversationUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single DMConversation record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *DMConversation) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("schema: no DMConversation provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), dmConversationPrimaryKeyMapping)
+	sql := "DELETE FROM `dm_conversations` WHERE `id`=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to delete from dm_conversations")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: failed to get rows affected by delete for dm_conversations")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q dmConversationQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("schema: no dmConversationQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to delete all from dm_conversations")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: failed to get rows affected by deleteall for dm_conversations")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o DMConversationSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(dmConversationBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), dmConversationPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `dm_conversations` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, dmConversationPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to delete all from dmConversation slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: failed to get rows affected by deleteall for dm_conversations")
+	}
+
+	if len(dmConversationAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *DMConversation) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindDMConversation(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *DMConversationSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := DMConversationSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), dmConversationPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `dm_conversations`.* FROM `dm_conversations` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, dmConversationPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "schema: unable to reload all in DMConversationSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// DMConversationExists checks if the DMConversation row exists.
+func DMConversationExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `dm_conversations` where `id`=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "schema: unable to check if dm_conversations exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the DMConversation row exists.
+func (o *DMConversation) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return DMConversationExists(ctx, exec, o.ID)
+}
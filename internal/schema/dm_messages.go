@@ -0,0 +1,1397 @@
+// Code generated by SQLBoiler 4.18.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package schema
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// DMMessage is an object representing the database table.
+type DMMessage struct {
+	ID             int       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	ConversationID int       `boil:"conversation_id" json:"conversation_id" toml:"conversation_id" yaml:"conversation_id"`
+	SenderID       int       `boil:"sender_id" json:"sender_id" toml:"sender_id" yaml:"sender_id"`
+	Content        string    `boil:"content" json:"content" toml:"content" yaml:"content"`
+	CreatedAt      null.Time `boil:"created_at" json:"created_at,omitempty" toml:"created_at" yaml:"created_at,omitempty"`
+
+	R *dmMessageR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L dmMessageL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var DMMessageColumns = struct {
+	ID             string
+	ConversationID string
+	SenderID       string
+	Content        string
+	CreatedAt      string
+}{
+	ID:             "id",
+	ConversationID: "conversation_id",
+	SenderID:       "sender_id",
+	Content:        "content",
+	CreatedAt:      "created_at",
+}
+
+var DMMessageTableColumns = struct {
+	ID             string
+	ConversationID string
+	SenderID       string
+	Content        string
+	CreatedAt      string
+}{
+	ID:             "dm_messages.id",
+	ConversationID: "dm_messages.conversation_id",
+	SenderID:       "dm_messages.sender_id",
+	Content:        "dm_messages.content",
+	CreatedAt:      "dm_messages.created_at",
+}
+
+// Generated where
+
+var DMMessageWhere = struct {
+	ID             whereHelperint
+	ConversationID whereHelperint
+	SenderID       whereHelperint
+	Content        whereHelperstring
+	CreatedAt      whereHelpernull_Time
+}{
+	ID:             whereHelperint{field: "`dm_messages`.`id`"},
+	ConversationID: whereHelperint{field: "`dm_messages`.`conversation_id`"},
+	SenderID:       whereHelperint{field: "`dm_messages`.`sender_id`"},
+	Content:        whereHelperstring{field: "`dm_messages`.`content`"},
+	CreatedAt:      whereHelpernull_Time{field: "`dm_messages`.`created_at`"},
+}
+
+// DMMessageRels is where relationship names are stored.
+var DMMessageRels = struct {
+	Conversation string
+	Sender       string
+}{
+	Conversation: "Conversation",
+	Sender:       "Sender",
+}
+
+// dmMessageR is where relationships are stored.
+type dmMessageR struct {
+	Conversation *DMConversation `boil:"Conversation" json:"Conversation" toml:"Conversation" yaml:"Conversation"`
+	Sender       *User           `boil:"Sender" json:"Sender" toml:"Sender" yaml:"Sender"`
+}
+
+// NewStruct creates a new relationship struct
+func (*dmMessageR) NewStruct() *dmMessageR {
+	return &dmMessageR{}
+}
+
+func (r *dmMessageR) GetConversation() *DMConversation {
+	if r == nil {
+		return nil
+	}
+	return r.Conversation
+}
+
+func (r *dmMessageR) GetSender() *User {
+	if r == nil {
+		return nil
+	}
+	return r.Sender
+}
+
+// dmMessageL is where Load methods for each relationship are stored.
+type dmMessageL struct{}
+
+var (
+	dmMessageAllColumns            = []string{"id", "conversation_id", "sender_id", "content", "created_at"}
+	dmMessageColumnsWithoutDefault = []string{"conversation_id", "sender_id", "content"}
+	dmMessageColumnsWithDefault    = []string{"id", "created_at"}
+	dmMessagePrimaryKeyColumns     = []string{"id"}
+	dmMessageGeneratedColumns      = []string{}
+)
+
+type (
+	// DMMessageSlice is an alias for a slice of pointers to DMMessage.
+	// This should almost always be used instead of []DMMessage.
+	DMMessageSlice []*DMMessage
+	// DMMessageHook is the signature for custom DMMessage hook methods
+	DMMessageHook func(context.Context, boil.ContextExecutor, *DMMessage) error
+
+	dmMessageQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	dmMessageType                 = reflect.TypeOf(&DMMessage{})
+	dmMessageMapping              = queries.MakeStructMapping(dmMessageType)
+	dmMessagePrimaryKeyMapping, _ = queries.BindMapping(dmMessageType, dmMessageMapping, dmMessagePrimaryKeyColumns)
+	dmMessageInsertCacheMut       sync.RWMutex
+	dmMessageInsertCache          = make(map[string]insertCache)
+	dmMessageUpdateCacheMut       sync.RWMutex
+	dmMessageUpdateCache          = make(map[string]updateCache)
+	dmMessageUpsertCacheMut       sync.RWMutex
+	dmMessageUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var dmMessageAfterSelectMu sync.Mutex
+var dmMessageAfterSelectHooks []DMMessageHook
+
+var dmMessageBeforeInsertMu sync.Mutex
+var dmMessageBeforeInsertHooks []DMMessageHook
+var dmMessageAfterInsertMu sync.Mutex
+var dmMessageAfterInsertHooks []DMMessageHook
+
+var dmMessageBeforeUpdateMu sync.Mutex
+var dmMessageBeforeUpdateHooks []DMMessageHook
+var dmMessageAfterUpdateMu sync.Mutex
+var dmMessageAfterUpdateHooks []DMMessageHook
+
+var dmMessageBeforeDeleteMu sync.Mutex
+var dmMessageBeforeDeleteHooks []DMMessageHook
+var dmMessageAfterDeleteMu sync.Mutex
+var dmMessageAfterDeleteHooks []DMMessageHook
+
+var dmMessageBeforeUpsertMu sync.Mutex
+var dmMessageBeforeUpsertHooks []DMMessageHook
+var dmMessageAfterUpsertMu sync.Mutex
+var dmMessageAfterUpsertHooks []DMMessageHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *DMMessage) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dmMessageAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *DMMessage) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dmMessageBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *DMMessage) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dmMessageAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *DMMessage) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dmMessageBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *DMMessage) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dmMessageAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *DMMessage) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dmMessageBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *DMMessage) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dmMessageAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *DMMessage) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dmMessageBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *DMMessage) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dmMessageAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddDMMessageHook registers your hook function for all future operations.
+func AddDMMessageHook(hookPoint boil.HookPoint, dmMessageHook DMMessageHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		dmMessageAfterSelectMu.Lock()
+		dmMessageAfterSelectHooks = append(dmMessageAfterSelectHooks, dmMessageHook)
+		dmMessageAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		dmMessageBeforeInsertMu.Lock()
+		dmMessageBeforeInsertHooks = append(dmMessageBeforeInsertHooks, dmMessageHook)
+		dmMessageBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		dmMessageAfterInsertMu.Lock()
+		dmMessageAfterInsertHooks = append(dmMessageAfterInsertHooks, dmMessageHook)
+		dmMessageAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		dmMessageBeforeUpdateMu.Lock()
+		dmMessageBeforeUpdateHooks = append(dmMessageBeforeUpdateHooks, dmMessageHook)
+		dmMessageBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		dmMessageAfterUpdateMu.Lock()
+		dmMessageAfterUpdateHooks = append(dmMessageAfterUpdateHooks, dmMessageHook)
+		dmMessageAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		dmMessageBeforeDeleteMu.Lock()
+		dmMessageBeforeDeleteHooks = append(dmMessageBeforeDeleteHooks, dmMessageHook)
+		dmMessageBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		dmMessageAfterDeleteMu.Lock()
+		dmMessageAfterDeleteHooks = append(dmMessageAfterDeleteHooks, dmMessageHook)
+		dmMessageAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		dmMessageBeforeUpsertMu.Lock()
+		dmMessageBeforeUpsertHooks = append(dmMessageBeforeUpsertHooks, dmMessageHook)
+		dmMessageBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		dmMessageAfterUpsertMu.Lock()
+		dmMessageAfterUpsertHooks = append(dmMessageAfterUpsertHooks, dmMessageHook)
+		dmMessageAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single dmMessage record from the query.
+func (q dmMessageQuery) One(ctx context.Context, exec boil.ContextExecutor) (*DMMessage, error) {
+	o := &DMMessage{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "schema: failed to execute a one query for dm_messages")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all DMMessage records from the query.
+func (q dmMessageQuery) All(ctx context.Context, exec boil.ContextExecutor) (DMMessageSlice, error) {
+	var o []*DMMessage
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "schema: failed to assign all query results to DMMessage slice")
+	}
+
+	if len(dmMessageAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all DMMessage records in the query.
+func (q dmMessageQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: failed to count dm_messages rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q dmMessageQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "schema: failed to check if dm_messages exists")
+	}
+
+	return count > 0, nil
+}
+
+// Conversation pointed to by the foreign key.
+func (o *DMMessage) Conversation(mods ...qm.QueryMod) dmConversationQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`id` = ?", o.ConversationID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return DMConversations(queryMods...)
+}
+
+// Sender pointed to by the foreign key.
+func (o *DMMessage) Sender(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`id` = ?", o.SenderID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadConversation allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (dmMessageL) LoadConversation(ctx context.Context, e boil.ContextExecutor, singular bool, maybeDMMessage interface{}, mods queries.Applicator) error {
+	var slice []*DMMessage
+	var object *DMMessage
+
+	if singular {
+		var ok bool
+		object, ok = maybeDMMessage.(*DMMessage)
+		if !ok {
+			object = new(DMMessage)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeDMMessage)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeDMMessage))
+			}
+		}
+	} else {
+		s, ok := maybeDMMessage.(*[]*DMMessage)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeDMMessage)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeDMMessage))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &dmMessageR{}
+		}
+		args[object.ConversationID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &dmMessageR{}
+			}
+
+			args[obj.ConversationID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`dm_conversations`),
+		qm.WhereIn(`dm_conversations.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load DMConversation")
+	}
+
+	var resultSlice []*DMConversation
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice DMConversation")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for dm_conversations")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for dm_conversations")
+	}
+
+	if len(dmConversationAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Conversation = foreign
+		if foreign.R == nil {
+			foreign.R = &dmConversationR{}
+		}
+		foreign.R.ConversationDMMessages = append(foreign.R.ConversationDMMessages, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ConversationID == foreign.ID {
+				local.R.Conversation = foreign
+				if foreign.R == nil {
+					foreign.R = &dmConversationR{}
+				}
+				foreign.R.ConversationDMMessages = append(foreign.R.ConversationDMMessages, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSender allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (dmMessageL) LoadSender(ctx context.Context, e boil.ContextExecutor, singular bool, maybeDMMessage interface{}, mods queries.Applicator) error {
+	var slice []*DMMessage
+	var object *DMMessage
+
+	if singular {
+		var ok bool
+		object, ok = maybeDMMessage.(*DMMessage)
+		if !ok {
+			object = new(DMMessage)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeDMMessage)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeDMMessage))
+			}
+		}
+	} else {
+		s, ok := maybeDMMessage.(*[]*DMMessage)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeDMMessage)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeDMMessage))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &dmMessageR{}
+		}
+		args[object.SenderID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &dmMessageR{}
+			}
+
+			args[obj.SenderID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`users.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Sender = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.SenderDMMessages = append(foreign.R.SenderDMMessages, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.SenderID == foreign.ID {
+				local.R.Sender = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.SenderDMMessages = append(foreign.R.SenderDMMessages, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetConversation of the dmMessage to the related item.
+// Sets o.R.Conversation to related.
+// Adds o to related.R.ConversationDMMessages.
+func (o *DMMessage) SetConversation(ctx context.Context, exec boil.ContextExecutor, insert bool, related *DMConversation) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `dm_messages` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"conversation_id"}),
+		strmangle.WhereClause("`", "`", 0, dmMessagePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ConversationID = related.ID
+	if o.R == nil {
+		o.R = &dmMessageR{
+			Conversation: related,
+		}
+	} else {
+		o.R.Conversation = related
+	}
+
+	if related.R == nil {
+		related.R = &dmConversationR{
+			ConversationDMMessages: DMMessageSlice{o},
+		}
+	} else {
+		related.R.ConversationDMMessages = append(related.R.ConversationDMMessages, o)
+	}
+
+	return nil
+}
+
+// SetSender of the dmMessage to the related item.
+// Sets o.R.Sender to related.
+// Adds o to related.R.SenderDMMessages.
+func (o *DMMessage) SetSender(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `dm_messages` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"sender_id"}),
+		strmangle.WhereClause("`", "`", 0, dmMessagePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.SenderID = related.ID
+	if o.R == nil {
+		o.R = &dmMessageR{
+			Sender: related,
+		}
+	} else {
+		o.R.Sender = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			SenderDMMessages: DMMessageSlice{o},
+		}
+	} else {
+		related.R.SenderDMMessages = append(related.R.SenderDMMessages, o)
+	}
+
+	return nil
+}
+
+// DMMessages retrieves all the records using an executor.
+func DMMessages(mods ...qm.QueryMod) dmMessageQuery {
+	mods = append(mods, qm.From("`dm_messages`"))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"`dm_messages`.*"})
+	}
+
+	return dmMessageQuery{q}
+}
+
+// FindDMMessage retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindDMMessage(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*DMMessage, error) {
+	dmMessageObj := &DMMessage{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `dm_messages` where `id`=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, dmMessageObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "schema: unable to select from dm_messages")
+	}
+
+	if err = dmMessageObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return dmMessageObj, err
+	}
+
+	return dmMessageObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *DMMessage) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("schema: no dm_messages provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(dmMessageColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	dmMessageInsertCacheMut.RLock()
+	cache, cached := dmMessageInsertCache[key]
+	dmMessageInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			dmMessageAllColumns,
+			dmMessageColumnsWithDefault,
+			dmMessageColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(dmMessageType, dmMessageMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(dmMessageType, dmMessageMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `dm_messages` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `dm_messages` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `dm_messages` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, dmMessagePrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "schema: unable to insert into dm_messages")
+	}
+
+	var lastID int64
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = int(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == dmMessageMapping["id"] {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.ID,
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, identifierCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "schema: unable to populate default values for dm_messages")
+	}
+
+CacheNoHooks:
+	if !cached {
+		dmMessageInsertCacheMut.Lock()
+		dmMessageInsertCache[key] = cache
+		dmMessageInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the DMMessage.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *DMMessage) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	dmMessageUpdateCacheMut.RLock()
+	cache, cached := dmMessageUpdateCache[key]
+	dmMessageUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			dmMessageAllColumns,
+			dmMessagePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("schema: unable to update dm_messages, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `dm_messages` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, dmMessagePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(dmMessageType, dmMessageMapping, append(wl, dmMessagePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to update dm_messages row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: failed to get rows affected by update for dm_messages")
+	}
+
+	if !cached {
+		dmMessageUpdateCacheMut.Lock()
+		dmMessageUpdateCache[key] = cache
+		dmMessageUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q dmMessageQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to update all for dm_messages")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to retrieve rows affected for dm_messages")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o DMMessageSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("schema: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), dmMessagePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `dm_messages` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, dmMessagePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to update all in dmMessage slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to retrieve rows affected all in update all dmMessage")
+	}
+	return rowsAff, nil
+}
+
+var mySQLDMMessageUniqueColumns = []string{
+	"id",
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *DMMessage) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("schema: no dm_messages provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(dmMessageColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLDMMessageUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	dmMessageUpsertCacheMut.RLock()
+	cache, cached := dmMessageUpsertCache[key]
+	dmMessageUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			dmMessageAllColumns,
+			dmMessageColumnsWithDefault,
+			dmMessageColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			dmMessageAllColumns,
+			dmMessagePrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("schema: unable to upsert dm_messages, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(dmMessageAllColumns, strmangle.SetIntersect(insert, update))
+
+		cache.query = buildUpsertQueryMySQL(dialect, "`dm_messages`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `dm_messages` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(dmMessageType, dmMessageMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(dmMessageType, dmMessageMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "schema: unable to upsert for dm_messages")
+	}
+
+	var lastID int64
+	var uniqueMap []uint64
+	var nzUniqueCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = int(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == dmMessageMapping["id"] {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(dmMessageType, dmMessageMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "schema: unable to retrieve unique values for dm_messages")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, nzUniqueCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "schema: unable to populate default values for dm_messages")
+	}
+
+CacheNoHooks:
+	if !cached {
+		dmMessageUpsertCacheMut.Lock()
+		dmMessageUpsertCache[key] = cache
+		dmMessageUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single DMMessage record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *DMMessage) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("schema: no DMMessage provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), dmMessagePrimaryKeyMapping)
+	sql := "DELETE FROM `dm_messages` WHERE `id`=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to delete from dm_messages")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: failed to get rows affected by delete for dm_messages")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q dmMessageQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("schema: no dmMessageQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to delete all from dm_messages")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: failed to get rows affected by deleteall for dm_messages")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o DMMessageSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(dmMessageBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), dmMessagePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `dm_messages` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, dmMessagePrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to delete all from dmMessage slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: failed to get rows affected by deleteall for dm_messages")
+	}
+
+	if len(dmMessageAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *DMMessage) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindDMMessage(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *DMMessageSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := DMMessageSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), dmMessagePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `dm_messages`.* FROM `dm_messages` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, dmMessagePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "schema: unable to reload all in DMMessageSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// DMMessageExists checks if the DMMessage row exists.
+func DMMessageExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `dm_messages` where `id`=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "schema: unable to check if dm_messages exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the DMMessage row exists.
+func (o *DMMessage) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return DMMessageExists(ctx, exec, o.ID)
+}
@@ -0,0 +1,1166 @@
+// Code generated by SQLBoiler 4.18.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package schema
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// DMOptIn is an object representing the database table.
+type DMOptIn struct {
+	UserID    int       `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	CreatedAt null.Time `boil:"created_at" json:"created_at,omitempty" toml:"created_at" yaml:"created_at,omitempty"`
+
+	R *dmOptInR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L dmOptInL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var DMOptInColumns = struct {
+	UserID    string
+	CreatedAt string
+}{
+	UserID:    "user_id",
+	CreatedAt: "created_at",
+}
+
+var DMOptInTableColumns = struct {
+	UserID    string
+	CreatedAt string
+}{
+	UserID:    "dm_opt_ins.user_id",
+	CreatedAt: "dm_opt_ins.created_at",
+}
+
+// Generated where
+
+var DMOptInWhere = struct {
+	UserID    whereHelperint
+	CreatedAt whereHelpernull_Time
+}{
+	UserID:    whereHelperint{field: "`dm_opt_ins`.`user_id`"},
+	CreatedAt: whereHelpernull_Time{field: "`dm_opt_ins`.`created_at`"},
+}
+
+// DMOptInRels is where relationship names are stored.
+var DMOptInRels = struct {
+	User string
+}{
+	User: "User",
+}
+
+// dmOptInR is where relationships are stored.
+type dmOptInR struct {
+	User *User `boil:"User" json:"User" toml:"User" yaml:"User"`
+}
+
+// NewStruct creates a new relationship struct
+func (*dmOptInR) NewStruct() *dmOptInR {
+	return &dmOptInR{}
+}
+
+func (r *dmOptInR) GetUser() *User {
+	if r == nil {
+		return nil
+	}
+	return r.User
+}
+
+// dmOptInL is where Load methods for each relationship are stored.
+type dmOptInL struct{}
+
+var (
+	dmOptInAllColumns            = []string{"user_id", "created_at"}
+	dmOptInColumnsWithoutDefault = []string{"user_id"}
+	dmOptInColumnsWithDefault    = []string{"created_at"}
+	dmOptInPrimaryKeyColumns     = []string{"user_id"}
+	dmOptInGeneratedColumns      = []string{}
+)
+
+type (
+	// DMOptInSlice is an alias for a slice of pointers to DMOptIn.
+	// This should almost always be used instead of []DMOptIn.
+	DMOptInSlice []*DMOptIn
+	// DMOptInHook is the signature for custom DMOptIn hook methods
+	DMOptInHook func(context.Context, boil.ContextExecutor, *DMOptIn) error
+
+	dmOptInQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	dmOptInType                 = reflect.TypeOf(&DMOptIn{})
+	dmOptInMapping              = queries.MakeStructMapping(dmOptInType)
+	dmOptInPrimaryKeyMapping, _ = queries.BindMapping(dmOptInType, dmOptInMapping, dmOptInPrimaryKeyColumns)
+	dmOptInInsertCacheMut       sync.RWMutex
+	dmOptInInsertCache          = make(map[string]insertCache)
+	dmOptInUpdateCacheMut       sync.RWMutex
+	dmOptInUpdateCache          = make(map[string]updateCache)
+	dmOptInUpsertCacheMut       sync.RWMutex
+	dmOptInUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var dmOptInAfterSelectMu sync.Mutex
+var dmOptInAfterSelectHooks []DMOptInHook
+
+var dmOptInBeforeInsertMu sync.Mutex
+var dmOptInBeforeInsertHooks []DMOptInHook
+var dmOptInAfterInsertMu sync.Mutex
+var dmOptInAfterInsertHooks []DMOptInHook
+
+var dmOptInBeforeUpdateMu sync.Mutex
+var dmOptInBeforeUpdateHooks []DMOptInHook
+var dmOptInAfterUpdateMu sync.Mutex
+var dmOptInAfterUpdateHooks []DMOptInHook
+
+var dmOptInBeforeDeleteMu sync.Mutex
+var dmOptInBeforeDeleteHooks []DMOptInHook
+var dmOptInAfterDeleteMu sync.Mutex
+var dmOptInAfterDeleteHooks []DMOptInHook
+
+var dmOptInBeforeUpsertMu sync.Mutex
+var dmOptInBeforeUpsertHooks []DMOptInHook
+var dmOptInAfterUpsertMu sync.Mutex
+var dmOptInAfterUpsertHooks []DMOptInHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *DMOptIn) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dmOptInAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *DMOptIn) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dmOptInBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *DMOptIn) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dmOptInAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *DMOptIn) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dmOptInBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *DMOptIn) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dmOptInAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *DMOptIn) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dmOptInBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *DMOptIn) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dmOptInAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *DMOptIn) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dmOptInBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *DMOptIn) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dmOptInAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddDMOptInHook registers your hook function for all future operations.
+func AddDMOptInHook(hookPoint boil.HookPoint, dmOptInHook DMOptInHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		dmOptInAfterSelectMu.Lock()
+		dmOptInAfterSelectHooks = append(dmOptInAfterSelectHooks, dmOptInHook)
+		dmOptInAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		dmOptInBeforeInsertMu.Lock()
+		dmOptInBeforeInsertHooks = append(dmOptInBeforeInsertHooks, dmOptInHook)
+		dmOptInBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		dmOptInAfterInsertMu.Lock()
+		dmOptInAfterInsertHooks = append(dmOptInAfterInsertHooks, dmOptInHook)
+		dmOptInAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		dmOptInBeforeUpdateMu.Lock()
+		dmOptInBeforeUpdateHooks = append(dmOptInBeforeUpdateHooks, dmOptInHook)
+		dmOptInBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		dmOptInAfterUpdateMu.Lock()
+		dmOptInAfterUpdateHooks = append(dmOptInAfterUpdateHooks, dmOptInHook)
+		dmOptInAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		dmOptInBeforeDeleteMu.Lock()
+		dmOptInBeforeDeleteHooks = append(dmOptInBeforeDeleteHooks, dmOptInHook)
+		dmOptInBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		dmOptInAfterDeleteMu.Lock()
+		dmOptInAfterDeleteHooks = append(dmOptInAfterDeleteHooks, dmOptInHook)
+		dmOptInAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		dmOptInBeforeUpsertMu.Lock()
+		dmOptInBeforeUpsertHooks = append(dmOptInBeforeUpsertHooks, dmOptInHook)
+		dmOptInBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		dmOptInAfterUpsertMu.Lock()
+		dmOptInAfterUpsertHooks = append(dmOptInAfterUpsertHooks, dmOptInHook)
+		dmOptInAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single dmOptIn record from the query.
+func (q dmOptInQuery) One(ctx context.Context, exec boil.ContextExecutor) (*DMOptIn, error) {
+	o := &DMOptIn{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "schema: failed to execute a one query for dm_opt_ins")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all DMOptIn records from the query.
+func (q dmOptInQuery) All(ctx context.Context, exec boil.ContextExecutor) (DMOptInSlice, error) {
+	var o []*DMOptIn
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "schema: failed to assign all query results to DMOptIn slice")
+	}
+
+	if len(dmOptInAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all DMOptIn records in the query.
+func (q dmOptInQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: failed to count dm_opt_ins rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q dmOptInQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "schema: failed to check if dm_opt_ins exists")
+	}
+
+	return count > 0, nil
+}
+
+// User pointed to by the foreign key.
+func (o *DMOptIn) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`id` = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (dmOptInL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeDMOptIn interface{}, mods queries.Applicator) error {
+	var slice []*DMOptIn
+	var object *DMOptIn
+
+	if singular {
+		var ok bool
+		object, ok = maybeDMOptIn.(*DMOptIn)
+		if !ok {
+			object = new(DMOptIn)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeDMOptIn)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeDMOptIn))
+			}
+		}
+	} else {
+		s, ok := maybeDMOptIn.(*[]*DMOptIn)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeDMOptIn)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeDMOptIn))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &dmOptInR{}
+		}
+		args[object.UserID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &dmOptInR{}
+			}
+
+			args[obj.UserID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`users.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.DMOptIn = object
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.DMOptIn = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetUser of the dmOptIn to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.DMOptIn.
+func (o *DMOptIn) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `dm_opt_ins` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"user_id"}),
+		strmangle.WhereClause("`", "`", 0, dmOptInPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.UserID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &dmOptInR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			DMOptIn: o,
+		}
+	} else {
+		related.R.DMOptIn = o
+	}
+
+	return nil
+}
+
+// DMOptIns retrieves all the records using an executor.
+func DMOptIns(mods ...qm.QueryMod) dmOptInQuery {
+	mods = append(mods, qm.From("`dm_opt_ins`"))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"`dm_opt_ins`.*"})
+	}
+
+	return dmOptInQuery{q}
+}
+
+// FindDMOptIn retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindDMOptIn(ctx context.Context, exec boil.ContextExecutor, userID int, selectCols ...string) (*DMOptIn, error) {
+	dmOptInObj := &DMOptIn{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `dm_opt_ins` where `user_id`=?", sel,
+	)
+
+	q := queries.Raw(query, userID)
+
+	err := q.Bind(ctx, exec, dmOptInObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "schema: unable to select from dm_opt_ins")
+	}
+
+	if err = dmOptInObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return dmOptInObj, err
+	}
+
+	return dmOptInObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *DMOptIn) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("schema: no dm_opt_ins provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(dmOptInColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	dmOptInInsertCacheMut.RLock()
+	cache, cached := dmOptInInsertCache[key]
+	dmOptInInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			dmOptInAllColumns,
+			dmOptInColumnsWithDefault,
+			dmOptInColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(dmOptInType, dmOptInMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(dmOptInType, dmOptInMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `dm_opt_ins` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `dm_opt_ins` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `dm_opt_ins` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, dmOptInPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "schema: unable to insert into dm_opt_ins")
+	}
+
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.UserID,
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, identifierCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "schema: unable to populate default values for dm_opt_ins")
+	}
+
+CacheNoHooks:
+	if !cached {
+		dmOptInInsertCacheMut.Lock()
+		dmOptInInsertCache[key] = cache
+		dmOptInInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the DMOptIn.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *DMOptIn) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	dmOptInUpdateCacheMut.RLock()
+	cache, cached := dmOptInUpdateCache[key]
+	dmOptInUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			dmOptInAllColumns,
+			dmOptInPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("schema: unable to update dm_opt_ins, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `dm_opt_ins` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, dmOptInPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(dmOptInType, dmOptInMapping, append(wl, dmOptInPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to update dm_opt_ins row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: failed to get rows affected by update for dm_opt_ins")
+	}
+
+	if !cached {
+		dmOptInUpdateCacheMut.Lock()
+		dmOptInUpdateCache[key] = cache
+		dmOptInUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q dmOptInQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to update all for dm_opt_ins")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to retrieve rows affected for dm_opt_ins")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o DMOptInSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("schema: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), dmOptInPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `dm_opt_ins` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, dmOptInPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to update all in dmOptIn slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to retrieve rows affected all in update all dmOptIn")
+	}
+	return rowsAff, nil
+}
+
+var mySQLDMOptInUniqueColumns = []string{
+	"user_id",
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *DMOptIn) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("schema: no dm_opt_ins provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(dmOptInColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLDMOptInUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	dmOptInUpsertCacheMut.RLock()
+	cache, cached := dmOptInUpsertCache[key]
+	dmOptInUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			dmOptInAllColumns,
+			dmOptInColumnsWithDefault,
+			dmOptInColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			dmOptInAllColumns,
+			dmOptInPrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("schema: unable to upsert dm_opt_ins, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(dmOptInAllColumns, strmangle.SetIntersect(insert, update))
+
+		cache.query = buildUpsertQueryMySQL(dialect, "`dm_opt_ins`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `dm_opt_ins` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(dmOptInType, dmOptInMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(dmOptInType, dmOptInMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "schema: unable to upsert for dm_opt_ins")
+	}
+
+	var uniqueMap []uint64
+	var nzUniqueCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(dmOptInType, dmOptInMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "schema: unable to retrieve unique values for dm_opt_ins")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, nzUniqueCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "schema: unable to populate default values for dm_opt_ins")
+	}
+
+CacheNoHooks:
+	if !cached {
+		dmOptInUpsertCacheMut.Lock()
+		dmOptInUpsertCache[key] = cache
+		dmOptInUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single DMOptIn record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *DMOptIn) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("schema: no DMOptIn provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), dmOptInPrimaryKeyMapping)
+	sql := "DELETE FROM `dm_opt_ins` WHERE `user_id`=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to delete from dm_opt_ins")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: failed to get rows affected by delete for dm_opt_ins")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q dmOptInQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("schema: no dmOptInQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to delete all from dm_opt_ins")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: failed to get rows affected by deleteall for dm_opt_ins")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o DMOptInSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(dmOptInBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), dmOptInPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `dm_opt_ins` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, dmOptInPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to delete all from dmOptIn slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: failed to get rows affected by deleteall for dm_opt_ins")
+	}
+
+	if len(dmOptInAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *DMOptIn) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindDMOptIn(ctx, exec, o.UserID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *DMOptInSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := DMOptInSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), dmOptInPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `dm_opt_ins`.* FROM `dm_opt_ins` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, dmOptInPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "schema: unable to reload all in DMOptInSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// DMOptInExists checks if the DMOptIn row exists.
+func DMOptInExists(ctx context.Context, exec boil.ContextExecutor, userID int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `dm_opt_ins` where `user_id`=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, userID)
+	}
+	row := exec.QueryRowContext(ctx, sql, userID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "schema: unable to check if dm_opt_ins exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the DMOptIn row exists.
+func (o *DMOptIn) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return DMOptInExists(ctx, exec, o.UserID)
+}
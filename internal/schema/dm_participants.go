@@ -0,0 +1,1367 @@
+// Code generated by SQLBoiler 4.18.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package schema
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// DMParticipant is an object representing the database table.
+type DMParticipant struct {
+	ConversationID    int       `boil:"conversation_id" json:"conversation_id" toml:"conversation_id" yaml:"conversation_id"`
+	UserID            int       `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	LastReadMessageID int       `boil:"last_read_message_id" json:"last_read_message_id" toml:"last_read_message_id" yaml:"last_read_message_id"`
+	CreatedAt         null.Time `boil:"created_at" json:"created_at,omitempty" toml:"created_at" yaml:"created_at,omitempty"`
+
+	R *dmParticipantR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L dmParticipantL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var DMParticipantColumns = struct {
+	ConversationID    string
+	UserID            string
+	LastReadMessageID string
+	CreatedAt         string
+}{
+	ConversationID:    "conversation_id",
+	UserID:            "user_id",
+	LastReadMessageID: "last_read_message_id",
+	CreatedAt:         "created_at",
+}
+
+var DMParticipantTableColumns = struct {
+	ConversationID    string
+	UserID            string
+	LastReadMessageID string
+	CreatedAt         string
+}{
+	ConversationID:    "dm_participants.conversation_id",
+	UserID:            "dm_participants.user_id",
+	LastReadMessageID: "dm_participants.last_read_message_id",
+	CreatedAt:         "dm_participants.created_at",
+}
+
+// Generated where
+
+var DMParticipantWhere = struct {
+	ConversationID    whereHelperint
+	UserID            whereHelperint
+	LastReadMessageID whereHelperint
+	CreatedAt         whereHelpernull_Time
+}{
+	ConversationID:    whereHelperint{field: "`dm_participants`.`conversation_id`"},
+	UserID:            whereHelperint{field: "`dm_participants`.`user_id`"},
+	LastReadMessageID: whereHelperint{field: "`dm_participants`.`last_read_message_id`"},
+	CreatedAt:         whereHelpernull_Time{field: "`dm_participants`.`created_at`"},
+}
+
+// DMParticipantRels is where relationship names are stored.
+var DMParticipantRels = struct {
+	Conversation string
+	User         string
+}{
+	Conversation: "Conversation",
+	User:         "User",
+}
+
+// dmParticipantR is where relationships are stored.
+type dmParticipantR struct {
+	Conversation *DMConversation `boil:"Conversation" json:"Conversation" toml:"Conversation" yaml:"Conversation"`
+	User         *User           `boil:"User" json:"User" toml:"User" yaml:"User"`
+}
+
+// NewStruct creates a new relationship struct
+func (*dmParticipantR) NewStruct() *dmParticipantR {
+	return &dmParticipantR{}
+}
+
+func (r *dmParticipantR) GetConversation() *DMConversation {
+	if r == nil {
+		return nil
+	}
+	return r.Conversation
+}
+
+func (r *dmParticipantR) GetUser() *User {
+	if r == nil {
+		return nil
+	}
+	return r.User
+}
+
+// dmParticipantL is where Load methods for each relationship are stored.
+type dmParticipantL struct{}
+
+var (
+	dmParticipantAllColumns            = []string{"conversation_id", "user_id", "last_read_message_id", "created_at"}
+	dmParticipantColumnsWithoutDefault = []string{"conversation_id", "user_id"}
+	dmParticipantColumnsWithDefault    = []string{"last_read_message_id", "created_at"}
+	dmParticipantPrimaryKeyColumns     = []string{"conversation_id", "user_id"}
+	dmParticipantGeneratedColumns      = []string{}
+)
+
+type (
+	// DMParticipantSlice is an alias for a slice of pointers to DMParticipant.
+	// This should almost always be used instead of []DMParticipant.
+	DMParticipantSlice []*DMParticipant
+	// DMParticipantHook is the signature for custom DMParticipant hook methods
+	DMParticipantHook func(context.Context, boil.ContextExecutor, *DMParticipant) error
+
+	dmParticipantQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	dmParticipantType                 = reflect.TypeOf(&DMParticipant{})
+	dmParticipantMapping              = queries.MakeStructMapping(dmParticipantType)
+	dmParticipantPrimaryKeyMapping, _ = queries.BindMapping(dmParticipantType, dmParticipantMapping, dmParticipantPrimaryKeyColumns)
+	dmParticipantInsertCacheMut       sync.RWMutex
+	dmParticipantInsertCache          = make(map[string]insertCache)
+	dmParticipantUpdateCacheMut       sync.RWMutex
+	dmParticipantUpdateCache          = make(map[string]updateCache)
+	dmParticipantUpsertCacheMut       sync.RWMutex
+	dmParticipantUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var dmParticipantAfterSelectMu sync.Mutex
+var dmParticipantAfterSelectHooks []DMParticipantHook
+
+var dmParticipantBeforeInsertMu sync.Mutex
+var dmParticipantBeforeInsertHooks []DMParticipantHook
+var dmParticipantAfterInsertMu sync.Mutex
+var dmParticipantAfterInsertHooks []DMParticipantHook
+
+var dmParticipantBeforeUpdateMu sync.Mutex
+var dmParticipantBeforeUpdateHooks []DMParticipantHook
+var dmParticipantAfterUpdateMu sync.Mutex
+var dmParticipantAfterUpdateHooks []DMParticipantHook
+
+var dmParticipantBeforeDeleteMu sync.Mutex
+var dmParticipantBeforeDeleteHooks []DMParticipantHook
+var dmParticipantAfterDeleteMu sync.Mutex
+var dmParticipantAfterDeleteHooks []DMParticipantHook
+
+var dmParticipantBeforeUpsertMu sync.Mutex
+var dmParticipantBeforeUpsertHooks []DMParticipantHook
+var dmParticipantAfterUpsertMu sync.Mutex
+var dmParticipantAfterUpsertHooks []DMParticipantHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *DMParticipant) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dmParticipantAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *DMParticipant) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dmParticipantBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *DMParticipant) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dmParticipantAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *DMParticipant) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dmParticipantBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *DMParticipant) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dmParticipantAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *DMParticipant) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dmParticipantBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *DMParticipant) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dmParticipantAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *DMParticipant) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dmParticipantBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *DMParticipant) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dmParticipantAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddDMParticipantHook registers your hook function for all future operations.
+func AddDMParticipantHook(hookPoint boil.HookPoint, dmParticipantHook DMParticipantHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		dmParticipantAfterSelectMu.Lock()
+		dmParticipantAfterSelectHooks = append(dmParticipantAfterSelectHooks, dmParticipantHook)
+		dmParticipantAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		dmParticipantBeforeInsertMu.Lock()
+		dmParticipantBeforeInsertHooks = append(dmParticipantBeforeInsertHooks, dmParticipantHook)
+		dmParticipantBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		dmParticipantAfterInsertMu.Lock()
+		dmParticipantAfterInsertHooks = append(dmParticipantAfterInsertHooks, dmParticipantHook)
+		dmParticipantAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		dmParticipantBeforeUpdateMu.Lock()
+		dmParticipantBeforeUpdateHooks = append(dmParticipantBeforeUpdateHooks, dmParticipantHook)
+		dmParticipantBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		dmParticipantAfterUpdateMu.Lock()
+		dmParticipantAfterUpdateHooks = append(dmParticipantAfterUpdateHooks, dmParticipantHook)
+		dmParticipantAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		dmParticipantBeforeDeleteMu.Lock()
+		dmParticipantBeforeDeleteHooks = append(dmParticipantBeforeDeleteHooks, dmParticipantHook)
+		dmParticipantBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		dmParticipantAfterDeleteMu.Lock()
+		dmParticipantAfterDeleteHooks = append(dmParticipantAfterDeleteHooks, dmParticipantHook)
+		dmParticipantAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		dmParticipantBeforeUpsertMu.Lock()
+		dmParticipantBeforeUpsertHooks = append(dmParticipantBeforeUpsertHooks, dmParticipantHook)
+		dmParticipantBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		dmParticipantAfterUpsertMu.Lock()
+		dmParticipantAfterUpsertHooks = append(dmParticipantAfterUpsertHooks, dmParticipantHook)
+		dmParticipantAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single dmParticipant record from the query.
+func (q dmParticipantQuery) One(ctx context.Context, exec boil.ContextExecutor) (*DMParticipant, error) {
+	o := &DMParticipant{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "schema: failed to execute a one query for dm_participants")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all DMParticipant records from the query.
+func (q dmParticipantQuery) All(ctx context.Context, exec boil.ContextExecutor) (DMParticipantSlice, error) {
+	var o []*DMParticipant
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "schema: failed to assign all query results to DMParticipant slice")
+	}
+
+	if len(dmParticipantAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all DMParticipant records in the query.
+func (q dmParticipantQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: failed to count dm_participants rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q dmParticipantQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "schema: failed to check if dm_participants exists")
+	}
+
+	return count > 0, nil
+}
+
+// Conversation pointed to by the foreign key.
+func (o *DMParticipant) Conversation(mods ...qm.QueryMod) dmConversationQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`id` = ?", o.ConversationID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return DMConversations(queryMods...)
+}
+
+// User pointed to by the foreign key.
+func (o *DMParticipant) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`id` = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadConversation allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (dmParticipantL) LoadConversation(ctx context.Context, e boil.ContextExecutor, singular bool, maybeDMParticipant interface{}, mods queries.Applicator) error {
+	var slice []*DMParticipant
+	var object *DMParticipant
+
+	if singular {
+		var ok bool
+		object, ok = maybeDMParticipant.(*DMParticipant)
+		if !ok {
+			object = new(DMParticipant)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeDMParticipant)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeDMParticipant))
+			}
+		}
+	} else {
+		s, ok := maybeDMParticipant.(*[]*DMParticipant)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeDMParticipant)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeDMParticipant))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &dmParticipantR{}
+		}
+		args[object.ConversationID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &dmParticipantR{}
+			}
+
+			args[obj.ConversationID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`dm_conversations`),
+		qm.WhereIn(`dm_conversations.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load DMConversation")
+	}
+
+	var resultSlice []*DMConversation
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice DMConversation")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for dm_conversations")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for dm_conversations")
+	}
+
+	if len(dmConversationAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Conversation = foreign
+		if foreign.R == nil {
+			foreign.R = &dmConversationR{}
+		}
+		foreign.R.ConversationDMParticipants = append(foreign.R.ConversationDMParticipants, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ConversationID == foreign.ID {
+				local.R.Conversation = foreign
+				if foreign.R == nil {
+					foreign.R = &dmConversationR{}
+				}
+				foreign.R.ConversationDMParticipants = append(foreign.R.ConversationDMParticipants, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (dmParticipantL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeDMParticipant interface{}, mods queries.Applicator) error {
+	var slice []*DMParticipant
+	var object *DMParticipant
+
+	if singular {
+		var ok bool
+		object, ok = maybeDMParticipant.(*DMParticipant)
+		if !ok {
+			object = new(DMParticipant)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeDMParticipant)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeDMParticipant))
+			}
+		}
+	} else {
+		s, ok := maybeDMParticipant.(*[]*DMParticipant)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeDMParticipant)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeDMParticipant))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &dmParticipantR{}
+		}
+		args[object.UserID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &dmParticipantR{}
+			}
+
+			args[obj.UserID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`users.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.DMParticipants = append(foreign.R.DMParticipants, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.DMParticipants = append(foreign.R.DMParticipants, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetConversation of the dmParticipant to the related item.
+// Sets o.R.Conversation to related.
+// Adds o to related.R.ConversationDMParticipants.
+func (o *DMParticipant) SetConversation(ctx context.Context, exec boil.ContextExecutor, insert bool, related *DMConversation) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `dm_participants` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"conversation_id"}),
+		strmangle.WhereClause("`", "`", 0, dmParticipantPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ConversationID, o.UserID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ConversationID = related.ID
+	if o.R == nil {
+		o.R = &dmParticipantR{
+			Conversation: related,
+		}
+	} else {
+		o.R.Conversation = related
+	}
+
+	if related.R == nil {
+		related.R = &dmConversationR{
+			ConversationDMParticipants: DMParticipantSlice{o},
+		}
+	} else {
+		related.R.ConversationDMParticipants = append(related.R.ConversationDMParticipants, o)
+	}
+
+	return nil
+}
+
+// SetUser of the dmParticipant to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.DMParticipants.
+func (o *DMParticipant) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `dm_participants` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"user_id"}),
+		strmangle.WhereClause("`", "`", 0, dmParticipantPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ConversationID, o.UserID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &dmParticipantR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			DMParticipants: DMParticipantSlice{o},
+		}
+	} else {
+		related.R.DMParticipants = append(related.R.DMParticipants, o)
+	}
+
+	return nil
+}
+
+// DMParticipants retrieves all the records using an executor.
+func DMParticipants(mods ...qm.QueryMod) dmParticipantQuery {
+	mods = append(mods, qm.From("`dm_participants`"))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"`dm_participants`.*"})
+	}
+
+	return dmParticipantQuery{q}
+}
+
+// FindDMParticipant retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindDMParticipant(ctx context.Context, exec boil.ContextExecutor, conversationID int, userID int, selectCols ...string) (*DMParticipant, error) {
+	dmParticipantObj := &DMParticipant{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `dm_participants` where `conversation_id`=? AND `user_id`=?", sel,
+	)
+
+	q := queries.Raw(query, conversationID, userID)
+
+	err := q.Bind(ctx, exec, dmParticipantObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "schema: unable to select from dm_participants")
+	}
+
+	if err = dmParticipantObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return dmParticipantObj, err
+	}
+
+	return dmParticipantObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *DMParticipant) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("schema: no dm_participants provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(dmParticipantColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	dmParticipantInsertCacheMut.RLock()
+	cache, cached := dmParticipantInsertCache[key]
+	dmParticipantInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			dmParticipantAllColumns,
+			dmParticipantColumnsWithDefault,
+			dmParticipantColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(dmParticipantType, dmParticipantMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(dmParticipantType, dmParticipantMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `dm_participants` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `dm_participants` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `dm_participants` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, dmParticipantPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "schema: unable to insert into dm_participants")
+	}
+
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.ConversationID,
+		o.UserID,
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, identifierCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "schema: unable to populate default values for dm_participants")
+	}
+
+CacheNoHooks:
+	if !cached {
+		dmParticipantInsertCacheMut.Lock()
+		dmParticipantInsertCache[key] = cache
+		dmParticipantInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the DMParticipant.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *DMParticipant) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	dmParticipantUpdateCacheMut.RLock()
+	cache, cached := dmParticipantUpdateCache[key]
+	dmParticipantUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			dmParticipantAllColumns,
+			dmParticipantPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("schema: unable to update dm_participants, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `dm_participants` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, dmParticipantPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(dmParticipantType, dmParticipantMapping, append(wl, dmParticipantPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to update dm_participants row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: failed to get rows affected by update for dm_participants")
+	}
+
+	if !cached {
+		dmParticipantUpdateCacheMut.Lock()
+		dmParticipantUpdateCache[key] = cache
+		dmParticipantUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q dmParticipantQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to update all for dm_participants")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to retrieve rows affected for dm_participants")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o DMParticipantSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("schema: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), dmParticipantPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `dm_participants` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, dmParticipantPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to update all in dmParticipant slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to retrieve rows affected all in update all dmParticipant")
+	}
+	return rowsAff, nil
+}
+
+var mySQLDMParticipantUniqueColumns = []string{}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *DMParticipant) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("schema: no dm_participants provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(dmParticipantColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLDMParticipantUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	dmParticipantUpsertCacheMut.RLock()
+	cache, cached := dmParticipantUpsertCache[key]
+	dmParticipantUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			dmParticipantAllColumns,
+			dmParticipantColumnsWithDefault,
+			dmParticipantColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			dmParticipantAllColumns,
+			dmParticipantPrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("schema: unable to upsert dm_participants, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(dmParticipantAllColumns, strmangle.SetIntersect(insert, update))
+
+		cache.query = buildUpsertQueryMySQL(dialect, "`dm_participants`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `dm_participants` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(dmParticipantType, dmParticipantMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(dmParticipantType, dmParticipantMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "schema: unable to upsert for dm_participants")
+	}
+
+	var uniqueMap []uint64
+	var nzUniqueCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(dmParticipantType, dmParticipantMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "schema: unable to retrieve unique values for dm_participants")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, nzUniqueCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "schema: unable to populate default values for dm_participants")
+	}
+
+CacheNoHooks:
+	if !cached {
+		dmParticipantUpsertCacheMut.Lock()
+		dmParticipantUpsertCache[key] = cache
+		dmParticipantUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single DMParticipant record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *DMParticipant) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("schema: no DMParticipant provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), dmParticipantPrimaryKeyMapping)
+	sql := "DELETE FROM `dm_participants` WHERE `conversation_id`=? AND `user_id`=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to delete from dm_participants")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: failed to get rows affected by delete for dm_participants")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q dmParticipantQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("schema: no dmParticipantQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to delete all from dm_participants")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: failed to get rows affected by deleteall for dm_participants")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o DMParticipantSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(dmParticipantBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), dmParticipantPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `dm_participants` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, dmParticipantPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to delete all from dmParticipant slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: failed to get rows affected by deleteall for dm_participants")
+	}
+
+	if len(dmParticipantAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *DMParticipant) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindDMParticipant(ctx, exec, o.ConversationID, o.UserID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *DMParticipantSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := DMParticipantSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), dmParticipantPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `dm_participants`.* FROM `dm_participants` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, dmParticipantPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "schema: unable to reload all in DMParticipantSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// DMParticipantExists checks if the DMParticipant row exists.
+func DMParticipantExists(ctx context.Context, exec boil.ContextExecutor, conversationID int, userID int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `dm_participants` where `conversation_id`=? AND `user_id`=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, conversationID, userID)
+	}
+	row := exec.QueryRowContext(ctx, sql, conversationID, userID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "schema: unable to check if dm_participants exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the DMParticipant row exists.
+func (o *DMParticipant) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return DMParticipantExists(ctx, exec, o.ConversationID, o.UserID)
+}
@@ -496,6 +496,7 @@ func (likeL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool
 	query := NewQuery(
 		qm.From(`users`),
 		qm.WhereIn(`users.id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`users.deleted_at`),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -616,6 +617,7 @@ func (likeL) LoadTweet(ctx context.Context, e boil.ContextExecutor, singular boo
 	query := NewQuery(
 		qm.From(`tweets`),
 		qm.WhereIn(`tweets.id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`tweets.deleted_at`),
 	)
 	if mods != nil {
 		mods.Apply(query)
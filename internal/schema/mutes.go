@@ -0,0 +1,1361 @@
+// Code generated by SQLBoiler 4.18.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package schema
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// Mute is an object representing the database table.
+type Mute struct {
+	MuterID   int       `boil:"muter_id" json:"muter_id" toml:"muter_id" yaml:"muter_id"`
+	MutedID   int       `boil:"muted_id" json:"muted_id" toml:"muted_id" yaml:"muted_id"`
+	CreatedAt null.Time `boil:"created_at" json:"created_at,omitempty" toml:"created_at" yaml:"created_at,omitempty"`
+
+	R *muteR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L muteL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var MuteColumns = struct {
+	MuterID   string
+	MutedID   string
+	CreatedAt string
+}{
+	MuterID:   "muter_id",
+	MutedID:   "muted_id",
+	CreatedAt: "created_at",
+}
+
+var MuteTableColumns = struct {
+	MuterID   string
+	MutedID   string
+	CreatedAt string
+}{
+	MuterID:   "mutes.muter_id",
+	MutedID:   "mutes.muted_id",
+	CreatedAt: "mutes.created_at",
+}
+
+// Generated where
+
+var MuteWhere = struct {
+	MuterID   whereHelperint
+	MutedID   whereHelperint
+	CreatedAt whereHelpernull_Time
+}{
+	MuterID:   whereHelperint{field: "`mutes`.`muter_id`"},
+	MutedID:   whereHelperint{field: "`mutes`.`muted_id`"},
+	CreatedAt: whereHelpernull_Time{field: "`mutes`.`created_at`"},
+}
+
+// MuteRels is where relationship names are stored.
+var MuteRels = struct {
+	Muter string
+	Muted string
+}{
+	Muter: "Muter",
+	Muted: "Muted",
+}
+
+// muteR is where relationships are stored.
+type muteR struct {
+	Muter *User `boil:"Muter" json:"Muter" toml:"Muter" yaml:"Muter"`
+	Muted *User `boil:"Muted" json:"Muted" toml:"Muted" yaml:"Muted"`
+}
+
+// NewStruct creates a new relationship struct
+func (*muteR) NewStruct() *muteR {
+	return &muteR{}
+}
+
+func (r *muteR) GetMuter() *User {
+	if r == nil {
+		return nil
+	}
+	return r.Muter
+}
+
+func (r *muteR) GetMuted() *User {
+	if r == nil {
+		return nil
+	}
+	return r.Muted
+}
+
+// muteL is where Load methods for each relationship are stored.
+type muteL struct{}
+
+var (
+	muteAllColumns            = []string{"muter_id", "muted_id", "created_at"}
+	muteColumnsWithoutDefault = []string{"muter_id", "muted_id"}
+	muteColumnsWithDefault    = []string{"created_at"}
+	mutePrimaryKeyColumns     = []string{"muter_id", "muted_id"}
+	muteGeneratedColumns      = []string{}
+)
+
+type (
+	// MuteSlice is an alias for a slice of pointers to Mute.
+	// This should almost always be used instead of []Mute.
+	MuteSlice []*Mute
+	// MuteHook is the signature for custom Mute hook methods
+	MuteHook func(context.Context, boil.ContextExecutor, *Mute) error
+
+	muteQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	muteType                 = reflect.TypeOf(&Mute{})
+	muteMapping              = queries.MakeStructMapping(muteType)
+	mutePrimaryKeyMapping, _ = queries.BindMapping(muteType, muteMapping, mutePrimaryKeyColumns)
+	muteInsertCacheMut       sync.RWMutex
+	muteInsertCache          = make(map[string]insertCache)
+	muteUpdateCacheMut       sync.RWMutex
+	muteUpdateCache          = make(map[string]updateCache)
+	muteUpsertCacheMut       sync.RWMutex
+	muteUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var muteAfterSelectMu sync.Mutex
+var muteAfterSelectHooks []MuteHook
+
+var muteBeforeInsertMu sync.Mutex
+var muteBeforeInsertHooks []MuteHook
+var muteAfterInsertMu sync.Mutex
+var muteAfterInsertHooks []MuteHook
+
+var muteBeforeUpdateMu sync.Mutex
+var muteBeforeUpdateHooks []MuteHook
+var muteAfterUpdateMu sync.Mutex
+var muteAfterUpdateHooks []MuteHook
+
+var muteBeforeDeleteMu sync.Mutex
+var muteBeforeDeleteHooks []MuteHook
+var muteAfterDeleteMu sync.Mutex
+var muteAfterDeleteHooks []MuteHook
+
+var muteBeforeUpsertMu sync.Mutex
+var muteBeforeUpsertHooks []MuteHook
+var muteAfterUpsertMu sync.Mutex
+var muteAfterUpsertHooks []MuteHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *Mute) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range muteAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *Mute) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range muteBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *Mute) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range muteAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *Mute) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range muteBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *Mute) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range muteAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *Mute) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range muteBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *Mute) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range muteAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *Mute) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range muteBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *Mute) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range muteAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddMuteHook registers your hook function for all future operations.
+func AddMuteHook(hookPoint boil.HookPoint, muteHook MuteHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		muteAfterSelectMu.Lock()
+		muteAfterSelectHooks = append(muteAfterSelectHooks, muteHook)
+		muteAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		muteBeforeInsertMu.Lock()
+		muteBeforeInsertHooks = append(muteBeforeInsertHooks, muteHook)
+		muteBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		muteAfterInsertMu.Lock()
+		muteAfterInsertHooks = append(muteAfterInsertHooks, muteHook)
+		muteAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		muteBeforeUpdateMu.Lock()
+		muteBeforeUpdateHooks = append(muteBeforeUpdateHooks, muteHook)
+		muteBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		muteAfterUpdateMu.Lock()
+		muteAfterUpdateHooks = append(muteAfterUpdateHooks, muteHook)
+		muteAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		muteBeforeDeleteMu.Lock()
+		muteBeforeDeleteHooks = append(muteBeforeDeleteHooks, muteHook)
+		muteBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		muteAfterDeleteMu.Lock()
+		muteAfterDeleteHooks = append(muteAfterDeleteHooks, muteHook)
+		muteAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		muteBeforeUpsertMu.Lock()
+		muteBeforeUpsertHooks = append(muteBeforeUpsertHooks, muteHook)
+		muteBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		muteAfterUpsertMu.Lock()
+		muteAfterUpsertHooks = append(muteAfterUpsertHooks, muteHook)
+		muteAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single mute record from the query.
+func (q muteQuery) One(ctx context.Context, exec boil.ContextExecutor) (*Mute, error) {
+	o := &Mute{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "schema: failed to execute a one query for mutes")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all Mute records from the query.
+func (q muteQuery) All(ctx context.Context, exec boil.ContextExecutor) (MuteSlice, error) {
+	var o []*Mute
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "schema: failed to assign all query results to Mute slice")
+	}
+
+	if len(muteAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all Mute records in the query.
+func (q muteQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: failed to count mutes rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q muteQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "schema: failed to check if mutes exists")
+	}
+
+	return count > 0, nil
+}
+
+// Muter pointed to by the foreign key.
+func (o *Mute) Muter(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`id` = ?", o.MuterID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// Muted pointed to by the foreign key.
+func (o *Mute) Muted(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`id` = ?", o.MutedID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadMuter allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (muteL) LoadMuter(ctx context.Context, e boil.ContextExecutor, singular bool, maybeMute interface{}, mods queries.Applicator) error {
+	var slice []*Mute
+	var object *Mute
+
+	if singular {
+		var ok bool
+		object, ok = maybeMute.(*Mute)
+		if !ok {
+			object = new(Mute)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeMute)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeMute))
+			}
+		}
+	} else {
+		s, ok := maybeMute.(*[]*Mute)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeMute)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeMute))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &muteR{}
+		}
+		args[object.MuterID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &muteR{}
+			}
+
+			args[obj.MuterID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`users.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Muter = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.MuterMutes = append(foreign.R.MuterMutes, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.MuterID == foreign.ID {
+				local.R.Muter = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.MuterMutes = append(foreign.R.MuterMutes, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadMuted allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (muteL) LoadMuted(ctx context.Context, e boil.ContextExecutor, singular bool, maybeMute interface{}, mods queries.Applicator) error {
+	var slice []*Mute
+	var object *Mute
+
+	if singular {
+		var ok bool
+		object, ok = maybeMute.(*Mute)
+		if !ok {
+			object = new(Mute)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeMute)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeMute))
+			}
+		}
+	} else {
+		s, ok := maybeMute.(*[]*Mute)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeMute)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeMute))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &muteR{}
+		}
+		args[object.MutedID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &muteR{}
+			}
+
+			args[obj.MutedID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`users.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Muted = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.MutedMutes = append(foreign.R.MutedMutes, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.MutedID == foreign.ID {
+				local.R.Muted = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.MutedMutes = append(foreign.R.MutedMutes, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetMuter of the mute to the related item.
+// Sets o.R.Muter to related.
+// Adds o to related.R.MuterMutes.
+func (o *Mute) SetMuter(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `mutes` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"muter_id"}),
+		strmangle.WhereClause("`", "`", 0, mutePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.MuterID, o.MutedID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.MuterID = related.ID
+	if o.R == nil {
+		o.R = &muteR{
+			Muter: related,
+		}
+	} else {
+		o.R.Muter = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			MuterMutes: MuteSlice{o},
+		}
+	} else {
+		related.R.MuterMutes = append(related.R.MuterMutes, o)
+	}
+
+	return nil
+}
+
+// SetMuted of the mute to the related item.
+// Sets o.R.Muted to related.
+// Adds o to related.R.MutedMutes.
+func (o *Mute) SetMuted(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `mutes` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"muted_id"}),
+		strmangle.WhereClause("`", "`", 0, mutePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.MuterID, o.MutedID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.MutedID = related.ID
+	if o.R == nil {
+		o.R = &muteR{
+			Muted: related,
+		}
+	} else {
+		o.R.Muted = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			MutedMutes: MuteSlice{o},
+		}
+	} else {
+		related.R.MutedMutes = append(related.R.MutedMutes, o)
+	}
+
+	return nil
+}
+
+// Mutes retrieves all the records using an executor.
+func Mutes(mods ...qm.QueryMod) muteQuery {
+	mods = append(mods, qm.From("`mutes`"))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"`mutes`.*"})
+	}
+
+	return muteQuery{q}
+}
+
+// FindMute retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindMute(ctx context.Context, exec boil.ContextExecutor, muterID int, mutedID int, selectCols ...string) (*Mute, error) {
+	muteObj := &Mute{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `mutes` where `muter_id`=? AND `muted_id`=?", sel,
+	)
+
+	q := queries.Raw(query, muterID, mutedID)
+
+	err := q.Bind(ctx, exec, muteObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "schema: unable to select from mutes")
+	}
+
+	if err = muteObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return muteObj, err
+	}
+
+	return muteObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *Mute) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("schema: no mutes provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(muteColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	muteInsertCacheMut.RLock()
+	cache, cached := muteInsertCache[key]
+	muteInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			muteAllColumns,
+			muteColumnsWithDefault,
+			muteColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(muteType, muteMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(muteType, muteMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `mutes` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `mutes` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `mutes` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, mutePrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "schema: unable to insert into mutes")
+	}
+
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.MuterID,
+		o.MutedID,
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, identifierCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "schema: unable to populate default values for mutes")
+	}
+
+CacheNoHooks:
+	if !cached {
+		muteInsertCacheMut.Lock()
+		muteInsertCache[key] = cache
+		muteInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the Mute.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *Mute) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	muteUpdateCacheMut.RLock()
+	cache, cached := muteUpdateCache[key]
+	muteUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			muteAllColumns,
+			mutePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("schema: unable to update mutes, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `mutes` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, mutePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(muteType, muteMapping, append(wl, mutePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to update mutes row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: failed to get rows affected by update for mutes")
+	}
+
+	if !cached {
+		muteUpdateCacheMut.Lock()
+		muteUpdateCache[key] = cache
+		muteUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q muteQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to update all for mutes")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to retrieve rows affected for mutes")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o MuteSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("schema: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), mutePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `mutes` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, mutePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to update all in mute slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to retrieve rows affected all in update all mute")
+	}
+	return rowsAff, nil
+}
+
+var mySQLMuteUniqueColumns = []string{}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *Mute) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("schema: no mutes provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(muteColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLMuteUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	muteUpsertCacheMut.RLock()
+	cache, cached := muteUpsertCache[key]
+	muteUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			muteAllColumns,
+			muteColumnsWithDefault,
+			muteColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			muteAllColumns,
+			mutePrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("schema: unable to upsert mutes, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(muteAllColumns, strmangle.SetIntersect(insert, update))
+
+		cache.query = buildUpsertQueryMySQL(dialect, "`mutes`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `mutes` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(muteType, muteMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(muteType, muteMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "schema: unable to upsert for mutes")
+	}
+
+	var uniqueMap []uint64
+	var nzUniqueCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(muteType, muteMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "schema: unable to retrieve unique values for mutes")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, nzUniqueCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "schema: unable to populate default values for mutes")
+	}
+
+CacheNoHooks:
+	if !cached {
+		muteUpsertCacheMut.Lock()
+		muteUpsertCache[key] = cache
+		muteUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single Mute record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *Mute) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("schema: no Mute provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), mutePrimaryKeyMapping)
+	sql := "DELETE FROM `mutes` WHERE `muter_id`=? AND `muted_id`=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to delete from mutes")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: failed to get rows affected by delete for mutes")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q muteQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("schema: no muteQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to delete all from mutes")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: failed to get rows affected by deleteall for mutes")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o MuteSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(muteBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), mutePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `mutes` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, mutePrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to delete all from mute slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: failed to get rows affected by deleteall for mutes")
+	}
+
+	if len(muteAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *Mute) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindMute(ctx, exec, o.MuterID, o.MutedID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *MuteSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := MuteSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), mutePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `mutes`.* FROM `mutes` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, mutePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "schema: unable to reload all in MuteSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// MuteExists checks if the Mute row exists.
+func MuteExists(ctx context.Context, exec boil.ContextExecutor, muterID int, mutedID int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `mutes` where `muter_id`=? AND `muted_id`=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, muterID, mutedID)
+	}
+	row := exec.QueryRowContext(ctx, sql, muterID, mutedID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "schema: unable to check if mutes exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the Mute row exists.
+func (o *Mute) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return MuteExists(ctx, exec, o.MuterID, o.MutedID)
+}
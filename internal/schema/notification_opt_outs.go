@@ -0,0 +1,1172 @@
+// Code generated by SQLBoiler 4.18.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package schema
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// NotificationOptOut is an object representing the database table.
+type NotificationOptOut struct {
+	UserID    int       `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	Type      string    `boil:"type" json:"type" toml:"type" yaml:"type"`
+	CreatedAt null.Time `boil:"created_at" json:"created_at,omitempty" toml:"created_at" yaml:"created_at,omitempty"`
+
+	R *notificationOptOutR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L notificationOptOutL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var NotificationOptOutColumns = struct {
+	UserID    string
+	Type      string
+	CreatedAt string
+}{
+	UserID:    "user_id",
+	Type:      "type",
+	CreatedAt: "created_at",
+}
+
+var NotificationOptOutTableColumns = struct {
+	UserID    string
+	Type      string
+	CreatedAt string
+}{
+	UserID:    "notification_opt_outs.user_id",
+	Type:      "notification_opt_outs.type",
+	CreatedAt: "notification_opt_outs.created_at",
+}
+
+// Generated where
+
+var NotificationOptOutWhere = struct {
+	UserID    whereHelperint
+	Type      whereHelperstring
+	CreatedAt whereHelpernull_Time
+}{
+	UserID:    whereHelperint{field: "`notification_opt_outs`.`user_id`"},
+	Type:      whereHelperstring{field: "`notification_opt_outs`.`type`"},
+	CreatedAt: whereHelpernull_Time{field: "`notification_opt_outs`.`created_at`"},
+}
+
+// NotificationOptOutRels is where relationship names are stored.
+var NotificationOptOutRels = struct {
+	User string
+}{
+	User: "User",
+}
+
+// notificationOptOutR is where relationships are stored.
+type notificationOptOutR struct {
+	User *User `boil:"User" json:"User" toml:"User" yaml:"User"`
+}
+
+// NewStruct creates a new relationship struct
+func (*notificationOptOutR) NewStruct() *notificationOptOutR {
+	return &notificationOptOutR{}
+}
+
+func (r *notificationOptOutR) GetUser() *User {
+	if r == nil {
+		return nil
+	}
+	return r.User
+}
+
+// notificationOptOutL is where Load methods for each relationship are stored.
+type notificationOptOutL struct{}
+
+var (
+	notificationOptOutAllColumns            = []string{"user_id", "type", "created_at"}
+	notificationOptOutColumnsWithoutDefault = []string{"user_id", "type"}
+	notificationOptOutColumnsWithDefault    = []string{"created_at"}
+	notificationOptOutPrimaryKeyColumns     = []string{"user_id", "type"}
+	notificationOptOutGeneratedColumns      = []string{}
+)
+
+type (
+	// NotificationOptOutSlice is an alias for a slice of pointers to NotificationOptOut.
+	// This should almost always be used instead of []NotificationOptOut.
+	NotificationOptOutSlice []*NotificationOptOut
+	// NotificationOptOutHook is the signature for custom NotificationOptOut hook methods
+	NotificationOptOutHook func(context.Context, boil.ContextExecutor, *NotificationOptOut) error
+
+	notificationOptOutQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	notificationOptOutType                 = reflect.TypeOf(&NotificationOptOut{})
+	notificationOptOutMapping              = queries.MakeStructMapping(notificationOptOutType)
+	notificationOptOutPrimaryKeyMapping, _ = queries.BindMapping(notificationOptOutType, notificationOptOutMapping, notificationOptOutPrimaryKeyColumns)
+	notificationOptOutInsertCacheMut       sync.RWMutex
+	notificationOptOutInsertCache          = make(map[string]insertCache)
+	notificationOptOutUpdateCacheMut       sync.RWMutex
+	notificationOptOutUpdateCache          = make(map[string]updateCache)
+	notificationOptOutUpsertCacheMut       sync.RWMutex
+	notificationOptOutUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var notificationOptOutAfterSelectMu sync.Mutex
+var notificationOptOutAfterSelectHooks []NotificationOptOutHook
+
+var notificationOptOutBeforeInsertMu sync.Mutex
+var notificationOptOutBeforeInsertHooks []NotificationOptOutHook
+var notificationOptOutAfterInsertMu sync.Mutex
+var notificationOptOutAfterInsertHooks []NotificationOptOutHook
+
+var notificationOptOutBeforeUpdateMu sync.Mutex
+var notificationOptOutBeforeUpdateHooks []NotificationOptOutHook
+var notificationOptOutAfterUpdateMu sync.Mutex
+var notificationOptOutAfterUpdateHooks []NotificationOptOutHook
+
+var notificationOptOutBeforeDeleteMu sync.Mutex
+var notificationOptOutBeforeDeleteHooks []NotificationOptOutHook
+var notificationOptOutAfterDeleteMu sync.Mutex
+var notificationOptOutAfterDeleteHooks []NotificationOptOutHook
+
+var notificationOptOutBeforeUpsertMu sync.Mutex
+var notificationOptOutBeforeUpsertHooks []NotificationOptOutHook
+var notificationOptOutAfterUpsertMu sync.Mutex
+var notificationOptOutAfterUpsertHooks []NotificationOptOutHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *NotificationOptOut) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range notificationOptOutAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *NotificationOptOut) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range notificationOptOutBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *NotificationOptOut) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range notificationOptOutAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *NotificationOptOut) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range notificationOptOutBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *NotificationOptOut) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range notificationOptOutAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *NotificationOptOut) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range notificationOptOutBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *NotificationOptOut) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range notificationOptOutAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *NotificationOptOut) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range notificationOptOutBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *NotificationOptOut) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range notificationOptOutAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddNotificationOptOutHook registers your hook function for all future operations.
+func AddNotificationOptOutHook(hookPoint boil.HookPoint, notificationOptOutHook NotificationOptOutHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		notificationOptOutAfterSelectMu.Lock()
+		notificationOptOutAfterSelectHooks = append(notificationOptOutAfterSelectHooks, notificationOptOutHook)
+		notificationOptOutAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		notificationOptOutBeforeInsertMu.Lock()
+		notificationOptOutBeforeInsertHooks = append(notificationOptOutBeforeInsertHooks, notificationOptOutHook)
+		notificationOptOutBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		notificationOptOutAfterInsertMu.Lock()
+		notificationOptOutAfterInsertHooks = append(notificationOptOutAfterInsertHooks, notificationOptOutHook)
+		notificationOptOutAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		notificationOptOutBeforeUpdateMu.Lock()
+		notificationOptOutBeforeUpdateHooks = append(notificationOptOutBeforeUpdateHooks, notificationOptOutHook)
+		notificationOptOutBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		notificationOptOutAfterUpdateMu.Lock()
+		notificationOptOutAfterUpdateHooks = append(notificationOptOutAfterUpdateHooks, notificationOptOutHook)
+		notificationOptOutAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		notificationOptOutBeforeDeleteMu.Lock()
+		notificationOptOutBeforeDeleteHooks = append(notificationOptOutBeforeDeleteHooks, notificationOptOutHook)
+		notificationOptOutBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		notificationOptOutAfterDeleteMu.Lock()
+		notificationOptOutAfterDeleteHooks = append(notificationOptOutAfterDeleteHooks, notificationOptOutHook)
+		notificationOptOutAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		notificationOptOutBeforeUpsertMu.Lock()
+		notificationOptOutBeforeUpsertHooks = append(notificationOptOutBeforeUpsertHooks, notificationOptOutHook)
+		notificationOptOutBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		notificationOptOutAfterUpsertMu.Lock()
+		notificationOptOutAfterUpsertHooks = append(notificationOptOutAfterUpsertHooks, notificationOptOutHook)
+		notificationOptOutAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single notificationOptOut record from the query.
+func (q notificationOptOutQuery) One(ctx context.Context, exec boil.ContextExecutor) (*NotificationOptOut, error) {
+	o := &NotificationOptOut{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "schema: failed to execute a one query for notification_opt_outs")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all NotificationOptOut records from the query.
+func (q notificationOptOutQuery) All(ctx context.Context, exec boil.ContextExecutor) (NotificationOptOutSlice, error) {
+	var o []*NotificationOptOut
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "schema: failed to assign all query results to NotificationOptOut slice")
+	}
+
+	if len(notificationOptOutAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all NotificationOptOut records in the query.
+func (q notificationOptOutQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: failed to count notification_opt_outs rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q notificationOptOutQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "schema: failed to check if notification_opt_outs exists")
+	}
+
+	return count > 0, nil
+}
+
+// User pointed to by the foreign key.
+func (o *NotificationOptOut) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`id` = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (notificationOptOutL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeNotificationOptOut interface{}, mods queries.Applicator) error {
+	var slice []*NotificationOptOut
+	var object *NotificationOptOut
+
+	if singular {
+		var ok bool
+		object, ok = maybeNotificationOptOut.(*NotificationOptOut)
+		if !ok {
+			object = new(NotificationOptOut)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeNotificationOptOut)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeNotificationOptOut))
+			}
+		}
+	} else {
+		s, ok := maybeNotificationOptOut.(*[]*NotificationOptOut)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeNotificationOptOut)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeNotificationOptOut))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &notificationOptOutR{}
+		}
+		args[object.UserID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &notificationOptOutR{}
+			}
+
+			args[obj.UserID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`users.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.NotificationOptOuts = append(foreign.R.NotificationOptOuts, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.NotificationOptOuts = append(foreign.R.NotificationOptOuts, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetUser of the notificationOptOut to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.NotificationOptOuts.
+func (o *NotificationOptOut) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `notification_opt_outs` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"user_id"}),
+		strmangle.WhereClause("`", "`", 0, notificationOptOutPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.UserID, o.Type}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &notificationOptOutR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			NotificationOptOuts: NotificationOptOutSlice{o},
+		}
+	} else {
+		related.R.NotificationOptOuts = append(related.R.NotificationOptOuts, o)
+	}
+
+	return nil
+}
+
+// NotificationOptOuts retrieves all the records using an executor.
+func NotificationOptOuts(mods ...qm.QueryMod) notificationOptOutQuery {
+	mods = append(mods, qm.From("`notification_opt_outs`"))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"`notification_opt_outs`.*"})
+	}
+
+	return notificationOptOutQuery{q}
+}
+
+// FindNotificationOptOut retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindNotificationOptOut(ctx context.Context, exec boil.ContextExecutor, userID int, type_ string, selectCols ...string) (*NotificationOptOut, error) {
+	notificationOptOutObj := &NotificationOptOut{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `notification_opt_outs` where `user_id`=? AND `type`=?", sel,
+	)
+
+	q := queries.Raw(query, userID, type_)
+
+	err := q.Bind(ctx, exec, notificationOptOutObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "schema: unable to select from notification_opt_outs")
+	}
+
+	if err = notificationOptOutObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return notificationOptOutObj, err
+	}
+
+	return notificationOptOutObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *NotificationOptOut) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("schema: no notification_opt_outs provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(notificationOptOutColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	notificationOptOutInsertCacheMut.RLock()
+	cache, cached := notificationOptOutInsertCache[key]
+	notificationOptOutInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			notificationOptOutAllColumns,
+			notificationOptOutColumnsWithDefault,
+			notificationOptOutColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(notificationOptOutType, notificationOptOutMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(notificationOptOutType, notificationOptOutMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `notification_opt_outs` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `notification_opt_outs` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `notification_opt_outs` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, notificationOptOutPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "schema: unable to insert into notification_opt_outs")
+	}
+
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.UserID,
+		o.Type,
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, identifierCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "schema: unable to populate default values for notification_opt_outs")
+	}
+
+CacheNoHooks:
+	if !cached {
+		notificationOptOutInsertCacheMut.Lock()
+		notificationOptOutInsertCache[key] = cache
+		notificationOptOutInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the NotificationOptOut.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *NotificationOptOut) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	notificationOptOutUpdateCacheMut.RLock()
+	cache, cached := notificationOptOutUpdateCache[key]
+	notificationOptOutUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			notificationOptOutAllColumns,
+			notificationOptOutPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("schema: unable to update notification_opt_outs, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `notification_opt_outs` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, notificationOptOutPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(notificationOptOutType, notificationOptOutMapping, append(wl, notificationOptOutPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to update notification_opt_outs row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: failed to get rows affected by update for notification_opt_outs")
+	}
+
+	if !cached {
+		notificationOptOutUpdateCacheMut.Lock()
+		notificationOptOutUpdateCache[key] = cache
+		notificationOptOutUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q notificationOptOutQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to update all for notification_opt_outs")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to retrieve rows affected for notification_opt_outs")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o NotificationOptOutSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("schema: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), notificationOptOutPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `notification_opt_outs` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, notificationOptOutPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to update all in notificationOptOut slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to retrieve rows affected all in update all notificationOptOut")
+	}
+	return rowsAff, nil
+}
+
+var mySQLNotificationOptOutUniqueColumns = []string{}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *NotificationOptOut) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("schema: no notification_opt_outs provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(notificationOptOutColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLNotificationOptOutUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	notificationOptOutUpsertCacheMut.RLock()
+	cache, cached := notificationOptOutUpsertCache[key]
+	notificationOptOutUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			notificationOptOutAllColumns,
+			notificationOptOutColumnsWithDefault,
+			notificationOptOutColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			notificationOptOutAllColumns,
+			notificationOptOutPrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("schema: unable to upsert notification_opt_outs, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(notificationOptOutAllColumns, strmangle.SetIntersect(insert, update))
+
+		cache.query = buildUpsertQueryMySQL(dialect, "`notification_opt_outs`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `notification_opt_outs` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(notificationOptOutType, notificationOptOutMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(notificationOptOutType, notificationOptOutMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "schema: unable to upsert for notification_opt_outs")
+	}
+
+	var uniqueMap []uint64
+	var nzUniqueCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(notificationOptOutType, notificationOptOutMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "schema: unable to retrieve unique values for notification_opt_outs")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, nzUniqueCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "schema: unable to populate default values for notification_opt_outs")
+	}
+
+CacheNoHooks:
+	if !cached {
+		notificationOptOutUpsertCacheMut.Lock()
+		notificationOptOutUpsertCache[key] = cache
+		notificationOptOutUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single NotificationOptOut record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *NotificationOptOut) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("schema: no NotificationOptOut provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), notificationOptOutPrimaryKeyMapping)
+	sql := "DELETE FROM `notification_opt_outs` WHERE `user_id`=? AND `type`=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to delete from notification_opt_outs")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: failed to get rows affected by delete for notification_opt_outs")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q notificationOptOutQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("schema: no notificationOptOutQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to delete all from notification_opt_outs")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: failed to get rows affected by deleteall for notification_opt_outs")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o NotificationOptOutSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(notificationOptOutBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), notificationOptOutPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `notification_opt_outs` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, notificationOptOutPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to delete all from notificationOptOut slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: failed to get rows affected by deleteall for notification_opt_outs")
+	}
+
+	if len(notificationOptOutAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *NotificationOptOut) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindNotificationOptOut(ctx, exec, o.UserID, o.Type)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *NotificationOptOutSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := NotificationOptOutSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), notificationOptOutPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `notification_opt_outs`.* FROM `notification_opt_outs` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, notificationOptOutPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "schema: unable to reload all in NotificationOptOutSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// NotificationOptOutExists checks if the NotificationOptOut row exists.
+func NotificationOptOutExists(ctx context.Context, exec boil.ContextExecutor, userID int, type_ string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `notification_opt_outs` where `user_id`=? AND `type`=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, userID, type_)
+	}
+	row := exec.QueryRowContext(ctx, sql, userID, type_)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "schema: unable to check if notification_opt_outs exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the NotificationOptOut row exists.
+func (o *NotificationOptOut) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return NotificationOptOutExists(ctx, exec, o.UserID, o.Type)
+}
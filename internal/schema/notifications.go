@@ -0,0 +1,1638 @@
+// Code generated by SQLBoiler 4.18.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package schema
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// Notification is an object representing the database table.
+type Notification struct {
+	ID        int       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	UserID    int       `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	ActorID   int       `boil:"actor_id" json:"actor_id" toml:"actor_id" yaml:"actor_id"`
+	Type      string    `boil:"type" json:"type" toml:"type" yaml:"type"`
+	TweetID   null.Int  `boil:"tweet_id" json:"tweet_id,omitempty" toml:"tweet_id" yaml:"tweet_id,omitempty"`
+	ReadAt    null.Time `boil:"read_at" json:"read_at,omitempty" toml:"read_at" yaml:"read_at,omitempty"`
+	CreatedAt null.Time `boil:"created_at" json:"created_at,omitempty" toml:"created_at" yaml:"created_at,omitempty"`
+
+	R *notificationR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L notificationL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var NotificationColumns = struct {
+	ID        string
+	UserID    string
+	ActorID   string
+	Type      string
+	TweetID   string
+	ReadAt    string
+	CreatedAt string
+}{
+	ID:        "id",
+	UserID:    "user_id",
+	ActorID:   "actor_id",
+	Type:      "type",
+	TweetID:   "tweet_id",
+	ReadAt:    "read_at",
+	CreatedAt: "created_at",
+}
+
+var NotificationTableColumns = struct {
+	ID        string
+	UserID    string
+	ActorID   string
+	Type      string
+	TweetID   string
+	ReadAt    string
+	CreatedAt string
+}{
+	ID:        "notifications.id",
+	UserID:    "notifications.user_id",
+	ActorID:   "notifications.actor_id",
+	Type:      "notifications.type",
+	TweetID:   "notifications.tweet_id",
+	ReadAt:    "notifications.read_at",
+	CreatedAt: "notifications.created_at",
+}
+
+// Generated where
+
+var NotificationWhere = struct {
+	ID        whereHelperint
+	UserID    whereHelperint
+	ActorID   whereHelperint
+	Type      whereHelperstring
+	TweetID   whereHelpernull_Int
+	ReadAt    whereHelpernull_Time
+	CreatedAt whereHelpernull_Time
+}{
+	ID:        whereHelperint{field: "`notifications`.`id`"},
+	UserID:    whereHelperint{field: "`notifications`.`user_id`"},
+	ActorID:   whereHelperint{field: "`notifications`.`actor_id`"},
+	Type:      whereHelperstring{field: "`notifications`.`type`"},
+	TweetID:   whereHelpernull_Int{field: "`notifications`.`tweet_id`"},
+	ReadAt:    whereHelpernull_Time{field: "`notifications`.`read_at`"},
+	CreatedAt: whereHelpernull_Time{field: "`notifications`.`created_at`"},
+}
+
+// NotificationRels is where relationship names are stored.
+var NotificationRels = struct {
+	User  string
+	Actor string
+	Tweet string
+}{
+	User:  "User",
+	Actor: "Actor",
+	Tweet: "Tweet",
+}
+
+// notificationR is where relationships are stored.
+type notificationR struct {
+	User  *User  `boil:"User" json:"User" toml:"User" yaml:"User"`
+	Actor *User  `boil:"Actor" json:"Actor" toml:"Actor" yaml:"Actor"`
+	Tweet *Tweet `boil:"Tweet" json:"Tweet" toml:"Tweet" yaml:"Tweet"`
+}
+
+// NewStruct creates a new relationship struct
+func (*notificationR) NewStruct() *notificationR {
+	return &notificationR{}
+}
+
+func (r *notificationR) GetUser() *User {
+	if r == nil {
+		return nil
+	}
+	return r.User
+}
+
+func (r *notificationR) GetActor() *User {
+	if r == nil {
+		return nil
+	}
+	return r.Actor
+}
+
+func (r *notificationR) GetTweet() *Tweet {
+	if r == nil {
+		return nil
+	}
+	return r.Tweet
+}
+
+// notificationL is where Load methods for each relationship are stored.
+type notificationL struct{}
+
+var (
+	notificationAllColumns            = []string{"id", "user_id", "actor_id", "type", "tweet_id", "read_at", "created_at"}
+	notificationColumnsWithoutDefault = []string{"user_id", "actor_id", "type", "tweet_id", "read_at"}
+	notificationColumnsWithDefault    = []string{"id", "created_at"}
+	notificationPrimaryKeyColumns     = []string{"id"}
+	notificationGeneratedColumns      = []string{}
+)
+
+type (
+	// NotificationSlice is an alias for a slice of pointers to Notification.
+	// This should almost always be used instead of []Notification.
+	NotificationSlice []*Notification
+	// NotificationHook is the signature for custom Notification hook methods
+	NotificationHook func(context.Context, boil.ContextExecutor, *Notification) error
+
+	notificationQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	notificationType                 = reflect.TypeOf(&Notification{})
+	notificationMapping              = queries.MakeStructMapping(notificationType)
+	notificationPrimaryKeyMapping, _ = queries.BindMapping(notificationType, notificationMapping, notificationPrimaryKeyColumns)
+	notificationInsertCacheMut       sync.RWMutex
+	notificationInsertCache          = make(map[string]insertCache)
+	notificationUpdateCacheMut       sync.RWMutex
+	notificationUpdateCache          = make(map[string]updateCache)
+	notificationUpsertCacheMut       sync.RWMutex
+	notificationUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var notificationAfterSelectMu sync.Mutex
+var notificationAfterSelectHooks []NotificationHook
+
+var notificationBeforeInsertMu sync.Mutex
+var notificationBeforeInsertHooks []NotificationHook
+var notificationAfterInsertMu sync.Mutex
+var notificationAfterInsertHooks []NotificationHook
+
+var notificationBeforeUpdateMu sync.Mutex
+var notificationBeforeUpdateHooks []NotificationHook
+var notificationAfterUpdateMu sync.Mutex
+var notificationAfterUpdateHooks []NotificationHook
+
+var notificationBeforeDeleteMu sync.Mutex
+var notificationBeforeDeleteHooks []NotificationHook
+var notificationAfterDeleteMu sync.Mutex
+var notificationAfterDeleteHooks []NotificationHook
+
+var notificationBeforeUpsertMu sync.Mutex
+var notificationBeforeUpsertHooks []NotificationHook
+var notificationAfterUpsertMu sync.Mutex
+var notificationAfterUpsertHooks []NotificationHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *Notification) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range notificationAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *Notification) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range notificationBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *Notification) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range notificationAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *Notification) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range notificationBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *Notification) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range notificationAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *Notification) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range notificationBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *Notification) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range notificationAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *Notification) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range notificationBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *Notification) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range notificationAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddNotificationHook registers your hook function for all future operations.
+func AddNotificationHook(hookPoint boil.HookPoint, notificationHook NotificationHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		notificationAfterSelectMu.Lock()
+		notificationAfterSelectHooks = append(notificationAfterSelectHooks, notificationHook)
+		notificationAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		notificationBeforeInsertMu.Lock()
+		notificationBeforeInsertHooks = append(notificationBeforeInsertHooks, notificationHook)
+		notificationBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		notificationAfterInsertMu.Lock()
+		notificationAfterInsertHooks = append(notificationAfterInsertHooks, notificationHook)
+		notificationAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		notificationBeforeUpdateMu.Lock()
+		notificationBeforeUpdateHooks = append(notificationBeforeUpdateHooks, notificationHook)
+		notificationBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		notificationAfterUpdateMu.Lock()
+		notificationAfterUpdateHooks = append(notificationAfterUpdateHooks, notificationHook)
+		notificationAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		notificationBeforeDeleteMu.Lock()
+		notificationBeforeDeleteHooks = append(notificationBeforeDeleteHooks, notificationHook)
+		notificationBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		notificationAfterDeleteMu.Lock()
+		notificationAfterDeleteHooks = append(notificationAfterDeleteHooks, notificationHook)
+		notificationAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		notificationBeforeUpsertMu.Lock()
+		notificationBeforeUpsertHooks = append(notificationBeforeUpsertHooks, notificationHook)
+		notificationBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		notificationAfterUpsertMu.Lock()
+		notificationAfterUpsertHooks = append(notificationAfterUpsertHooks, notificationHook)
+		notificationAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single notification record from the query.
+func (q notificationQuery) One(ctx context.Context, exec boil.ContextExecutor) (*Notification, error) {
+	o := &Notification{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "schema: failed to execute a one query for notifications")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all Notification records from the query.
+func (q notificationQuery) All(ctx context.Context, exec boil.ContextExecutor) (NotificationSlice, error) {
+	var o []*Notification
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "schema: failed to assign all query results to Notification slice")
+	}
+
+	if len(notificationAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all Notification records in the query.
+func (q notificationQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: failed to count notifications rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q notificationQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "schema: failed to check if notifications exists")
+	}
+
+	return count > 0, nil
+}
+
+// User pointed to by the foreign key.
+func (o *Notification) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`id` = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// Actor pointed to by the foreign key.
+func (o *Notification) Actor(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`id` = ?", o.ActorID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// Tweet pointed to by the foreign key.
+func (o *Notification) Tweet(mods ...qm.QueryMod) tweetQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`id` = ?", o.TweetID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Tweets(queryMods...)
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (notificationL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeNotification interface{}, mods queries.Applicator) error {
+	var slice []*Notification
+	var object *Notification
+
+	if singular {
+		var ok bool
+		object, ok = maybeNotification.(*Notification)
+		if !ok {
+			object = new(Notification)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeNotification)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeNotification))
+			}
+		}
+	} else {
+		s, ok := maybeNotification.(*[]*Notification)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeNotification)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeNotification))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &notificationR{}
+		}
+		args[object.UserID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &notificationR{}
+			}
+
+			args[obj.UserID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`users.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.Notifications = append(foreign.R.Notifications, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.Notifications = append(foreign.R.Notifications, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadActor allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (notificationL) LoadActor(ctx context.Context, e boil.ContextExecutor, singular bool, maybeNotification interface{}, mods queries.Applicator) error {
+	var slice []*Notification
+	var object *Notification
+
+	if singular {
+		var ok bool
+		object, ok = maybeNotification.(*Notification)
+		if !ok {
+			object = new(Notification)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeNotification)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeNotification))
+			}
+		}
+	} else {
+		s, ok := maybeNotification.(*[]*Notification)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeNotification)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeNotification))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &notificationR{}
+		}
+		args[object.ActorID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &notificationR{}
+			}
+
+			args[obj.ActorID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`users.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Actor = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.ActorNotifications = append(foreign.R.ActorNotifications, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ActorID == foreign.ID {
+				local.R.Actor = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.ActorNotifications = append(foreign.R.ActorNotifications, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTweet allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (notificationL) LoadTweet(ctx context.Context, e boil.ContextExecutor, singular bool, maybeNotification interface{}, mods queries.Applicator) error {
+	var slice []*Notification
+	var object *Notification
+
+	if singular {
+		var ok bool
+		object, ok = maybeNotification.(*Notification)
+		if !ok {
+			object = new(Notification)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeNotification)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeNotification))
+			}
+		}
+	} else {
+		s, ok := maybeNotification.(*[]*Notification)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeNotification)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeNotification))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &notificationR{}
+		}
+		if !queries.IsNil(object.TweetID) {
+			args[object.TweetID] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &notificationR{}
+			}
+
+			if !queries.IsNil(obj.TweetID) {
+				args[obj.TweetID] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`tweets`),
+		qm.WhereIn(`tweets.id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`tweets.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Tweet")
+	}
+
+	var resultSlice []*Tweet
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Tweet")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for tweets")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tweets")
+	}
+
+	if len(tweetAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Tweet = foreign
+		if foreign.R == nil {
+			foreign.R = &tweetR{}
+		}
+		foreign.R.Notifications = append(foreign.R.Notifications, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.TweetID, foreign.ID) {
+				local.R.Tweet = foreign
+				if foreign.R == nil {
+					foreign.R = &tweetR{}
+				}
+				foreign.R.Notifications = append(foreign.R.Notifications, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetUser of the notification to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.Notifications.
+func (o *Notification) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `notifications` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"user_id"}),
+		strmangle.WhereClause("`", "`", 0, notificationPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &notificationR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			Notifications: NotificationSlice{o},
+		}
+	} else {
+		related.R.Notifications = append(related.R.Notifications, o)
+	}
+
+	return nil
+}
+
+// SetActor of the notification to the related item.
+// Sets o.R.Actor to related.
+// Adds o to related.R.ActorNotifications.
+func (o *Notification) SetActor(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `notifications` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"actor_id"}),
+		strmangle.WhereClause("`", "`", 0, notificationPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ActorID = related.ID
+	if o.R == nil {
+		o.R = &notificationR{
+			Actor: related,
+		}
+	} else {
+		o.R.Actor = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			ActorNotifications: NotificationSlice{o},
+		}
+	} else {
+		related.R.ActorNotifications = append(related.R.ActorNotifications, o)
+	}
+
+	return nil
+}
+
+// SetTweet of the notification to the related item.
+// Sets o.R.Tweet to related.
+// Adds o to related.R.Notifications.
+func (o *Notification) SetTweet(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Tweet) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `notifications` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"tweet_id"}),
+		strmangle.WhereClause("`", "`", 0, notificationPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.TweetID, related.ID)
+	if o.R == nil {
+		o.R = &notificationR{
+			Tweet: related,
+		}
+	} else {
+		o.R.Tweet = related
+	}
+
+	if related.R == nil {
+		related.R = &tweetR{
+			Notifications: NotificationSlice{o},
+		}
+	} else {
+		related.R.Notifications = append(related.R.Notifications, o)
+	}
+
+	return nil
+}
+
+// RemoveTweet relationship.
+// Sets o.R.Tweet to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *Notification) RemoveTweet(ctx context.Context, exec boil.ContextExecutor, related *Tweet) error {
+	var err error
+
+	queries.SetScanner(&o.TweetID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("tweet_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Tweet = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.Notifications {
+		if queries.Equal(o.TweetID, ri.TweetID) {
+			continue
+		}
+
+		ln := len(related.R.Notifications)
+		if ln > 1 && i < ln-1 {
+			related.R.Notifications[i] = related.R.Notifications[ln-1]
+		}
+		related.R.Notifications = related.R.Notifications[:ln-1]
+		break
+	}
+	return nil
+}
+
+// Notifications retrieves all the records using an executor.
+func Notifications(mods ...qm.QueryMod) notificationQuery {
+	mods = append(mods, qm.From("`notifications`"))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"`notifications`.*"})
+	}
+
+	return notificationQuery{q}
+}
+
+// FindNotification retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindNotification(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*Notification, error) {
+	notificationObj := &Notification{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `notifications` where `id`=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, notificationObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "schema: unable to select from notifications")
+	}
+
+	if err = notificationObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return notificationObj, err
+	}
+
+	return notificationObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *Notification) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("schema: no notifications provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(notificationColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	notificationInsertCacheMut.RLock()
+	cache, cached := notificationInsertCache[key]
+	notificationInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			notificationAllColumns,
+			notificationColumnsWithDefault,
+			notificationColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(notificationType, notificationMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(notificationType, notificationMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `notifications` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `notifications` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `notifications` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, notificationPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "schema: unable to insert into notifications")
+	}
+
+	var lastID int64
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = int(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == notificationMapping["id"] {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.ID,
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, identifierCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "schema: unable to populate default values for notifications")
+	}
+
+CacheNoHooks:
+	if !cached {
+		notificationInsertCacheMut.Lock()
+		notificationInsertCache[key] = cache
+		notificationInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the Notification.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *Notification) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	notificationUpdateCacheMut.RLock()
+	cache, cached := notificationUpdateCache[key]
+	notificationUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			notificationAllColumns,
+			notificationPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("schema: unable to update notifications, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `notifications` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, notificationPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(notificationType, notificationMapping, append(wl, notificationPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to update notifications row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: failed to get rows affected by update for notifications")
+	}
+
+	if !cached {
+		notificationUpdateCacheMut.Lock()
+		notificationUpdateCache[key] = cache
+		notificationUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q notificationQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to update all for notifications")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to retrieve rows affected for notifications")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o NotificationSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("schema: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), notificationPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `notifications` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, notificationPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to update all in notification slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to retrieve rows affected all in update all notification")
+	}
+	return rowsAff, nil
+}
+
+var mySQLNotificationUniqueColumns = []string{
+	"id",
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *Notification) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("schema: no notifications provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(notificationColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLNotificationUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	notificationUpsertCacheMut.RLock()
+	cache, cached := notificationUpsertCache[key]
+	notificationUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			notificationAllColumns,
+			notificationColumnsWithDefault,
+			notificationColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			notificationAllColumns,
+			notificationPrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("schema: unable to upsert notifications, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(notificationAllColumns, strmangle.SetIntersect(insert, update))
+
+		cache.query = buildUpsertQueryMySQL(dialect, "`notifications`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `notifications` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(notificationType, notificationMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(notificationType, notificationMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "schema: unable to upsert for notifications")
+	}
+
+	var lastID int64
+	var uniqueMap []uint64
+	var nzUniqueCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = int(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == notificationMapping["id"] {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(notificationType, notificationMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "schema: unable to retrieve unique values for notifications")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, nzUniqueCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "schema: unable to populate default values for notifications")
+	}
+
+CacheNoHooks:
+	if !cached {
+		notificationUpsertCacheMut.Lock()
+		notificationUpsertCache[key] = cache
+		notificationUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single Notification record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *Notification) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("schema: no Notification provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), notificationPrimaryKeyMapping)
+	sql := "DELETE FROM `notifications` WHERE `id`=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to delete from notifications")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: failed to get rows affected by delete for notifications")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q notificationQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("schema: no notificationQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to delete all from notifications")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: failed to get rows affected by deleteall for notifications")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o NotificationSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(notificationBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), notificationPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `notifications` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, notificationPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to delete all from notification slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: failed to get rows affected by deleteall for notifications")
+	}
+
+	if len(notificationAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *Notification) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindNotification(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *NotificationSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := NotificationSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), notificationPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `notifications`.* FROM `notifications` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, notificationPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "schema: unable to reload all in NotificationSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// NotificationExists checks if the Notification row exists.
+func NotificationExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `notifications` where `id`=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "schema: unable to check if notifications exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the Notification row exists.
+func (o *Notification) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return NotificationExists(ctx, exec, o.ID)
+}
@@ -0,0 +1,1361 @@
+// Code generated by SQLBoiler 4.18.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package schema
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// Retweet is an object representing the database table.
+type Retweet struct {
+	UserID    int       `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	TweetID   int       `boil:"tweet_id" json:"tweet_id" toml:"tweet_id" yaml:"tweet_id"`
+	CreatedAt null.Time `boil:"created_at" json:"created_at,omitempty" toml:"created_at" yaml:"created_at,omitempty"`
+
+	R *retweetR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L retweetL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var RetweetColumns = struct {
+	UserID    string
+	TweetID   string
+	CreatedAt string
+}{
+	UserID:    "user_id",
+	TweetID:   "tweet_id",
+	CreatedAt: "created_at",
+}
+
+var RetweetTableColumns = struct {
+	UserID    string
+	TweetID   string
+	CreatedAt string
+}{
+	UserID:    "retweets.user_id",
+	TweetID:   "retweets.tweet_id",
+	CreatedAt: "retweets.created_at",
+}
+
+// Generated where
+
+var RetweetWhere = struct {
+	UserID    whereHelperint
+	TweetID   whereHelperint
+	CreatedAt whereHelpernull_Time
+}{
+	UserID:    whereHelperint{field: "`retweets`.`user_id`"},
+	TweetID:   whereHelperint{field: "`retweets`.`tweet_id`"},
+	CreatedAt: whereHelpernull_Time{field: "`retweets`.`created_at`"},
+}
+
+// RetweetRels is where relationship names are stored.
+var RetweetRels = struct {
+	User  string
+	Tweet string
+}{
+	User:  "User",
+	Tweet: "Tweet",
+}
+
+// retweetR is where relationships are stored.
+type retweetR struct {
+	User  *User  `boil:"User" json:"User" toml:"User" yaml:"User"`
+	Tweet *Tweet `boil:"Tweet" json:"Tweet" toml:"Tweet" yaml:"Tweet"`
+}
+
+// NewStruct creates a new relationship struct
+func (*retweetR) NewStruct() *retweetR {
+	return &retweetR{}
+}
+
+func (r *retweetR) GetUser() *User {
+	if r == nil {
+		return nil
+	}
+	return r.User
+}
+
+func (r *retweetR) GetTweet() *Tweet {
+	if r == nil {
+		return nil
+	}
+	return r.Tweet
+}
+
+// retweetL is where Load methods for each relationship are stored.
+type retweetL struct{}
+
+var (
+	retweetAllColumns            = []string{"user_id", "tweet_id", "created_at"}
+	retweetColumnsWithoutDefault = []string{"user_id", "tweet_id"}
+	retweetColumnsWithDefault    = []string{"created_at"}
+	retweetPrimaryKeyColumns     = []string{"user_id", "tweet_id"}
+	retweetGeneratedColumns      = []string{}
+)
+
+type (
+	// RetweetSlice is an alias for a slice of pointers to Retweet.
+	// This should almost always be used instead of []Retweet.
+	RetweetSlice []*Retweet
+	// RetweetHook is the signature for custom Retweet hook methods
+	RetweetHook func(context.Context, boil.ContextExecutor, *Retweet) error
+
+	retweetQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	retweetType                 = reflect.TypeOf(&Retweet{})
+	retweetMapping              = queries.MakeStructMapping(retweetType)
+	retweetPrimaryKeyMapping, _ = queries.BindMapping(retweetType, retweetMapping, retweetPrimaryKeyColumns)
+	retweetInsertCacheMut       sync.RWMutex
+	retweetInsertCache          = make(map[string]insertCache)
+	retweetUpdateCacheMut       sync.RWMutex
+	retweetUpdateCache          = make(map[string]updateCache)
+	retweetUpsertCacheMut       sync.RWMutex
+	retweetUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var retweetAfterSelectMu sync.Mutex
+var retweetAfterSelectHooks []RetweetHook
+
+var retweetBeforeInsertMu sync.Mutex
+var retweetBeforeInsertHooks []RetweetHook
+var retweetAfterInsertMu sync.Mutex
+var retweetAfterInsertHooks []RetweetHook
+
+var retweetBeforeUpdateMu sync.Mutex
+var retweetBeforeUpdateHooks []RetweetHook
+var retweetAfterUpdateMu sync.Mutex
+var retweetAfterUpdateHooks []RetweetHook
+
+var retweetBeforeDeleteMu sync.Mutex
+var retweetBeforeDeleteHooks []RetweetHook
+var retweetAfterDeleteMu sync.Mutex
+var retweetAfterDeleteHooks []RetweetHook
+
+var retweetBeforeUpsertMu sync.Mutex
+var retweetBeforeUpsertHooks []RetweetHook
+var retweetAfterUpsertMu sync.Mutex
+var retweetAfterUpsertHooks []RetweetHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *Retweet) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range retweetAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *Retweet) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range retweetBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *Retweet) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range retweetAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *Retweet) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range retweetBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *Retweet) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range retweetAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *Retweet) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range retweetBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *Retweet) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range retweetAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *Retweet) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range retweetBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *Retweet) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range retweetAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddRetweetHook registers your hook function for all future operations.
+func AddRetweetHook(hookPoint boil.HookPoint, retweetHook RetweetHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		retweetAfterSelectMu.Lock()
+		retweetAfterSelectHooks = append(retweetAfterSelectHooks, retweetHook)
+		retweetAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		retweetBeforeInsertMu.Lock()
+		retweetBeforeInsertHooks = append(retweetBeforeInsertHooks, retweetHook)
+		retweetBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		retweetAfterInsertMu.Lock()
+		retweetAfterInsertHooks = append(retweetAfterInsertHooks, retweetHook)
+		retweetAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		retweetBeforeUpdateMu.Lock()
+		retweetBeforeUpdateHooks = append(retweetBeforeUpdateHooks, retweetHook)
+		retweetBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		retweetAfterUpdateMu.Lock()
+		retweetAfterUpdateHooks = append(retweetAfterUpdateHooks, retweetHook)
+		retweetAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		retweetBeforeDeleteMu.Lock()
+		retweetBeforeDeleteHooks = append(retweetBeforeDeleteHooks, retweetHook)
+		retweetBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		retweetAfterDeleteMu.Lock()
+		retweetAfterDeleteHooks = append(retweetAfterDeleteHooks, retweetHook)
+		retweetAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		retweetBeforeUpsertMu.Lock()
+		retweetBeforeUpsertHooks = append(retweetBeforeUpsertHooks, retweetHook)
+		retweetBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		retweetAfterUpsertMu.Lock()
+		retweetAfterUpsertHooks = append(retweetAfterUpsertHooks, retweetHook)
+		retweetAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single retweet record from the query.
+func (q retweetQuery) One(ctx context.Context, exec boil.ContextExecutor) (*Retweet, error) {
+	o := &Retweet{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "schema: failed to execute a one query for retweets")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all Retweet records from the query.
+func (q retweetQuery) All(ctx context.Context, exec boil.ContextExecutor) (RetweetSlice, error) {
+	var o []*Retweet
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "schema: failed to assign all query results to Retweet slice")
+	}
+
+	if len(retweetAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all Retweet records in the query.
+func (q retweetQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: failed to count retweets rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q retweetQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "schema: failed to check if retweets exists")
+	}
+
+	return count > 0, nil
+}
+
+// User pointed to by the foreign key.
+func (o *Retweet) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`id` = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// Tweet pointed to by the foreign key.
+func (o *Retweet) Tweet(mods ...qm.QueryMod) tweetQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`id` = ?", o.TweetID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Tweets(queryMods...)
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (retweetL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeRetweet interface{}, mods queries.Applicator) error {
+	var slice []*Retweet
+	var object *Retweet
+
+	if singular {
+		var ok bool
+		object, ok = maybeRetweet.(*Retweet)
+		if !ok {
+			object = new(Retweet)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeRetweet)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeRetweet))
+			}
+		}
+	} else {
+		s, ok := maybeRetweet.(*[]*Retweet)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeRetweet)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeRetweet))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &retweetR{}
+		}
+		args[object.UserID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &retweetR{}
+			}
+
+			args[obj.UserID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`users.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.Retweets = append(foreign.R.Retweets, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.Retweets = append(foreign.R.Retweets, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTweet allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (retweetL) LoadTweet(ctx context.Context, e boil.ContextExecutor, singular bool, maybeRetweet interface{}, mods queries.Applicator) error {
+	var slice []*Retweet
+	var object *Retweet
+
+	if singular {
+		var ok bool
+		object, ok = maybeRetweet.(*Retweet)
+		if !ok {
+			object = new(Retweet)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeRetweet)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeRetweet))
+			}
+		}
+	} else {
+		s, ok := maybeRetweet.(*[]*Retweet)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeRetweet)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeRetweet))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &retweetR{}
+		}
+		args[object.TweetID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &retweetR{}
+			}
+
+			args[obj.TweetID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`tweets`),
+		qm.WhereIn(`tweets.id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`tweets.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Tweet")
+	}
+
+	var resultSlice []*Tweet
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Tweet")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for tweets")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tweets")
+	}
+
+	if len(tweetAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Tweet = foreign
+		if foreign.R == nil {
+			foreign.R = &tweetR{}
+		}
+		foreign.R.Retweets = append(foreign.R.Retweets, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.TweetID == foreign.ID {
+				local.R.Tweet = foreign
+				if foreign.R == nil {
+					foreign.R = &tweetR{}
+				}
+				foreign.R.Retweets = append(foreign.R.Retweets, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetUser of the retweet to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.Retweets.
+func (o *Retweet) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `retweets` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"user_id"}),
+		strmangle.WhereClause("`", "`", 0, retweetPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.UserID, o.TweetID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &retweetR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			Retweets: RetweetSlice{o},
+		}
+	} else {
+		related.R.Retweets = append(related.R.Retweets, o)
+	}
+
+	return nil
+}
+
+// SetTweet of the retweet to the related item.
+// Sets o.R.Tweet to related.
+// Adds o to related.R.Retweets.
+func (o *Retweet) SetTweet(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Tweet) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `retweets` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"tweet_id"}),
+		strmangle.WhereClause("`", "`", 0, retweetPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.UserID, o.TweetID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.TweetID = related.ID
+	if o.R == nil {
+		o.R = &retweetR{
+			Tweet: related,
+		}
+	} else {
+		o.R.Tweet = related
+	}
+
+	if related.R == nil {
+		related.R = &tweetR{
+			Retweets: RetweetSlice{o},
+		}
+	} else {
+		related.R.Retweets = append(related.R.Retweets, o)
+	}
+
+	return nil
+}
+
+// Retweets retrieves all the records using an executor.
+func Retweets(mods ...qm.QueryMod) retweetQuery {
+	mods = append(mods, qm.From("`retweets`"))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"`retweets`.*"})
+	}
+
+	return retweetQuery{q}
+}
+
+// FindRetweet retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindRetweet(ctx context.Context, exec boil.ContextExecutor, userID int, tweetID int, selectCols ...string) (*Retweet, error) {
+	retweetObj := &Retweet{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `retweets` where `user_id`=? AND `tweet_id`=?", sel,
+	)
+
+	q := queries.Raw(query, userID, tweetID)
+
+	err := q.Bind(ctx, exec, retweetObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "schema: unable to select from retweets")
+	}
+
+	if err = retweetObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return retweetObj, err
+	}
+
+	return retweetObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *Retweet) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("schema: no retweets provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(retweetColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	retweetInsertCacheMut.RLock()
+	cache, cached := retweetInsertCache[key]
+	retweetInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			retweetAllColumns,
+			retweetColumnsWithDefault,
+			retweetColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(retweetType, retweetMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(retweetType, retweetMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `retweets` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `retweets` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `retweets` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, retweetPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "schema: unable to insert into retweets")
+	}
+
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.UserID,
+		o.TweetID,
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, identifierCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "schema: unable to populate default values for retweets")
+	}
+
+CacheNoHooks:
+	if !cached {
+		retweetInsertCacheMut.Lock()
+		retweetInsertCache[key] = cache
+		retweetInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the Retweet.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *Retweet) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	retweetUpdateCacheMut.RLock()
+	cache, cached := retweetUpdateCache[key]
+	retweetUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			retweetAllColumns,
+			retweetPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("schema: unable to update retweets, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `retweets` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, retweetPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(retweetType, retweetMapping, append(wl, retweetPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to update retweets row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: failed to get rows affected by update for retweets")
+	}
+
+	if !cached {
+		retweetUpdateCacheMut.Lock()
+		retweetUpdateCache[key] = cache
+		retweetUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q retweetQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to update all for retweets")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to retrieve rows affected for retweets")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o RetweetSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("schema: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), retweetPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `retweets` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, retweetPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to update all in retweet slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to retrieve rows affected all in update all retweet")
+	}
+	return rowsAff, nil
+}
+
+var mySQLRetweetUniqueColumns = []string{}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *Retweet) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("schema: no retweets provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(retweetColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLRetweetUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	retweetUpsertCacheMut.RLock()
+	cache, cached := retweetUpsertCache[key]
+	retweetUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			retweetAllColumns,
+			retweetColumnsWithDefault,
+			retweetColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			retweetAllColumns,
+			retweetPrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("schema: unable to upsert retweets, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(retweetAllColumns, strmangle.SetIntersect(insert, update))
+
+		cache.query = buildUpsertQueryMySQL(dialect, "`retweets`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `retweets` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(retweetType, retweetMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(retweetType, retweetMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "schema: unable to upsert for retweets")
+	}
+
+	var uniqueMap []uint64
+	var nzUniqueCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(retweetType, retweetMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "schema: unable to retrieve unique values for retweets")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, nzUniqueCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "schema: unable to populate default values for retweets")
+	}
+
+CacheNoHooks:
+	if !cached {
+		retweetUpsertCacheMut.Lock()
+		retweetUpsertCache[key] = cache
+		retweetUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single Retweet record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *Retweet) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("schema: no Retweet provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), retweetPrimaryKeyMapping)
+	sql := "DELETE FROM `retweets` WHERE `user_id`=? AND `tweet_id`=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to delete from retweets")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: failed to get rows affected by delete for retweets")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q retweetQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("schema: no retweetQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to delete all from retweets")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: failed to get rows affected by deleteall for retweets")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o RetweetSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(retweetBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), retweetPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `retweets` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, retweetPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to delete all from retweet slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: failed to get rows affected by deleteall for retweets")
+	}
+
+	if len(retweetAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *Retweet) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindRetweet(ctx, exec, o.UserID, o.TweetID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *RetweetSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := RetweetSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), retweetPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `retweets`.* FROM `retweets` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, retweetPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "schema: unable to reload all in RetweetSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// RetweetExists checks if the Retweet row exists.
+func RetweetExists(ctx context.Context, exec boil.ContextExecutor, userID int, tweetID int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `retweets` where `user_id`=? AND `tweet_id`=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, userID, tweetID)
+	}
+	row := exec.QueryRowContext(ctx, sql, userID, tweetID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "schema: unable to check if retweets exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the Retweet row exists.
+func (o *Retweet) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return RetweetExists(ctx, exec, o.UserID, o.TweetID)
+}
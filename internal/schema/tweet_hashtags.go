@@ -0,0 +1,1164 @@
+// Code generated by SQLBoiler 4.18.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package schema
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// TweetHashtag is an object representing the database table.
+type TweetHashtag struct {
+	TweetID     int    `boil:"tweet_id" json:"tweet_id" toml:"tweet_id" yaml:"tweet_id"`
+	StartOffset int    `boil:"start_offset" json:"start_offset" toml:"start_offset" yaml:"start_offset"`
+	EndOffset   int    `boil:"end_offset" json:"end_offset" toml:"end_offset" yaml:"end_offset"`
+	Tag         string `boil:"tag" json:"tag" toml:"tag" yaml:"tag"`
+
+	R *tweetHashtagR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L tweetHashtagL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var TweetHashtagColumns = struct {
+	TweetID     string
+	StartOffset string
+	EndOffset   string
+	Tag         string
+}{
+	TweetID:     "tweet_id",
+	StartOffset: "start_offset",
+	EndOffset:   "end_offset",
+	Tag:         "tag",
+}
+
+var TweetHashtagTableColumns = struct {
+	TweetID     string
+	StartOffset string
+	EndOffset   string
+	Tag         string
+}{
+	TweetID:     "tweet_hashtags.tweet_id",
+	StartOffset: "tweet_hashtags.start_offset",
+	EndOffset:   "tweet_hashtags.end_offset",
+	Tag:         "tweet_hashtags.tag",
+}
+
+// Generated where
+
+var TweetHashtagWhere = struct {
+	TweetID     whereHelperint
+	StartOffset whereHelperint
+	EndOffset   whereHelperint
+	Tag         whereHelperstring
+}{
+	TweetID:     whereHelperint{field: "`tweet_hashtags`.`tweet_id`"},
+	StartOffset: whereHelperint{field: "`tweet_hashtags`.`start_offset`"},
+	EndOffset:   whereHelperint{field: "`tweet_hashtags`.`end_offset`"},
+	Tag:         whereHelperstring{field: "`tweet_hashtags`.`tag`"},
+}
+
+// TweetHashtagRels is where relationship names are stored.
+var TweetHashtagRels = struct {
+	Tweet string
+}{
+	Tweet: "Tweet",
+}
+
+// tweetHashtagR is where relationships are stored.
+type tweetHashtagR struct {
+	Tweet *Tweet `boil:"Tweet" json:"Tweet" toml:"Tweet" yaml:"Tweet"`
+}
+
+// NewStruct creates a new relationship struct
+func (*tweetHashtagR) NewStruct() *tweetHashtagR {
+	return &tweetHashtagR{}
+}
+
+func (r *tweetHashtagR) GetTweet() *Tweet {
+	if r == nil {
+		return nil
+	}
+	return r.Tweet
+}
+
+// tweetHashtagL is where Load methods for each relationship are stored.
+type tweetHashtagL struct{}
+
+var (
+	tweetHashtagAllColumns            = []string{"tweet_id", "start_offset", "end_offset", "tag"}
+	tweetHashtagColumnsWithoutDefault = []string{"tweet_id", "start_offset", "end_offset", "tag"}
+	tweetHashtagColumnsWithDefault    = []string{}
+	tweetHashtagPrimaryKeyColumns     = []string{"tweet_id", "start_offset"}
+	tweetHashtagGeneratedColumns      = []string{}
+)
+
+type (
+	// TweetHashtagSlice is an alias for a slice of pointers to TweetHashtag.
+	// This should almost always be used instead of []TweetHashtag.
+	TweetHashtagSlice []*TweetHashtag
+	// TweetHashtagHook is the signature for custom TweetHashtag hook methods
+	TweetHashtagHook func(context.Context, boil.ContextExecutor, *TweetHashtag) error
+
+	tweetHashtagQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	tweetHashtagType                 = reflect.TypeOf(&TweetHashtag{})
+	tweetHashtagMapping              = queries.MakeStructMapping(tweetHashtagType)
+	tweetHashtagPrimaryKeyMapping, _ = queries.BindMapping(tweetHashtagType, tweetHashtagMapping, tweetHashtagPrimaryKeyColumns)
+	tweetHashtagInsertCacheMut       sync.RWMutex
+	tweetHashtagInsertCache          = make(map[string]insertCache)
+	tweetHashtagUpdateCacheMut       sync.RWMutex
+	tweetHashtagUpdateCache          = make(map[string]updateCache)
+	tweetHashtagUpsertCacheMut       sync.RWMutex
+	tweetHashtagUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var tweetHashtagAfterSelectMu sync.Mutex
+var tweetHashtagAfterSelectHooks []TweetHashtagHook
+
+var tweetHashtagBeforeInsertMu sync.Mutex
+var tweetHashtagBeforeInsertHooks []TweetHashtagHook
+var tweetHashtagAfterInsertMu sync.Mutex
+var tweetHashtagAfterInsertHooks []TweetHashtagHook
+
+var tweetHashtagBeforeUpdateMu sync.Mutex
+var tweetHashtagBeforeUpdateHooks []TweetHashtagHook
+var tweetHashtagAfterUpdateMu sync.Mutex
+var tweetHashtagAfterUpdateHooks []TweetHashtagHook
+
+var tweetHashtagBeforeDeleteMu sync.Mutex
+var tweetHashtagBeforeDeleteHooks []TweetHashtagHook
+var tweetHashtagAfterDeleteMu sync.Mutex
+var tweetHashtagAfterDeleteHooks []TweetHashtagHook
+
+var tweetHashtagBeforeUpsertMu sync.Mutex
+var tweetHashtagBeforeUpsertHooks []TweetHashtagHook
+var tweetHashtagAfterUpsertMu sync.Mutex
+var tweetHashtagAfterUpsertHooks []TweetHashtagHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *TweetHashtag) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tweetHashtagAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *TweetHashtag) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tweetHashtagBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *TweetHashtag) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tweetHashtagAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *TweetHashtag) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tweetHashtagBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *TweetHashtag) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tweetHashtagAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *TweetHashtag) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tweetHashtagBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *TweetHashtag) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tweetHashtagAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *TweetHashtag) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tweetHashtagBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *TweetHashtag) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tweetHashtagAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddTweetHashtagHook registers your hook function for all future operations.
+func AddTweetHashtagHook(hookPoint boil.HookPoint, tweetHashtagHook TweetHashtagHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		tweetHashtagAfterSelectMu.Lock()
+		tweetHashtagAfterSelectHooks = append(tweetHashtagAfterSelectHooks, tweetHashtagHook)
+		tweetHashtagAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		tweetHashtagBeforeInsertMu.Lock()
+		tweetHashtagBeforeInsertHooks = append(tweetHashtagBeforeInsertHooks, tweetHashtagHook)
+		tweetHashtagBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		tweetHashtagAfterInsertMu.Lock()
+		tweetHashtagAfterInsertHooks = append(tweetHashtagAfterInsertHooks, tweetHashtagHook)
+		tweetHashtagAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		tweetHashtagBeforeUpdateMu.Lock()
+		tweetHashtagBeforeUpdateHooks = append(tweetHashtagBeforeUpdateHooks, tweetHashtagHook)
+		tweetHashtagBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		tweetHashtagAfterUpdateMu.Lock()
+		tweetHashtagAfterUpdateHooks = append(tweetHashtagAfterUpdateHooks, tweetHashtagHook)
+		tweetHashtagAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		tweetHashtagBeforeDeleteMu.Lock()
+		tweetHashtagBeforeDeleteHooks = append(tweetHashtagBeforeDeleteHooks, tweetHashtagHook)
+		tweetHashtagBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		tweetHashtagAfterDeleteMu.Lock()
+		tweetHashtagAfterDeleteHooks = append(tweetHashtagAfterDeleteHooks, tweetHashtagHook)
+		tweetHashtagAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		tweetHashtagBeforeUpsertMu.Lock()
+		tweetHashtagBeforeUpsertHooks = append(tweetHashtagBeforeUpsertHooks, tweetHashtagHook)
+		tweetHashtagBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		tweetHashtagAfterUpsertMu.Lock()
+		tweetHashtagAfterUpsertHooks = append(tweetHashtagAfterUpsertHooks, tweetHashtagHook)
+		tweetHashtagAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single tweetHashtag record from the query.
+func (q tweetHashtagQuery) One(ctx context.Context, exec boil.ContextExecutor) (*TweetHashtag, error) {
+	o := &TweetHashtag{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "schema: failed to execute a one query for tweet_hashtags")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all TweetHashtag records from the query.
+func (q tweetHashtagQuery) All(ctx context.Context, exec boil.ContextExecutor) (TweetHashtagSlice, error) {
+	var o []*TweetHashtag
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "schema: failed to assign all query results to TweetHashtag slice")
+	}
+
+	if len(tweetHashtagAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all TweetHashtag records in the query.
+func (q tweetHashtagQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: failed to count tweet_hashtags rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q tweetHashtagQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "schema: failed to check if tweet_hashtags exists")
+	}
+
+	return count > 0, nil
+}
+
+// Tweet pointed to by the foreign key.
+func (o *TweetHashtag) Tweet(mods ...qm.QueryMod) tweetQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`id` = ?", o.TweetID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Tweets(queryMods...)
+}
+
+// LoadTweet allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (tweetHashtagL) LoadTweet(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTweetHashtag interface{}, mods queries.Applicator) error {
+	var slice []*TweetHashtag
+	var object *TweetHashtag
+
+	if singular {
+		var ok bool
+		object, ok = maybeTweetHashtag.(*TweetHashtag)
+		if !ok {
+			object = new(TweetHashtag)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTweetHashtag)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTweetHashtag))
+			}
+		}
+	} else {
+		s, ok := maybeTweetHashtag.(*[]*TweetHashtag)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTweetHashtag)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTweetHashtag))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &tweetHashtagR{}
+		}
+		args[object.TweetID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &tweetHashtagR{}
+			}
+
+			args[obj.TweetID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`tweets`),
+		qm.WhereIn(`tweets.id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`tweets.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Tweet")
+	}
+
+	var resultSlice []*Tweet
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Tweet")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for tweets")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tweets")
+	}
+
+	if len(tweetAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Tweet = foreign
+		if foreign.R == nil {
+			foreign.R = &tweetR{}
+		}
+		foreign.R.TweetHashtags = append(foreign.R.TweetHashtags, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.TweetID == foreign.ID {
+				local.R.Tweet = foreign
+				if foreign.R == nil {
+					foreign.R = &tweetR{}
+				}
+				foreign.R.TweetHashtags = append(foreign.R.TweetHashtags, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetTweet of the tweetHashtag to the related item.
+// Sets o.R.Tweet to related.
+// Adds o to related.R.TweetHashtags.
+func (o *TweetHashtag) SetTweet(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Tweet) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `tweet_hashtags` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"tweet_id"}),
+		strmangle.WhereClause("`", "`", 0, tweetHashtagPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.TweetID, o.StartOffset}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.TweetID = related.ID
+	if o.R == nil {
+		o.R = &tweetHashtagR{
+			Tweet: related,
+		}
+	} else {
+		o.R.Tweet = related
+	}
+
+	if related.R == nil {
+		related.R = &tweetR{
+			TweetHashtags: TweetHashtagSlice{o},
+		}
+	} else {
+		related.R.TweetHashtags = append(related.R.TweetHashtags, o)
+	}
+
+	return nil
+}
+
+// TweetHashtags retrieves all the records using an executor.
+func TweetHashtags(mods ...qm.QueryMod) tweetHashtagQuery {
+	mods = append(mods, qm.From("`tweet_hashtags`"))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"`tweet_hashtags`.*"})
+	}
+
+	return tweetHashtagQuery{q}
+}
+
+// FindTweetHashtag retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindTweetHashtag(ctx context.Context, exec boil.ContextExecutor, tweetID int, startOffset int, selectCols ...string) (*TweetHashtag, error) {
+	tweetHashtagObj := &TweetHashtag{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `tweet_hashtags` where `tweet_id`=? AND `start_offset`=?", sel,
+	)
+
+	q := queries.Raw(query, tweetID, startOffset)
+
+	err := q.Bind(ctx, exec, tweetHashtagObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "schema: unable to select from tweet_hashtags")
+	}
+
+	if err = tweetHashtagObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return tweetHashtagObj, err
+	}
+
+	return tweetHashtagObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *TweetHashtag) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("schema: no tweet_hashtags provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(tweetHashtagColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	tweetHashtagInsertCacheMut.RLock()
+	cache, cached := tweetHashtagInsertCache[key]
+	tweetHashtagInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			tweetHashtagAllColumns,
+			tweetHashtagColumnsWithDefault,
+			tweetHashtagColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(tweetHashtagType, tweetHashtagMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(tweetHashtagType, tweetHashtagMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `tweet_hashtags` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `tweet_hashtags` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `tweet_hashtags` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, tweetHashtagPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "schema: unable to insert into tweet_hashtags")
+	}
+
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.TweetID,
+		o.StartOffset,
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, identifierCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "schema: unable to populate default values for tweet_hashtags")
+	}
+
+CacheNoHooks:
+	if !cached {
+		tweetHashtagInsertCacheMut.Lock()
+		tweetHashtagInsertCache[key] = cache
+		tweetHashtagInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the TweetHashtag.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *TweetHashtag) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	tweetHashtagUpdateCacheMut.RLock()
+	cache, cached := tweetHashtagUpdateCache[key]
+	tweetHashtagUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			tweetHashtagAllColumns,
+			tweetHashtagPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("schema: unable to update tweet_hashtags, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `tweet_hashtags` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, tweetHashtagPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(tweetHashtagType, tweetHashtagMapping, append(wl, tweetHashtagPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to update tweet_hashtags row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: failed to get rows affected by update for tweet_hashtags")
+	}
+
+	if !cached {
+		tweetHashtagUpdateCacheMut.Lock()
+		tweetHashtagUpdateCache[key] = cache
+		tweetHashtagUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q tweetHashtagQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to update all for tweet_hashtags")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to retrieve rows affected for tweet_hashtags")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o TweetHashtagSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("schema: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), tweetHashtagPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `tweet_hashtags` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, tweetHashtagPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to update all in tweetHashtag slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to retrieve rows affected all in update all tweetHashtag")
+	}
+	return rowsAff, nil
+}
+
+var mySQLTweetHashtagUniqueColumns = []string{}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *TweetHashtag) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("schema: no tweet_hashtags provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(tweetHashtagColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLTweetHashtagUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	tweetHashtagUpsertCacheMut.RLock()
+	cache, cached := tweetHashtagUpsertCache[key]
+	tweetHashtagUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			tweetHashtagAllColumns,
+			tweetHashtagColumnsWithDefault,
+			tweetHashtagColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			tweetHashtagAllColumns,
+			tweetHashtagPrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("schema: unable to upsert tweet_hashtags, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(tweetHashtagAllColumns, strmangle.SetIntersect(insert, update))
+
+		cache.query = buildUpsertQueryMySQL(dialect, "`tweet_hashtags`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `tweet_hashtags` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(tweetHashtagType, tweetHashtagMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(tweetHashtagType, tweetHashtagMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "schema: unable to upsert for tweet_hashtags")
+	}
+
+	var uniqueMap []uint64
+	var nzUniqueCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(tweetHashtagType, tweetHashtagMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "schema: unable to retrieve unique values for tweet_hashtags")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, nzUniqueCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "schema: unable to populate default values for tweet_hashtags")
+	}
+
+CacheNoHooks:
+	if !cached {
+		tweetHashtagUpsertCacheMut.Lock()
+		tweetHashtagUpsertCache[key] = cache
+		tweetHashtagUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single TweetHashtag record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *TweetHashtag) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("schema: no TweetHashtag provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), tweetHashtagPrimaryKeyMapping)
+	sql := "DELETE FROM `tweet_hashtags` WHERE `tweet_id`=? AND `start_offset`=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to delete from tweet_hashtags")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: failed to get rows affected by delete for tweet_hashtags")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q tweetHashtagQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("schema: no tweetHashtagQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to delete all from tweet_hashtags")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: failed to get rows affected by deleteall for tweet_hashtags")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o TweetHashtagSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(tweetHashtagBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), tweetHashtagPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `tweet_hashtags` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, tweetHashtagPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to delete all from tweetHashtag slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: failed to get rows affected by deleteall for tweet_hashtags")
+	}
+
+	if len(tweetHashtagAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *TweetHashtag) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindTweetHashtag(ctx, exec, o.TweetID, o.StartOffset)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *TweetHashtagSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := TweetHashtagSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), tweetHashtagPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `tweet_hashtags`.* FROM `tweet_hashtags` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, tweetHashtagPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "schema: unable to reload all in TweetHashtagSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// TweetHashtagExists checks if the TweetHashtag row exists.
+func TweetHashtagExists(ctx context.Context, exec boil.ContextExecutor, tweetID int, startOffset int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `tweet_hashtags` where `tweet_id`=? AND `start_offset`=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, tweetID, startOffset)
+	}
+	row := exec.QueryRowContext(ctx, sql, tweetID, startOffset)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "schema: unable to check if tweet_hashtags exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the TweetHashtag row exists.
+func (o *TweetHashtag) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return TweetHashtagExists(ctx, exec, o.TweetID, o.StartOffset)
+}
@@ -0,0 +1,1353 @@
+// Code generated by SQLBoiler 4.18.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package schema
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// TweetMention is an object representing the database table.
+type TweetMention struct {
+	TweetID     int `boil:"tweet_id" json:"tweet_id" toml:"tweet_id" yaml:"tweet_id"`
+	StartOffset int `boil:"start_offset" json:"start_offset" toml:"start_offset" yaml:"start_offset"`
+	EndOffset   int `boil:"end_offset" json:"end_offset" toml:"end_offset" yaml:"end_offset"`
+	UserID      int `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+
+	R *tweetMentionR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L tweetMentionL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var TweetMentionColumns = struct {
+	TweetID     string
+	StartOffset string
+	EndOffset   string
+	UserID      string
+}{
+	TweetID:     "tweet_id",
+	StartOffset: "start_offset",
+	EndOffset:   "end_offset",
+	UserID:      "user_id",
+}
+
+var TweetMentionTableColumns = struct {
+	TweetID     string
+	StartOffset string
+	EndOffset   string
+	UserID      string
+}{
+	TweetID:     "tweet_mentions.tweet_id",
+	StartOffset: "tweet_mentions.start_offset",
+	EndOffset:   "tweet_mentions.end_offset",
+	UserID:      "tweet_mentions.user_id",
+}
+
+// Generated where
+
+var TweetMentionWhere = struct {
+	TweetID     whereHelperint
+	StartOffset whereHelperint
+	EndOffset   whereHelperint
+	UserID      whereHelperint
+}{
+	TweetID:     whereHelperint{field: "`tweet_mentions`.`tweet_id`"},
+	StartOffset: whereHelperint{field: "`tweet_mentions`.`start_offset`"},
+	EndOffset:   whereHelperint{field: "`tweet_mentions`.`end_offset`"},
+	UserID:      whereHelperint{field: "`tweet_mentions`.`user_id`"},
+}
+
+// TweetMentionRels is where relationship names are stored.
+var TweetMentionRels = struct {
+	Tweet string
+	User  string
+}{
+	Tweet: "Tweet",
+	User:  "User",
+}
+
+// tweetMentionR is where relationships are stored.
+type tweetMentionR struct {
+	Tweet *Tweet `boil:"Tweet" json:"Tweet" toml:"Tweet" yaml:"Tweet"`
+	User  *User  `boil:"User" json:"User" toml:"User" yaml:"User"`
+}
+
+// NewStruct creates a new relationship struct
+func (*tweetMentionR) NewStruct() *tweetMentionR {
+	return &tweetMentionR{}
+}
+
+func (r *tweetMentionR) GetTweet() *Tweet {
+	if r == nil {
+		return nil
+	}
+	return r.Tweet
+}
+
+func (r *tweetMentionR) GetUser() *User {
+	if r == nil {
+		return nil
+	}
+	return r.User
+}
+
+// tweetMentionL is where Load methods for each relationship are stored.
+type tweetMentionL struct{}
+
+var (
+	tweetMentionAllColumns            = []string{"tweet_id", "start_offset", "end_offset", "user_id"}
+	tweetMentionColumnsWithoutDefault = []string{"tweet_id", "start_offset", "end_offset", "user_id"}
+	tweetMentionColumnsWithDefault    = []string{}
+	tweetMentionPrimaryKeyColumns     = []string{"tweet_id", "start_offset"}
+	tweetMentionGeneratedColumns      = []string{}
+)
+
+type (
+	// TweetMentionSlice is an alias for a slice of pointers to TweetMention.
+	// This should almost always be used instead of []TweetMention.
+	TweetMentionSlice []*TweetMention
+	// TweetMentionHook is the signature for custom TweetMention hook methods
+	TweetMentionHook func(context.Context, boil.ContextExecutor, *TweetMention) error
+
+	tweetMentionQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	tweetMentionType                 = reflect.TypeOf(&TweetMention{})
+	tweetMentionMapping              = queries.MakeStructMapping(tweetMentionType)
+	tweetMentionPrimaryKeyMapping, _ = queries.BindMapping(tweetMentionType, tweetMentionMapping, tweetMentionPrimaryKeyColumns)
+	tweetMentionInsertCacheMut       sync.RWMutex
+	tweetMentionInsertCache          = make(map[string]insertCache)
+	tweetMentionUpdateCacheMut       sync.RWMutex
+	tweetMentionUpdateCache          = make(map[string]updateCache)
+	tweetMentionUpsertCacheMut       sync.RWMutex
+	tweetMentionUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var tweetMentionAfterSelectMu sync.Mutex
+var tweetMentionAfterSelectHooks []TweetMentionHook
+
+var tweetMentionBeforeInsertMu sync.Mutex
+var tweetMentionBeforeInsertHooks []TweetMentionHook
+var tweetMentionAfterInsertMu sync.Mutex
+var tweetMentionAfterInsertHooks []TweetMentionHook
+
+var tweetMentionBeforeUpdateMu sync.Mutex
+var tweetMentionBeforeUpdateHooks []TweetMentionHook
+var tweetMentionAfterUpdateMu sync.Mutex
+var tweetMentionAfterUpdateHooks []TweetMentionHook
+
+var tweetMentionBeforeDeleteMu sync.Mutex
+var tweetMentionBeforeDeleteHooks []TweetMentionHook
+var tweetMentionAfterDeleteMu sync.Mutex
+var tweetMentionAfterDeleteHooks []TweetMentionHook
+
+var tweetMentionBeforeUpsertMu sync.Mutex
+var tweetMentionBeforeUpsertHooks []TweetMentionHook
+var tweetMentionAfterUpsertMu sync.Mutex
+var tweetMentionAfterUpsertHooks []TweetMentionHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *TweetMention) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tweetMentionAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *TweetMention) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tweetMentionBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *TweetMention) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tweetMentionAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *TweetMention) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tweetMentionBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *TweetMention) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tweetMentionAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *TweetMention) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tweetMentionBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *TweetMention) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tweetMentionAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *TweetMention) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tweetMentionBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *TweetMention) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tweetMentionAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddTweetMentionHook registers your hook function for all future operations.
+func AddTweetMentionHook(hookPoint boil.HookPoint, tweetMentionHook TweetMentionHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		tweetMentionAfterSelectMu.Lock()
+		tweetMentionAfterSelectHooks = append(tweetMentionAfterSelectHooks, tweetMentionHook)
+		tweetMentionAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		tweetMentionBeforeInsertMu.Lock()
+		tweetMentionBeforeInsertHooks = append(tweetMentionBeforeInsertHooks, tweetMentionHook)
+		tweetMentionBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		tweetMentionAfterInsertMu.Lock()
+		tweetMentionAfterInsertHooks = append(tweetMentionAfterInsertHooks, tweetMentionHook)
+		tweetMentionAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		tweetMentionBeforeUpdateMu.Lock()
+		tweetMentionBeforeUpdateHooks = append(tweetMentionBeforeUpdateHooks, tweetMentionHook)
+		tweetMentionBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		tweetMentionAfterUpdateMu.Lock()
+		tweetMentionAfterUpdateHooks = append(tweetMentionAfterUpdateHooks, tweetMentionHook)
+		tweetMentionAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		tweetMentionBeforeDeleteMu.Lock()
+		tweetMentionBeforeDeleteHooks = append(tweetMentionBeforeDeleteHooks, tweetMentionHook)
+		tweetMentionBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		tweetMentionAfterDeleteMu.Lock()
+		tweetMentionAfterDeleteHooks = append(tweetMentionAfterDeleteHooks, tweetMentionHook)
+		tweetMentionAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		tweetMentionBeforeUpsertMu.Lock()
+		tweetMentionBeforeUpsertHooks = append(tweetMentionBeforeUpsertHooks, tweetMentionHook)
+		tweetMentionBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		tweetMentionAfterUpsertMu.Lock()
+		tweetMentionAfterUpsertHooks = append(tweetMentionAfterUpsertHooks, tweetMentionHook)
+		tweetMentionAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single tweetMention record from the query.
+func (q tweetMentionQuery) One(ctx context.Context, exec boil.ContextExecutor) (*TweetMention, error) {
+	o := &TweetMention{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "schema: failed to execute a one query for tweet_mentions")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all TweetMention records from the query.
+func (q tweetMentionQuery) All(ctx context.Context, exec boil.ContextExecutor) (TweetMentionSlice, error) {
+	var o []*TweetMention
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "schema: failed to assign all query results to TweetMention slice")
+	}
+
+	if len(tweetMentionAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all TweetMention records in the query.
+func (q tweetMentionQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: failed to count tweet_mentions rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q tweetMentionQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "schema: failed to check if tweet_mentions exists")
+	}
+
+	return count > 0, nil
+}
+
+// Tweet pointed to by the foreign key.
+func (o *TweetMention) Tweet(mods ...qm.QueryMod) tweetQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`id` = ?", o.TweetID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Tweets(queryMods...)
+}
+
+// User pointed to by the foreign key.
+func (o *TweetMention) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`id` = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadTweet allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (tweetMentionL) LoadTweet(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTweetMention interface{}, mods queries.Applicator) error {
+	var slice []*TweetMention
+	var object *TweetMention
+
+	if singular {
+		var ok bool
+		object, ok = maybeTweetMention.(*TweetMention)
+		if !ok {
+			object = new(TweetMention)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTweetMention)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTweetMention))
+			}
+		}
+	} else {
+		s, ok := maybeTweetMention.(*[]*TweetMention)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTweetMention)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTweetMention))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &tweetMentionR{}
+		}
+		args[object.TweetID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &tweetMentionR{}
+			}
+
+			args[obj.TweetID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`tweets`),
+		qm.WhereIn(`tweets.id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`tweets.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Tweet")
+	}
+
+	var resultSlice []*Tweet
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Tweet")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for tweets")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tweets")
+	}
+
+	if len(tweetAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Tweet = foreign
+		if foreign.R == nil {
+			foreign.R = &tweetR{}
+		}
+		foreign.R.TweetMentions = append(foreign.R.TweetMentions, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.TweetID == foreign.ID {
+				local.R.Tweet = foreign
+				if foreign.R == nil {
+					foreign.R = &tweetR{}
+				}
+				foreign.R.TweetMentions = append(foreign.R.TweetMentions, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (tweetMentionL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTweetMention interface{}, mods queries.Applicator) error {
+	var slice []*TweetMention
+	var object *TweetMention
+
+	if singular {
+		var ok bool
+		object, ok = maybeTweetMention.(*TweetMention)
+		if !ok {
+			object = new(TweetMention)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTweetMention)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTweetMention))
+			}
+		}
+	} else {
+		s, ok := maybeTweetMention.(*[]*TweetMention)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTweetMention)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTweetMention))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &tweetMentionR{}
+		}
+		args[object.UserID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &tweetMentionR{}
+			}
+
+			args[obj.UserID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`users.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.TweetMentions = append(foreign.R.TweetMentions, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.TweetMentions = append(foreign.R.TweetMentions, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetTweet of the tweetMention to the related item.
+// Sets o.R.Tweet to related.
+// Adds o to related.R.TweetMentions.
+func (o *TweetMention) SetTweet(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Tweet) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `tweet_mentions` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"tweet_id"}),
+		strmangle.WhereClause("`", "`", 0, tweetMentionPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.TweetID, o.StartOffset}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.TweetID = related.ID
+	if o.R == nil {
+		o.R = &tweetMentionR{
+			Tweet: related,
+		}
+	} else {
+		o.R.Tweet = related
+	}
+
+	if related.R == nil {
+		related.R = &tweetR{
+			TweetMentions: TweetMentionSlice{o},
+		}
+	} else {
+		related.R.TweetMentions = append(related.R.TweetMentions, o)
+	}
+
+	return nil
+}
+
+// SetUser of the tweetMention to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.TweetMentions.
+func (o *TweetMention) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `tweet_mentions` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"user_id"}),
+		strmangle.WhereClause("`", "`", 0, tweetMentionPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.TweetID, o.StartOffset}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &tweetMentionR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			TweetMentions: TweetMentionSlice{o},
+		}
+	} else {
+		related.R.TweetMentions = append(related.R.TweetMentions, o)
+	}
+
+	return nil
+}
+
+// TweetMentions retrieves all the records using an executor.
+func TweetMentions(mods ...qm.QueryMod) tweetMentionQuery {
+	mods = append(mods, qm.From("`tweet_mentions`"))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"`tweet_mentions`.*"})
+	}
+
+	return tweetMentionQuery{q}
+}
+
+// FindTweetMention retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindTweetMention(ctx context.Context, exec boil.ContextExecutor, tweetID int, startOffset int, selectCols ...string) (*TweetMention, error) {
+	tweetMentionObj := &TweetMention{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `tweet_mentions` where `tweet_id`=? AND `start_offset`=?", sel,
+	)
+
+	q := queries.Raw(query, tweetID, startOffset)
+
+	err := q.Bind(ctx, exec, tweetMentionObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "schema: unable to select from tweet_mentions")
+	}
+
+	if err = tweetMentionObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return tweetMentionObj, err
+	}
+
+	return tweetMentionObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *TweetMention) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("schema: no tweet_mentions provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(tweetMentionColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	tweetMentionInsertCacheMut.RLock()
+	cache, cached := tweetMentionInsertCache[key]
+	tweetMentionInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			tweetMentionAllColumns,
+			tweetMentionColumnsWithDefault,
+			tweetMentionColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(tweetMentionType, tweetMentionMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(tweetMentionType, tweetMentionMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `tweet_mentions` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `tweet_mentions` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `tweet_mentions` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, tweetMentionPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "schema: unable to insert into tweet_mentions")
+	}
+
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.TweetID,
+		o.StartOffset,
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, identifierCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "schema: unable to populate default values for tweet_mentions")
+	}
+
+CacheNoHooks:
+	if !cached {
+		tweetMentionInsertCacheMut.Lock()
+		tweetMentionInsertCache[key] = cache
+		tweetMentionInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the TweetMention.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *TweetMention) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	tweetMentionUpdateCacheMut.RLock()
+	cache, cached := tweetMentionUpdateCache[key]
+	tweetMentionUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			tweetMentionAllColumns,
+			tweetMentionPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("schema: unable to update tweet_mentions, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `tweet_mentions` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, tweetMentionPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(tweetMentionType, tweetMentionMapping, append(wl, tweetMentionPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to update tweet_mentions row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: failed to get rows affected by update for tweet_mentions")
+	}
+
+	if !cached {
+		tweetMentionUpdateCacheMut.Lock()
+		tweetMentionUpdateCache[key] = cache
+		tweetMentionUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q tweetMentionQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to update all for tweet_mentions")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to retrieve rows affected for tweet_mentions")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o TweetMentionSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("schema: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), tweetMentionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `tweet_mentions` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, tweetMentionPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to update all in tweetMention slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to retrieve rows affected all in update all tweetMention")
+	}
+	return rowsAff, nil
+}
+
+var mySQLTweetMentionUniqueColumns = []string{}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *TweetMention) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("schema: no tweet_mentions provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(tweetMentionColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLTweetMentionUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	tweetMentionUpsertCacheMut.RLock()
+	cache, cached := tweetMentionUpsertCache[key]
+	tweetMentionUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			tweetMentionAllColumns,
+			tweetMentionColumnsWithDefault,
+			tweetMentionColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			tweetMentionAllColumns,
+			tweetMentionPrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("schema: unable to upsert tweet_mentions, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(tweetMentionAllColumns, strmangle.SetIntersect(insert, update))
+
+		cache.query = buildUpsertQueryMySQL(dialect, "`tweet_mentions`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `tweet_mentions` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(tweetMentionType, tweetMentionMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(tweetMentionType, tweetMentionMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "schema: unable to upsert for tweet_mentions")
+	}
+
+	var uniqueMap []uint64
+	var nzUniqueCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(tweetMentionType, tweetMentionMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "schema: unable to retrieve unique values for tweet_mentions")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, nzUniqueCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "schema: unable to populate default values for tweet_mentions")
+	}
+
+CacheNoHooks:
+	if !cached {
+		tweetMentionUpsertCacheMut.Lock()
+		tweetMentionUpsertCache[key] = cache
+		tweetMentionUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single TweetMention record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *TweetMention) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("schema: no TweetMention provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), tweetMentionPrimaryKeyMapping)
+	sql := "DELETE FROM `tweet_mentions` WHERE `tweet_id`=? AND `start_offset`=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to delete from tweet_mentions")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: failed to get rows affected by delete for tweet_mentions")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q tweetMentionQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("schema: no tweetMentionQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to delete all from tweet_mentions")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: failed to get rows affected by deleteall for tweet_mentions")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o TweetMentionSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(tweetMentionBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), tweetMentionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `tweet_mentions` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, tweetMentionPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to delete all from tweetMention slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: failed to get rows affected by deleteall for tweet_mentions")
+	}
+
+	if len(tweetMentionAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *TweetMention) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindTweetMention(ctx, exec, o.TweetID, o.StartOffset)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *TweetMentionSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := TweetMentionSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), tweetMentionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `tweet_mentions`.* FROM `tweet_mentions` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, tweetMentionPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "schema: unable to reload all in TweetMentionSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// TweetMentionExists checks if the TweetMention row exists.
+func TweetMentionExists(ctx context.Context, exec boil.ContextExecutor, tweetID int, startOffset int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `tweet_mentions` where `tweet_id`=? AND `start_offset`=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, tweetID, startOffset)
+	}
+	row := exec.QueryRowContext(ctx, sql, tweetID, startOffset)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "schema: unable to check if tweet_mentions exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the TweetMention row exists.
+func (o *TweetMention) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return TweetMentionExists(ctx, exec, o.TweetID, o.StartOffset)
+}
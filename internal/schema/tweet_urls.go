@@ -0,0 +1,1164 @@
+// Code generated by SQLBoiler 4.18.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package schema
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// TweetURL is an object representing the database table.
+type TweetURL struct {
+	TweetID     int    `boil:"tweet_id" json:"tweet_id" toml:"tweet_id" yaml:"tweet_id"`
+	StartOffset int    `boil:"start_offset" json:"start_offset" toml:"start_offset" yaml:"start_offset"`
+	EndOffset   int    `boil:"end_offset" json:"end_offset" toml:"end_offset" yaml:"end_offset"`
+	URL         string `boil:"url" json:"url" toml:"url" yaml:"url"`
+
+	R *tweetURLR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L tweetURLL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var TweetURLColumns = struct {
+	TweetID     string
+	StartOffset string
+	EndOffset   string
+	URL         string
+}{
+	TweetID:     "tweet_id",
+	StartOffset: "start_offset",
+	EndOffset:   "end_offset",
+	URL:         "url",
+}
+
+var TweetURLTableColumns = struct {
+	TweetID     string
+	StartOffset string
+	EndOffset   string
+	URL         string
+}{
+	TweetID:     "tweet_urls.tweet_id",
+	StartOffset: "tweet_urls.start_offset",
+	EndOffset:   "tweet_urls.end_offset",
+	URL:         "tweet_urls.url",
+}
+
+// Generated where
+
+var TweetURLWhere = struct {
+	TweetID     whereHelperint
+	StartOffset whereHelperint
+	EndOffset   whereHelperint
+	URL         whereHelperstring
+}{
+	TweetID:     whereHelperint{field: "`tweet_urls`.`tweet_id`"},
+	StartOffset: whereHelperint{field: "`tweet_urls`.`start_offset`"},
+	EndOffset:   whereHelperint{field: "`tweet_urls`.`end_offset`"},
+	URL:         whereHelperstring{field: "`tweet_urls`.`url`"},
+}
+
+// TweetURLRels is where relationship names are stored.
+var TweetURLRels = struct {
+	Tweet string
+}{
+	Tweet: "Tweet",
+}
+
+// tweetURLR is where relationships are stored.
+type tweetURLR struct {
+	Tweet *Tweet `boil:"Tweet" json:"Tweet" toml:"Tweet" yaml:"Tweet"`
+}
+
+// NewStruct creates a new relationship struct
+func (*tweetURLR) NewStruct() *tweetURLR {
+	return &tweetURLR{}
+}
+
+func (r *tweetURLR) GetTweet() *Tweet {
+	if r == nil {
+		return nil
+	}
+	return r.Tweet
+}
+
+// tweetURLL is where Load methods for each relationship are stored.
+type tweetURLL struct{}
+
+var (
+	tweetURLAllColumns            = []string{"tweet_id", "start_offset", "end_offset", "url"}
+	tweetURLColumnsWithoutDefault = []string{"tweet_id", "start_offset", "end_offset", "url"}
+	tweetURLColumnsWithDefault    = []string{}
+	tweetURLPrimaryKeyColumns     = []string{"tweet_id", "start_offset"}
+	tweetURLGeneratedColumns      = []string{}
+)
+
+type (
+	// TweetURLSlice is an alias for a slice of pointers to TweetURL.
+	// This should almost always be used instead of []TweetURL.
+	TweetURLSlice []*TweetURL
+	// TweetURLHook is the signature for custom TweetURL hook methods
+	TweetURLHook func(context.Context, boil.ContextExecutor, *TweetURL) error
+
+	tweetURLQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	tweetURLType                 = reflect.TypeOf(&TweetURL{})
+	tweetURLMapping              = queries.MakeStructMapping(tweetURLType)
+	tweetURLPrimaryKeyMapping, _ = queries.BindMapping(tweetURLType, tweetURLMapping, tweetURLPrimaryKeyColumns)
+	tweetURLInsertCacheMut       sync.RWMutex
+	tweetURLInsertCache          = make(map[string]insertCache)
+	tweetURLUpdateCacheMut       sync.RWMutex
+	tweetURLUpdateCache          = make(map[string]updateCache)
+	tweetURLUpsertCacheMut       sync.RWMutex
+	tweetURLUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var tweetURLAfterSelectMu sync.Mutex
+var tweetURLAfterSelectHooks []TweetURLHook
+
+var tweetURLBeforeInsertMu sync.Mutex
+var tweetURLBeforeInsertHooks []TweetURLHook
+var tweetURLAfterInsertMu sync.Mutex
+var tweetURLAfterInsertHooks []TweetURLHook
+
+var tweetURLBeforeUpdateMu sync.Mutex
+var tweetURLBeforeUpdateHooks []TweetURLHook
+var tweetURLAfterUpdateMu sync.Mutex
+var tweetURLAfterUpdateHooks []TweetURLHook
+
+var tweetURLBeforeDeleteMu sync.Mutex
+var tweetURLBeforeDeleteHooks []TweetURLHook
+var tweetURLAfterDeleteMu sync.Mutex
+var tweetURLAfterDeleteHooks []TweetURLHook
+
+var tweetURLBeforeUpsertMu sync.Mutex
+var tweetURLBeforeUpsertHooks []TweetURLHook
+var tweetURLAfterUpsertMu sync.Mutex
+var tweetURLAfterUpsertHooks []TweetURLHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *TweetURL) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tweetURLAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *TweetURL) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tweetURLBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *TweetURL) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tweetURLAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *TweetURL) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tweetURLBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *TweetURL) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tweetURLAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *TweetURL) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tweetURLBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *TweetURL) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tweetURLAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *TweetURL) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tweetURLBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *TweetURL) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tweetURLAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddTweetURLHook registers your hook function for all future operations.
+func AddTweetURLHook(hookPoint boil.HookPoint, tweetURLHook TweetURLHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		tweetURLAfterSelectMu.Lock()
+		tweetURLAfterSelectHooks = append(tweetURLAfterSelectHooks, tweetURLHook)
+		tweetURLAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		tweetURLBeforeInsertMu.Lock()
+		tweetURLBeforeInsertHooks = append(tweetURLBeforeInsertHooks, tweetURLHook)
+		tweetURLBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		tweetURLAfterInsertMu.Lock()
+		tweetURLAfterInsertHooks = append(tweetURLAfterInsertHooks, tweetURLHook)
+		tweetURLAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		tweetURLBeforeUpdateMu.Lock()
+		tweetURLBeforeUpdateHooks = append(tweetURLBeforeUpdateHooks, tweetURLHook)
+		tweetURLBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		tweetURLAfterUpdateMu.Lock()
+		tweetURLAfterUpdateHooks = append(tweetURLAfterUpdateHooks, tweetURLHook)
+		tweetURLAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		tweetURLBeforeDeleteMu.Lock()
+		tweetURLBeforeDeleteHooks = append(tweetURLBeforeDeleteHooks, tweetURLHook)
+		tweetURLBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		tweetURLAfterDeleteMu.Lock()
+		tweetURLAfterDeleteHooks = append(tweetURLAfterDeleteHooks, tweetURLHook)
+		tweetURLAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		tweetURLBeforeUpsertMu.Lock()
+		tweetURLBeforeUpsertHooks = append(tweetURLBeforeUpsertHooks, tweetURLHook)
+		tweetURLBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		tweetURLAfterUpsertMu.Lock()
+		tweetURLAfterUpsertHooks = append(tweetURLAfterUpsertHooks, tweetURLHook)
+		tweetURLAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single tweetURL record from the query.
+func (q tweetURLQuery) One(ctx context.Context, exec boil.ContextExecutor) (*TweetURL, error) {
+	o := &TweetURL{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "schema: failed to execute a one query for tweet_urls")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all TweetURL records from the query.
+func (q tweetURLQuery) All(ctx context.Context, exec boil.ContextExecutor) (TweetURLSlice, error) {
+	var o []*TweetURL
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "schema: failed to assign all query results to TweetURL slice")
+	}
+
+	if len(tweetURLAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all TweetURL records in the query.
+func (q tweetURLQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: failed to count tweet_urls rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q tweetURLQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "schema: failed to check if tweet_urls exists")
+	}
+
+	return count > 0, nil
+}
+
+// Tweet pointed to by the foreign key.
+func (o *TweetURL) Tweet(mods ...qm.QueryMod) tweetQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`id` = ?", o.TweetID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Tweets(queryMods...)
+}
+
+// LoadTweet allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (tweetURLL) LoadTweet(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTweetURL interface{}, mods queries.Applicator) error {
+	var slice []*TweetURL
+	var object *TweetURL
+
+	if singular {
+		var ok bool
+		object, ok = maybeTweetURL.(*TweetURL)
+		if !ok {
+			object = new(TweetURL)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTweetURL)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTweetURL))
+			}
+		}
+	} else {
+		s, ok := maybeTweetURL.(*[]*TweetURL)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTweetURL)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTweetURL))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &tweetURLR{}
+		}
+		args[object.TweetID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &tweetURLR{}
+			}
+
+			args[obj.TweetID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`tweets`),
+		qm.WhereIn(`tweets.id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`tweets.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Tweet")
+	}
+
+	var resultSlice []*Tweet
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Tweet")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for tweets")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tweets")
+	}
+
+	if len(tweetAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Tweet = foreign
+		if foreign.R == nil {
+			foreign.R = &tweetR{}
+		}
+		foreign.R.TweetUrls = append(foreign.R.TweetUrls, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.TweetID == foreign.ID {
+				local.R.Tweet = foreign
+				if foreign.R == nil {
+					foreign.R = &tweetR{}
+				}
+				foreign.R.TweetUrls = append(foreign.R.TweetUrls, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetTweet of the tweetURL to the related item.
+// Sets o.R.Tweet to related.
+// Adds o to related.R.TweetUrls.
+func (o *TweetURL) SetTweet(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Tweet) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `tweet_urls` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"tweet_id"}),
+		strmangle.WhereClause("`", "`", 0, tweetURLPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.TweetID, o.StartOffset}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.TweetID = related.ID
+	if o.R == nil {
+		o.R = &tweetURLR{
+			Tweet: related,
+		}
+	} else {
+		o.R.Tweet = related
+	}
+
+	if related.R == nil {
+		related.R = &tweetR{
+			TweetUrls: TweetURLSlice{o},
+		}
+	} else {
+		related.R.TweetUrls = append(related.R.TweetUrls, o)
+	}
+
+	return nil
+}
+
+// TweetUrls retrieves all the records using an executor.
+func TweetUrls(mods ...qm.QueryMod) tweetURLQuery {
+	mods = append(mods, qm.From("`tweet_urls`"))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"`tweet_urls`.*"})
+	}
+
+	return tweetURLQuery{q}
+}
+
+// FindTweetURL retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindTweetURL(ctx context.Context, exec boil.ContextExecutor, tweetID int, startOffset int, selectCols ...string) (*TweetURL, error) {
+	tweetURLObj := &TweetURL{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `tweet_urls` where `tweet_id`=? AND `start_offset`=?", sel,
+	)
+
+	q := queries.Raw(query, tweetID, startOffset)
+
+	err := q.Bind(ctx, exec, tweetURLObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "schema: unable to select from tweet_urls")
+	}
+
+	if err = tweetURLObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return tweetURLObj, err
+	}
+
+	return tweetURLObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *TweetURL) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("schema: no tweet_urls provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(tweetURLColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	tweetURLInsertCacheMut.RLock()
+	cache, cached := tweetURLInsertCache[key]
+	tweetURLInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			tweetURLAllColumns,
+			tweetURLColumnsWithDefault,
+			tweetURLColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(tweetURLType, tweetURLMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(tweetURLType, tweetURLMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `tweet_urls` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `tweet_urls` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `tweet_urls` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, tweetURLPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "schema: unable to insert into tweet_urls")
+	}
+
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.TweetID,
+		o.StartOffset,
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, identifierCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "schema: unable to populate default values for tweet_urls")
+	}
+
+CacheNoHooks:
+	if !cached {
+		tweetURLInsertCacheMut.Lock()
+		tweetURLInsertCache[key] = cache
+		tweetURLInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the TweetURL.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *TweetURL) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	tweetURLUpdateCacheMut.RLock()
+	cache, cached := tweetURLUpdateCache[key]
+	tweetURLUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			tweetURLAllColumns,
+			tweetURLPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("schema: unable to update tweet_urls, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `tweet_urls` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, tweetURLPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(tweetURLType, tweetURLMapping, append(wl, tweetURLPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to update tweet_urls row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: failed to get rows affected by update for tweet_urls")
+	}
+
+	if !cached {
+		tweetURLUpdateCacheMut.Lock()
+		tweetURLUpdateCache[key] = cache
+		tweetURLUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q tweetURLQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to update all for tweet_urls")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to retrieve rows affected for tweet_urls")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o TweetURLSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("schema: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), tweetURLPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `tweet_urls` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, tweetURLPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to update all in tweetURL slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to retrieve rows affected all in update all tweetURL")
+	}
+	return rowsAff, nil
+}
+
+var mySQLTweetURLUniqueColumns = []string{}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *TweetURL) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("schema: no tweet_urls provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(tweetURLColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLTweetURLUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	tweetURLUpsertCacheMut.RLock()
+	cache, cached := tweetURLUpsertCache[key]
+	tweetURLUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			tweetURLAllColumns,
+			tweetURLColumnsWithDefault,
+			tweetURLColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			tweetURLAllColumns,
+			tweetURLPrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("schema: unable to upsert tweet_urls, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(tweetURLAllColumns, strmangle.SetIntersect(insert, update))
+
+		cache.query = buildUpsertQueryMySQL(dialect, "`tweet_urls`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `tweet_urls` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(tweetURLType, tweetURLMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(tweetURLType, tweetURLMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "schema: unable to upsert for tweet_urls")
+	}
+
+	var uniqueMap []uint64
+	var nzUniqueCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(tweetURLType, tweetURLMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "schema: unable to retrieve unique values for tweet_urls")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, nzUniqueCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "schema: unable to populate default values for tweet_urls")
+	}
+
+CacheNoHooks:
+	if !cached {
+		tweetURLUpsertCacheMut.Lock()
+		tweetURLUpsertCache[key] = cache
+		tweetURLUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single TweetURL record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *TweetURL) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("schema: no TweetURL provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), tweetURLPrimaryKeyMapping)
+	sql := "DELETE FROM `tweet_urls` WHERE `tweet_id`=? AND `start_offset`=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to delete from tweet_urls")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: failed to get rows affected by delete for tweet_urls")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q tweetURLQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("schema: no tweetURLQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to delete all from tweet_urls")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: failed to get rows affected by deleteall for tweet_urls")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o TweetURLSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(tweetURLBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), tweetURLPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `tweet_urls` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, tweetURLPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: unable to delete all from tweetURL slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "schema: failed to get rows affected by deleteall for tweet_urls")
+	}
+
+	if len(tweetURLAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *TweetURL) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindTweetURL(ctx, exec, o.TweetID, o.StartOffset)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *TweetURLSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := TweetURLSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), tweetURLPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `tweet_urls`.* FROM `tweet_urls` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, tweetURLPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "schema: unable to reload all in TweetURLSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// TweetURLExists checks if the TweetURL row exists.
+func TweetURLExists(ctx context.Context, exec boil.ContextExecutor, tweetID int, startOffset int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `tweet_urls` where `tweet_id`=? AND `start_offset`=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, tweetID, startOffset)
+	}
+	row := exec.QueryRowContext(ctx, sql, tweetID, startOffset)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "schema: unable to check if tweet_urls exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the TweetURL row exists.
+func (o *TweetURL) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return TweetURLExists(ctx, exec, o.TweetID, o.StartOffset)
+}
@@ -24,149 +24,123 @@ import (
 
 // Tweet is an object representing the database table.
 type Tweet struct {
-	ID        int         `boil:"id" json:"id" toml:"id" yaml:"id"`
-	UserID    int         `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
-	Content   string      `boil:"content" json:"content" toml:"content" yaml:"content"`
-	ImageURL  null.String `boil:"image_url" json:"image_url,omitempty" toml:"image_url" yaml:"image_url,omitempty"`
-	CreatedAt null.Time   `boil:"created_at" json:"created_at,omitempty" toml:"created_at" yaml:"created_at,omitempty"`
-	UpdatedAt null.Time   `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
+	ID             int         `boil:"id" json:"id" toml:"id" yaml:"id"`
+	UserID         int         `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	Content        string      `boil:"content" json:"content" toml:"content" yaml:"content"`
+	ImageURL       null.String `boil:"image_url" json:"image_url,omitempty" toml:"image_url" yaml:"image_url,omitempty"`
+	CreatedAt      null.Time   `boil:"created_at" json:"created_at,omitempty" toml:"created_at" yaml:"created_at,omitempty"`
+	UpdatedAt      null.Time   `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
+	DeletedAt      null.Time   `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+	ReplyToTweetID null.Int    `boil:"reply_to_tweet_id" json:"reply_to_tweet_id,omitempty" toml:"reply_to_tweet_id" yaml:"reply_to_tweet_id,omitempty"`
+	ConversationID null.Int    `boil:"conversation_id" json:"conversation_id,omitempty" toml:"conversation_id" yaml:"conversation_id,omitempty"`
+	QuoteTweetID   null.Int    `boil:"quote_tweet_id" json:"quote_tweet_id,omitempty" toml:"quote_tweet_id" yaml:"quote_tweet_id,omitempty"`
 
 	R *tweetR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L tweetL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var TweetColumns = struct {
-	ID        string
-	UserID    string
-	Content   string
-	ImageURL  string
-	CreatedAt string
-	UpdatedAt string
+	ID             string
+	UserID         string
+	Content        string
+	ImageURL       string
+	CreatedAt      string
+	UpdatedAt      string
+	DeletedAt      string
+	ReplyToTweetID string
+	ConversationID string
+	QuoteTweetID   string
 }{
-	ID:        "id",
-	UserID:    "user_id",
-	Content:   "content",
-	ImageURL:  "image_url",
-	CreatedAt: "created_at",
-	UpdatedAt: "updated_at",
+	ID:             "id",
+	UserID:         "user_id",
+	Content:        "content",
+	ImageURL:       "image_url",
+	CreatedAt:      "created_at",
+	UpdatedAt:      "updated_at",
+	DeletedAt:      "deleted_at",
+	ReplyToTweetID: "reply_to_tweet_id",
+	ConversationID: "conversation_id",
+	QuoteTweetID:   "quote_tweet_id",
 }
 
 var TweetTableColumns = struct {
-	ID        string
-	UserID    string
-	Content   string
-	ImageURL  string
-	CreatedAt string
-	UpdatedAt string
+	ID             string
+	UserID         string
+	Content        string
+	ImageURL       string
+	CreatedAt      string
+	UpdatedAt      string
+	DeletedAt      string
+	ReplyToTweetID string
+	ConversationID string
+	QuoteTweetID   string
 }{
-	ID:        "tweets.id",
-	UserID:    "tweets.user_id",
-	Content:   "tweets.content",
-	ImageURL:  "tweets.image_url",
-	CreatedAt: "tweets.created_at",
-	UpdatedAt: "tweets.updated_at",
+	ID:             "tweets.id",
+	UserID:         "tweets.user_id",
+	Content:        "tweets.content",
+	ImageURL:       "tweets.image_url",
+	CreatedAt:      "tweets.created_at",
+	UpdatedAt:      "tweets.updated_at",
+	DeletedAt:      "tweets.deleted_at",
+	ReplyToTweetID: "tweets.reply_to_tweet_id",
+	ConversationID: "tweets.conversation_id",
+	QuoteTweetID:   "tweets.quote_tweet_id",
 }
 
 // Generated where
 
-type whereHelperstring struct{ field string }
-
-func (w whereHelperstring) EQ(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperstring) NEQ(x string) qm.QueryMod   { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperstring) LT(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperstring) LTE(x string) qm.QueryMod   { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperstring) GT(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperstring) GTE(x string) qm.QueryMod   { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-func (w whereHelperstring) LIKE(x string) qm.QueryMod  { return qm.Where(w.field+" LIKE ?", x) }
-func (w whereHelperstring) NLIKE(x string) qm.QueryMod { return qm.Where(w.field+" NOT LIKE ?", x) }
-func (w whereHelperstring) IN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
-}
-func (w whereHelperstring) NIN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
-}
-
-type whereHelpernull_String struct{ field string }
-
-func (w whereHelpernull_String) EQ(x null.String) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, false, x)
-}
-func (w whereHelpernull_String) NEQ(x null.String) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, true, x)
-}
-func (w whereHelpernull_String) LT(x null.String) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
-}
-func (w whereHelpernull_String) LTE(x null.String) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
-}
-func (w whereHelpernull_String) GT(x null.String) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
-}
-func (w whereHelpernull_String) GTE(x null.String) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
-}
-func (w whereHelpernull_String) LIKE(x null.String) qm.QueryMod {
-	return qm.Where(w.field+" LIKE ?", x)
-}
-func (w whereHelpernull_String) NLIKE(x null.String) qm.QueryMod {
-	return qm.Where(w.field+" NOT LIKE ?", x)
-}
-func (w whereHelpernull_String) IN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
-}
-func (w whereHelpernull_String) NIN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
-}
-
-func (w whereHelpernull_String) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
-func (w whereHelpernull_String) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
-
 var TweetWhere = struct {
-	ID        whereHelperint
-	UserID    whereHelperint
-	Content   whereHelperstring
-	ImageURL  whereHelpernull_String
-	CreatedAt whereHelpernull_Time
-	UpdatedAt whereHelpernull_Time
+	ID             whereHelperint
+	UserID         whereHelperint
+	Content        whereHelperstring
+	ImageURL       whereHelpernull_String
+	CreatedAt      whereHelpernull_Time
+	UpdatedAt      whereHelpernull_Time
+	DeletedAt      whereHelpernull_Time
+	ReplyToTweetID whereHelpernull_Int
+	ConversationID whereHelpernull_Int
+	QuoteTweetID   whereHelpernull_Int
 }{
-	ID:        whereHelperint{field: "`tweets`.`id`"},
-	UserID:    whereHelperint{field: "`tweets`.`user_id`"},
-	Content:   whereHelperstring{field: "`tweets`.`content`"},
-	ImageURL:  whereHelpernull_String{field: "`tweets`.`image_url`"},
-	CreatedAt: whereHelpernull_Time{field: "`tweets`.`created_at`"},
-	UpdatedAt: whereHelpernull_Time{field: "`tweets`.`updated_at`"},
+	ID:             whereHelperint{field: "`tweets`.`id`"},
+	UserID:         whereHelperint{field: "`tweets`.`user_id`"},
+	Content:        whereHelperstring{field: "`tweets`.`content`"},
+	ImageURL:       whereHelpernull_String{field: "`tweets`.`image_url`"},
+	CreatedAt:      whereHelpernull_Time{field: "`tweets`.`created_at`"},
+	UpdatedAt:      whereHelpernull_Time{field: "`tweets`.`updated_at`"},
+	DeletedAt:      whereHelpernull_Time{field: "`tweets`.`deleted_at`"},
+	ReplyToTweetID: whereHelpernull_Int{field: "`tweets`.`reply_to_tweet_id`"},
+	ConversationID: whereHelpernull_Int{field: "`tweets`.`conversation_id`"},
+	QuoteTweetID:   whereHelpernull_Int{field: "`tweets`.`quote_tweet_id`"},
 }
 
 // TweetRels is where relationship names are stored.
 var TweetRels = struct {
-	User  string
-	Likes string
+	User          string
+	Likes         string
+	Notifications string
+	Retweets      string
+	TweetHashtags string
+	TweetMentions string
+	TweetUrls     string
 }{
-	User:  "User",
-	Likes: "Likes",
+	User:          "User",
+	Likes:         "Likes",
+	Notifications: "Notifications",
+	Retweets:      "Retweets",
+	TweetHashtags: "TweetHashtags",
+	TweetMentions: "TweetMentions",
+	TweetUrls:     "TweetUrls",
 }
 
 // tweetR is where relationships are stored.
 type tweetR struct {
-	User  *User     `boil:"User" json:"User" toml:"User" yaml:"User"`
-	Likes LikeSlice `boil:"Likes" json:"Likes" toml:"Likes" yaml:"Likes"`
+	User          *User             `boil:"User" json:"User" toml:"User" yaml:"User"`
+	Likes         LikeSlice         `boil:"Likes" json:"Likes" toml:"Likes" yaml:"Likes"`
+	Notifications NotificationSlice `boil:"Notifications" json:"Notifications" toml:"Notifications" yaml:"Notifications"`
+	Retweets      RetweetSlice      `boil:"Retweets" json:"Retweets" toml:"Retweets" yaml:"Retweets"`
+	TweetHashtags TweetHashtagSlice `boil:"TweetHashtags" json:"TweetHashtags" toml:"TweetHashtags" yaml:"TweetHashtags"`
+	TweetMentions TweetMentionSlice `boil:"TweetMentions" json:"TweetMentions" toml:"TweetMentions" yaml:"TweetMentions"`
+	TweetUrls     TweetURLSlice     `boil:"TweetUrls" json:"TweetUrls" toml:"TweetUrls" yaml:"TweetUrls"`
 }
 
 // NewStruct creates a new relationship struct
@@ -188,12 +162,47 @@ func (r *tweetR) GetLikes() LikeSlice {
 	return r.Likes
 }
 
+func (r *tweetR) GetNotifications() NotificationSlice {
+	if r == nil {
+		return nil
+	}
+	return r.Notifications
+}
+
+func (r *tweetR) GetRetweets() RetweetSlice {
+	if r == nil {
+		return nil
+	}
+	return r.Retweets
+}
+
+func (r *tweetR) GetTweetHashtags() TweetHashtagSlice {
+	if r == nil {
+		return nil
+	}
+	return r.TweetHashtags
+}
+
+func (r *tweetR) GetTweetMentions() TweetMentionSlice {
+	if r == nil {
+		return nil
+	}
+	return r.TweetMentions
+}
+
+func (r *tweetR) GetTweetUrls() TweetURLSlice {
+	if r == nil {
+		return nil
+	}
+	return r.TweetUrls
+}
+
 // tweetL is where Load methods for each relationship are stored.
 type tweetL struct{}
 
 var (
-	tweetAllColumns            = []string{"id", "user_id", "content", "image_url", "created_at", "updated_at"}
-	tweetColumnsWithoutDefault = []string{"user_id", "content", "image_url"}
+	tweetAllColumns            = []string{"id", "user_id", "content", "image_url", "created_at", "updated_at", "deleted_at", "reply_to_tweet_id", "conversation_id", "quote_tweet_id"}
+	tweetColumnsWithoutDefault = []string{"user_id", "content", "image_url", "deleted_at", "reply_to_tweet_id", "conversation_id", "quote_tweet_id"}
 	tweetColumnsWithDefault    = []string{"id", "created_at", "updated_at"}
 	tweetPrimaryKeyColumns     = []string{"id"}
 	tweetGeneratedColumns      = []string{}
@@ -529,6 +538,76 @@ func (o *Tweet) Likes(mods ...qm.QueryMod) likeQuery {
 	return Likes(queryMods...)
 }
 
+// Notifications retrieves all the notification's Notifications with an executor.
+func (o *Tweet) Notifications(mods ...qm.QueryMod) notificationQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`notifications`.`tweet_id`=?", o.ID),
+	)
+
+	return Notifications(queryMods...)
+}
+
+// Retweets retrieves all the retweet's Retweets with an executor.
+func (o *Tweet) Retweets(mods ...qm.QueryMod) retweetQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`retweets`.`tweet_id`=?", o.ID),
+	)
+
+	return Retweets(queryMods...)
+}
+
+// TweetHashtags retrieves all the tweet_hashtag's TweetHashtags with an executor.
+func (o *Tweet) TweetHashtags(mods ...qm.QueryMod) tweetHashtagQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`tweet_hashtags`.`tweet_id`=?", o.ID),
+	)
+
+	return TweetHashtags(queryMods...)
+}
+
+// TweetMentions retrieves all the tweet_mention's TweetMentions with an executor.
+func (o *Tweet) TweetMentions(mods ...qm.QueryMod) tweetMentionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`tweet_mentions`.`tweet_id`=?", o.ID),
+	)
+
+	return TweetMentions(queryMods...)
+}
+
+// TweetUrls retrieves all the tweet_url's TweetUrls with an executor.
+func (o *Tweet) TweetUrls(mods ...qm.QueryMod) tweetURLQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`tweet_urls`.`tweet_id`=?", o.ID),
+	)
+
+	return TweetUrls(queryMods...)
+}
+
 // LoadUser allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
 func (tweetL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTweet interface{}, mods queries.Applicator) error {
@@ -589,6 +668,7 @@ func (tweetL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular boo
 	query := NewQuery(
 		qm.From(`users`),
 		qm.WhereIn(`users.id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`users.deleted_at`),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -762,116 +842,1020 @@ func (tweetL) LoadLikes(ctx context.Context, e boil.ContextExecutor, singular bo
 	return nil
 }
 
-// SetUser of the tweet to the related item.
-// Sets o.R.User to related.
-// Adds o to related.R.Tweets.
-func (o *Tweet) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
-	var err error
-	if insert {
-		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
+// LoadNotifications allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (tweetL) LoadNotifications(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTweet interface{}, mods queries.Applicator) error {
+	var slice []*Tweet
+	var object *Tweet
+
+	if singular {
+		var ok bool
+		object, ok = maybeTweet.(*Tweet)
+		if !ok {
+			object = new(Tweet)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTweet)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTweet))
+			}
+		}
+	} else {
+		s, ok := maybeTweet.(*[]*Tweet)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTweet)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTweet))
+			}
 		}
 	}
 
-	updateQuery := fmt.Sprintf(
-		"UPDATE `tweets` SET %s WHERE %s",
-		strmangle.SetParamNames("`", "`", 0, []string{"user_id"}),
-		strmangle.WhereClause("`", "`", 0, tweetPrimaryKeyColumns),
-	)
-	values := []interface{}{related.ID, o.ID}
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &tweetR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &tweetR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
 
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, updateQuery)
-		fmt.Fprintln(writer, values)
+	if len(args) == 0 {
+		return nil
 	}
-	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-		return errors.Wrap(err, "failed to update local table")
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
 	}
 
-	o.UserID = related.ID
-	if o.R == nil {
-		o.R = &tweetR{
-			User: related,
-		}
-	} else {
-		o.R.User = related
+	query := NewQuery(
+		qm.From(`notifications`),
+		qm.WhereIn(`notifications.tweet_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
 	}
 
-	if related.R == nil {
-		related.R = &userR{
-			Tweets: TweetSlice{o},
-		}
-	} else {
-		related.R.Tweets = append(related.R.Tweets, o)
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load notifications")
 	}
 
-	return nil
-}
+	var resultSlice []*Notification
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice notifications")
+	}
 
-// AddLikes adds the given related objects to the existing relationships
-// of the tweet, optionally inserting them as new records.
-// Appends related to o.R.Likes.
-// Sets related.R.Tweet appropriately.
-func (o *Tweet) AddLikes(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Like) error {
-	var err error
-	for _, rel := range related {
-		if insert {
-			rel.TweetID = o.ID
-			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
-				return errors.Wrap(err, "failed to insert into foreign table")
-			}
-		} else {
-			updateQuery := fmt.Sprintf(
-				"UPDATE `likes` SET %s WHERE %s",
-				strmangle.SetParamNames("`", "`", 0, []string{"tweet_id"}),
-				strmangle.WhereClause("`", "`", 0, likePrimaryKeyColumns),
-			)
-			values := []interface{}{o.ID, rel.UserID, rel.TweetID}
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on notifications")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for notifications")
+	}
 
-			if boil.IsDebug(ctx) {
-				writer := boil.DebugWriterFrom(ctx)
-				fmt.Fprintln(writer, updateQuery)
-				fmt.Fprintln(writer, values)
-			}
-			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-				return errors.Wrap(err, "failed to update foreign table")
+	if len(notificationAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
 			}
-
-			rel.TweetID = o.ID
 		}
 	}
-
-	if o.R == nil {
-		o.R = &tweetR{
-			Likes: related,
+	if singular {
+		object.R.Notifications = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &notificationR{}
+			}
+			foreign.R.Tweet = object
 		}
-	} else {
-		o.R.Likes = append(o.R.Likes, related...)
+		return nil
 	}
 
-	for _, rel := range related {
-		if rel.R == nil {
-			rel.R = &likeR{
-				Tweet: o,
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.TweetID) {
+				local.R.Notifications = append(local.R.Notifications, foreign)
+				if foreign.R == nil {
+					foreign.R = &notificationR{}
+				}
+				foreign.R.Tweet = local
+				break
 			}
-		} else {
-			rel.R.Tweet = o
 		}
 	}
+
 	return nil
 }
 
-// Tweets retrieves all the records using an executor.
-func Tweets(mods ...qm.QueryMod) tweetQuery {
-	mods = append(mods, qm.From("`tweets`"))
-	q := NewQuery(mods...)
-	if len(queries.GetSelect(q)) == 0 {
-		queries.SetSelect(q, []string{"`tweets`.*"})
-	}
-
-	return tweetQuery{q}
-}
+// LoadRetweets allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (tweetL) LoadRetweets(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTweet interface{}, mods queries.Applicator) error {
+	var slice []*Tweet
+	var object *Tweet
+
+	if singular {
+		var ok bool
+		object, ok = maybeTweet.(*Tweet)
+		if !ok {
+			object = new(Tweet)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTweet)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTweet))
+			}
+		}
+	} else {
+		s, ok := maybeTweet.(*[]*Tweet)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTweet)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTweet))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &tweetR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &tweetR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`retweets`),
+		qm.WhereIn(`retweets.tweet_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load retweets")
+	}
+
+	var resultSlice []*Retweet
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice retweets")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on retweets")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for retweets")
+	}
+
+	if len(retweetAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Retweets = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &retweetR{}
+			}
+			foreign.R.Tweet = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.TweetID {
+				local.R.Retweets = append(local.R.Retweets, foreign)
+				if foreign.R == nil {
+					foreign.R = &retweetR{}
+				}
+				foreign.R.Tweet = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTweetHashtags allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (tweetL) LoadTweetHashtags(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTweet interface{}, mods queries.Applicator) error {
+	var slice []*Tweet
+	var object *Tweet
+
+	if singular {
+		var ok bool
+		object, ok = maybeTweet.(*Tweet)
+		if !ok {
+			object = new(Tweet)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTweet)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTweet))
+			}
+		}
+	} else {
+		s, ok := maybeTweet.(*[]*Tweet)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTweet)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTweet))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &tweetR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &tweetR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`tweet_hashtags`),
+		qm.WhereIn(`tweet_hashtags.tweet_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load tweet_hashtags")
+	}
+
+	var resultSlice []*TweetHashtag
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice tweet_hashtags")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on tweet_hashtags")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tweet_hashtags")
+	}
+
+	if len(tweetHashtagAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.TweetHashtags = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &tweetHashtagR{}
+			}
+			foreign.R.Tweet = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.TweetID {
+				local.R.TweetHashtags = append(local.R.TweetHashtags, foreign)
+				if foreign.R == nil {
+					foreign.R = &tweetHashtagR{}
+				}
+				foreign.R.Tweet = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTweetMentions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (tweetL) LoadTweetMentions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTweet interface{}, mods queries.Applicator) error {
+	var slice []*Tweet
+	var object *Tweet
+
+	if singular {
+		var ok bool
+		object, ok = maybeTweet.(*Tweet)
+		if !ok {
+			object = new(Tweet)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTweet)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTweet))
+			}
+		}
+	} else {
+		s, ok := maybeTweet.(*[]*Tweet)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTweet)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTweet))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &tweetR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &tweetR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`tweet_mentions`),
+		qm.WhereIn(`tweet_mentions.tweet_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load tweet_mentions")
+	}
+
+	var resultSlice []*TweetMention
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice tweet_mentions")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on tweet_mentions")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tweet_mentions")
+	}
+
+	if len(tweetMentionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.TweetMentions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &tweetMentionR{}
+			}
+			foreign.R.Tweet = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.TweetID {
+				local.R.TweetMentions = append(local.R.TweetMentions, foreign)
+				if foreign.R == nil {
+					foreign.R = &tweetMentionR{}
+				}
+				foreign.R.Tweet = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTweetUrls allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (tweetL) LoadTweetUrls(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTweet interface{}, mods queries.Applicator) error {
+	var slice []*Tweet
+	var object *Tweet
+
+	if singular {
+		var ok bool
+		object, ok = maybeTweet.(*Tweet)
+		if !ok {
+			object = new(Tweet)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTweet)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTweet))
+			}
+		}
+	} else {
+		s, ok := maybeTweet.(*[]*Tweet)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTweet)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTweet))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &tweetR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &tweetR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`tweet_urls`),
+		qm.WhereIn(`tweet_urls.tweet_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load tweet_urls")
+	}
+
+	var resultSlice []*TweetURL
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice tweet_urls")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on tweet_urls")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tweet_urls")
+	}
+
+	if len(tweetURLAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.TweetUrls = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &tweetURLR{}
+			}
+			foreign.R.Tweet = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.TweetID {
+				local.R.TweetUrls = append(local.R.TweetUrls, foreign)
+				if foreign.R == nil {
+					foreign.R = &tweetURLR{}
+				}
+				foreign.R.Tweet = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetUser of the tweet to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.Tweets.
+func (o *Tweet) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `tweets` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"user_id"}),
+		strmangle.WhereClause("`", "`", 0, tweetPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &tweetR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			Tweets: TweetSlice{o},
+		}
+	} else {
+		related.R.Tweets = append(related.R.Tweets, o)
+	}
+
+	return nil
+}
+
+// AddLikes adds the given related objects to the existing relationships
+// of the tweet, optionally inserting them as new records.
+// Appends related to o.R.Likes.
+// Sets related.R.Tweet appropriately.
+func (o *Tweet) AddLikes(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Like) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.TweetID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `likes` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"tweet_id"}),
+				strmangle.WhereClause("`", "`", 0, likePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.UserID, rel.TweetID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.TweetID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &tweetR{
+			Likes: related,
+		}
+	} else {
+		o.R.Likes = append(o.R.Likes, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &likeR{
+				Tweet: o,
+			}
+		} else {
+			rel.R.Tweet = o
+		}
+	}
+	return nil
+}
+
+// AddNotifications adds the given related objects to the existing relationships
+// of the tweet, optionally inserting them as new records.
+// Appends related to o.R.Notifications.
+// Sets related.R.Tweet appropriately.
+func (o *Tweet) AddNotifications(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Notification) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.TweetID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `notifications` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"tweet_id"}),
+				strmangle.WhereClause("`", "`", 0, notificationPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.TweetID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &tweetR{
+			Notifications: related,
+		}
+	} else {
+		o.R.Notifications = append(o.R.Notifications, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &notificationR{
+				Tweet: o,
+			}
+		} else {
+			rel.R.Tweet = o
+		}
+	}
+	return nil
+}
+
+// SetNotifications removes all previously related items of the
+// tweet replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Tweet's Notifications accordingly.
+// Replaces o.R.Notifications with related.
+// Sets related.R.Tweet's Notifications accordingly.
+func (o *Tweet) SetNotifications(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Notification) error {
+	query := "update `notifications` set `tweet_id` = null where `tweet_id` = ?"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.Notifications {
+			queries.SetScanner(&rel.TweetID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Tweet = nil
+		}
+		o.R.Notifications = nil
+	}
+
+	return o.AddNotifications(ctx, exec, insert, related...)
+}
+
+// RemoveNotifications relationships from objects passed in.
+// Removes related items from R.Notifications (uses pointer comparison, removal does not keep order)
+// Sets related.R.Tweet.
+func (o *Tweet) RemoveNotifications(ctx context.Context, exec boil.ContextExecutor, related ...*Notification) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.TweetID, nil)
+		if rel.R != nil {
+			rel.R.Tweet = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("tweet_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.Notifications {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.Notifications)
+			if ln > 1 && i < ln-1 {
+				o.R.Notifications[i] = o.R.Notifications[ln-1]
+			}
+			o.R.Notifications = o.R.Notifications[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddRetweets adds the given related objects to the existing relationships
+// of the tweet, optionally inserting them as new records.
+// Appends related to o.R.Retweets.
+// Sets related.R.Tweet appropriately.
+func (o *Tweet) AddRetweets(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Retweet) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.TweetID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `retweets` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"tweet_id"}),
+				strmangle.WhereClause("`", "`", 0, retweetPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.UserID, rel.TweetID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.TweetID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &tweetR{
+			Retweets: related,
+		}
+	} else {
+		o.R.Retweets = append(o.R.Retweets, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &retweetR{
+				Tweet: o,
+			}
+		} else {
+			rel.R.Tweet = o
+		}
+	}
+	return nil
+}
+
+// AddTweetHashtags adds the given related objects to the existing relationships
+// of the tweet, optionally inserting them as new records.
+// Appends related to o.R.TweetHashtags.
+// Sets related.R.Tweet appropriately.
+func (o *Tweet) AddTweetHashtags(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*TweetHashtag) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.TweetID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `tweet_hashtags` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"tweet_id"}),
+				strmangle.WhereClause("`", "`", 0, tweetHashtagPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.TweetID, rel.StartOffset}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.TweetID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &tweetR{
+			TweetHashtags: related,
+		}
+	} else {
+		o.R.TweetHashtags = append(o.R.TweetHashtags, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &tweetHashtagR{
+				Tweet: o,
+			}
+		} else {
+			rel.R.Tweet = o
+		}
+	}
+	return nil
+}
+
+// AddTweetMentions adds the given related objects to the existing relationships
+// of the tweet, optionally inserting them as new records.
+// Appends related to o.R.TweetMentions.
+// Sets related.R.Tweet appropriately.
+func (o *Tweet) AddTweetMentions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*TweetMention) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.TweetID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `tweet_mentions` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"tweet_id"}),
+				strmangle.WhereClause("`", "`", 0, tweetMentionPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.TweetID, rel.StartOffset}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.TweetID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &tweetR{
+			TweetMentions: related,
+		}
+	} else {
+		o.R.TweetMentions = append(o.R.TweetMentions, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &tweetMentionR{
+				Tweet: o,
+			}
+		} else {
+			rel.R.Tweet = o
+		}
+	}
+	return nil
+}
+
+// AddTweetUrls adds the given related objects to the existing relationships
+// of the tweet, optionally inserting them as new records.
+// Appends related to o.R.TweetUrls.
+// Sets related.R.Tweet appropriately.
+func (o *Tweet) AddTweetUrls(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*TweetURL) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.TweetID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `tweet_urls` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"tweet_id"}),
+				strmangle.WhereClause("`", "`", 0, tweetURLPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.TweetID, rel.StartOffset}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.TweetID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &tweetR{
+			TweetUrls: related,
+		}
+	} else {
+		o.R.TweetUrls = append(o.R.TweetUrls, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &tweetURLR{
+				Tweet: o,
+			}
+		} else {
+			rel.R.Tweet = o
+		}
+	}
+	return nil
+}
+
+// Tweets retrieves all the records using an executor.
+func Tweets(mods ...qm.QueryMod) tweetQuery {
+	mods = append(mods, qm.From("`tweets`"), qmhelper.WhereIsNull("`tweets`.`deleted_at`"))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"`tweets`.*"})
+	}
+
+	return tweetQuery{q}
+}
 
 // FindTweet retrieves a single record by ID with an executor.
 // If selectCols is empty Find will return all columns.
@@ -883,7 +1867,7 @@ func FindTweet(ctx context.Context, exec boil.ContextExecutor, iD int, selectCol
 		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
 	}
 	query := fmt.Sprintf(
-		"select %s from `tweets` where `id`=?", sel,
+		"select %s from `tweets` where `id`=? and `deleted_at` is null", sel,
 	)
 
 	q := queries.Raw(query, iD)
@@ -1313,7 +2297,7 @@ CacheNoHooks:
 
 // Delete deletes a single Tweet record with an executor.
 // Delete will match against the primary key column to find the record to delete.
-func (o *Tweet) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+func (o *Tweet) Delete(ctx context.Context, exec boil.ContextExecutor, hardDelete bool) (int64, error) {
 	if o == nil {
 		return 0, errors.New("schema: no Tweet provided for delete")
 	}
@@ -1322,8 +2306,26 @@ func (o *Tweet) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, e
 		return 0, err
 	}
 
-	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), tweetPrimaryKeyMapping)
-	sql := "DELETE FROM `tweets` WHERE `id`=?"
+	var (
+		sql  string
+		args []interface{}
+	)
+	if hardDelete {
+		args = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), tweetPrimaryKeyMapping)
+		sql = "DELETE FROM `tweets` WHERE `id`=?"
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		o.DeletedAt = null.TimeFrom(currTime)
+		wl := []string{"deleted_at"}
+		sql = fmt.Sprintf("UPDATE `tweets` SET %s WHERE `id`=?",
+			strmangle.SetParamNames("`", "`", 0, wl),
+		)
+		valueMapping, err := queries.BindMapping(tweetType, tweetMapping, append(wl, tweetPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+		args = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), valueMapping)
+	}
 
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
@@ -1348,12 +2350,17 @@ func (o *Tweet) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, e
 }
 
 // DeleteAll deletes all matching rows.
-func (q tweetQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+func (q tweetQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor, hardDelete bool) (int64, error) {
 	if q.Query == nil {
 		return 0, errors.New("schema: no tweetQuery provided for delete all")
 	}
 
-	queries.SetDelete(q.Query)
+	if hardDelete {
+		queries.SetDelete(q.Query)
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		queries.SetUpdate(q.Query, M{"deleted_at": currTime})
+	}
 
 	result, err := q.Query.ExecContext(ctx, exec)
 	if err != nil {
@@ -1369,7 +2376,7 @@ func (q tweetQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (i
 }
 
 // DeleteAll deletes all rows in the slice, using an executor.
-func (o TweetSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+func (o TweetSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor, hardDelete bool) (int64, error) {
 	if len(o) == 0 {
 		return 0, nil
 	}
@@ -1382,15 +2389,32 @@ func (o TweetSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (i
 		}
 	}
 
-	var args []interface{}
-	for _, obj := range o {
-		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), tweetPrimaryKeyMapping)
-		args = append(args, pkeyArgs...)
+	var (
+		sql  string
+		args []interface{}
+	)
+	if hardDelete {
+		for _, obj := range o {
+			pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), tweetPrimaryKeyMapping)
+			args = append(args, pkeyArgs...)
+		}
+		sql = "DELETE FROM `tweets` WHERE " +
+			strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, tweetPrimaryKeyColumns, len(o))
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		for _, obj := range o {
+			pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), tweetPrimaryKeyMapping)
+			args = append(args, pkeyArgs...)
+			obj.DeletedAt = null.TimeFrom(currTime)
+		}
+		wl := []string{"deleted_at"}
+		sql = fmt.Sprintf("UPDATE `tweets` SET %s WHERE "+
+			strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, tweetPrimaryKeyColumns, len(o)),
+			strmangle.SetParamNames("`", "`", 0, wl),
+		)
+		args = append([]interface{}{currTime}, args...)
 	}
 
-	sql := "DELETE FROM `tweets` WHERE " +
-		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, tweetPrimaryKeyColumns, len(o))
-
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
 		fmt.Fprintln(writer, sql)
@@ -1444,7 +2468,8 @@ func (o *TweetSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) e
 	}
 
 	sql := "SELECT `tweets`.* FROM `tweets` WHERE " +
-		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, tweetPrimaryKeyColumns, len(*o))
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, tweetPrimaryKeyColumns, len(*o)) +
+		"and `deleted_at` is null"
 
 	q := queries.Raw(sql, args...)
 
@@ -1461,7 +2486,7 @@ func (o *TweetSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) e
 // TweetExists checks if the Tweet row exists.
 func TweetExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
 	var exists bool
-	sql := "select exists(select 1 from `tweets` where `id`=? limit 1)"
+	sql := "select exists(select 1 from `tweets` where `id`=? and `deleted_at` is null limit 1)"
 
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
@@ -33,6 +33,10 @@ type User struct {
 	ProfileImageURL null.String `boil:"profile_image_url" json:"profile_image_url,omitempty" toml:"profile_image_url" yaml:"profile_image_url,omitempty"`
 	CreatedAt       null.Time   `boil:"created_at" json:"created_at,omitempty" toml:"created_at" yaml:"created_at,omitempty"`
 	UpdatedAt       null.Time   `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
+	FollowersCount  int         `boil:"followers_count" json:"followers_count" toml:"followers_count" yaml:"followers_count"`
+	FollowingCount  int         `boil:"following_count" json:"following_count" toml:"following_count" yaml:"following_count"`
+	TweetsCount     int         `boil:"tweets_count" json:"tweets_count" toml:"tweets_count" yaml:"tweets_count"`
+	DeletedAt       null.Time   `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
 
 	R *userR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L userL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -48,6 +52,10 @@ var UserColumns = struct {
 	ProfileImageURL string
 	CreatedAt       string
 	UpdatedAt       string
+	FollowersCount  string
+	FollowingCount  string
+	TweetsCount     string
+	DeletedAt       string
 }{
 	ID:              "id",
 	Username:        "username",
@@ -58,6 +66,10 @@ var UserColumns = struct {
 	ProfileImageURL: "profile_image_url",
 	CreatedAt:       "created_at",
 	UpdatedAt:       "updated_at",
+	FollowersCount:  "followers_count",
+	FollowingCount:  "following_count",
+	TweetsCount:     "tweets_count",
+	DeletedAt:       "deleted_at",
 }
 
 var UserTableColumns = struct {
@@ -70,6 +82,10 @@ var UserTableColumns = struct {
 	ProfileImageURL string
 	CreatedAt       string
 	UpdatedAt       string
+	FollowersCount  string
+	FollowingCount  string
+	TweetsCount     string
+	DeletedAt       string
 }{
 	ID:              "users.id",
 	Username:        "users.username",
@@ -80,6 +96,10 @@ var UserTableColumns = struct {
 	ProfileImageURL: "users.profile_image_url",
 	CreatedAt:       "users.created_at",
 	UpdatedAt:       "users.updated_at",
+	FollowersCount:  "users.followers_count",
+	FollowingCount:  "users.following_count",
+	TweetsCount:     "users.tweets_count",
+	DeletedAt:       "users.deleted_at",
 }
 
 // Generated where
@@ -94,6 +114,10 @@ var UserWhere = struct {
 	ProfileImageURL whereHelpernull_String
 	CreatedAt       whereHelpernull_Time
 	UpdatedAt       whereHelpernull_Time
+	FollowersCount  whereHelperint
+	FollowingCount  whereHelperint
+	TweetsCount     whereHelperint
+	DeletedAt       whereHelpernull_Time
 }{
 	ID:              whereHelperint{field: "`users`.`id`"},
 	Username:        whereHelperstring{field: "`users`.`username`"},
@@ -104,27 +128,70 @@ var UserWhere = struct {
 	ProfileImageURL: whereHelpernull_String{field: "`users`.`profile_image_url`"},
 	CreatedAt:       whereHelpernull_Time{field: "`users`.`created_at`"},
 	UpdatedAt:       whereHelpernull_Time{field: "`users`.`updated_at`"},
+	FollowersCount:  whereHelperint{field: "`users`.`followers_count`"},
+	FollowingCount:  whereHelperint{field: "`users`.`following_count`"},
+	TweetsCount:     whereHelperint{field: "`users`.`tweets_count`"},
+	DeletedAt:       whereHelpernull_Time{field: "`users`.`deleted_at`"},
 }
 
 // UserRels is where relationship names are stored.
 var UserRels = struct {
-	FollowerFollows  string
-	FollowingFollows string
-	Likes            string
-	Tweets           string
+	DMOptIn             string
+	BlockerBlocks       string
+	BlockedBlocks       string
+	DataExports         string
+	SenderDMMessages    string
+	DMParticipants      string
+	FollowerFollows     string
+	FollowingFollows    string
+	Likes               string
+	MuterMutes          string
+	MutedMutes          string
+	NotificationOptOuts string
+	Notifications       string
+	ActorNotifications  string
+	Retweets            string
+	TweetMentions       string
+	Tweets              string
 }{
-	FollowerFollows:  "FollowerFollows",
-	FollowingFollows: "FollowingFollows",
-	Likes:            "Likes",
-	Tweets:           "Tweets",
+	DMOptIn:             "DMOptIn",
+	BlockerBlocks:       "BlockerBlocks",
+	BlockedBlocks:       "BlockedBlocks",
+	DataExports:         "DataExports",
+	SenderDMMessages:    "SenderDMMessages",
+	DMParticipants:      "DMParticipants",
+	FollowerFollows:     "FollowerFollows",
+	FollowingFollows:    "FollowingFollows",
+	Likes:               "Likes",
+	MuterMutes:          "MuterMutes",
+	MutedMutes:          "MutedMutes",
+	NotificationOptOuts: "NotificationOptOuts",
+	Notifications:       "Notifications",
+	ActorNotifications:  "ActorNotifications",
+	Retweets:            "Retweets",
+	TweetMentions:       "TweetMentions",
+	Tweets:              "Tweets",
 }
 
 // userR is where relationships are stored.
 type userR struct {
-	FollowerFollows  FollowSlice `boil:"FollowerFollows" json:"FollowerFollows" toml:"FollowerFollows" yaml:"FollowerFollows"`
-	FollowingFollows FollowSlice `boil:"FollowingFollows" json:"FollowingFollows" toml:"FollowingFollows" yaml:"FollowingFollows"`
-	Likes            LikeSlice   `boil:"Likes" json:"Likes" toml:"Likes" yaml:"Likes"`
-	Tweets           TweetSlice  `boil:"Tweets" json:"Tweets" toml:"Tweets" yaml:"Tweets"`
+	DMOptIn             *DMOptIn                `boil:"DMOptIn" json:"DMOptIn" toml:"DMOptIn" yaml:"DMOptIn"`
+	BlockerBlocks       BlockSlice              `boil:"BlockerBlocks" json:"BlockerBlocks" toml:"BlockerBlocks" yaml:"BlockerBlocks"`
+	BlockedBlocks       BlockSlice              `boil:"BlockedBlocks" json:"BlockedBlocks" toml:"BlockedBlocks" yaml:"BlockedBlocks"`
+	DataExports         DataExportSlice         `boil:"DataExports" json:"DataExports" toml:"DataExports" yaml:"DataExports"`
+	SenderDMMessages    DMMessageSlice          `boil:"SenderDMMessages" json:"SenderDMMessages" toml:"SenderDMMessages" yaml:"SenderDMMessages"`
+	DMParticipants      DMParticipantSlice      `boil:"DMParticipants" json:"DMParticipants" toml:"DMParticipants" yaml:"DMParticipants"`
+	FollowerFollows     FollowSlice             `boil:"FollowerFollows" json:"FollowerFollows" toml:"FollowerFollows" yaml:"FollowerFollows"`
+	FollowingFollows    FollowSlice             `boil:"FollowingFollows" json:"FollowingFollows" toml:"FollowingFollows" yaml:"FollowingFollows"`
+	Likes               LikeSlice               `boil:"Likes" json:"Likes" toml:"Likes" yaml:"Likes"`
+	MuterMutes          MuteSlice               `boil:"MuterMutes" json:"MuterMutes" toml:"MuterMutes" yaml:"MuterMutes"`
+	MutedMutes          MuteSlice               `boil:"MutedMutes" json:"MutedMutes" toml:"MutedMutes" yaml:"MutedMutes"`
+	NotificationOptOuts NotificationOptOutSlice `boil:"NotificationOptOuts" json:"NotificationOptOuts" toml:"NotificationOptOuts" yaml:"NotificationOptOuts"`
+	Notifications       NotificationSlice       `boil:"Notifications" json:"Notifications" toml:"Notifications" yaml:"Notifications"`
+	ActorNotifications  NotificationSlice       `boil:"ActorNotifications" json:"ActorNotifications" toml:"ActorNotifications" yaml:"ActorNotifications"`
+	Retweets            RetweetSlice            `boil:"Retweets" json:"Retweets" toml:"Retweets" yaml:"Retweets"`
+	TweetMentions       TweetMentionSlice       `boil:"TweetMentions" json:"TweetMentions" toml:"TweetMentions" yaml:"TweetMentions"`
+	Tweets              TweetSlice              `boil:"Tweets" json:"Tweets" toml:"Tweets" yaml:"Tweets"`
 }
 
 // NewStruct creates a new relationship struct
@@ -132,6 +199,48 @@ func (*userR) NewStruct() *userR {
 	return &userR{}
 }
 
+func (r *userR) GetDMOptIn() *DMOptIn {
+	if r == nil {
+		return nil
+	}
+	return r.DMOptIn
+}
+
+func (r *userR) GetBlockerBlocks() BlockSlice {
+	if r == nil {
+		return nil
+	}
+	return r.BlockerBlocks
+}
+
+func (r *userR) GetBlockedBlocks() BlockSlice {
+	if r == nil {
+		return nil
+	}
+	return r.BlockedBlocks
+}
+
+func (r *userR) GetDataExports() DataExportSlice {
+	if r == nil {
+		return nil
+	}
+	return r.DataExports
+}
+
+func (r *userR) GetSenderDMMessages() DMMessageSlice {
+	if r == nil {
+		return nil
+	}
+	return r.SenderDMMessages
+}
+
+func (r *userR) GetDMParticipants() DMParticipantSlice {
+	if r == nil {
+		return nil
+	}
+	return r.DMParticipants
+}
+
 func (r *userR) GetFollowerFollows() FollowSlice {
 	if r == nil {
 		return nil
@@ -153,6 +262,55 @@ func (r *userR) GetLikes() LikeSlice {
 	return r.Likes
 }
 
+func (r *userR) GetMuterMutes() MuteSlice {
+	if r == nil {
+		return nil
+	}
+	return r.MuterMutes
+}
+
+func (r *userR) GetMutedMutes() MuteSlice {
+	if r == nil {
+		return nil
+	}
+	return r.MutedMutes
+}
+
+func (r *userR) GetNotificationOptOuts() NotificationOptOutSlice {
+	if r == nil {
+		return nil
+	}
+	return r.NotificationOptOuts
+}
+
+func (r *userR) GetNotifications() NotificationSlice {
+	if r == nil {
+		return nil
+	}
+	return r.Notifications
+}
+
+func (r *userR) GetActorNotifications() NotificationSlice {
+	if r == nil {
+		return nil
+	}
+	return r.ActorNotifications
+}
+
+func (r *userR) GetRetweets() RetweetSlice {
+	if r == nil {
+		return nil
+	}
+	return r.Retweets
+}
+
+func (r *userR) GetTweetMentions() TweetMentionSlice {
+	if r == nil {
+		return nil
+	}
+	return r.TweetMentions
+}
+
 func (r *userR) GetTweets() TweetSlice {
 	if r == nil {
 		return nil
@@ -164,9 +322,9 @@ func (r *userR) GetTweets() TweetSlice {
 type userL struct{}
 
 var (
-	userAllColumns            = []string{"id", "username", "display_name", "email", "password_hash", "bio", "profile_image_url", "created_at", "updated_at"}
-	userColumnsWithoutDefault = []string{"username", "display_name", "email", "password_hash", "bio", "profile_image_url"}
-	userColumnsWithDefault    = []string{"id", "created_at", "updated_at"}
+	userAllColumns            = []string{"id", "username", "display_name", "email", "password_hash", "bio", "profile_image_url", "created_at", "updated_at", "followers_count", "following_count", "tweets_count", "deleted_at"}
+	userColumnsWithoutDefault = []string{"username", "display_name", "email", "password_hash", "bio", "profile_image_url", "deleted_at"}
+	userColumnsWithDefault    = []string{"id", "created_at", "updated_at", "followers_count", "following_count", "tweets_count"}
 	userPrimaryKeyColumns     = []string{"id"}
 	userGeneratedColumns      = []string{}
 )
@@ -476,6 +634,87 @@ func (q userQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool,
 	return count > 0, nil
 }
 
+// DMOptIn pointed to by the foreign key.
+func (o *User) DMOptIn(mods ...qm.QueryMod) dmOptInQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`user_id` = ?", o.ID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return DMOptIns(queryMods...)
+}
+
+// BlockerBlocks retrieves all the block's Blocks with an executor via blocker_id column.
+func (o *User) BlockerBlocks(mods ...qm.QueryMod) blockQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`blocks`.`blocker_id`=?", o.ID),
+	)
+
+	return Blocks(queryMods...)
+}
+
+// BlockedBlocks retrieves all the block's Blocks with an executor via blocked_id column.
+func (o *User) BlockedBlocks(mods ...qm.QueryMod) blockQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`blocks`.`blocked_id`=?", o.ID),
+	)
+
+	return Blocks(queryMods...)
+}
+
+// DataExports retrieves all the data_export's DataExports with an executor.
+func (o *User) DataExports(mods ...qm.QueryMod) dataExportQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`data_exports`.`user_id`=?", o.ID),
+	)
+
+	return DataExports(queryMods...)
+}
+
+// SenderDMMessages retrieves all the dm_message's DMMessages with an executor via sender_id column.
+func (o *User) SenderDMMessages(mods ...qm.QueryMod) dmMessageQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`dm_messages`.`sender_id`=?", o.ID),
+	)
+
+	return DMMessages(queryMods...)
+}
+
+// DMParticipants retrieves all the dm_participant's DMParticipants with an executor.
+func (o *User) DMParticipants(mods ...qm.QueryMod) dmParticipantQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`dm_participants`.`user_id`=?", o.ID),
+	)
+
+	return DMParticipants(queryMods...)
+}
+
 // FollowerFollows retrieves all the follow's Follows with an executor via follower_id column.
 func (o *User) FollowerFollows(mods ...qm.QueryMod) followQuery {
 	var queryMods []qm.QueryMod
@@ -518,6 +757,104 @@ func (o *User) Likes(mods ...qm.QueryMod) likeQuery {
 	return Likes(queryMods...)
 }
 
+// MuterMutes retrieves all the mute's Mutes with an executor via muter_id column.
+func (o *User) MuterMutes(mods ...qm.QueryMod) muteQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`mutes`.`muter_id`=?", o.ID),
+	)
+
+	return Mutes(queryMods...)
+}
+
+// MutedMutes retrieves all the mute's Mutes with an executor via muted_id column.
+func (o *User) MutedMutes(mods ...qm.QueryMod) muteQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`mutes`.`muted_id`=?", o.ID),
+	)
+
+	return Mutes(queryMods...)
+}
+
+// NotificationOptOuts retrieves all the notification_opt_out's NotificationOptOuts with an executor.
+func (o *User) NotificationOptOuts(mods ...qm.QueryMod) notificationOptOutQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`notification_opt_outs`.`user_id`=?", o.ID),
+	)
+
+	return NotificationOptOuts(queryMods...)
+}
+
+// Notifications retrieves all the notification's Notifications with an executor.
+func (o *User) Notifications(mods ...qm.QueryMod) notificationQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`notifications`.`user_id`=?", o.ID),
+	)
+
+	return Notifications(queryMods...)
+}
+
+// ActorNotifications retrieves all the notification's Notifications with an executor via actor_id column.
+func (o *User) ActorNotifications(mods ...qm.QueryMod) notificationQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`notifications`.`actor_id`=?", o.ID),
+	)
+
+	return Notifications(queryMods...)
+}
+
+// Retweets retrieves all the retweet's Retweets with an executor.
+func (o *User) Retweets(mods ...qm.QueryMod) retweetQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`retweets`.`user_id`=?", o.ID),
+	)
+
+	return Retweets(queryMods...)
+}
+
+// TweetMentions retrieves all the tweet_mention's TweetMentions with an executor.
+func (o *User) TweetMentions(mods ...qm.QueryMod) tweetMentionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`tweet_mentions`.`user_id`=?", o.ID),
+	)
+
+	return TweetMentions(queryMods...)
+}
+
 // Tweets retrieves all the tweet's Tweets with an executor.
 func (o *User) Tweets(mods ...qm.QueryMod) tweetQuery {
 	var queryMods []qm.QueryMod
@@ -532,9 +869,9 @@ func (o *User) Tweets(mods ...qm.QueryMod) tweetQuery {
 	return Tweets(queryMods...)
 }
 
-// LoadFollowerFollows allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadFollowerFollows(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+// LoadDMOptIn allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (userL) LoadDMOptIn(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -571,6 +908,7 @@ func (userL) LoadFollowerFollows(ctx context.Context, e boil.ContextExecutor, si
 			if obj.R == nil {
 				obj.R = &userR{}
 			}
+
 			args[obj.ID] = struct{}{}
 		}
 	}
@@ -587,8 +925,8 @@ func (userL) LoadFollowerFollows(ctx context.Context, e boil.ContextExecutor, si
 	}
 
 	query := NewQuery(
-		qm.From(`follows`),
-		qm.WhereIn(`follows.follower_id in ?`, argsSlice...),
+		qm.From(`dm_opt_ins`),
+		qm.WhereIn(`dm_opt_ins.user_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -596,47 +934,50 @@ func (userL) LoadFollowerFollows(ctx context.Context, e boil.ContextExecutor, si
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load follows")
+		return errors.Wrap(err, "failed to eager load DMOptIn")
 	}
 
-	var resultSlice []*Follow
+	var resultSlice []*DMOptIn
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice follows")
+		return errors.Wrap(err, "failed to bind eager loaded slice DMOptIn")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on follows")
+		return errors.Wrap(err, "failed to close results of eager load for dm_opt_ins")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for follows")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for dm_opt_ins")
 	}
 
-	if len(followAfterSelectHooks) != 0 {
+	if len(dmOptInAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
 			}
 		}
 	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
 	if singular {
-		object.R.FollowerFollows = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &followR{}
-			}
-			foreign.R.Follower = object
+		foreign := resultSlice[0]
+		object.R.DMOptIn = foreign
+		if foreign.R == nil {
+			foreign.R = &dmOptInR{}
 		}
-		return nil
+		foreign.R.User = object
 	}
 
-	for _, foreign := range resultSlice {
-		for _, local := range slice {
-			if local.ID == foreign.FollowerID {
-				local.R.FollowerFollows = append(local.R.FollowerFollows, foreign)
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ID == foreign.UserID {
+				local.R.DMOptIn = foreign
 				if foreign.R == nil {
-					foreign.R = &followR{}
+					foreign.R = &dmOptInR{}
 				}
-				foreign.R.Follower = local
+				foreign.R.User = local
 				break
 			}
 		}
@@ -645,9 +986,9 @@ func (userL) LoadFollowerFollows(ctx context.Context, e boil.ContextExecutor, si
 	return nil
 }
 
-// LoadFollowingFollows allows an eager lookup of values, cached into the
+// LoadBlockerBlocks allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadFollowingFollows(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadBlockerBlocks(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -700,8 +1041,8 @@ func (userL) LoadFollowingFollows(ctx context.Context, e boil.ContextExecutor, s
 	}
 
 	query := NewQuery(
-		qm.From(`follows`),
-		qm.WhereIn(`follows.following_id in ?`, argsSlice...),
+		qm.From(`blocks`),
+		qm.WhereIn(`blocks.blocker_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -709,22 +1050,22 @@ func (userL) LoadFollowingFollows(ctx context.Context, e boil.ContextExecutor, s
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load follows")
+		return errors.Wrap(err, "failed to eager load blocks")
 	}
 
-	var resultSlice []*Follow
+	var resultSlice []*Block
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice follows")
+		return errors.Wrap(err, "failed to bind eager loaded slice blocks")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on follows")
+		return errors.Wrap(err, "failed to close results in eager load on blocks")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for follows")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for blocks")
 	}
 
-	if len(followAfterSelectHooks) != 0 {
+	if len(blockAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -732,24 +1073,24 @@ func (userL) LoadFollowingFollows(ctx context.Context, e boil.ContextExecutor, s
 		}
 	}
 	if singular {
-		object.R.FollowingFollows = resultSlice
+		object.R.BlockerBlocks = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &followR{}
+				foreign.R = &blockR{}
 			}
-			foreign.R.Following = object
+			foreign.R.Blocker = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == foreign.FollowingID {
-				local.R.FollowingFollows = append(local.R.FollowingFollows, foreign)
+			if local.ID == foreign.BlockerID {
+				local.R.BlockerBlocks = append(local.R.BlockerBlocks, foreign)
 				if foreign.R == nil {
-					foreign.R = &followR{}
+					foreign.R = &blockR{}
 				}
-				foreign.R.Following = local
+				foreign.R.Blocker = local
 				break
 			}
 		}
@@ -758,9 +1099,9 @@ func (userL) LoadFollowingFollows(ctx context.Context, e boil.ContextExecutor, s
 	return nil
 }
 
-// LoadLikes allows an eager lookup of values, cached into the
+// LoadBlockedBlocks allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadLikes(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadBlockedBlocks(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -813,8 +1154,8 @@ func (userL) LoadLikes(ctx context.Context, e boil.ContextExecutor, singular boo
 	}
 
 	query := NewQuery(
-		qm.From(`likes`),
-		qm.WhereIn(`likes.user_id in ?`, argsSlice...),
+		qm.From(`blocks`),
+		qm.WhereIn(`blocks.blocked_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -822,22 +1163,22 @@ func (userL) LoadLikes(ctx context.Context, e boil.ContextExecutor, singular boo
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load likes")
+		return errors.Wrap(err, "failed to eager load blocks")
 	}
 
-	var resultSlice []*Like
+	var resultSlice []*Block
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice likes")
+		return errors.Wrap(err, "failed to bind eager loaded slice blocks")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on likes")
+		return errors.Wrap(err, "failed to close results in eager load on blocks")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for likes")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for blocks")
 	}
 
-	if len(likeAfterSelectHooks) != 0 {
+	if len(blockAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -845,24 +1186,24 @@ func (userL) LoadLikes(ctx context.Context, e boil.ContextExecutor, singular boo
 		}
 	}
 	if singular {
-		object.R.Likes = resultSlice
+		object.R.BlockedBlocks = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &likeR{}
+				foreign.R = &blockR{}
 			}
-			foreign.R.User = object
+			foreign.R.Blocked = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == foreign.UserID {
-				local.R.Likes = append(local.R.Likes, foreign)
+			if local.ID == foreign.BlockedID {
+				local.R.BlockedBlocks = append(local.R.BlockedBlocks, foreign)
 				if foreign.R == nil {
-					foreign.R = &likeR{}
+					foreign.R = &blockR{}
 				}
-				foreign.R.User = local
+				foreign.R.Blocked = local
 				break
 			}
 		}
@@ -871,138 +1212,2294 @@ func (userL) LoadLikes(ctx context.Context, e boil.ContextExecutor, singular boo
 	return nil
 }
 
-// LoadTweets allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadTweets(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
-	var slice []*User
-	var object *User
-
-	if singular {
-		var ok bool
-		object, ok = maybeUser.(*User)
-		if !ok {
-			object = new(User)
-			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
-			if !ok {
-				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+// LoadDataExports allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadDataExports(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`data_exports`),
+		qm.WhereIn(`data_exports.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load data_exports")
+	}
+
+	var resultSlice []*DataExport
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice data_exports")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on data_exports")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for data_exports")
+	}
+
+	if len(dataExportAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.DataExports = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &dataExportR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.DataExports = append(local.R.DataExports, foreign)
+				if foreign.R == nil {
+					foreign.R = &dataExportR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSenderDMMessages allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadSenderDMMessages(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`dm_messages`),
+		qm.WhereIn(`dm_messages.sender_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load dm_messages")
+	}
+
+	var resultSlice []*DMMessage
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice dm_messages")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on dm_messages")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for dm_messages")
+	}
+
+	if len(dmMessageAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.SenderDMMessages = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &dmMessageR{}
+			}
+			foreign.R.Sender = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.SenderID {
+				local.R.SenderDMMessages = append(local.R.SenderDMMessages, foreign)
+				if foreign.R == nil {
+					foreign.R = &dmMessageR{}
+				}
+				foreign.R.Sender = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadDMParticipants allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadDMParticipants(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`dm_participants`),
+		qm.WhereIn(`dm_participants.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load dm_participants")
+	}
+
+	var resultSlice []*DMParticipant
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice dm_participants")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on dm_participants")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for dm_participants")
+	}
+
+	if len(dmParticipantAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.DMParticipants = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &dmParticipantR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.DMParticipants = append(local.R.DMParticipants, foreign)
+				if foreign.R == nil {
+					foreign.R = &dmParticipantR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadFollowerFollows allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadFollowerFollows(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`follows`),
+		qm.WhereIn(`follows.follower_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load follows")
+	}
+
+	var resultSlice []*Follow
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice follows")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on follows")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for follows")
+	}
+
+	if len(followAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.FollowerFollows = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &followR{}
+			}
+			foreign.R.Follower = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.FollowerID {
+				local.R.FollowerFollows = append(local.R.FollowerFollows, foreign)
+				if foreign.R == nil {
+					foreign.R = &followR{}
+				}
+				foreign.R.Follower = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadFollowingFollows allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadFollowingFollows(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`follows`),
+		qm.WhereIn(`follows.following_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load follows")
+	}
+
+	var resultSlice []*Follow
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice follows")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on follows")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for follows")
+	}
+
+	if len(followAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.FollowingFollows = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &followR{}
+			}
+			foreign.R.Following = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.FollowingID {
+				local.R.FollowingFollows = append(local.R.FollowingFollows, foreign)
+				if foreign.R == nil {
+					foreign.R = &followR{}
+				}
+				foreign.R.Following = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadLikes allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadLikes(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`likes`),
+		qm.WhereIn(`likes.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load likes")
+	}
+
+	var resultSlice []*Like
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice likes")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on likes")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for likes")
+	}
+
+	if len(likeAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Likes = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &likeR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.Likes = append(local.R.Likes, foreign)
+				if foreign.R == nil {
+					foreign.R = &likeR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadMuterMutes allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadMuterMutes(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`mutes`),
+		qm.WhereIn(`mutes.muter_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load mutes")
+	}
+
+	var resultSlice []*Mute
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice mutes")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on mutes")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for mutes")
+	}
+
+	if len(muteAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.MuterMutes = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &muteR{}
+			}
+			foreign.R.Muter = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.MuterID {
+				local.R.MuterMutes = append(local.R.MuterMutes, foreign)
+				if foreign.R == nil {
+					foreign.R = &muteR{}
+				}
+				foreign.R.Muter = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadMutedMutes allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadMutedMutes(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`mutes`),
+		qm.WhereIn(`mutes.muted_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load mutes")
+	}
+
+	var resultSlice []*Mute
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice mutes")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on mutes")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for mutes")
+	}
+
+	if len(muteAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.MutedMutes = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &muteR{}
+			}
+			foreign.R.Muted = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.MutedID {
+				local.R.MutedMutes = append(local.R.MutedMutes, foreign)
+				if foreign.R == nil {
+					foreign.R = &muteR{}
+				}
+				foreign.R.Muted = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadNotificationOptOuts allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadNotificationOptOuts(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`notification_opt_outs`),
+		qm.WhereIn(`notification_opt_outs.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load notification_opt_outs")
+	}
+
+	var resultSlice []*NotificationOptOut
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice notification_opt_outs")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on notification_opt_outs")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for notification_opt_outs")
+	}
+
+	if len(notificationOptOutAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.NotificationOptOuts = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &notificationOptOutR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.NotificationOptOuts = append(local.R.NotificationOptOuts, foreign)
+				if foreign.R == nil {
+					foreign.R = &notificationOptOutR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadNotifications allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadNotifications(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`notifications`),
+		qm.WhereIn(`notifications.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load notifications")
+	}
+
+	var resultSlice []*Notification
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice notifications")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on notifications")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for notifications")
+	}
+
+	if len(notificationAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Notifications = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &notificationR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.Notifications = append(local.R.Notifications, foreign)
+				if foreign.R == nil {
+					foreign.R = &notificationR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadActorNotifications allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadActorNotifications(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`notifications`),
+		qm.WhereIn(`notifications.actor_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load notifications")
+	}
+
+	var resultSlice []*Notification
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice notifications")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on notifications")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for notifications")
+	}
+
+	if len(notificationAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ActorNotifications = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &notificationR{}
+			}
+			foreign.R.Actor = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ActorID {
+				local.R.ActorNotifications = append(local.R.ActorNotifications, foreign)
+				if foreign.R == nil {
+					foreign.R = &notificationR{}
+				}
+				foreign.R.Actor = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadRetweets allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadRetweets(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`retweets`),
+		qm.WhereIn(`retweets.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load retweets")
+	}
+
+	var resultSlice []*Retweet
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice retweets")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on retweets")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for retweets")
+	}
+
+	if len(retweetAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Retweets = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &retweetR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.Retweets = append(local.R.Retweets, foreign)
+				if foreign.R == nil {
+					foreign.R = &retweetR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTweetMentions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadTweetMentions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`tweet_mentions`),
+		qm.WhereIn(`tweet_mentions.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load tweet_mentions")
+	}
+
+	var resultSlice []*TweetMention
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice tweet_mentions")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on tweet_mentions")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tweet_mentions")
+	}
+
+	if len(tweetMentionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.TweetMentions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &tweetMentionR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.TweetMentions = append(local.R.TweetMentions, foreign)
+				if foreign.R == nil {
+					foreign.R = &tweetMentionR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTweets allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadTweets(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`tweets`),
+		qm.WhereIn(`tweets.user_id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`tweets.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load tweets")
+	}
+
+	var resultSlice []*Tweet
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice tweets")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on tweets")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tweets")
+	}
+
+	if len(tweetAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Tweets = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &tweetR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.Tweets = append(local.R.Tweets, foreign)
+				if foreign.R == nil {
+					foreign.R = &tweetR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetDMOptIn of the user to the related item.
+// Sets o.R.DMOptIn to related.
+// Adds o to related.R.User.
+func (o *User) SetDMOptIn(ctx context.Context, exec boil.ContextExecutor, insert bool, related *DMOptIn) error {
+	var err error
+
+	if insert {
+		related.UserID = o.ID
+
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE `dm_opt_ins` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, []string{"user_id"}),
+			strmangle.WhereClause("`", "`", 0, dmOptInPrimaryKeyColumns),
+		)
+		values := []interface{}{o.ID, related.UserID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, updateQuery)
+			fmt.Fprintln(writer, values)
+		}
+		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		related.UserID = o.ID
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			DMOptIn: related,
+		}
+	} else {
+		o.R.DMOptIn = related
+	}
+
+	if related.R == nil {
+		related.R = &dmOptInR{
+			User: o,
+		}
+	} else {
+		related.R.User = o
+	}
+	return nil
+}
+
+// AddBlockerBlocks adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.BlockerBlocks.
+// Sets related.R.Blocker appropriately.
+func (o *User) AddBlockerBlocks(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Block) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.BlockerID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `blocks` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"blocker_id"}),
+				strmangle.WhereClause("`", "`", 0, blockPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.BlockerID, rel.BlockedID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.BlockerID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			BlockerBlocks: related,
+		}
+	} else {
+		o.R.BlockerBlocks = append(o.R.BlockerBlocks, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &blockR{
+				Blocker: o,
+			}
+		} else {
+			rel.R.Blocker = o
+		}
+	}
+	return nil
+}
+
+// AddBlockedBlocks adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.BlockedBlocks.
+// Sets related.R.Blocked appropriately.
+func (o *User) AddBlockedBlocks(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Block) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.BlockedID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `blocks` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"blocked_id"}),
+				strmangle.WhereClause("`", "`", 0, blockPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.BlockerID, rel.BlockedID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.BlockedID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			BlockedBlocks: related,
+		}
+	} else {
+		o.R.BlockedBlocks = append(o.R.BlockedBlocks, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &blockR{
+				Blocked: o,
+			}
+		} else {
+			rel.R.Blocked = o
+		}
+	}
+	return nil
+}
+
+// AddDataExports adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.DataExports.
+// Sets related.R.User appropriately.
+func (o *User) AddDataExports(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*DataExport) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `data_exports` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"user_id"}),
+				strmangle.WhereClause("`", "`", 0, dataExportPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			DataExports: related,
+		}
+	} else {
+		o.R.DataExports = append(o.R.DataExports, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &dataExportR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddSenderDMMessages adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.SenderDMMessages.
+// Sets related.R.Sender appropriately.
+func (o *User) AddSenderDMMessages(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*DMMessage) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.SenderID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `dm_messages` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"sender_id"}),
+				strmangle.WhereClause("`", "`", 0, dmMessagePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.SenderID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			SenderDMMessages: related,
+		}
+	} else {
+		o.R.SenderDMMessages = append(o.R.SenderDMMessages, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &dmMessageR{
+				Sender: o,
+			}
+		} else {
+			rel.R.Sender = o
+		}
+	}
+	return nil
+}
+
+// AddDMParticipants adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.DMParticipants.
+// Sets related.R.User appropriately.
+func (o *User) AddDMParticipants(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*DMParticipant) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `dm_participants` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"user_id"}),
+				strmangle.WhereClause("`", "`", 0, dmParticipantPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ConversationID, rel.UserID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			DMParticipants: related,
+		}
+	} else {
+		o.R.DMParticipants = append(o.R.DMParticipants, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &dmParticipantR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddFollowerFollows adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.FollowerFollows.
+// Sets related.R.Follower appropriately.
+func (o *User) AddFollowerFollows(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Follow) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.FollowerID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `follows` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"follower_id"}),
+				strmangle.WhereClause("`", "`", 0, followPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.FollowerID, rel.FollowingID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.FollowerID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			FollowerFollows: related,
+		}
+	} else {
+		o.R.FollowerFollows = append(o.R.FollowerFollows, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &followR{
+				Follower: o,
+			}
+		} else {
+			rel.R.Follower = o
+		}
+	}
+	return nil
+}
+
+// AddFollowingFollows adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.FollowingFollows.
+// Sets related.R.Following appropriately.
+func (o *User) AddFollowingFollows(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Follow) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.FollowingID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `follows` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"following_id"}),
+				strmangle.WhereClause("`", "`", 0, followPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.FollowerID, rel.FollowingID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.FollowingID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			FollowingFollows: related,
+		}
+	} else {
+		o.R.FollowingFollows = append(o.R.FollowingFollows, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &followR{
+				Following: o,
+			}
+		} else {
+			rel.R.Following = o
+		}
+	}
+	return nil
+}
+
+// AddLikes adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.Likes.
+// Sets related.R.User appropriately.
+func (o *User) AddLikes(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Like) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `likes` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"user_id"}),
+				strmangle.WhereClause("`", "`", 0, likePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.UserID, rel.TweetID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			Likes: related,
+		}
+	} else {
+		o.R.Likes = append(o.R.Likes, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &likeR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddMuterMutes adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.MuterMutes.
+// Sets related.R.Muter appropriately.
+func (o *User) AddMuterMutes(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Mute) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.MuterID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `mutes` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"muter_id"}),
+				strmangle.WhereClause("`", "`", 0, mutePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.MuterID, rel.MutedID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.MuterID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			MuterMutes: related,
+		}
+	} else {
+		o.R.MuterMutes = append(o.R.MuterMutes, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &muteR{
+				Muter: o,
+			}
+		} else {
+			rel.R.Muter = o
+		}
+	}
+	return nil
+}
+
+// AddMutedMutes adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.MutedMutes.
+// Sets related.R.Muted appropriately.
+func (o *User) AddMutedMutes(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Mute) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.MutedID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
 			}
-		}
-	} else {
-		s, ok := maybeUser.(*[]*User)
-		if ok {
-			slice = *s
 		} else {
-			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
-			if !ok {
-				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			updateQuery := fmt.Sprintf(
+				"UPDATE `mutes` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"muted_id"}),
+				strmangle.WhereClause("`", "`", 0, mutePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.MuterID, rel.MutedID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
 			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.MutedID = o.ID
 		}
 	}
 
-	args := make(map[interface{}]struct{})
-	if singular {
-		if object.R == nil {
-			object.R = &userR{}
+	if o.R == nil {
+		o.R = &userR{
+			MutedMutes: related,
 		}
-		args[object.ID] = struct{}{}
 	} else {
-		for _, obj := range slice {
-			if obj.R == nil {
-				obj.R = &userR{}
+		o.R.MutedMutes = append(o.R.MutedMutes, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &muteR{
+				Muted: o,
 			}
-			args[obj.ID] = struct{}{}
+		} else {
+			rel.R.Muted = o
 		}
 	}
+	return nil
+}
 
-	if len(args) == 0 {
-		return nil
-	}
+// AddNotificationOptOuts adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.NotificationOptOuts.
+// Sets related.R.User appropriately.
+func (o *User) AddNotificationOptOuts(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*NotificationOptOut) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `notification_opt_outs` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"user_id"}),
+				strmangle.WhereClause("`", "`", 0, notificationOptOutPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.UserID, rel.Type}
 
-	argsSlice := make([]interface{}, len(args))
-	i := 0
-	for arg := range args {
-		argsSlice[i] = arg
-		i++
-	}
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
 
-	query := NewQuery(
-		qm.From(`tweets`),
-		qm.WhereIn(`tweets.user_id in ?`, argsSlice...),
-	)
-	if mods != nil {
-		mods.Apply(query)
+			rel.UserID = o.ID
+		}
 	}
 
-	results, err := query.QueryContext(ctx, e)
-	if err != nil {
-		return errors.Wrap(err, "failed to eager load tweets")
+	if o.R == nil {
+		o.R = &userR{
+			NotificationOptOuts: related,
+		}
+	} else {
+		o.R.NotificationOptOuts = append(o.R.NotificationOptOuts, related...)
 	}
 
-	var resultSlice []*Tweet
-	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice tweets")
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &notificationOptOutR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
 	}
+	return nil
+}
 
-	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on tweets")
-	}
-	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tweets")
-	}
+// AddNotifications adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.Notifications.
+// Sets related.R.User appropriately.
+func (o *User) AddNotifications(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Notification) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `notifications` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"user_id"}),
+				strmangle.WhereClause("`", "`", 0, notificationPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
 
-	if len(tweetAfterSelectHooks) != 0 {
-		for _, obj := range resultSlice {
-			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
-				return err
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
 			}
+
+			rel.UserID = o.ID
 		}
 	}
-	if singular {
-		object.R.Tweets = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &tweetR{}
-			}
-			foreign.R.User = object
+
+	if o.R == nil {
+		o.R = &userR{
+			Notifications: related,
 		}
-		return nil
+	} else {
+		o.R.Notifications = append(o.R.Notifications, related...)
 	}
 
-	for _, foreign := range resultSlice {
-		for _, local := range slice {
-			if local.ID == foreign.UserID {
-				local.R.Tweets = append(local.R.Tweets, foreign)
-				if foreign.R == nil {
-					foreign.R = &tweetR{}
-				}
-				foreign.R.User = local
-				break
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &notificationR{
+				User: o,
 			}
+		} else {
+			rel.R.User = o
 		}
 	}
-
 	return nil
 }
 
-// AddFollowerFollows adds the given related objects to the existing relationships
+// AddActorNotifications adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.FollowerFollows.
-// Sets related.R.Follower appropriately.
-func (o *User) AddFollowerFollows(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Follow) error {
+// Appends related to o.R.ActorNotifications.
+// Sets related.R.Actor appropriately.
+func (o *User) AddActorNotifications(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Notification) error {
 	var err error
 	for _, rel := range related {
 		if insert {
-			rel.FollowerID = o.ID
+			rel.ActorID = o.ID
 			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
 				return errors.Wrap(err, "failed to insert into foreign table")
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE `follows` SET %s WHERE %s",
-				strmangle.SetParamNames("`", "`", 0, []string{"follower_id"}),
-				strmangle.WhereClause("`", "`", 0, followPrimaryKeyColumns),
+				"UPDATE `notifications` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"actor_id"}),
+				strmangle.WhereClause("`", "`", 0, notificationPrimaryKeyColumns),
 			)
-			values := []interface{}{o.ID, rel.FollowerID, rel.FollowingID}
+			values := []interface{}{o.ID, rel.ID}
 
 			if boil.IsDebug(ctx) {
 				writer := boil.DebugWriterFrom(ctx)
@@ -1013,49 +3510,49 @@ func (o *User) AddFollowerFollows(ctx context.Context, exec boil.ContextExecutor
 				return errors.Wrap(err, "failed to update foreign table")
 			}
 
-			rel.FollowerID = o.ID
+			rel.ActorID = o.ID
 		}
 	}
 
 	if o.R == nil {
 		o.R = &userR{
-			FollowerFollows: related,
+			ActorNotifications: related,
 		}
 	} else {
-		o.R.FollowerFollows = append(o.R.FollowerFollows, related...)
+		o.R.ActorNotifications = append(o.R.ActorNotifications, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &followR{
-				Follower: o,
+			rel.R = &notificationR{
+				Actor: o,
 			}
 		} else {
-			rel.R.Follower = o
+			rel.R.Actor = o
 		}
 	}
 	return nil
 }
 
-// AddFollowingFollows adds the given related objects to the existing relationships
+// AddRetweets adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.FollowingFollows.
-// Sets related.R.Following appropriately.
-func (o *User) AddFollowingFollows(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Follow) error {
+// Appends related to o.R.Retweets.
+// Sets related.R.User appropriately.
+func (o *User) AddRetweets(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Retweet) error {
 	var err error
 	for _, rel := range related {
 		if insert {
-			rel.FollowingID = o.ID
+			rel.UserID = o.ID
 			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
 				return errors.Wrap(err, "failed to insert into foreign table")
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE `follows` SET %s WHERE %s",
-				strmangle.SetParamNames("`", "`", 0, []string{"following_id"}),
-				strmangle.WhereClause("`", "`", 0, followPrimaryKeyColumns),
+				"UPDATE `retweets` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"user_id"}),
+				strmangle.WhereClause("`", "`", 0, retweetPrimaryKeyColumns),
 			)
-			values := []interface{}{o.ID, rel.FollowerID, rel.FollowingID}
+			values := []interface{}{o.ID, rel.UserID, rel.TweetID}
 
 			if boil.IsDebug(ctx) {
 				writer := boil.DebugWriterFrom(ctx)
@@ -1066,35 +3563,35 @@ func (o *User) AddFollowingFollows(ctx context.Context, exec boil.ContextExecuto
 				return errors.Wrap(err, "failed to update foreign table")
 			}
 
-			rel.FollowingID = o.ID
+			rel.UserID = o.ID
 		}
 	}
 
 	if o.R == nil {
 		o.R = &userR{
-			FollowingFollows: related,
+			Retweets: related,
 		}
 	} else {
-		o.R.FollowingFollows = append(o.R.FollowingFollows, related...)
+		o.R.Retweets = append(o.R.Retweets, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &followR{
-				Following: o,
+			rel.R = &retweetR{
+				User: o,
 			}
 		} else {
-			rel.R.Following = o
+			rel.R.User = o
 		}
 	}
 	return nil
 }
 
-// AddLikes adds the given related objects to the existing relationships
+// AddTweetMentions adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.Likes.
+// Appends related to o.R.TweetMentions.
 // Sets related.R.User appropriately.
-func (o *User) AddLikes(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Like) error {
+func (o *User) AddTweetMentions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*TweetMention) error {
 	var err error
 	for _, rel := range related {
 		if insert {
@@ -1104,11 +3601,11 @@ func (o *User) AddLikes(ctx context.Context, exec boil.ContextExecutor, insert b
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE `likes` SET %s WHERE %s",
+				"UPDATE `tweet_mentions` SET %s WHERE %s",
 				strmangle.SetParamNames("`", "`", 0, []string{"user_id"}),
-				strmangle.WhereClause("`", "`", 0, likePrimaryKeyColumns),
+				strmangle.WhereClause("`", "`", 0, tweetMentionPrimaryKeyColumns),
 			)
-			values := []interface{}{o.ID, rel.UserID, rel.TweetID}
+			values := []interface{}{o.ID, rel.TweetID, rel.StartOffset}
 
 			if boil.IsDebug(ctx) {
 				writer := boil.DebugWriterFrom(ctx)
@@ -1125,15 +3622,15 @@ func (o *User) AddLikes(ctx context.Context, exec boil.ContextExecutor, insert b
 
 	if o.R == nil {
 		o.R = &userR{
-			Likes: related,
+			TweetMentions: related,
 		}
 	} else {
-		o.R.Likes = append(o.R.Likes, related...)
+		o.R.TweetMentions = append(o.R.TweetMentions, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &likeR{
+			rel.R = &tweetMentionR{
 				User: o,
 			}
 		} else {
@@ -1198,7 +3695,7 @@ func (o *User) AddTweets(ctx context.Context, exec boil.ContextExecutor, insert
 
 // Users retrieves all the records using an executor.
 func Users(mods ...qm.QueryMod) userQuery {
-	mods = append(mods, qm.From("`users`"))
+	mods = append(mods, qm.From("`users`"), qmhelper.WhereIsNull("`users`.`deleted_at`"))
 	q := NewQuery(mods...)
 	if len(queries.GetSelect(q)) == 0 {
 		queries.SetSelect(q, []string{"`users`.*"})
@@ -1217,7 +3714,7 @@ func FindUser(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols
 		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
 	}
 	query := fmt.Sprintf(
-		"select %s from `users` where `id`=?", sel,
+		"select %s from `users` where `id`=? and `deleted_at` is null", sel,
 	)
 
 	q := queries.Raw(query, iD)
@@ -1649,7 +4146,7 @@ CacheNoHooks:
 
 // Delete deletes a single User record with an executor.
 // Delete will match against the primary key column to find the record to delete.
-func (o *User) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+func (o *User) Delete(ctx context.Context, exec boil.ContextExecutor, hardDelete bool) (int64, error) {
 	if o == nil {
 		return 0, errors.New("schema: no User provided for delete")
 	}
@@ -1658,8 +4155,26 @@ func (o *User) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, er
 		return 0, err
 	}
 
-	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), userPrimaryKeyMapping)
-	sql := "DELETE FROM `users` WHERE `id`=?"
+	var (
+		sql  string
+		args []interface{}
+	)
+	if hardDelete {
+		args = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), userPrimaryKeyMapping)
+		sql = "DELETE FROM `users` WHERE `id`=?"
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		o.DeletedAt = null.TimeFrom(currTime)
+		wl := []string{"deleted_at"}
+		sql = fmt.Sprintf("UPDATE `users` SET %s WHERE `id`=?",
+			strmangle.SetParamNames("`", "`", 0, wl),
+		)
+		valueMapping, err := queries.BindMapping(userType, userMapping, append(wl, userPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+		args = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), valueMapping)
+	}
 
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
@@ -1684,12 +4199,17 @@ func (o *User) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, er
 }
 
 // DeleteAll deletes all matching rows.
-func (q userQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+func (q userQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor, hardDelete bool) (int64, error) {
 	if q.Query == nil {
 		return 0, errors.New("schema: no userQuery provided for delete all")
 	}
 
-	queries.SetDelete(q.Query)
+	if hardDelete {
+		queries.SetDelete(q.Query)
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		queries.SetUpdate(q.Query, M{"deleted_at": currTime})
+	}
 
 	result, err := q.Query.ExecContext(ctx, exec)
 	if err != nil {
@@ -1705,7 +4225,7 @@ func (q userQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (in
 }
 
 // DeleteAll deletes all rows in the slice, using an executor.
-func (o UserSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+func (o UserSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor, hardDelete bool) (int64, error) {
 	if len(o) == 0 {
 		return 0, nil
 	}
@@ -1718,15 +4238,32 @@ func (o UserSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (in
 		}
 	}
 
-	var args []interface{}
-	for _, obj := range o {
-		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), userPrimaryKeyMapping)
-		args = append(args, pkeyArgs...)
+	var (
+		sql  string
+		args []interface{}
+	)
+	if hardDelete {
+		for _, obj := range o {
+			pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), userPrimaryKeyMapping)
+			args = append(args, pkeyArgs...)
+		}
+		sql = "DELETE FROM `users` WHERE " +
+			strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, userPrimaryKeyColumns, len(o))
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		for _, obj := range o {
+			pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), userPrimaryKeyMapping)
+			args = append(args, pkeyArgs...)
+			obj.DeletedAt = null.TimeFrom(currTime)
+		}
+		wl := []string{"deleted_at"}
+		sql = fmt.Sprintf("UPDATE `users` SET %s WHERE "+
+			strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, userPrimaryKeyColumns, len(o)),
+			strmangle.SetParamNames("`", "`", 0, wl),
+		)
+		args = append([]interface{}{currTime}, args...)
 	}
 
-	sql := "DELETE FROM `users` WHERE " +
-		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, userPrimaryKeyColumns, len(o))
-
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
 		fmt.Fprintln(writer, sql)
@@ -1780,7 +4317,8 @@ func (o *UserSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) er
 	}
 
 	sql := "SELECT `users`.* FROM `users` WHERE " +
-		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, userPrimaryKeyColumns, len(*o))
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, userPrimaryKeyColumns, len(*o)) +
+		"and `deleted_at` is null"
 
 	q := queries.Raw(sql, args...)
 
@@ -1797,7 +4335,7 @@ func (o *UserSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) er
 // UserExists checks if the User row exists.
 func UserExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
 	var exists bool
-	sql := "select exists(select 1 from `users` where `id`=? limit 1)"
+	sql := "select exists(select 1 from `users` where `id`=? and `deleted_at` is null limit 1)"
 
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
@@ -0,0 +1,53 @@
+package schemacheck
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/volatiletech/sqlboiler/v4/drivers"
+	"github.com/volatiletech/sqlboiler/v4/drivers/sqlboiler-mysql/driver"
+)
+
+// LoadDatabase は sqlboiler の MySQL ドライバーでデータベースを読み取り、
+// モデル生成時と同じ型変換をしたテーブル情報を返す。
+// ignore に含まれるテーブル(マイグレーションのバージョン管理用など)は除外する
+func LoadDatabase(config drivers.Config, ignore ...string) ([]Table, error) {
+	info, err := driver.Assemble(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to introspect database: %w", err)
+	}
+
+	skip := make(map[string]bool, len(ignore))
+	for _, name := range ignore {
+		skip[name] = true
+	}
+
+	var tables []Table
+	for _, t := range info.Tables {
+		if t.IsView || skip[t.Name] {
+			continue
+		}
+
+		table := Table{Name: t.Name}
+		if t.PKey != nil {
+			table.PrimaryKey = t.PKey.Columns
+		}
+		for _, c := range t.Columns {
+			table.Columns = append(table.Columns, Column{
+				Name:     c.Name,
+				GoType:   c.Type,
+				Nullable: c.Nullable,
+			})
+			if c.Default != "" {
+				table.WithDefault = append(table.WithDefault, c.Name)
+			}
+			if c.Unique {
+				table.Unique = append(table.Unique, c.Name)
+			}
+		}
+		tables = append(tables, table)
+	}
+
+	sort.Slice(tables, func(i, j int) bool { return tables[i].Name < tables[j].Name })
+	return tables, nil
+}
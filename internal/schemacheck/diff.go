@@ -0,0 +1,111 @@
+package schemacheck
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Diff はデータベース(マイグレーション適用後)と生成済みモデルの差分を
+// 人が読める形式の行で返す。差分がなければ空
+func Diff(database, models []Table) []string {
+	var lines []string
+
+	modelByName := make(map[string]Table, len(models))
+	for _, t := range models {
+		modelByName[t.Name] = t
+	}
+	dbByName := make(map[string]Table, len(database))
+	for _, t := range database {
+		dbByName[t.Name] = t
+	}
+
+	for _, db := range database {
+		model, ok := modelByName[db.Name]
+		if !ok {
+			lines = append(lines, fmt.Sprintf("+ table %s: exists in migrations but has no generated model", db.Name))
+			continue
+		}
+		lines = append(lines, diffTable(db, model)...)
+	}
+	for _, model := range models {
+		if _, ok := dbByName[model.Name]; !ok {
+			lines = append(lines, fmt.Sprintf("- table %s: has a generated model but does not exist in migrations", model.Name))
+		}
+	}
+
+	return lines
+}
+
+func diffTable(db, model Table) []string {
+	var lines []string
+	add := func(format string, args ...interface{}) {
+		lines = append(lines, fmt.Sprintf("  %s: ", db.Name)+fmt.Sprintf(format, args...))
+	}
+
+	modelCols := make(map[string]Column, len(model.Columns))
+	for _, c := range model.Columns {
+		modelCols[c.Name] = c
+	}
+	dbCols := make(map[string]Column, len(db.Columns))
+	for _, c := range db.Columns {
+		dbCols[c.Name] = c
+	}
+
+	for _, c := range db.Columns {
+		mc, ok := modelCols[c.Name]
+		if !ok {
+			add("+ column %s %s: missing from model", c.Name, c.GoType)
+			continue
+		}
+		if c.Nullable != mc.Nullable {
+			add("~ column %s: nullable %t in migrations, %t in model", c.Name, c.Nullable, mc.Nullable)
+		}
+		if c.GoType != mc.GoType {
+			add("~ column %s: type %s in migrations, %s in model", c.Name, c.GoType, mc.GoType)
+		}
+	}
+	for _, c := range model.Columns {
+		if _, ok := dbCols[c.Name]; !ok {
+			add("- column %s %s: not in migrations", c.Name, c.GoType)
+		}
+	}
+
+	if len(lines) == 0 && !equal(columnNames(db.Columns), columnNames(model.Columns)) {
+		add("~ column order: %s in migrations, %s in model", list(columnNames(db.Columns)), list(columnNames(model.Columns)))
+	}
+	if !equal(db.PrimaryKey, model.PrimaryKey) {
+		add("~ primary key: %s in migrations, %s in model", list(db.PrimaryKey), list(model.PrimaryKey))
+	}
+	if !equal(db.Unique, model.Unique) {
+		add("~ unique columns: %s in migrations, %s in model", list(db.Unique), list(model.Unique))
+	}
+	if !equal(db.WithDefault, model.WithDefault) {
+		add("~ columns with default: %s in migrations, %s in model", list(db.WithDefault), list(model.WithDefault))
+	}
+
+	return lines
+}
+
+func columnNames(cols []Column) []string {
+	names := make([]string, len(cols))
+	for i, c := range cols {
+		names[i] = c.Name
+	}
+	return names
+}
+
+func equal(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func list(values []string) string {
+	return "[" + strings.Join(values, ", ") + "]"
+}
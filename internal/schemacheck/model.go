@@ -0,0 +1,215 @@
+package schemacheck
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Table はテーブル1つ分のスキーマ情報。
+// 生成済みモデルとデータベースの両方からこの形に変換して比較する
+type Table struct {
+	Name        string
+	Columns     []Column
+	PrimaryKey  []string
+	WithDefault []string
+	Unique      []string
+}
+
+// Column のGoの型は sqlboiler の型変換後のもの(null.String, time.Time など)で、
+// NULL 許容かどうかも型に現れる
+type Column struct {
+	Name     string
+	GoType   string
+	Nullable bool
+}
+
+// LoadModels は sqlboiler が生成したモデルのソース(dir/*.go)を解析し、
+// 各テーブルのカラム・型・キーの情報を取り出す
+func LoadModels(dir string) ([]Table, error) {
+	fset := token.NewFileSet()
+	files, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+
+	var tables []Table
+	for _, path := range files {
+		if strings.HasPrefix(filepath.Base(path), "boil_") || strings.HasSuffix(path, "_test.go") {
+			continue
+		}
+
+		file, err := parser.ParseFile(fset, path, nil, 0)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+		}
+
+		table, ok, err := tableFromFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		if ok {
+			tables = append(tables, table)
+		}
+	}
+
+	sort.Slice(tables, func(i, j int) bool { return tables[i].Name < tables[j].Name })
+	return tables, nil
+}
+
+// tableFromFile は1つのモデルファイルから情報を取り出す。
+// モデル名は "<Model>TableColumns" 変数の有無で判定する
+func tableFromFile(file *ast.File) (Table, bool, error) {
+	vars := stringSliceVars(file)
+
+	var model string
+	var tableName string
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.VAR {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			vs := spec.(*ast.ValueSpec)
+			for i, name := range vs.Names {
+				if !strings.HasSuffix(name.Name, "TableColumns") || i >= len(vs.Values) {
+					continue
+				}
+				model = strings.TrimSuffix(name.Name, "TableColumns")
+				tableName = tableNameFromColumns(vs.Values[i])
+			}
+		}
+	}
+	if model == "" {
+		return Table{}, false, nil
+	}
+	if tableName == "" {
+		return Table{}, false, fmt.Errorf("could not determine table name of %s", model)
+	}
+
+	lower := strings.ToLower(model[:1]) + model[1:]
+	table := Table{
+		Name:        tableName,
+		PrimaryKey:  vars[lower+"PrimaryKeyColumns"],
+		WithDefault: vars[lower+"ColumnsWithDefault"],
+		Unique:      vars["mySQL"+model+"UniqueColumns"],
+	}
+
+	fields := structFields(file, model)
+	for _, name := range vars[lower+"AllColumns"] {
+		goType, ok := fields[name]
+		if !ok {
+			return Table{}, false, fmt.Errorf("%s has no field for column %s", model, name)
+		}
+		table.Columns = append(table.Columns, Column{
+			Name:     name,
+			GoType:   goType,
+			Nullable: isNullType(goType),
+		})
+	}
+
+	return table, true, nil
+}
+
+// stringSliceVars は `x = []string{"a", "b"}` 形式の変数を集める
+func stringSliceVars(file *ast.File) map[string][]string {
+	vars := make(map[string][]string)
+	ast.Inspect(file, func(n ast.Node) bool {
+		vs, ok := n.(*ast.ValueSpec)
+		if !ok {
+			return true
+		}
+		for i, name := range vs.Names {
+			if i >= len(vs.Values) {
+				continue
+			}
+			lit, ok := vs.Values[i].(*ast.CompositeLit)
+			if !ok || types.ExprString(lit.Type) != "[]string" {
+				continue
+			}
+			values := make([]string, 0, len(lit.Elts))
+			for _, elt := range lit.Elts {
+				if s, ok := stringLit(elt); ok {
+					values = append(values, s)
+				}
+			}
+			vars[name.Name] = values
+		}
+		return true
+	})
+	return vars
+}
+
+// tableNameFromColumns は TableColumns の値("users.id" など)からテーブル名を取り出す
+func tableNameFromColumns(expr ast.Expr) string {
+	lit, ok := expr.(*ast.CompositeLit)
+	if !ok {
+		return ""
+	}
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+		if s, ok := stringLit(kv.Value); ok {
+			if i := strings.Index(s, "."); i > 0 {
+				return s[:i]
+			}
+		}
+	}
+	return ""
+}
+
+// structFields はモデル構造体の boil タグ(カラム名)からGoの型への対応を返す
+func structFields(file *ast.File, model string) map[string]string {
+	fields := make(map[string]string)
+	ast.Inspect(file, func(n ast.Node) bool {
+		ts, ok := n.(*ast.TypeSpec)
+		if !ok || ts.Name.Name != model {
+			return true
+		}
+		st, ok := ts.Type.(*ast.StructType)
+		if !ok {
+			return false
+		}
+		for _, field := range st.Fields.List {
+			if field.Tag == nil {
+				continue
+			}
+			tag, err := strconv.Unquote(field.Tag.Value)
+			if err != nil {
+				continue
+			}
+			column := reflect.StructTag(tag).Get("boil")
+			if column == "" || column == "-" {
+				continue
+			}
+			fields[column] = types.ExprString(field.Type)
+		}
+		return false
+	})
+	return fields
+}
+
+func stringLit(expr ast.Expr) (string, bool) {
+	bl, ok := expr.(*ast.BasicLit)
+	if !ok || bl.Kind != token.STRING {
+		return "", false
+	}
+	s, err := strconv.Unquote(bl.Value)
+	if err != nil {
+		return "", false
+	}
+	return s, true
+}
+
+func isNullType(goType string) bool {
+	return strings.HasPrefix(goType, "null.") || strings.HasPrefix(goType, "types.Null")
+}
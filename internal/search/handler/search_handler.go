@@ -0,0 +1,52 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"todoapp/internal/domain"
+	"todoapp/internal/search/model"
+	"todoapp/internal/search/usecase"
+
+	"github.com/labstack/echo/v4"
+)
+
+type SearchHandler struct {
+	usecase usecase.SearchUsecase
+}
+
+func NewSearchHandler(u usecase.SearchUsecase) *SearchHandler {
+	return &SearchHandler{
+		usecase: u,
+	}
+}
+
+// Search は q に一致するツイート(type=tweets)またはユーザー(type=users)を返す
+func (h *SearchHandler) Search(c echo.Context) error {
+	var req model.SearchRequest
+	if err := (&echo.DefaultBinder{}).BindQueryParams(c, &req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid query parameters"})
+	}
+
+	userID, _ := c.Get("user_id").(int)
+	ctx := c.Request().Context()
+	var (
+		result interface{}
+		err    error
+	)
+	switch req.Type {
+	case "", model.TypeTweets:
+		result, err = h.usecase.SearchTweets(ctx, userID, req)
+	case model.TypeUsers:
+		result, err = h.usecase.SearchUsers(ctx, userID, req)
+	default:
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "type must be tweets or users"})
+	}
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalid) {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+		}
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
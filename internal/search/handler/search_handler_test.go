@@ -0,0 +1,227 @@
+package handler_test
+
+import (
+	"net/http"
+	"net/url"
+	"reflect"
+	"strconv"
+	"testing"
+	"todoapp/internal/router"
+	"todoapp/internal/search"
+	"todoapp/internal/search/handler"
+	"todoapp/internal/search/model"
+	"todoapp/internal/search/usecase"
+	"todoapp/internal/testutil"
+	tweethandler "todoapp/internal/tweet/handler"
+	tweetmodel "todoapp/internal/tweet/model"
+	tweetrepository "todoapp/internal/tweet/repository"
+	tweetusecase "todoapp/internal/tweet/usecase"
+
+	"github.com/labstack/echo/v4"
+)
+
+func newTestServer(t *testing.T) *echo.Echo {
+	t.Helper()
+	env := testutil.NewEnv(t)
+	index := search.NewLocal()
+	search.RegisterIndexHooks(index)
+
+	tweetRepo := tweetrepository.NewTweetRepository(env.DB, env.DB)
+	return env.Server(router.Handlers{
+		Tweet:  tweethandler.NewTweetHandler(tweetusecase.NewTweetUsecase(tweetRepo, env.UserRepo, env.TxManager)),
+		Search: handler.NewSearchHandler(usecase.NewSearchUsecase(index, tweetRepo, env.UserRepo)),
+	})
+}
+
+func post(t *testing.T, e *echo.Echo, token, content string) int {
+	t.Helper()
+	rec := testutil.Do(t, e, http.MethodPost, "/api/tweets", token, `{"content":"`+content+`"}`)
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("POST /api/tweets: status = %d, body = %s", rec.Code, rec.Body)
+	}
+	var tweet tweetmodel.Tweet
+	testutil.Decode(t, rec, &tweet)
+	return tweet.ID
+}
+
+// searchTweets は q で検索したツイートのIDと次のページのカーソルを返す
+func searchTweets(t *testing.T, e *echo.Echo, token, q, cursor string) ([]int, *string) {
+	t.Helper()
+	params := url.Values{"q": {q}, "limit": {"2"}}
+	if cursor != "" {
+		params.Set("cursor", cursor)
+	}
+	rec := testutil.Do(t, e, http.MethodGet, "/api/search?"+params.Encode(), token, "")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("search %q: status = %d, body = %s", q, rec.Code, rec.Body)
+	}
+	var resp model.TweetResults
+	testutil.Decode(t, rec, &resp)
+	ids := []int{}
+	for _, tweet := range resp.Tweets {
+		ids = append(ids, tweet.ID)
+	}
+	return ids, resp.NextCursor
+}
+
+func TestSearchTweets(t *testing.T) {
+	e := newTestServer(t)
+	_, alice := testutil.RegisterAndLoginAs(t, e, "alice", "Alice")
+	_, bob := testutil.RegisterAndLoginAs(t, e, "bob", "Bob")
+
+	t1 := post(t, e, alice, "東京で Go のミートアップ")
+	t2 := post(t, e, bob, "東京タワーに行った")
+	t3 := post(t, e, alice, "京都と東京")
+	post(t, e, bob, "大阪のたこ焼き")
+
+	ids, next := searchTweets(t, e, alice, "東京", "")
+	if !equal(ids, t3, t2) || next == nil {
+		t.Fatalf("page 1 = %v, next = %v, want [%d %d]", ids, next, t3, t2)
+	}
+	ids, next = searchTweets(t, e, alice, "東京", *next)
+	if !equal(ids, t1) || next != nil {
+		t.Errorf("page 2 = %v, next = %v, want [%d]", ids, next, t1)
+	}
+
+	if ids, _ := searchTweets(t, e, alice, `"東京タワー" from:bob`, ""); !equal(ids, t2) {
+		t.Errorf(`"東京タワー" from:bob = %v, want [%d]`, ids, t2)
+	}
+	if ids, _ := searchTweets(t, e, alice, "from:alice ｇｏ", ""); !equal(ids, t1) {
+		t.Errorf("from:alice ｇｏ = %v, want [%d]", ids, t1)
+	}
+	if ids, _ := searchTweets(t, e, alice, "東京 until:2000-01-01", ""); !equal(ids) {
+		t.Errorf("until:2000-01-01 = %v, want none", ids)
+	}
+
+	// 削除したツイートは検索結果に含めない
+	if rec := testutil.Do(t, e, http.MethodDelete, "/api/tweets/"+strconv.Itoa(t3), alice, ""); rec.Code != http.StatusNoContent {
+		t.Fatalf("delete: status = %d, body = %s", rec.Code, rec.Body)
+	}
+	if ids, _ := searchTweets(t, e, alice, "京都", ""); !equal(ids) {
+		t.Errorf("after delete = %v, want none", ids)
+	}
+
+	for _, q := range []string{"", "since:2024-1-1", "東京&cursor=abc", "東京&type=hashtags"} {
+		rec := testutil.Do(t, e, http.MethodGet, "/api/search?q="+q, alice, "")
+		if rec.Code != http.StatusBadRequest {
+			t.Errorf("q=%s: status = %d, want 400, body = %s", q, rec.Code, rec.Body)
+		}
+	}
+	if rec := testutil.Do(t, e, http.MethodGet, "/api/search?q=test", "", ""); rec.Code != http.StatusUnauthorized {
+		t.Errorf("without token: status = %d, want 401", rec.Code)
+	}
+}
+
+func TestSearchUsers(t *testing.T) {
+	e := newTestServer(t)
+	_, alice := testutil.RegisterAndLoginAs(t, e, "alice", "東京のアリス")
+	testutil.RegisterAndLoginAs(t, e, "bob", "Bob")
+	_, carol := testutil.RegisterAndLoginAs(t, e, "carol", "東京のキャロル")
+
+	rec := testutil.Do(t, e, http.MethodGet, "/api/search?type=users&q="+url.QueryEscape("東京"), alice, "")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, body = %s", rec.Code, rec.Body)
+	}
+	var resp model.UserResults
+	testutil.Decode(t, rec, &resp)
+	if len(resp.Users) != 2 || resp.Users[0].User.Username != "carol" || resp.Users[1].User.Username != "alice" {
+		t.Fatalf("users = %+v, want carol, alice", resp.Users)
+	}
+
+	// ブロックしたユーザーとブロックされたユーザーは、お互いの検索結果に含めない
+	blockPath := "/api/users/" + strconv.Itoa(resp.Users[1].User.ID) + "/block"
+	if rec := testutil.Do(t, e, http.MethodPost, blockPath, carol, ""); rec.Code != http.StatusCreated {
+		t.Fatalf("block: status = %d, body = %s", rec.Code, rec.Body)
+	}
+	rec = testutil.Do(t, e, http.MethodGet, "/api/search?type=users&q="+url.QueryEscape("東京"), alice, "")
+	resp = model.UserResults{}
+	testutil.Decode(t, rec, &resp)
+	if len(resp.Users) != 1 || resp.Users[0].User.Username != "alice" {
+		t.Errorf("users after block = %+v, want alice", resp.Users)
+	}
+	if rec := testutil.Do(t, e, http.MethodDelete, blockPath, carol, ""); rec.Code != http.StatusNoContent {
+		t.Fatalf("unblock: status = %d, body = %s", rec.Code, rec.Body)
+	}
+
+	// 退会したユーザーは検索結果に含めない
+	if rec := testutil.Do(t, e, http.MethodDelete, "/api/users/me", carol, `{"password":"password123"}`); rec.Code != http.StatusNoContent {
+		t.Fatalf("deactivate: status = %d, body = %s", rec.Code, rec.Body)
+	}
+	rec = testutil.Do(t, e, http.MethodGet, "/api/search?type=users&q="+url.QueryEscape("東京"), alice, "")
+	resp = model.UserResults{}
+	testutil.Decode(t, rec, &resp)
+	if len(resp.Users) != 1 || resp.Users[0].User.Username != "alice" {
+		t.Errorf("users after deactivate = %+v, want alice", resp.Users)
+	}
+
+	rec = testutil.Do(t, e, http.MethodGet, "/api/search?type=users&q=from:alice", alice, "")
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("from: with type=users: status = %d, want 400", rec.Code)
+	}
+}
+
+func equal(got []int, want ...int) bool {
+	if len(got) != len(want) {
+		return false
+	}
+	for i := range got {
+		if got[i] != want[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// ブロックの関係にあるユーザーはインデックスで除くので、ページの境目にいてもページは limit 件で埋まる
+func TestSearchPagingWithBlocks(t *testing.T) {
+	e := newTestServer(t)
+	viewerID, viewer := testutil.RegisterAndLoginAs(t, e, "viewer", "viewer")
+	var users, tweets []int
+	var tokens []string
+	for i := 1; i <= 5; i++ {
+		id, token := testutil.RegisterAndLoginAs(t, e, "user"+strconv.Itoa(i), "東京"+strconv.Itoa(i))
+		users = append(users, id)
+		tokens = append(tokens, token)
+		tweets = append(tweets, post(t, e, token, "東京"+strconv.Itoa(i)))
+	}
+
+	// 新しい順で1ページ目の最後になる user4 を viewer がブロックし、その次の user3 が viewer をブロックする
+	testutil.MustDo(t, e, http.MethodPost, "/api/users/"+strconv.Itoa(users[3])+"/block", viewer, "", http.StatusCreated)
+	testutil.MustDo(t, e, http.MethodPost, "/api/users/"+strconv.Itoa(viewerID)+"/block", tokens[2], "", http.StatusCreated)
+
+	var gotTweets [][]int
+	for cursor := ""; ; {
+		ids, next := searchTweets(t, e, viewer, "東京", cursor)
+		gotTweets = append(gotTweets, ids)
+		if next == nil {
+			break
+		}
+		cursor = *next
+	}
+	if want := [][]int{{tweets[4], tweets[1]}, {tweets[0]}}; !reflect.DeepEqual(gotTweets, want) {
+		t.Errorf("tweet pages = %v, want %v", gotTweets, want)
+	}
+
+	var gotUsers [][]int
+	for cursor := ""; ; {
+		params := url.Values{"type": {"users"}, "q": {"東京"}, "limit": {"2"}}
+		if cursor != "" {
+			params.Set("cursor", cursor)
+		}
+		rec := testutil.MustDo(t, e, http.MethodGet, "/api/search?"+params.Encode(), viewer, "", http.StatusOK)
+		var resp model.UserResults
+		testutil.Decode(t, rec, &resp)
+		ids := []int{}
+		for _, u := range resp.Users {
+			ids = append(ids, u.User.ID)
+		}
+		gotUsers = append(gotUsers, ids)
+		if resp.NextCursor == nil {
+			break
+		}
+		cursor = *resp.NextCursor
+	}
+	if want := [][]int{{users[4], users[1]}, {users[0]}}; !reflect.DeepEqual(gotUsers, want) {
+		t.Errorf("user pages = %v, want %v", gotUsers, want)
+	}
+}
@@ -0,0 +1,59 @@
+package search
+
+import (
+	"context"
+	"todoapp/internal/infrastructure"
+	"todoapp/internal/schema"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// RegisterIndexHooks はsqlboilerのフックを登録し、ツイートとユーザーの変更を idx に反映する。
+// 反映はコミットの後に行うので、ロールバックされた変更は反映しない。
+// フックはパッケージ全体で共有されるので、アプリケーションの起動時に1回だけ呼ぶこと。
+// フックは UpdateAll / DeleteAll や生のSQLでは実行されないので、それらの変更は次の Rebuild まで反映されない
+func RegisterIndexHooks(idx Index) {
+	tweetCreated := func(ctx context.Context, exec boil.ContextExecutor, o *schema.Tweet) error {
+		doc := tweetDoc(o)
+		infrastructure.AfterCommit(ctx, func() {
+			_ = idx.IndexTweet(context.WithoutCancel(ctx), doc)
+		})
+		return nil
+	}
+	schema.AddTweetHook(boil.AfterInsertHook, tweetCreated)
+
+	// 論理削除と物理削除のどちらでも取り除く
+	tweetDeleted := func(ctx context.Context, exec boil.ContextExecutor, o *schema.Tweet) error {
+		id := o.ID
+		infrastructure.AfterCommit(ctx, func() {
+			_ = idx.DeleteTweet(context.WithoutCancel(ctx), id)
+		})
+		return nil
+	}
+	schema.AddTweetHook(boil.AfterDeleteHook, tweetDeleted)
+
+	// 退会は deleted_at の更新なので、更新後の値で追加するか取り除くかを決める
+	userChanged := func(ctx context.Context, exec boil.ContextExecutor, o *schema.User) error {
+		doc, deleted := userDoc(o), o.DeletedAt.Valid
+		infrastructure.AfterCommit(ctx, func() {
+			if deleted {
+				_ = idx.DeleteUser(context.WithoutCancel(ctx), doc.ID)
+				return
+			}
+			_ = idx.IndexUser(context.WithoutCancel(ctx), doc)
+		})
+		return nil
+	}
+	schema.AddUserHook(boil.AfterInsertHook, userChanged)
+	schema.AddUserHook(boil.AfterUpdateHook, userChanged)
+	schema.AddUserHook(boil.AfterUpsertHook, userChanged)
+
+	userDeleted := func(ctx context.Context, exec boil.ContextExecutor, o *schema.User) error {
+		id := o.ID
+		infrastructure.AfterCommit(ctx, func() {
+			_ = idx.DeleteUser(context.WithoutCancel(ctx), id)
+		})
+		return nil
+	}
+	schema.AddUserHook(boil.AfterDeleteHook, userDeleted)
+}
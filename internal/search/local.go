@@ -0,0 +1,354 @@
+package search
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"todoapp/internal/schema"
+	"unicode"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"golang.org/x/text/unicode/norm"
+)
+
+// rebuildBatchSize は Rebuild で1回に読み込む行数
+const rebuildBatchSize = 1000
+
+// Local はプロセス内の転置インデックス。語ごとに文書内の位置を持つので、
+// フレーズは語が連続して現れる文書だけに一致する
+type Local struct {
+	mu     sync.RWMutex
+	tweets invertedIndex
+	users  invertedIndex
+	// tweetDocs は絞り込みに使うツイートの投稿者と投稿日時(Content は持たない)
+	tweetDocs map[int]TweetDoc
+	// usernames は退会中でないユーザーの小文字のユーザー名とIDの対応
+	usernames map[string]int
+	userIDs   map[int]string
+}
+
+func NewLocal() *Local {
+	return &Local{
+		tweets:    newInvertedIndex(),
+		users:     newInvertedIndex(),
+		tweetDocs: make(map[int]TweetDoc),
+		usernames: make(map[string]int),
+		userIDs:   make(map[int]string),
+	}
+}
+
+// Rebuild はインデックスを空にしてから、データベースの退会中でないユーザーと
+// 削除されていないツイートをすべて追加する。起動時に RegisterIndexHooks の後で呼ぶ
+func (l *Local) Rebuild(ctx context.Context, exec boil.ContextExecutor) error {
+	l.mu.Lock()
+	l.tweets = newInvertedIndex()
+	l.users = newInvertedIndex()
+	l.tweetDocs = make(map[int]TweetDoc)
+	l.usernames = make(map[string]int)
+	l.userIDs = make(map[int]string)
+	l.mu.Unlock()
+
+	for lastID := 0; ; {
+		users, err := schema.Users(
+			schema.UserWhere.ID.GT(lastID),
+			qm.OrderBy(schema.UserColumns.ID),
+			qm.Limit(rebuildBatchSize),
+		).All(ctx, exec)
+		if err != nil {
+			return err
+		}
+		for _, u := range users {
+			l.IndexUser(ctx, userDoc(u))
+			lastID = u.ID
+		}
+		if len(users) < rebuildBatchSize {
+			break
+		}
+	}
+
+	for lastID := 0; ; {
+		tweets, err := schema.Tweets(
+			schema.TweetWhere.ID.GT(lastID),
+			qm.OrderBy(schema.TweetColumns.ID),
+			qm.Limit(rebuildBatchSize),
+		).All(ctx, exec)
+		if err != nil {
+			return err
+		}
+		for _, t := range tweets {
+			l.IndexTweet(ctx, tweetDoc(t))
+			lastID = t.ID
+		}
+		if len(tweets) < rebuildBatchSize {
+			break
+		}
+	}
+	return nil
+}
+
+func (l *Local) SearchTweets(ctx context.Context, q *Query, beforeID, limit int) ([]int, error) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	fromID := 0
+	if q.From != "" {
+		id, ok := l.usernames[strings.ToLower(q.From)]
+		if !ok {
+			return nil, nil
+		}
+		fromID = id
+	}
+
+	excluded := q.excludedUsers()
+	var ids []int
+	for _, id := range l.tweets.search(q.Terms) {
+		doc := l.tweetDocs[id]
+		if beforeID > 0 && id >= beforeID {
+			continue
+		}
+		if excluded[doc.UserID] {
+			continue
+		}
+		// 投稿者が退会中のツイートはインデックスに残っていても返さない
+		if _, ok := l.userIDs[doc.UserID]; !ok {
+			continue
+		}
+		if fromID != 0 && doc.UserID != fromID {
+			continue
+		}
+		if !q.Since.IsZero() && doc.CreatedAt.Before(q.Since) {
+			continue
+		}
+		if !q.Until.IsZero() && !doc.CreatedAt.Before(q.Until) {
+			continue
+		}
+		ids = append(ids, id)
+	}
+	return newest(ids, limit), nil
+}
+
+func (l *Local) SearchUsers(ctx context.Context, q *Query, beforeID, limit int) ([]int, error) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	excluded := q.excludedUsers()
+	var ids []int
+	for _, id := range l.users.search(q.Terms) {
+		if beforeID > 0 && id >= beforeID || excluded[id] {
+			continue
+		}
+		ids = append(ids, id)
+	}
+	return newest(ids, limit), nil
+}
+
+func (l *Local) IndexTweet(ctx context.Context, doc TweetDoc) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.tweets.add(doc.ID, doc.Content)
+	doc.Content = ""
+	l.tweetDocs[doc.ID] = doc
+	return nil
+}
+
+func (l *Local) DeleteTweet(ctx context.Context, id int) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.tweets.remove(id)
+	delete(l.tweetDocs, id)
+	return nil
+}
+
+func (l *Local) IndexUser(ctx context.Context, doc UserDoc) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.deleteUser(doc.ID)
+	l.users.add(doc.ID, doc.Username, doc.DisplayName, doc.Bio)
+	l.usernames[strings.ToLower(doc.Username)] = doc.ID
+	l.userIDs[doc.ID] = doc.Username
+	return nil
+}
+
+// DeleteUser はユーザーを取り除く。そのユーザーのツイートは残すが、検索結果には含めない
+// (退会を取り消した場合に IndexUser だけで元に戻せるようにするため)
+func (l *Local) DeleteUser(ctx context.Context, id int) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.deleteUser(id)
+	return nil
+}
+
+func (l *Local) deleteUser(id int) {
+	if username, ok := l.userIDs[id]; ok {
+		delete(l.usernames, strings.ToLower(username))
+		delete(l.userIDs, id)
+	}
+	l.users.remove(id)
+}
+
+// newest は ids を新しい(IDの大きい)順に並べ、先頭の limit 件を返す
+func newest(ids []int, limit int) []int {
+	sort.Sort(sort.Reverse(sort.IntSlice(ids)))
+	if len(ids) > limit {
+		ids = ids[:limit]
+	}
+	return ids
+}
+
+// invertedIndex は語ごとに、その語を含む文書のIDと文書内の位置を持つ
+type invertedIndex struct {
+	postings map[string]map[int][]int
+	// terms は文書ごとの語(削除で postings から取り除くため)
+	terms map[int][]string
+}
+
+func newInvertedIndex() invertedIndex {
+	return invertedIndex{
+		postings: make(map[string]map[int][]int),
+		terms:    make(map[int][]string),
+	}
+}
+
+// add は fields を1つの文書として追加する。フィールドの間は位置を1つ空け、
+// フレーズがフィールドをまたいで一致しないようにする
+func (ix *invertedIndex) add(id int, fields ...string) {
+	ix.remove(id)
+
+	pos := 0
+	var terms []string
+	for _, field := range fields {
+		for _, token := range tokenize(field) {
+			docs := ix.postings[token]
+			if docs == nil {
+				docs = make(map[int][]int)
+				ix.postings[token] = docs
+			}
+			if _, ok := docs[id]; !ok {
+				terms = append(terms, token)
+			}
+			docs[id] = append(docs[id], pos)
+			pos++
+		}
+		pos++
+	}
+	ix.terms[id] = terms
+}
+
+func (ix *invertedIndex) remove(id int) {
+	for _, token := range ix.terms[id] {
+		delete(ix.postings[token], id)
+		if len(ix.postings[token]) == 0 {
+			delete(ix.postings, token)
+		}
+	}
+	delete(ix.terms, id)
+}
+
+// search は terms をすべて含む文書のIDを順不同で返す。terms が空ならすべての文書を返す。
+// 語句は分割した語が連続して現れる場合に一致し、語を1つも含まない語句(記号だけなど)には何も一致しない
+func (ix *invertedIndex) search(terms []string) []int {
+	var ids []int
+	if len(terms) == 0 {
+		for id := range ix.terms {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+
+	phrases := make([][]string, len(terms))
+	for i, term := range terms {
+		phrases[i] = tokenize(term)
+		if len(phrases[i]) == 0 {
+			return nil
+		}
+	}
+	// 最も文書の少ない語句の先頭の語から候補を絞る
+	sort.Slice(phrases, func(i, j int) bool {
+		return len(ix.postings[phrases[i][0]]) < len(ix.postings[phrases[j][0]])
+	})
+
+	for id := range ix.postings[phrases[0][0]] {
+		matched := true
+		for _, phrase := range phrases {
+			if !ix.containsPhrase(id, phrase) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// containsPhrase は文書 id に phrase の語がこの順に連続して現れるかを返す
+func (ix *invertedIndex) containsPhrase(id int, phrase []string) bool {
+	for _, start := range ix.postings[phrase[0]][id] {
+		matched := true
+		for i, token := range phrase[1:] {
+			if !containsInt(ix.postings[token][id], start+i+1) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return true
+		}
+	}
+	return false
+}
+
+// containsInt は昇順の positions に pos が含まれるかを返す
+func containsInt(positions []int, pos int) bool {
+	i := sort.SearchInts(positions, pos)
+	return i < len(positions) && positions[i] == pos
+}
+
+// tokenize は s を索引の語に分ける。NFKC で全角・半角を揃えて小文字にし、
+// 英数字は連続する文字を1語に、漢字・かな・ハングルは1文字を1語にする。
+// 日本語は単語を空白で区切らないので、1文字ずつの語の連続としてフレーズで照合する。
+// 記号と空白は語の区切りで、語には含めない
+func tokenize(s string) []string {
+	var tokens []string
+	var word []rune
+	flush := func() {
+		if len(word) > 0 {
+			tokens = append(tokens, string(word))
+			word = word[:0]
+		}
+	}
+
+	for _, r := range strings.ToLower(norm.NFKC.String(s)) {
+		switch {
+		case isCJK(r):
+			flush()
+			tokens = append(tokens, string(r))
+		case unicode.IsLetter(r) || unicode.IsDigit(r) || (unicode.IsMark(r) && len(word) > 0):
+			word = append(word, r)
+		default:
+			flush()
+		}
+	}
+	flush()
+	return tokens
+}
+
+func isCJK(r rune) bool {
+	// 長音符(ー)はどの文字体系にも属さないが、カタカナと同じ扱いにする
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul) || r == 'ー'
+}
+
+func tweetDoc(t *schema.Tweet) TweetDoc {
+	return TweetDoc{ID: t.ID, UserID: t.UserID, Content: t.Content, CreatedAt: t.CreatedAt.Time}
+}
+
+func userDoc(u *schema.User) UserDoc {
+	return UserDoc{ID: u.ID, Username: u.Username, DisplayName: u.DisplayName, Bio: u.Bio.String}
+}
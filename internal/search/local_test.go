@@ -0,0 +1,192 @@
+package search
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+	"todoapp/internal/dbtest"
+	"todoapp/internal/infrastructure"
+	tweetmodel "todoapp/internal/tweet/model"
+	tweetrepository "todoapp/internal/tweet/repository"
+	usermodel "todoapp/internal/user/model"
+	userrepository "todoapp/internal/user/repository"
+)
+
+func TestTokenize(t *testing.T) {
+	got := tokenize("Hello, Ｗｏｒｌｄ！ 東京ﾀﾜｰ gopher_jp")
+	want := []string{"hello", "world", "東", "京", "タ", "ワ", "ー", "gopher", "jp"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("tokenize = %q, want %q", got, want)
+	}
+}
+
+func TestLocalSearch(t *testing.T) {
+	ctx := context.Background()
+	l := NewLocal()
+	day := func(d int) time.Time { return time.Date(2024, 1, d, 12, 0, 0, 0, time.UTC) }
+
+	l.IndexUser(ctx, UserDoc{ID: 1, Username: "alice", DisplayName: "Alice", Bio: "東京在住"})
+	l.IndexUser(ctx, UserDoc{ID: 2, Username: "gopher_jp", DisplayName: "ゴーファー", Bio: "Goが好き"})
+	l.IndexTweet(ctx, TweetDoc{ID: 1, UserID: 1, Content: "Go言語で書いたサーバー", CreatedAt: day(1)})
+	l.IndexTweet(ctx, TweetDoc{ID: 2, UserID: 2, Content: "東京タワーに行った", CreatedAt: day(2)})
+	l.IndexTweet(ctx, TweetDoc{ID: 3, UserID: 1, Content: "京都と東京", CreatedAt: day(3)})
+	l.IndexTweet(ctx, TweetDoc{ID: 4, UserID: 2, Content: "Hello world from GO", CreatedAt: day(4)})
+
+	tweetTests := []struct {
+		query    string
+		beforeID int
+		want     []int
+	}{
+		{"東京", 0, []int{3, 2}},
+		{"京東", 0, nil},
+		{"ｇｏ", 0, []int{4, 1}},
+		{"go 言語", 0, []int{1}},
+		{`"world from"`, 0, []int{4}},
+		{`"from world"`, 0, nil},
+		{"from:Alice", 0, []int{3, 1}},
+		{"from:alice 東京", 0, []int{3}},
+		{"from:nobody", 0, nil},
+		{"since:2024-01-02 until:2024-01-04", 0, []int{3, 2}},
+		{"東京", 3, []int{2}},
+		{"!!!", 0, nil},
+	}
+	for _, tt := range tweetTests {
+		q, err := ParseQuery(tt.query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := l.SearchTweets(ctx, q, tt.beforeID, 10)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("SearchTweets(%q, %d) = %v, want %v", tt.query, tt.beforeID, got, tt.want)
+		}
+	}
+
+	userTests := []struct {
+		query string
+		want  []int
+	}{
+		{"東京", []int{1}},
+		{"gopher", []int{2}},
+		{"ゴーファー", []int{2}},
+		// ユーザー名と表示名をまたぐフレーズには一致しない
+		{`"jp ゴ"`, nil},
+	}
+	for _, tt := range userTests {
+		q, _ := ParseQuery(tt.query)
+		got, err := l.SearchUsers(ctx, q, 0, 10)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("SearchUsers(%q) = %v, want %v", tt.query, got, tt.want)
+		}
+	}
+
+	// 上限の件数で切り詰める
+	q, _ := ParseQuery("東京")
+	if got, _ := l.SearchTweets(ctx, q, 0, 1); !reflect.DeepEqual(got, []int{3}) {
+		t.Errorf("SearchTweets(limit 1) = %v, want [3]", got)
+	}
+
+	// 内容を置き換え、削除したツイートと退会したユーザーのツイートは返さない
+	l.IndexTweet(ctx, TweetDoc{ID: 2, UserID: 2, Content: "大阪", CreatedAt: day(2)})
+	l.DeleteTweet(ctx, 3)
+	if got, _ := l.SearchTweets(ctx, q, 0, 10); got != nil {
+		t.Errorf("SearchTweets after delete = %v, want none", got)
+	}
+	l.DeleteUser(ctx, 2)
+	q, _ = ParseQuery("go")
+	if got, _ := l.SearchTweets(ctx, q, 0, 10); !reflect.DeepEqual(got, []int{1}) {
+		t.Errorf("SearchTweets after deactivation = %v, want [1]", got)
+	}
+}
+
+func TestLocalHooks(t *testing.T) {
+	ctx := context.Background()
+	db := dbtest.New(t)
+	l := NewLocal()
+	RegisterIndexHooks(l)
+
+	userRepo := userrepository.NewUserRepository(db, db)
+	tweetRepo := tweetrepository.NewTweetRepository(db, db)
+	txManager := infrastructure.NewTxManager(db, nil)
+
+	alice, err := userRepo.Create(ctx, &usermodel.RegisterRequest{
+		Username: "alice", DisplayName: "Alice", Email: "alice@example.com", Password: "password123",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	first, err := tweetRepo.Create(ctx, alice.ID, &tweetmodel.CreateTweetRequest{Content: "検索のテスト"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := tweetRepo.Create(ctx, alice.ID, &tweetmodel.CreateTweetRequest{Content: "二つ目のテスト"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// ロールバックしたツイートは追加しない
+	txManager.RunInTx(ctx, func(ctx context.Context) error {
+		if _, err := tweetRepo.Create(ctx, alice.ID, &tweetmodel.CreateTweetRequest{Content: "取り消すテスト"}, nil); err != nil {
+			t.Fatal(err)
+		}
+		return context.Canceled
+	})
+
+	search := func(query string) []int {
+		t.Helper()
+		q, err := ParseQuery(query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids, err := l.SearchTweets(ctx, q, 0, 10)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return ids
+	}
+	if got := search("テスト"); !reflect.DeepEqual(got, []int{second.ID, first.ID}) {
+		t.Errorf("after create = %v, want [%d %d]", got, second.ID, first.ID)
+	}
+
+	if err := tweetRepo.Delete(ctx, first.ID); err != nil {
+		t.Fatal(err)
+	}
+	if got := search("テスト"); !reflect.DeepEqual(got, []int{second.ID}) {
+		t.Errorf("after delete = %v, want [%d]", got, second.ID)
+	}
+
+	if err := userRepo.Deactivate(ctx, alice.ID); err != nil {
+		t.Fatal(err)
+	}
+	if got := search("テスト"); got != nil {
+		t.Errorf("after deactivate = %v, want none", got)
+	}
+	if _, err := userRepo.Reactivate(ctx, alice.ID); err != nil {
+		t.Fatal(err)
+	}
+	if got := search("from:alice"); !reflect.DeepEqual(got, []int{second.ID}) {
+		t.Errorf("after reactivate = %v, want [%d]", got, second.ID)
+	}
+
+	// データベースから作り直しても同じ結果になる
+	rebuilt := NewLocal()
+	if err := rebuilt.Rebuild(ctx, db); err != nil {
+		t.Fatal(err)
+	}
+	for _, query := range []string{"テスト", "from:alice", "取り消す"} {
+		q, _ := ParseQuery(query)
+		want, _ := l.SearchTweets(ctx, q, 0, 10)
+		got, err := rebuilt.SearchTweets(ctx, q, 0, 10)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("rebuilt SearchTweets(%q) = %v, want %v", query, got, want)
+		}
+	}
+}
@@ -0,0 +1,34 @@
+package model
+
+import (
+	tweetmodel "todoapp/internal/tweet/model"
+	usermodel "todoapp/internal/user/model"
+)
+
+// 検索の対象
+const (
+	TypeTweets = "tweets"
+	TypeUsers  = "users"
+)
+
+// SearchRequest は検索のクエリパラメーター。
+// Q の書式は search.ParseQuery、Type は tweets(省略時)または users。
+// Cursor には前のページの NextCursor を指定する
+type SearchRequest struct {
+	Q      string `query:"q"`
+	Type   string `query:"type"`
+	Cursor string `query:"cursor"`
+	Limit  int    `query:"limit"`
+}
+
+// TweetResults は検索に一致したツイートを新しい順に返す
+type TweetResults struct {
+	Tweets     []*tweetmodel.Tweet `json:"tweets"`
+	NextCursor *string             `json:"next_cursor,omitempty"`
+}
+
+// UserResults は検索に一致したユーザーを新しい順に返す
+type UserResults struct {
+	Users      []*usermodel.UserProfile `json:"users"`
+	NextCursor *string                  `json:"next_cursor,omitempty"`
+}
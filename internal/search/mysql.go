@@ -0,0 +1,113 @@
+package search
+
+import (
+	"context"
+	"strings"
+	"todoapp/internal/schema"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// MySQL はマイグレーションで作成した FULLTEXT インデックス(ngram パーサー)で検索する。
+// インデックスはテーブルと一緒に更新されるので、Index* / Delete* は何もしない
+type MySQL struct {
+	readDB boil.ContextExecutor
+}
+
+func NewMySQL(readDB boil.ContextExecutor) *MySQL {
+	return &MySQL{readDB: readDB}
+}
+
+type idRow struct {
+	ID int `boil:"id"`
+}
+
+func (m *MySQL) SearchTweets(ctx context.Context, q *Query, beforeID, limit int) ([]int, error) {
+	mods := []qm.QueryMod{
+		qm.Select("tweets.id AS id"),
+		qm.InnerJoin("users ON users.id = tweets.user_id AND users.deleted_at IS NULL"),
+		qm.OrderBy("tweets.id DESC"),
+		qm.Limit(limit),
+	}
+	if len(q.Terms) > 0 {
+		mods = append(mods, qm.Where("MATCH(tweets.content) AGAINST(? IN BOOLEAN MODE)", booleanQuery(q.Terms)))
+	}
+	if q.From != "" {
+		mods = append(mods, qm.Where("users.username = ?", q.From))
+	}
+	if !q.Since.IsZero() {
+		mods = append(mods, qm.Where("tweets.created_at >= ?", q.Since))
+	}
+	if !q.Until.IsZero() {
+		mods = append(mods, qm.Where("tweets.created_at < ?", q.Until))
+	}
+	if beforeID > 0 {
+		mods = append(mods, qm.Where("tweets.id < ?", beforeID))
+	}
+	if len(q.ExcludeUserIDs) > 0 {
+		mods = append(mods, qm.WhereNotIn("tweets.user_id NOT IN ?", idArgs(q.ExcludeUserIDs)...))
+	}
+
+	var rows []*idRow
+	if err := schema.Tweets(mods...).Bind(ctx, m.readDB, &rows); err != nil {
+		return nil, err
+	}
+	return rowIDs(rows), nil
+}
+
+func (m *MySQL) SearchUsers(ctx context.Context, q *Query, beforeID, limit int) ([]int, error) {
+	mods := []qm.QueryMod{
+		qm.Select("users.id AS id"),
+		qm.OrderBy("users.id DESC"),
+		qm.Limit(limit),
+	}
+	if len(q.Terms) > 0 {
+		mods = append(mods, qm.Where("MATCH(users.username, users.display_name, users.bio) AGAINST(? IN BOOLEAN MODE)", booleanQuery(q.Terms)))
+	}
+	if beforeID > 0 {
+		mods = append(mods, qm.Where("users.id < ?", beforeID))
+	}
+	if len(q.ExcludeUserIDs) > 0 {
+		mods = append(mods, qm.WhereNotIn("users.id NOT IN ?", idArgs(q.ExcludeUserIDs)...))
+	}
+
+	var rows []*idRow
+	if err := schema.Users(mods...).Bind(ctx, m.readDB, &rows); err != nil {
+		return nil, err
+	}
+	return rowIDs(rows), nil
+}
+
+func (m *MySQL) IndexTweet(ctx context.Context, doc TweetDoc) error { return nil }
+func (m *MySQL) DeleteTweet(ctx context.Context, id int) error      { return nil }
+func (m *MySQL) IndexUser(ctx context.Context, doc UserDoc) error   { return nil }
+func (m *MySQL) DeleteUser(ctx context.Context, id int) error       { return nil }
+
+// booleanQuery は terms をすべて含むものに一致する IN BOOLEAN MODE の検索語にする。
+// 語句を "..." で囲むと、ngram パーサーは語句のトークンが連続するものに一致させるので、
+// 空白で区切らない日本語の語句もフレーズとして照合できる
+func booleanQuery(terms []string) string {
+	quoted := make([]string, len(terms))
+	for i, term := range terms {
+		quoted[i] = `+"` + strings.ReplaceAll(term, `"`, "") + `"`
+	}
+	return strings.Join(quoted, " ")
+}
+
+func rowIDs(rows []*idRow) []int {
+	ids := make([]int, len(rows))
+	for i, row := range rows {
+		ids[i] = row.ID
+	}
+	return ids
+}
+
+// idArgs は ids を qm.WhereNotIn の引数にする
+func idArgs(ids []int) []interface{} {
+	args := make([]interface{}, len(ids))
+	for i, id := range ids {
+		args[i] = id
+	}
+	return args
+}
@@ -0,0 +1,171 @@
+package search
+
+import (
+	"context"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+	"todoapp/internal/dbtest"
+	"todoapp/internal/schema"
+	tweetmodel "todoapp/internal/tweet/model"
+	tweetrepository "todoapp/internal/tweet/repository"
+	usermodel "todoapp/internal/user/model"
+	userrepository "todoapp/internal/user/repository"
+)
+
+// 組み込みサーバーは ngram パーサーと IN BOOLEAN MODE に対応していないので、
+// DBTEST_SERVER=external の mysqld でだけ実行する(CIの mysql ジョブ)。検索語以外の条件は TestMySQLFilters で確かめる
+func TestMySQL(t *testing.T) {
+	if os.Getenv("DBTEST_SERVER") != "external" {
+		t.Skip("requires DBTEST_SERVER=external")
+	}
+	ctx := context.Background()
+	db := dbtest.New(t)
+	m := NewMySQL(db)
+
+	userRepo := userrepository.NewUserRepository(db, db)
+	tweetRepo := tweetrepository.NewTweetRepository(db, db)
+	users := make(map[string]int)
+	for _, name := range []string{"alice", "bob"} {
+		u, err := userRepo.Create(ctx, &usermodel.RegisterRequest{
+			Username: name, DisplayName: name, Email: name + "@example.com", Password: "password123",
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		users[name] = u.ID
+	}
+	var ids []int
+	for _, tt := range []struct{ user, content string }{
+		{"alice", "東京タワーに行った"},
+		{"bob", "京都と東京"},
+		{"bob", "hello world from go"},
+	} {
+		tweet, err := tweetRepo.Create(ctx, users[tt.user], &tweetmodel.CreateTweetRequest{Content: tt.content}, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, tweet.ID)
+	}
+
+	tests := []struct {
+		query string
+		want  []int
+	}{
+		{"東京", []int{ids[1], ids[0]}},
+		{"東京 タワー", []int{ids[0]}},
+		{`"world from"`, []int{ids[2]}},
+		{"from:bob 東京", []int{ids[1]}},
+		{"since:2000-01-01 until:2000-01-02", nil},
+	}
+	for _, tt := range tests {
+		q, err := ParseQuery(tt.query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := m.SearchTweets(ctx, q, 0, 10)
+		if err != nil {
+			t.Fatalf("SearchTweets(%q): %v", tt.query, err)
+		}
+		if len(got) == 0 {
+			got = nil
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("SearchTweets(%q) = %v, want %v", tt.query, got, tt.want)
+		}
+	}
+
+	if err := userRepo.Deactivate(ctx, users["bob"]); err != nil {
+		t.Fatal(err)
+	}
+	q, _ := ParseQuery("東京")
+	if got, err := m.SearchTweets(ctx, q, 0, 10); err != nil || !reflect.DeepEqual(got, []int{ids[0]}) {
+		t.Errorf("SearchTweets after deactivate = %v, %v, want [%d]", got, err, ids[0])
+	}
+	q, _ = ParseQuery("alice")
+	if got, err := m.SearchUsers(ctx, q, 0, 10); err != nil || !reflect.DeepEqual(got, []int{users["alice"]}) {
+		t.Errorf("SearchUsers = %v, %v, want [%d]", got, err, users["alice"])
+	}
+}
+
+// TestMySQLFilters は検索語のない(MATCH を使わない)検索で、組み込みサーバーでも
+// 投稿者・日付・カーソル・件数の条件と、退会中のユーザーと ExcludeUserIDs の除外を確かめる
+func TestMySQLFilters(t *testing.T) {
+	ctx := context.Background()
+	db := dbtest.New(t)
+	m := NewMySQL(db)
+
+	userRepo := userrepository.NewUserRepository(db, db)
+	tweetRepo := tweetrepository.NewTweetRepository(db, db)
+	users := make(map[string]int)
+	for _, name := range []string{"alice", "bob", "carol"} {
+		u, err := userRepo.Create(ctx, &usermodel.RegisterRequest{
+			Username: name, DisplayName: name, Email: name + "@example.com", Password: "password123",
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		users[name] = u.ID
+	}
+	var ids []int
+	for _, tt := range []struct{ user, createdAt string }{
+		{"alice", "2024-01-01"},
+		{"bob", "2024-01-02"},
+		{"bob", "2024-01-03"},
+		{"carol", "2024-01-03"},
+		{"bob", "2024-01-04"},
+	} {
+		tweet, err := tweetRepo.Create(ctx, users[tt.user], &tweetmodel.CreateTweetRequest{Content: "hello"}, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		createdAt, _ := time.Parse(dateLayout, tt.createdAt)
+		_, err = schema.Tweets(schema.TweetWhere.ID.EQ(tweet.ID)).UpdateAll(ctx, db, schema.M{schema.TweetColumns.CreatedAt: createdAt})
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, tweet.ID)
+	}
+	if err := userRepo.Deactivate(ctx, users["carol"]); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		query    string
+		beforeID int
+		limit    int
+		want     []int
+	}{
+		{"from:bob", 0, 10, []int{ids[4], ids[2], ids[1]}},
+		{"from:bob", 0, 2, []int{ids[4], ids[2]}},
+		{"from:bob", ids[2], 10, []int{ids[1]}},
+		{"since:2024-01-02 until:2024-01-04", 0, 10, []int{ids[2], ids[1]}},
+		{"from:carol", 0, 10, nil},
+	}
+	for _, tt := range tests {
+		q, err := ParseQuery(tt.query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := m.SearchTweets(ctx, q, tt.beforeID, tt.limit)
+		if err != nil {
+			t.Fatalf("SearchTweets(%q): %v", tt.query, err)
+		}
+		if len(got) == 0 {
+			got = nil
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("SearchTweets(%q, %d, %d) = %v, want %v", tt.query, tt.beforeID, tt.limit, got, tt.want)
+		}
+	}
+
+	// ExcludeUserIDs のユーザーは件数を数える前に除く
+	q := &Query{ExcludeUserIDs: []int{users["bob"]}}
+	if got, err := m.SearchTweets(ctx, q, 0, 1); err != nil || !reflect.DeepEqual(got, []int{ids[0]}) {
+		t.Errorf("SearchTweets excluding bob = %v, %v, want [%d]", got, err, ids[0])
+	}
+	if got, err := m.SearchUsers(ctx, q, users["carol"], 1); err != nil || !reflect.DeepEqual(got, []int{users["alice"]}) {
+		t.Errorf("SearchUsers excluding bob = %v, %v, want [%d]", got, err, users["alice"])
+	}
+}
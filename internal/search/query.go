@@ -0,0 +1,111 @@
+package search
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// dateLayout は since: と until: の日付の形式
+const dateLayout = "2006-01-02"
+
+// Query は検索語を解析した結果
+type Query struct {
+	// Terms は空白で区切った語句と "..." で囲んだフレーズ。すべてを含むものに一致する。
+	// 語句もフレーズも、その文字の並びをそのまま含むものに一致する
+	Terms []string
+	// From は from:username で指定した投稿者のユーザー名(先頭の @ は含まない)
+	From string
+	// Since と Until は since:YYYY-MM-DD と until:YYYY-MM-DD で指定した投稿日時の範囲(UTC)。
+	// Since の日を含み、Until の日を含まない。指定がなければゼロ値
+	Since time.Time
+	Until time.Time
+	// ExcludeUserIDs は結果から除くユーザーで、ツイートの検索ではそのユーザーのツイートを除く。
+	// 検索語からは指定できず、閲覧しているユーザーとブロックの関係にあるユーザーを呼び出し側で設定する。
+	// インデックスで除くので、ページの件数は limit より少なくならない
+	ExcludeUserIDs []int
+}
+
+// excludedUsers は ExcludeUserIDs を引けるようにしたもの
+func (q *Query) excludedUsers() map[int]bool {
+	excluded := make(map[int]bool, len(q.ExcludeUserIDs))
+	for _, id := range q.ExcludeUserIDs {
+		excluded[id] = true
+	}
+	return excluded
+}
+
+// HasFilters は from: / since: / until: のいずれかを指定しているかを返す
+func (q *Query) HasFilters() bool {
+	return q.From != "" || !q.Since.IsZero() || !q.Until.IsZero()
+}
+
+// ParseQuery は検索語を解析する。書式は X(Twitter)の検索に合わせ、
+// 空白で区切った語句、"..." のフレーズ、from:username、since:YYYY-MM-DD、until:YYYY-MM-DD を使える。
+// 閉じていない " は末尾までをフレーズとして扱う
+func ParseQuery(s string) (*Query, error) {
+	q := &Query{}
+	for _, token := range splitQuery(s) {
+		if token.quoted {
+			if phrase := strings.Join(strings.Fields(token.text), " "); phrase != "" {
+				q.Terms = append(q.Terms, phrase)
+			}
+			continue
+		}
+
+		name, value, ok := strings.Cut(token.text, ":")
+		switch name = strings.ToLower(name); {
+		case ok && name == "from":
+			q.From = strings.TrimPrefix(value, "@")
+			if q.From == "" {
+				return nil, fmt.Errorf("from: requires a username")
+			}
+		case ok && (name == "since" || name == "until"):
+			date, err := time.Parse(dateLayout, value)
+			if err != nil {
+				return nil, fmt.Errorf("%s: must be a date in YYYY-MM-DD format", name)
+			}
+			if name == "since" {
+				q.Since = date
+			} else {
+				q.Until = date
+			}
+		default:
+			q.Terms = append(q.Terms, token.text)
+		}
+	}
+	return q, nil
+}
+
+type queryToken struct {
+	text   string
+	quoted bool
+}
+
+// splitQuery は s を空白と " で区切る
+func splitQuery(s string) []queryToken {
+	var tokens []queryToken
+	var b strings.Builder
+	quoted := false
+	flush := func() {
+		if b.Len() > 0 || quoted {
+			tokens = append(tokens, queryToken{text: b.String(), quoted: quoted})
+		}
+		b.Reset()
+	}
+
+	for _, r := range s {
+		switch {
+		case r == '"':
+			flush()
+			quoted = !quoted
+		case unicode.IsSpace(r) && !quoted:
+			flush()
+		default:
+			b.WriteRune(r)
+		}
+	}
+	flush()
+	return tokens
+}
@@ -0,0 +1,49 @@
+package search
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestParseQuery(t *testing.T) {
+	date := func(s string) time.Time {
+		d, _ := time.Parse(dateLayout, s)
+		return d
+	}
+
+	tests := []struct {
+		name string
+		in   string
+		want *Query
+	}{
+		{"terms", "  go   言語 ", &Query{Terms: []string{"go", "言語"}}},
+		{"phrase", `"hello  world" foo`, &Query{Terms: []string{"hello world", "foo"}}},
+		{"phrase next to term", `a"b c"d`, &Query{Terms: []string{"a", "b c", "d"}}},
+		{"unclosed phrase", `"hello world`, &Query{Terms: []string{"hello world"}}},
+		{"empty phrase", `"" go`, &Query{Terms: []string{"go"}}},
+		{"from", "from:@alice go", &Query{Terms: []string{"go"}, From: "alice"}},
+		{"operators are case insensitive", "FROM:alice", &Query{From: "alice"}},
+		{"dates", "since:2024-01-02 until:2024-02-01", &Query{Since: date("2024-01-02"), Until: date("2024-02-01")}},
+		// 演算子でない : を含む語句とフレーズの中の演算子は語句として扱う
+		{"not an operator", `http://example.com "from:alice"`, &Query{Terms: []string{"http://example.com", "from:alice"}}},
+		{"empty", "   ", &Query{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseQuery(tt.in)
+			if err != nil {
+				t.Fatalf("ParseQuery(%q): %v", tt.in, err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseQuery(%q) = %+v, want %+v", tt.in, got, tt.want)
+			}
+		})
+	}
+
+	for _, in := range []string{"from:", "from:@", "since:2024-13-01", "until:yesterday"} {
+		if _, err := ParseQuery(in); err == nil {
+			t.Errorf("ParseQuery(%q) succeeded, want error", in)
+		}
+	}
+}
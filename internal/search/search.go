@@ -0,0 +1,93 @@
+// Package search はツイートとユーザーの全文検索のインデックスを提供する。
+//
+// 実装はMySQLの FULLTEXT インデックス(NewMySQL)と、プロセス内の転置インデックス(NewLocal)がある。
+// MySQLの実装はテーブルのインデックスをそのまま使うので、書き込みのたびに更新する必要はない。
+// プロセス内の実装は起動時に Rebuild でデータベースから作り、RegisterIndexHooks で
+// ツイートとユーザーの変更に追従させる。インスタンスごとに独立しているので、
+// 複数のインスタンスで動かす場合はMySQLの実装を使うこと
+package search
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+	"todoapp/internal/infrastructure"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// TweetDoc は検索の対象にするツイート
+type TweetDoc struct {
+	ID        int
+	UserID    int
+	Content   string
+	CreatedAt time.Time
+}
+
+// UserDoc は検索の対象にするユーザー(退会中のユーザーは含めない)
+type UserDoc struct {
+	ID          int
+	Username    string
+	DisplayName string
+	Bio         string
+}
+
+type Index interface {
+	// SearchTweets は q に一致するツイートのIDを新しい順に、beforeID より小さいものから
+	// 最大 limit 件返す。beforeID が0なら先頭から返す。
+	// 削除済みのツイートと、投稿者が退会中か q.ExcludeUserIDs に含まれるツイートは含めない
+	SearchTweets(ctx context.Context, q *Query, beforeID, limit int) ([]int, error)
+	// SearchUsers は q の語句にユーザー名・表示名・自己紹介が一致するユーザーのIDを
+	// 新しい順に返す。q.ExcludeUserIDs のユーザーは含めない。q の絞り込み(from: など)は使わない
+	SearchUsers(ctx context.Context, q *Query, beforeID, limit int) ([]int, error)
+	// IndexTweet はツイートを追加する。同じIDがあれば置き換える
+	IndexTweet(ctx context.Context, doc TweetDoc) error
+	// DeleteTweet はツイートを取り除く。存在しないIDは無視する
+	DeleteTweet(ctx context.Context, id int) error
+	// IndexUser はユーザーを追加する。同じIDがあれば置き換える
+	IndexUser(ctx context.Context, doc UserDoc) error
+	// DeleteUser はユーザーを取り除く。存在しないIDは無視する
+	DeleteUser(ctx context.Context, id int) error
+}
+
+const (
+	DriverMySQL = "mysql"
+	DriverLocal = "local"
+)
+
+type Config struct {
+	// Driver は mysql / local のいずれか
+	Driver string
+}
+
+// ConfigFromEnv は SEARCH_DRIVER から設定を読み込む。
+// 指定がなければ、DB_DRIVER がMySQLの場合は mysql、それ以外は local にする
+func ConfigFromEnv() (Config, error) {
+	cfg := Config{Driver: os.Getenv("SEARCH_DRIVER")}
+	if cfg.Driver == "" {
+		cfg.Driver = DriverLocal
+		if db := os.Getenv("DB_DRIVER"); db == "" || db == infrastructure.DriverMySQL {
+			cfg.Driver = DriverMySQL
+		}
+	}
+
+	switch cfg.Driver {
+	case DriverMySQL:
+		if db := os.Getenv("DB_DRIVER"); db != "" && db != infrastructure.DriverMySQL {
+			return Config{}, fmt.Errorf("SEARCH_DRIVER=mysql requires DB_DRIVER=mysql, got %q", db)
+		}
+	case DriverLocal:
+	default:
+		return Config{}, fmt.Errorf("unsupported SEARCH_DRIVER: %q", cfg.Driver)
+	}
+	return cfg, nil
+}
+
+// New は cfg.Driver のインデックスを返す。mysql の場合は readDB で検索する
+func New(cfg Config, readDB boil.ContextExecutor) Index {
+	if cfg.Driver == DriverMySQL {
+		return NewMySQL(readDB)
+	}
+	return NewLocal()
+}
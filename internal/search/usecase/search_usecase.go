@@ -0,0 +1,158 @@
+package usecase
+
+import (
+	"context"
+	"strconv"
+	"todoapp/internal/domain"
+	"todoapp/internal/search"
+	"todoapp/internal/search/model"
+	tweetmodel "todoapp/internal/tweet/model"
+	usermodel "todoapp/internal/user/model"
+)
+
+const (
+	defaultSearchLimit = 20
+	maxSearchLimit     = 100
+)
+
+// TweetLister は検索結果のIDのツイートを読み込む(tweetrepository.TweetRepository が満たす)
+type TweetLister interface {
+	ListByIDs(ctx context.Context, ids []int, viewerID int) ([]*tweetmodel.Tweet, error)
+}
+
+// ProfileLister は検索結果のIDのユーザーを読み込む(userrepository.UserRepository が満たす)
+type ProfileLister interface {
+	GetProfiles(ctx context.Context, userIDs []int, currentUserID int) ([]*usermodel.UserProfile, error)
+	GetBlockIDs(ctx context.Context, userID int) (blockedIDs, blockerIDs []int, err error)
+}
+
+// viewerID は閲覧しているユーザーで、retweeted_by_me と is_following の判定に使う
+type SearchUsecase interface {
+	// SearchTweets は req.Q に一致するツイートを新しい順に返す。viewerID とどちらかがブロックしているユーザーのツイートは含めない
+	SearchTweets(ctx context.Context, viewerID int, req model.SearchRequest) (*model.TweetResults, error)
+	// SearchUsers は req.Q にユーザー名・表示名・自己紹介が一致するユーザーを新しい順に返す。
+	// viewerID とどちらかがブロックしているユーザーは含めない。from: / since: / until: は指定できない
+	SearchUsers(ctx context.Context, viewerID int, req model.SearchRequest) (*model.UserResults, error)
+}
+
+type searchUsecase struct {
+	index    search.Index
+	tweets   TweetLister
+	profiles ProfileLister
+}
+
+func NewSearchUsecase(index search.Index, tweets TweetLister, profiles ProfileLister) SearchUsecase {
+	return &searchUsecase{
+		index:    index,
+		tweets:   tweets,
+		profiles: profiles,
+	}
+}
+
+func (u *searchUsecase) SearchTweets(ctx context.Context, viewerID int, req model.SearchRequest) (*model.TweetResults, error) {
+	q, beforeID, err := parseRequest(&req)
+	if err != nil {
+		return nil, err
+	}
+	if len(q.Terms) == 0 && !q.HasFilters() {
+		return nil, domain.Invalid("q is required")
+	}
+
+	if q.ExcludeUserIDs, err = u.blockedUserIDs(ctx, viewerID); err != nil {
+		return nil, err
+	}
+
+	// 1件多く読み、続きがあるかを判定する
+	ids, err := u.index.SearchTweets(ctx, q, beforeID, req.Limit+1)
+	if err != nil {
+		return nil, err
+	}
+	ids, next := page(ids, req.Limit)
+
+	// インデックスの反映が遅れて削除済みのツイートが含まれていても、読み込みで取り除かれる
+	tweets, err := u.tweets.ListByIDs(ctx, ids, viewerID)
+	if err != nil {
+		return nil, err
+	}
+	return &model.TweetResults{Tweets: tweets, NextCursor: next}, nil
+}
+
+func (u *searchUsecase) SearchUsers(ctx context.Context, viewerID int, req model.SearchRequest) (*model.UserResults, error) {
+	q, beforeID, err := parseRequest(&req)
+	if err != nil {
+		return nil, err
+	}
+	if q.HasFilters() {
+		return nil, domain.Invalid("from:, since: and until: can only be used to search tweets")
+	}
+	if len(q.Terms) == 0 {
+		return nil, domain.Invalid("q is required")
+	}
+
+	if q.ExcludeUserIDs, err = u.blockedUserIDs(ctx, viewerID); err != nil {
+		return nil, err
+	}
+
+	ids, err := u.index.SearchUsers(ctx, q, beforeID, req.Limit+1)
+	if err != nil {
+		return nil, err
+	}
+	ids, next := page(ids, req.Limit)
+
+	profiles := []*usermodel.UserProfile{}
+	if len(ids) > 0 {
+		profiles, err = u.profiles.GetProfiles(ctx, ids, viewerID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &model.UserResults{Users: profiles, NextCursor: next}, nil
+}
+
+// blockedUserIDs は viewerID とどちらかがブロックしているユーザーのIDを返す。
+// 検索のインデックスで除き、ページの件数が limit より少なくならないようにする
+func (u *searchUsecase) blockedUserIDs(ctx context.Context, viewerID int) ([]int, error) {
+	if viewerID == 0 {
+		return nil, nil
+	}
+	blockedIDs, blockerIDs, err := u.profiles.GetBlockIDs(ctx, viewerID)
+	if err != nil {
+		return nil, err
+	}
+	return append(blockedIDs, blockerIDs...), nil
+}
+
+// parseRequest は検索語とカーソルを解析し、req.Limit を範囲内に収める。
+// カーソルは前のページの最後のID
+func parseRequest(req *model.SearchRequest) (*search.Query, int, error) {
+	q, err := search.ParseQuery(req.Q)
+	if err != nil {
+		return nil, 0, domain.Invalid(err.Error())
+	}
+
+	beforeID := 0
+	if req.Cursor != "" {
+		beforeID, err = strconv.Atoi(req.Cursor)
+		if err != nil || beforeID <= 0 {
+			return nil, 0, domain.Invalid("invalid cursor")
+		}
+	}
+
+	if req.Limit <= 0 {
+		req.Limit = defaultSearchLimit
+	}
+	if req.Limit > maxSearchLimit {
+		req.Limit = maxSearchLimit
+	}
+	return q, beforeID, nil
+}
+
+// page は limit 件より多ければ limit 件に切り詰め、次のページのカーソルを返す
+func page(ids []int, limit int) ([]int, *string) {
+	if len(ids) <= limit {
+		return ids, nil
+	}
+	ids = ids[:limit]
+	next := strconv.Itoa(ids[limit-1])
+	return ids, &next
+}
@@ -0,0 +1,178 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+	"todoapp/internal/stream"
+
+	"github.com/labstack/echo/v4"
+	"golang.org/x/net/websocket"
+)
+
+// writeTimeout は1回の送信を待つ時間。これを超えるクライアントは切断する
+const writeTimeout = 10 * time.Second
+
+type StreamHandler struct {
+	hub       *stream.Hub
+	heartbeat time.Duration
+}
+
+func NewStreamHandler(hub *stream.Hub, heartbeat time.Duration) *StreamHandler {
+	return &StreamHandler{
+		hub:       hub,
+		heartbeat: heartbeat,
+	}
+}
+
+// TokenFromQuery は Authorization ヘッダーがなければ access_token クエリパラメーターをヘッダーにする。
+// ブラウザーの WebSocket と EventSource はヘッダーを設定できないため。
+// URLはアクセスログに残りうるので、ヘッダーを設定できるクライアントはヘッダーを使うこと。
+// 認証ミドルウェアより前に設定する
+func TokenFromQuery(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		req := c.Request()
+		if token := c.QueryParam("access_token"); token != "" && req.Header.Get(echo.HeaderAuthorization) == "" {
+			req.Header.Set(echo.HeaderAuthorization, "Bearer "+token)
+		}
+		return next(c)
+	}
+}
+
+// Stream はログイン中のユーザーのイベントを送り続ける。WebSocketのアップグレード要求なら
+// WebSocketで、それ以外は Server-Sent Events で送る。
+// 再接続では最後に受け取ったイベントのIDを Last-Event-ID ヘッダー(EventSource が自動で送る)か
+// last_event_id クエリパラメーターで指定すると、その後のイベントを先に送る
+func (h *StreamHandler) Stream(c echo.Context) error {
+	var lastEventID uint64
+	v := c.Request().Header.Get("Last-Event-ID")
+	if v == "" {
+		v = c.QueryParam("last_event_id")
+	}
+	if v != "" {
+		id, err := strconv.ParseUint(v, 10, 64)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid Last-Event-ID"})
+		}
+		lastEventID = id
+	}
+
+	userID, _ := c.Get("user_id").(int)
+	if strings.EqualFold(c.Request().Header.Get(echo.HeaderUpgrade), "websocket") {
+		return h.serveWebSocket(c, userID, lastEventID)
+	}
+	return h.serveSSE(c, userID, lastEventID)
+}
+
+func (h *StreamHandler) serveSSE(c echo.Context, userID int, lastEventID uint64) error {
+	res := c.Response()
+	res.Header().Set(echo.HeaderContentType, "text/event-stream")
+	res.Header().Set("Cache-Control", "no-cache")
+	res.Header().Set("Connection", "keep-alive")
+	// nginx などのプロキシにバッファリングさせない
+	res.Header().Set("X-Accel-Buffering", "no")
+	res.WriteHeader(http.StatusOK)
+
+	rc := http.NewResponseController(res.Writer)
+	write := func(format string, args ...interface{}) error {
+		if err := rc.SetWriteDeadline(time.Now().Add(writeTimeout)); err != nil && err != http.ErrNotSupported {
+			return err
+		}
+		if _, err := fmt.Fprintf(res, format, args...); err != nil {
+			return err
+		}
+		res.Flush()
+		return nil
+	}
+	send := func(e stream.Event) error {
+		if e.ID == 0 {
+			return write("event: %s\ndata: {}\n\n", e.Type)
+		}
+		return write("id: %d\nevent: %s\ndata: %s\n\n", e.ID, e.Type, e.Data)
+	}
+
+	sub := h.hub.Subscribe(userID, lastEventID)
+	defer sub.Close()
+
+	// 切断されたときに EventSource が再接続するまでの時間(ミリ秒)
+	if err := write("retry: 3000\n\n"); err != nil {
+		return nil
+	}
+	// 送信に失敗した接続は既に切れているので、エラーのレスポンスは返さない
+	_ = h.run(c.Request().Context().Done(), sub, send, func() error { return write(": heartbeat\n\n") })
+	return nil
+}
+
+func (h *StreamHandler) serveWebSocket(c echo.Context, userID int, lastEventID uint64) error {
+	server := websocket.Server{
+		// 認証はCookieではなくトークンで行うので、他のオリジンからの接続も受け付ける
+		Handshake: func(*websocket.Config, *http.Request) error { return nil },
+		Handler: func(ws *websocket.Conn) {
+			defer ws.Close()
+			send := func(e stream.Event) error {
+				if err := ws.SetWriteDeadline(time.Now().Add(writeTimeout)); err != nil {
+					return err
+				}
+				data, err := json.Marshal(e)
+				if err != nil {
+					return err
+				}
+				return websocket.Message.Send(ws, string(data))
+			}
+
+			sub := h.hub.Subscribe(userID, lastEventID)
+			defer sub.Close()
+
+			// クライアントからのメッセージは使わないが、切断を検知するために読み続ける
+			closed := make(chan struct{})
+			go func() {
+				defer close(closed)
+				var discard string
+				for websocket.Message.Receive(ws, &discard) == nil {
+				}
+			}()
+
+			_ = h.run(closed, sub, send, func() error { return send(stream.Event{Type: stream.EventHeartbeat}) })
+		},
+	}
+	server.ServeHTTP(c.Response(), c.Request())
+	return nil
+}
+
+// run は done が閉じるか、送信に失敗するか、購読が閉じられる(送信が追いつかない)までイベントを送る。
+// 再送できないイベントがあれば最初に EventReset を送る
+func (h *StreamHandler) run(done <-chan struct{}, sub *stream.Subscription, send func(stream.Event) error, heartbeat func() error) error {
+	if sub.Reset {
+		if err := send(stream.Event{Type: stream.EventReset}); err != nil {
+			return err
+		}
+	}
+	for _, e := range sub.Replay {
+		if err := send(e); err != nil {
+			return err
+		}
+	}
+
+	ticker := time.NewTicker(h.heartbeat)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-done:
+			return nil
+		case e, ok := <-sub.Events:
+			if !ok {
+				return nil
+			}
+			if err := send(e); err != nil {
+				return err
+			}
+		case <-ticker.C:
+			if err := heartbeat(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,202 @@
+package handler_test
+
+import (
+	"bufio"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+	notificationrepository "todoapp/internal/notification/repository"
+	"todoapp/internal/router"
+	"todoapp/internal/stream"
+	"todoapp/internal/stream/handler"
+	"todoapp/internal/testutil"
+	tweethandler "todoapp/internal/tweet/handler"
+	tweetmodel "todoapp/internal/tweet/model"
+	tweetrepository "todoapp/internal/tweet/repository"
+	tweetusecase "todoapp/internal/tweet/usecase"
+
+	"github.com/labstack/echo/v4"
+	"golang.org/x/net/websocket"
+)
+
+// newTestServer はサーバーと、ストリームをネットワーク越しに読むための同じサーバーの httptest.Server を返す
+func newTestServer(t *testing.T) (*echo.Echo, *httptest.Server) {
+	t.Helper()
+	env := testutil.NewEnv(t)
+	notificationrepository.RegisterModelHooks()
+
+	tweetRepo := tweetrepository.NewTweetRepository(env.DB, env.DB)
+	hub, err := stream.NewHub(stream.NewLocal(), stream.Config{BufferSize: 16, HistorySize: 100})
+	if err != nil {
+		t.Fatalf("NewHub: %v", err)
+	}
+	t.Cleanup(hub.Close)
+	stream.RegisterPublishHooks(hub, tweetRepo)
+
+	e := env.Server(router.Handlers{
+		Tweet:  tweethandler.NewTweetHandler(tweetusecase.NewTweetUsecase(tweetRepo, env.UserRepo, env.TxManager)),
+		Stream: handler.NewStreamHandler(hub, time.Hour),
+	})
+	server := httptest.NewServer(e)
+	t.Cleanup(server.Close)
+	return e, server
+}
+
+// sseStream は Server-Sent Events のレスポンスを1イベントずつ読む
+type sseStream struct {
+	res    *http.Response
+	events chan stream.Event
+}
+
+func openSSE(t *testing.T, server *httptest.Server, token, lastEventID string) *sseStream {
+	t.Helper()
+	req, err := http.NewRequest(http.MethodGet, server.URL+"/api/stream", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set(echo.HeaderAuthorization, "Bearer "+token)
+	if lastEventID != "" {
+		req.Header.Set("Last-Event-ID", lastEventID)
+	}
+	res, err := server.Client().Do(req)
+	if err != nil {
+		t.Fatalf("GET /api/stream: %v", err)
+	}
+	if res.StatusCode != http.StatusOK || res.Header.Get(echo.HeaderContentType) != "text/event-stream" {
+		t.Fatalf("GET /api/stream: status = %d, content type = %q", res.StatusCode, res.Header.Get(echo.HeaderContentType))
+	}
+
+	s := &sseStream{res: res, events: make(chan stream.Event, 16)}
+	go func() {
+		defer close(s.events)
+		var e stream.Event
+		scanner := bufio.NewScanner(res.Body)
+		for scanner.Scan() {
+			name, value, _ := strings.Cut(scanner.Text(), ": ")
+			switch name {
+			case "id":
+				e.ID, _ = strconv.ParseUint(value, 10, 64)
+			case "event":
+				e.Type = value
+			case "data":
+				e.Data = json.RawMessage(value)
+			case "":
+				if e.Type != "" {
+					s.events <- e
+				}
+				e = stream.Event{}
+			}
+		}
+	}()
+	t.Cleanup(s.close)
+	return s
+}
+
+func (s *sseStream) close() {
+	s.res.Body.Close()
+}
+
+func (s *sseStream) next(t *testing.T) stream.Event {
+	t.Helper()
+	select {
+	case e, ok := <-s.events:
+		if !ok {
+			t.Fatal("stream closed")
+		}
+		return e
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for an event")
+	}
+	return stream.Event{}
+}
+
+func TestStreamSSE(t *testing.T) {
+	e, server := newTestServer(t)
+	_, alice := testutil.RegisterAndLogin(t, e, "alice")
+	bobID, bob := testutil.RegisterAndLogin(t, e, "bob")
+	_, carol := testutil.RegisterAndLogin(t, e, "carol")
+
+	if rec := testutil.Do(t, e, http.MethodGet, "/api/stream", "", ""); rec.Code != http.StatusUnauthorized {
+		t.Errorf("without token: status = %d, want 401", rec.Code)
+	}
+	if rec := testutil.Do(t, e, http.MethodGet, "/api/stream?last_event_id=abc", alice, ""); rec.Code != http.StatusBadRequest {
+		t.Errorf("invalid last_event_id: status = %d, want 400", rec.Code)
+	}
+
+	s := openSSE(t, server, alice, "")
+	testutil.MustDo(t, e, http.MethodPost, "/api/users/"+strconv.Itoa(bobID)+"/follow", alice, "", http.StatusCreated)
+	testutil.MustDo(t, e, http.MethodPost, "/api/tweets", bob, `{"content":"hello from bob"}`, http.StatusCreated)
+	// フォローしていないユーザーのツイートは届かない
+	testutil.MustDo(t, e, http.MethodPost, "/api/tweets", carol, `{"content":"hello from carol"}`, http.StatusCreated)
+
+	ev := s.next(t)
+	var tweet tweetmodel.Tweet
+	if err := json.Unmarshal(ev.Data, &tweet); err != nil || ev.Type != stream.EventTweet || tweet.Content != "hello from bob" {
+		t.Fatalf("event = %s %s, want bob's tweet", ev.Type, ev.Data)
+	}
+
+	rec := testutil.MustDo(t, e, http.MethodPost, "/api/tweets", alice, `{"content":"mine"}`, http.StatusCreated)
+	testutil.Decode(t, rec, &tweet)
+	if e := s.next(t); e.Type != stream.EventTweet {
+		t.Fatalf("event = %s %s, want own tweet", e.Type, e.Data)
+	}
+	testutil.MustDo(t, e, http.MethodPost, "/api/tweets/"+strconv.Itoa(tweet.ID)+"/like", carol, "", http.StatusCreated)
+	got := map[string]stream.Event{}
+	for i := 0; i < 2; i++ {
+		e := s.next(t)
+		got[e.Type] = e
+	}
+	var notification stream.NotificationEvent
+	if err := json.Unmarshal(got[stream.EventNotification].Data, &notification); err != nil || notification.Type != "like" || notification.TweetID == nil || *notification.TweetID != tweet.ID {
+		t.Errorf("notification = %s", got[stream.EventNotification].Data)
+	}
+	var likes stream.LikeCountEvent
+	if err := json.Unmarshal(got[stream.EventLikeCount].Data, &likes); err != nil || likes != (stream.LikeCountEvent{TweetID: tweet.ID, LikeCount: 1}) {
+		t.Errorf("like_count = %s", got[stream.EventLikeCount].Data)
+	}
+
+	// 再接続すると Last-Event-ID の後のイベントを再送する
+	s.close()
+	resumed := openSSE(t, server, alice, strconv.FormatUint(ev.ID, 10))
+	for i := 0; i < 3; i++ {
+		if e := resumed.next(t); e.Type == stream.EventReset {
+			t.Fatalf("unexpected reset")
+		}
+	}
+
+	// 再送できない Last-Event-ID では reset を送る
+	if e := openSSE(t, server, alice, "1000").next(t); e.Type != stream.EventReset {
+		t.Errorf("event = %s, want reset", e.Type)
+	}
+}
+
+func TestStreamWebSocket(t *testing.T) {
+	e, server := newTestServer(t)
+	aliceID, alice := testutil.RegisterAndLogin(t, e, "alice")
+	_, bob := testutil.RegisterAndLogin(t, e, "bob")
+
+	url := strings.Replace(server.URL, "http", "ws", 1) + "/api/stream?access_token=" + alice
+	ws, err := websocket.Dial(url, "", server.URL)
+	if err != nil {
+		t.Fatalf("Dial: %v", err)
+	}
+	defer ws.Close()
+
+	testutil.MustDo(t, e, http.MethodPost, "/api/users/"+strconv.Itoa(aliceID)+"/follow", bob, "", http.StatusCreated)
+
+	if err := ws.SetReadDeadline(time.Now().Add(5 * time.Second)); err != nil {
+		t.Fatal(err)
+	}
+	var ev stream.Event
+	if err := websocket.JSON.Receive(ws, &ev); err != nil {
+		t.Fatalf("Receive: %v", err)
+	}
+	var notification stream.NotificationEvent
+	if err := json.Unmarshal(ev.Data, &notification); err != nil || ev.Type != stream.EventNotification || notification.Type != "follow" || ev.ID == 0 {
+		t.Errorf("event = %+v, want follow notification", ev)
+	}
+}
@@ -0,0 +1,135 @@
+package stream
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	dmmodel "todoapp/internal/dm/model"
+	"todoapp/internal/infrastructure"
+	"todoapp/internal/schema"
+	tweetmodel "todoapp/internal/tweet/model"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// TweetGetter は配信するツイートを読み込む(tweetrepository.TweetRepository が満たす)
+type TweetGetter interface {
+	GetByID(ctx context.Context, id, viewerID int) (*tweetmodel.Tweet, error)
+}
+
+// RegisterPublishHooks はsqlboilerのフックを登録し、ツイート・通知・いいね・ダイレクトメッセージの変更を hub に配る。
+// 宛先はフックと同じ exec で読み、配信はコミットの後に行うので、ロールバックされた変更は配らない。
+// ツイートといいね数はコミットの後にトランザクションの外で tweets から読み直し、エンティティや件数を含めて送る。
+// フックはパッケージ全体で共有されるので、アプリケーションの起動時に1回だけ呼ぶこと
+func RegisterPublishHooks(hub *Hub, tweets TweetGetter) {
+	// 新しいツイートは投稿者と、投稿者をフォローしている(ミュートしていない)ユーザーのタイムラインに配る
+	tweetCreated := func(ctx context.Context, exec boil.ContextExecutor, o *schema.Tweet) error {
+		recipients, err := timelineRecipients(ctx, exec, o.UserID)
+		if err != nil {
+			return err
+		}
+		id := o.ID
+		infrastructure.AfterCommit(ctx, func() {
+			ctx := context.WithoutCancel(infrastructure.WithoutTx(ctx))
+			tweet, err := tweets.GetByID(ctx, id, 0)
+			if err != nil {
+				return
+			}
+			_ = hub.Publish(ctx, recipients, EventTweet, tweet)
+		})
+		return nil
+	}
+	schema.AddTweetHook(boil.AfterInsertHook, tweetCreated)
+
+	notificationCreated := func(ctx context.Context, exec boil.ContextExecutor, o *schema.Notification) error {
+		event := NotificationEvent{
+			ID:        o.ID,
+			Type:      o.Type,
+			ActorID:   o.ActorID,
+			TweetID:   o.TweetID.Ptr(),
+			CreatedAt: o.CreatedAt.Time,
+		}
+		userID := o.UserID
+		infrastructure.AfterCommit(ctx, func() {
+			_ = hub.Publish(context.WithoutCancel(ctx), []int{userID}, EventNotification, event)
+		})
+		return nil
+	}
+	schema.AddNotificationHook(boil.AfterInsertHook, notificationCreated)
+
+	// いいね数はツイートを表示しうる、投稿者とそのフォロワーに配る
+	likeChanged := func(ctx context.Context, exec boil.ContextExecutor, o *schema.Like) error {
+		// 削除済みのツイートのいいねも取り消せるので、削除済みの行も読む
+		tweet, err := schema.Tweets(
+			qm.WithDeleted(),
+			qm.Select(schema.TweetColumns.UserID),
+			schema.TweetWhere.ID.EQ(o.TweetID),
+		).One(ctx, exec)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		recipients, err := timelineRecipients(ctx, exec, tweet.UserID)
+		if err != nil {
+			return err
+		}
+		tweetID := o.TweetID
+		infrastructure.AfterCommit(ctx, func() {
+			ctx := context.WithoutCancel(infrastructure.WithoutTx(ctx))
+			tweet, err := tweets.GetByID(ctx, tweetID, 0)
+			if err != nil {
+				return
+			}
+			_ = hub.Publish(ctx, recipients, EventLikeCount, LikeCountEvent{TweetID: tweet.ID, LikeCount: tweet.LikeCount})
+		})
+		return nil
+	}
+	schema.AddLikeHook(boil.AfterInsertHook, likeChanged)
+	schema.AddLikeHook(boil.AfterDeleteHook, likeChanged)
+
+	// ダイレクトメッセージは送信者を含む会話の参加者に配る(送信者の他の端末にも表示するため)
+	messageCreated := func(ctx context.Context, exec boil.ContextExecutor, o *schema.DMMessage) error {
+		participants, err := schema.DMParticipants(
+			schema.DMParticipantWhere.ConversationID.EQ(o.ConversationID),
+		).All(ctx, exec)
+		if err != nil {
+			return err
+		}
+		recipients := make([]int, len(participants))
+		for i, p := range participants {
+			recipients[i] = p.UserID
+		}
+		message := dmmodel.Message{
+			ID:             o.ID,
+			ConversationID: o.ConversationID,
+			SenderID:       o.SenderID,
+			Content:        o.Content,
+			CreatedAt:      o.CreatedAt.Time,
+		}
+		infrastructure.AfterCommit(ctx, func() {
+			_ = hub.Publish(context.WithoutCancel(ctx), recipients, EventMessage, message)
+		})
+		return nil
+	}
+	schema.AddDMMessageHook(boil.AfterInsertHook, messageCreated)
+}
+
+// timelineRecipients は userID と、userID をフォローしていてミュートしていないユーザーのIDを返す
+func timelineRecipients(ctx context.Context, exec boil.ContextExecutor, userID int) ([]int, error) {
+	follows, err := schema.Follows(
+		schema.FollowWhere.FollowingID.EQ(userID),
+		qm.Where("follows.follower_id NOT IN (SELECT mutes.muter_id FROM mutes WHERE mutes.muted_id = ?)", userID),
+	).All(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]int, 0, len(follows)+1)
+	ids = append(ids, userID)
+	for _, f := range follows {
+		ids = append(ids, f.FollowerID)
+	}
+	return ids, nil
+}
@@ -0,0 +1,155 @@
+package stream
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+)
+
+// Hub は Broker から受け取ったイベントを、このインスタンスに接続しているクライアントに渡す。
+// 再接続したクライアントに再送するため、直近のイベントを少なくとも HistorySize 件保持する
+type Hub struct {
+	broker      Broker
+	unsubscribe func()
+	bufferSize  int
+	historySize int
+
+	mu   sync.Mutex
+	subs map[int]map[*Subscription]struct{}
+	// history は保持しているイベントを古い順に並べたもの。
+	// evictedID は history から取り除いた最も新しいイベントの番号
+	history   []*Message
+	evictedID uint64
+	lastID    uint64
+}
+
+// Subscription は1つの接続の購読
+type Subscription struct {
+	hub    *Hub
+	userID int
+	// Replay は Last-Event-ID より後のイベントで、Events より先に送る
+	Replay []Event
+	// Reset は Last-Event-ID より後のイベントの一部を保持していないことを表す
+	Reset bool
+	// Events は新しいイベント。送信が追いつかずにバッファが溢れるか、Close で閉じる
+	Events <-chan Event
+
+	ch     chan Event
+	closed bool
+}
+
+func NewHub(broker Broker, cfg Config) (*Hub, error) {
+	h := &Hub{
+		broker:      broker,
+		bufferSize:  cfg.BufferSize,
+		historySize: cfg.HistorySize,
+		subs:        make(map[int]map[*Subscription]struct{}),
+	}
+	unsubscribe, err := broker.Subscribe(h.dispatch)
+	if err != nil {
+		return nil, err
+	}
+	h.unsubscribe = unsubscribe
+	return h, nil
+}
+
+// Close は Broker の購読をやめる。接続中のクライアントの Events は閉じない
+func (h *Hub) Close() {
+	h.unsubscribe()
+}
+
+// Publish は data をJSONにして、userIDs のユーザーにイベントを配る
+func (h *Hub) Publish(ctx context.Context, userIDs []int, eventType string, data interface{}) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	return h.broker.Publish(ctx, &Message{Event: Event{Type: eventType, Data: payload}, UserIDs: userIDs})
+}
+
+// Subscribe は userID のイベントの購読を開始する。lastEventID が0でなければ、
+// それより後に配られた userID のイベントを Replay に入れる
+func (h *Hub) Subscribe(userID int, lastEventID uint64) *Subscription {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	ch := make(chan Event, h.bufferSize)
+	sub := &Subscription{hub: h, userID: userID, Events: ch, ch: ch}
+	if lastEventID > 0 {
+		// 取り除いたイベントに未送信のものがあるか、番号が数え直されている(Local の再起動)
+		sub.Reset = lastEventID < h.evictedID || lastEventID > h.lastID
+		if !sub.Reset {
+			for _, msg := range h.history {
+				if msg.ID > lastEventID && containsUser(msg.UserIDs, userID) {
+					sub.Replay = append(sub.Replay, msg.Event)
+				}
+			}
+		}
+	}
+
+	if h.subs[userID] == nil {
+		h.subs[userID] = make(map[*Subscription]struct{})
+	}
+	h.subs[userID][sub] = struct{}{}
+	return sub
+}
+
+// Close は購読をやめて Events を閉じる。複数回呼んでもよい
+func (s *Subscription) Close() {
+	s.hub.mu.Lock()
+	defer s.hub.mu.Unlock()
+	s.hub.remove(s)
+}
+
+func (h *Hub) dispatch(msg *Message) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if msg.ID > h.lastID {
+		h.lastID = msg.ID
+	}
+	h.history = append(h.history, msg)
+	// 毎回コピーしないよう、2倍まで溜まってから直近の historySize 件に詰める
+	if len(h.history) >= 2*h.historySize {
+		over := len(h.history) - h.historySize
+		h.evictedID = h.history[over-1].ID
+		h.history = append(h.history[:0:0], h.history[over:]...)
+	}
+
+	for _, userID := range msg.UserIDs {
+		for sub := range h.subs[userID] {
+			select {
+			case sub.ch <- msg.Event:
+			default:
+				// 送信が追いつかないクライアントは切断する。クライアントは再接続して
+				// Last-Event-ID から再送を受けるので、他のクライアントへの配信を遅らせない
+				h.remove(sub)
+			}
+		}
+	}
+}
+
+// remove は h.mu をロックして呼ぶ
+func (h *Hub) remove(sub *Subscription) {
+	if sub.closed {
+		return
+	}
+	sub.closed = true
+	close(sub.ch)
+	delete(h.subs[sub.userID], sub)
+	if len(h.subs[sub.userID]) == 0 {
+		delete(h.subs, sub.userID)
+	}
+}
+
+func containsUser(userIDs []int, userID int) bool {
+	for _, id := range userIDs {
+		if id == userID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,127 @@
+package stream
+
+import (
+	"context"
+	"testing"
+)
+
+func newTestHub(t *testing.T, bufferSize, historySize int) *Hub {
+	t.Helper()
+	hub, err := NewHub(NewLocal(), Config{BufferSize: bufferSize, HistorySize: historySize})
+	if err != nil {
+		t.Fatalf("NewHub: %v", err)
+	}
+	t.Cleanup(hub.Close)
+	return hub
+}
+
+func publish(t *testing.T, hub *Hub, userIDs ...int) {
+	t.Helper()
+	if err := hub.Publish(context.Background(), userIDs, EventTweet, map[string]int{"id": 1}); err != nil {
+		t.Fatalf("Publish: %v", err)
+	}
+}
+
+// received は sub.Events に届いているイベントのIDを返す
+func received(sub *Subscription) []uint64 {
+	var ids []uint64
+	for {
+		select {
+		case e, ok := <-sub.Events:
+			if !ok {
+				return ids
+			}
+			ids = append(ids, e.ID)
+		default:
+			return ids
+		}
+	}
+}
+
+func equalIDs(a, b []uint64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestHub(t *testing.T) {
+	hub := newTestHub(t, 10, 10)
+	alice := hub.Subscribe(1, 0)
+	defer alice.Close()
+
+	publish(t, hub, 1, 2)
+	publish(t, hub, 2)
+	publish(t, hub, 1)
+	if got := received(alice); !equalIDs(got, []uint64{1, 3}) {
+		t.Errorf("alice received %v, want [1 3]", got)
+	}
+
+	// 再接続では Last-Event-ID より後の自分宛てのイベントを再送する
+	bob := hub.Subscribe(2, 1)
+	defer bob.Close()
+	var replayed []uint64
+	for _, e := range bob.Replay {
+		replayed = append(replayed, e.ID)
+	}
+	if bob.Reset || !equalIDs(replayed, []uint64{2}) {
+		t.Errorf("bob replay = %v (reset %v), want [2]", replayed, bob.Reset)
+	}
+
+	// 番号が数え直された場合(再起動)は再送できない
+	if sub := hub.Subscribe(1, 100); !sub.Reset {
+		t.Error("Subscribe with a future Last-Event-ID: Reset = false")
+	}
+
+	alice.Close()
+	alice.Close()
+	publish(t, hub, 1)
+	if _, ok := <-alice.Events; ok {
+		t.Error("closed subscription received an event")
+	}
+}
+
+func TestHubHistory(t *testing.T) {
+	hub := newTestHub(t, 100, 2)
+	for i := 0; i < 5; i++ {
+		publish(t, hub, 1)
+	}
+	// 少なくとも直近の2件は再送できる
+	if sub := hub.Subscribe(1, 3); sub.Reset || len(sub.Replay) != 2 {
+		t.Errorf("Subscribe(1, 3) = %d events (reset %v), want 2", len(sub.Replay), sub.Reset)
+	}
+	// 取り除いたイベントを送っていないクライアントは読み直す
+	if sub := hub.Subscribe(1, 1); !sub.Reset || len(sub.Replay) != 0 {
+		t.Errorf("Subscribe(1, 1) = %d events (reset %v), want reset", len(sub.Replay), sub.Reset)
+	}
+}
+
+func TestHubSlowClient(t *testing.T) {
+	hub := newTestHub(t, 2, 10)
+	slow := hub.Subscribe(1, 0)
+	fast := hub.Subscribe(1, 0)
+
+	publish(t, hub, 1)
+	publish(t, hub, 1)
+	if got := received(fast); len(got) != 2 {
+		t.Fatalf("fast received %v, want 2 events", got)
+	}
+	publish(t, hub, 1)
+
+	// バッファが溢れたクライアントだけを切断する
+	if got := received(slow); !equalIDs(got, []uint64{1, 2}) {
+		t.Errorf("slow received %v, want [1 2] and then closed", got)
+	}
+	if _, ok := <-slow.Events; ok {
+		t.Error("slow subscription is not closed")
+	}
+	if got := received(fast); !equalIDs(got, []uint64{3}) {
+		t.Errorf("fast received %v, want [3]", got)
+	}
+	fast.Close()
+}
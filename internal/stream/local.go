@@ -0,0 +1,45 @@
+package stream
+
+import (
+	"context"
+	"sync"
+)
+
+// Local はプロセス内の Broker。通し番号はプロセスの起動ごとに1から数え直す
+type Local struct {
+	mu       sync.Mutex
+	lastID   uint64
+	handlers map[int]func(*Message)
+	nextKey  int
+}
+
+func NewLocal() *Local {
+	return &Local{handlers: make(map[int]func(*Message))}
+}
+
+// Publish は番号の順に配るため、番号を付けてから handler を呼び終えるまでロックする
+func (l *Local) Publish(ctx context.Context, msg *Message) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.lastID++
+	msg.ID = l.lastID
+	for _, handler := range l.handlers {
+		handler(msg)
+	}
+	return nil
+}
+
+func (l *Local) Subscribe(handler func(*Message)) (func(), error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	key := l.nextKey
+	l.nextKey++
+	l.handlers[key] = handler
+	return func() {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		delete(l.handlers, key)
+	}, nil
+}
@@ -0,0 +1,95 @@
+package stream
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// redisIDKey は通し番号のカウンター、redisChannel はメッセージを配るチャンネル
+	redisIDKey   = "stream:last_event_id"
+	redisChannel = "stream:events"
+)
+
+// publishScript は番号の採番と PUBLISH を1回で行い、すべてのインスタンスに番号の順で届くようにする。
+// メッセージは "<番号> <JSON>" の形式で配る
+var publishScript = redis.NewScript(`
+local id = redis.call('INCR', KEYS[1])
+redis.call('PUBLISH', KEYS[2], id .. ' ' .. ARGV[1])
+return id`)
+
+// Redis はRedisプロトコルのサーバーの Pub/Sub を使う Broker。
+// 番号はサーバーに保存するので、インスタンスを再起動しても続きから数える
+type Redis struct {
+	client *redis.Client
+}
+
+func NewRedis(addr string) *Redis {
+	return &Redis{client: redis.NewClient(&redis.Options{Addr: addr})}
+}
+
+func (r *Redis) Publish(ctx context.Context, msg *Message) error {
+	payload, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	id, err := publishScript.Run(ctx, r.client, []string{redisIDKey, redisChannel}, payload).Int64()
+	if err != nil {
+		return err
+	}
+	msg.ID = uint64(id)
+	return nil
+}
+
+func (r *Redis) Subscribe(handler func(*Message)) (func(), error) {
+	ctx := context.Background()
+	pubsub := r.client.Subscribe(ctx, redisChannel)
+	// 購読の開始を待ち、戻った後に配られたメッセージを取りこぼさないようにする
+	if _, err := pubsub.Receive(ctx); err != nil {
+		pubsub.Close()
+		return nil, err
+	}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for m := range pubsub.Channel() {
+			if msg, ok := decodeMessage(m.Payload); ok {
+				handler(msg)
+			}
+		}
+	}()
+	return func() {
+		pubsub.Close()
+		<-done
+	}, nil
+}
+
+func decodeMessage(payload string) (*Message, bool) {
+	idStr, body, ok := strings.Cut(payload, " ")
+	if !ok {
+		return nil, false
+	}
+	id, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		return nil, false
+	}
+	var msg Message
+	if err := json.Unmarshal([]byte(body), &msg); err != nil {
+		return nil, false
+	}
+	msg.ID = id
+	return &msg, true
+}
+
+func (r *Redis) Ping(ctx context.Context) error {
+	return r.client.Ping(ctx).Err()
+}
+
+func (r *Redis) Close() error {
+	return r.client.Close()
+}
@@ -0,0 +1,46 @@
+package stream
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+)
+
+func TestRedis(t *testing.T) {
+	ctx := context.Background()
+	server := miniredis.RunT(t)
+
+	// 別々のインスタンスの Broker として2つの接続を使う
+	publisher, subscriber := NewRedis(server.Addr()), NewRedis(server.Addr())
+	t.Cleanup(func() {
+		publisher.Close()
+		subscriber.Close()
+	})
+	hub, err := NewHub(subscriber, Config{BufferSize: 10, HistorySize: 10})
+	if err != nil {
+		t.Fatalf("NewHub: %v", err)
+	}
+	defer hub.Close()
+	sub := hub.Subscribe(1, 0)
+	defer sub.Close()
+
+	for _, userIDs := range [][]int{{1, 2}, {2}, {1}} {
+		msg := &Message{Event: Event{Type: EventTweet, Data: []byte(`{"id":1}`)}, UserIDs: userIDs}
+		if err := publisher.Publish(ctx, msg); err != nil {
+			t.Fatalf("Publish: %v", err)
+		}
+	}
+
+	for _, want := range []uint64{1, 3} {
+		select {
+		case e := <-sub.Events:
+			if e.ID != want || e.Type != EventTweet || string(e.Data) != `{"id":1}` {
+				t.Errorf("event = %+v, want id %d", e, want)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out waiting for event %d", want)
+		}
+	}
+}
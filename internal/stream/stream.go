@@ -0,0 +1,149 @@
+// Package stream はタイムライン・通知・ダイレクトメッセージのイベントをクライアントにリアルタイムで配信する。
+//
+// イベントは Hub.Publish で Broker に送り、Broker がすべてのインスタンスの Hub に配る。
+// Hub はそのインスタンスに接続しているクライアントのうち、宛先のユーザーのものに渡す。
+// Broker はプロセス内の Local と、Redisプロトコルのサーバーの Pub/Sub を使う Redis がある。
+// 複数のインスタンスで動かす場合は Redis を使わないと、他のインスタンスで発生したイベントが届かない
+package stream
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+)
+
+// イベントの種類
+const (
+	// EventTweet はフォロー中のユーザー(と自分)の新しいツイート。data はツイート
+	EventTweet = "tweet"
+	// EventNotification は新しい通知。data は NotificationEvent
+	EventNotification = "notification"
+	// EventLikeCount はタイムラインに表示されうるツイートのいいね数の変化。data は LikeCountEvent
+	EventLikeCount = "like_count"
+	// EventMessage は参加している会話の新しいダイレクトメッセージ(自分が送ったものを含む)。data はメッセージ
+	EventMessage = "message"
+	// EventReset は Last-Event-ID 以降のイベントを再送できないことを表す。
+	// クライアントはタイムラインと通知を読み直す
+	EventReset = "reset"
+	// EventHeartbeat は接続を維持するために定期的に送る(WebSocketのみ。SSEではコメント行を送る)
+	EventHeartbeat = "heartbeat"
+)
+
+// Event はクライアントに送るイベント。ID はすべてのユーザーのイベントで共通の通し番号で、
+// 再接続したクライアントは最後に受け取った ID を Last-Event-ID で送る
+type Event struct {
+	ID   uint64          `json:"id,omitempty"`
+	Type string          `json:"type"`
+	Data json.RawMessage `json:"data,omitempty"`
+}
+
+// Message は Broker で配るイベントと宛先のユーザー
+type Message struct {
+	Event
+	UserIDs []int `json:"user_ids"`
+}
+
+type NotificationEvent struct {
+	ID        int       `json:"id"`
+	Type      string    `json:"type"`
+	ActorID   int       `json:"actor_id"`
+	TweetID   *int      `json:"tweet_id,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type LikeCountEvent struct {
+	TweetID   int `json:"tweet_id"`
+	LikeCount int `json:"like_count"`
+}
+
+// Broker はメッセージをすべてのインスタンスの購読者に配る
+type Broker interface {
+	// Publish は msg.ID に通し番号を付けて配る。番号は配る順に大きくなる
+	Publish(ctx context.Context, msg *Message) error
+	// Subscribe は配られたメッセージごとに handler を呼ぶ。購読を開始してから戻り、
+	// 戻り値の関数で購読をやめる。handler はブロックしないこと
+	Subscribe(handler func(*Message)) (func(), error)
+}
+
+const (
+	DriverLocal = "local"
+	DriverRedis = "redis"
+)
+
+type Config struct {
+	// Driver は Broker の種類で、local / redis のいずれか
+	Driver string
+	// RedisAddr は redis の接続先(host:port)
+	RedisAddr string
+	// Heartbeat はイベントがなくても接続を維持するために送る間隔
+	Heartbeat time.Duration
+	// BufferSize はクライアントごとに送信待ちにできるイベントの数。
+	// 溢れたクライアントは切断し、再接続の Last-Event-ID から再送させる
+	BufferSize int
+	// HistorySize は再送のために保持する直近のイベントの数(すべてのユーザーの合計)
+	HistorySize int
+}
+
+// ConfigFromEnv は STREAM_DRIVER, STREAM_REDIS_ADDR, STREAM_HEARTBEAT,
+// STREAM_BUFFER_SIZE, STREAM_HISTORY_SIZE から設定を読み込む
+func ConfigFromEnv() (Config, error) {
+	cfg := Config{
+		Driver:      os.Getenv("STREAM_DRIVER"),
+		RedisAddr:   os.Getenv("STREAM_REDIS_ADDR"),
+		Heartbeat:   25 * time.Second,
+		BufferSize:  64,
+		HistorySize: 10000,
+	}
+	if cfg.Driver == "" {
+		cfg.Driver = DriverLocal
+	}
+	if cfg.RedisAddr == "" {
+		cfg.RedisAddr = "localhost:6379"
+	}
+
+	if v := os.Getenv("STREAM_HEARTBEAT"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d <= 0 {
+			return Config{}, fmt.Errorf("invalid STREAM_HEARTBEAT: %q", v)
+		}
+		cfg.Heartbeat = d
+	}
+	for _, s := range []struct {
+		name string
+		dst  *int
+	}{
+		{"STREAM_BUFFER_SIZE", &cfg.BufferSize},
+		{"STREAM_HISTORY_SIZE", &cfg.HistorySize},
+	} {
+		if v := os.Getenv(s.name); v != "" {
+			n, err := strconv.Atoi(v)
+			if err != nil || n <= 0 {
+				return Config{}, fmt.Errorf("invalid %s: %q", s.name, v)
+			}
+			*s.dst = n
+		}
+	}
+
+	switch cfg.Driver {
+	case DriverLocal, DriverRedis:
+	default:
+		return Config{}, fmt.Errorf("unsupported STREAM_DRIVER: %q", cfg.Driver)
+	}
+	return cfg, nil
+}
+
+// NewBroker は cfg.Driver の Broker を返す
+func NewBroker(ctx context.Context, cfg Config) (Broker, error) {
+	if cfg.Driver != DriverRedis {
+		return NewLocal(), nil
+	}
+	b := NewRedis(cfg.RedisAddr)
+	if err := b.Ping(ctx); err != nil {
+		b.Close()
+		return nil, fmt.Errorf("failed to connect to redis: %w", err)
+	}
+	return b, nil
+}
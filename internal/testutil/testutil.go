@@ -0,0 +1,129 @@
+// Package testutil はハンドラーのテストで共通に使う、テスト用のサーバーの組み立てと
+// リクエストのヘルパーを提供する。テストからだけ使うこと
+package testutil
+
+import (
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	auditrepository "todoapp/internal/audit/repository"
+	auditusecase "todoapp/internal/audit/usecase"
+	"todoapp/internal/dbtest"
+	"todoapp/internal/infrastructure"
+	"todoapp/internal/router"
+	userhandler "todoapp/internal/user/handler"
+	usermodel "todoapp/internal/user/model"
+	userrepository "todoapp/internal/user/repository"
+	userusecase "todoapp/internal/user/usecase"
+
+	"github.com/labstack/echo/v4"
+)
+
+// JWTSecret はテスト用のサーバーがトークンの署名に使う鍵。
+// user/handler の認証ミドルウェアが検証に使う鍵と同じ値にしておく
+const JWTSecret = "your-secret-key"
+
+// Env はマイグレーション適用済みの独立したデータベースと、
+// ユーザーの登録・ログインに必要な依存をまとめたもの
+type Env struct {
+	DB        *sql.DB
+	TxManager infrastructure.TxManager
+	UserRepo  userrepository.UserRepository
+	AuditRepo auditrepository.AuditRepository
+	Audit     auditusecase.AuditUsecase
+	Users     userusecase.UserUsecase
+}
+
+// NewEnv はテストごとのデータベースを作り、その上の Env を返す
+func NewEnv(t testing.TB) *Env {
+	t.Helper()
+	db := dbtest.New(t)
+
+	txManager := infrastructure.NewTxManager(db, nil)
+	userRepo := userrepository.NewUserRepository(db, db)
+	auditRepo := auditrepository.NewAuditRepository(db, db)
+	audit := auditusecase.NewAuditUsecase(auditRepo)
+	return &Env{
+		DB:        db,
+		TxManager: txManager,
+		UserRepo:  userRepo,
+		AuditRepo: auditRepo,
+		Audit:     audit,
+		Users:     userusecase.NewUserUsecase(userRepo, txManager, audit, JWTSecret, userusecase.DefaultGracePeriod),
+	}
+}
+
+// Server は h のエンドポイントを登録したサーバーを返す。
+// h.User が nil なら env のユーザーのハンドラーを登録する
+func (env *Env) Server(h router.Handlers, middleware ...echo.MiddlewareFunc) *echo.Echo {
+	if h.User == nil {
+		h.User = userhandler.NewUserHandler(env.Users)
+	}
+	e := echo.New()
+	e.Use(middleware...)
+	router.Register(e, h)
+	return e
+}
+
+// NewRequest は token(空なら付けない)で認証したJSONのリクエストを作る
+func NewRequest(method, path, token, body string) *http.Request {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	if token != "" {
+		req.Header.Set(echo.HeaderAuthorization, "Bearer "+token)
+	}
+	return req
+}
+
+// Serve は req を h で処理したレスポンスを返す
+func Serve(h http.Handler, req *http.Request) *httptest.ResponseRecorder {
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec
+}
+
+// Do は NewRequest で作ったリクエストを h で処理したレスポンスを返す
+func Do(t testing.TB, h http.Handler, method, path, token, body string) *httptest.ResponseRecorder {
+	t.Helper()
+	return Serve(h, NewRequest(method, path, token, body))
+}
+
+// MustDo は Do のステータスコードが want でなければテストを終了する
+func MustDo(t testing.TB, h http.Handler, method, path, token, body string, want int) *httptest.ResponseRecorder {
+	t.Helper()
+	rec := Do(t, h, method, path, token, body)
+	if rec.Code != want {
+		t.Fatalf("%s %s: status = %d, want %d, body = %s", method, path, rec.Code, want, rec.Body)
+	}
+	return rec
+}
+
+// Decode はレスポンスのJSONを v に読み込む
+func Decode(t testing.TB, rec *httptest.ResponseRecorder, v interface{}) {
+	t.Helper()
+	if err := json.Unmarshal(rec.Body.Bytes(), v); err != nil {
+		t.Fatalf("invalid response body %q: %v", rec.Body.String(), err)
+	}
+}
+
+// RegisterAndLogin は username を表示名にしてユーザーを登録してログインし、ユーザーIDとトークンを返す
+func RegisterAndLogin(t testing.TB, h http.Handler, username string) (int, string) {
+	t.Helper()
+	return RegisterAndLoginAs(t, h, username, username)
+}
+
+// RegisterAndLoginAs は表示名を指定してユーザーを登録してログインし、ユーザーIDとトークンを返す
+func RegisterAndLoginAs(t testing.TB, h http.Handler, username, displayName string) (int, string) {
+	t.Helper()
+	MustDo(t, h, http.MethodPost, "/register", "",
+		`{"username":"`+username+`","display_name":"`+displayName+`","email":"`+username+`@example.com","password":"password123"}`,
+		http.StatusCreated)
+
+	rec := MustDo(t, h, http.MethodPost, "/login", "", `{"email":"`+username+`@example.com","password":"password123"}`, http.StatusOK)
+	var resp usermodel.LoginResponse
+	Decode(t, rec, &resp)
+	return resp.User.ID, resp.Token
+}
@@ -0,0 +1,94 @@
+package tracing
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"todoapp/internal/infrastructure"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// executor はSQL文ごとにクライアントスパンを記録する boil.ContextExecutor
+type executor struct {
+	exec     boil.ContextExecutor
+	dbSystem attribute.KeyValue
+}
+
+// WrapExecutorFor はsqlboilerのモデルに渡すエグゼキューターをトレース対応にする関数を返す。
+// スパンには DB_DRIVER に応じた db.system を記録する
+func WrapExecutorFor(driver string) func(boil.ContextExecutor) boil.ContextExecutor {
+	dbSystem := semconv.DBSystemMySQL
+	switch driver {
+	case infrastructure.DriverPostgres:
+		dbSystem = semconv.DBSystemPostgreSQL
+	case infrastructure.DriverSQLite:
+		dbSystem = semconv.DBSystemSqlite
+	}
+	return func(exec boil.ContextExecutor) boil.ContextExecutor {
+		return &executor{exec: exec, dbSystem: dbSystem}
+	}
+}
+
+func (e *executor) Exec(query string, args ...interface{}) (sql.Result, error) {
+	return e.ExecContext(context.Background(), query, args...)
+}
+
+func (e *executor) Query(query string, args ...interface{}) (*sql.Rows, error) {
+	return e.QueryContext(context.Background(), query, args...)
+}
+
+func (e *executor) QueryRow(query string, args ...interface{}) *sql.Row {
+	return e.QueryRowContext(context.Background(), query, args...)
+}
+
+func (e *executor) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	ctx, span := e.startQuerySpan(ctx, query)
+	defer span.End()
+
+	result, err := e.exec.ExecContext(ctx, query, args...)
+	RecordError(span, err)
+	return result, err
+}
+
+func (e *executor) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	ctx, span := e.startQuerySpan(ctx, query)
+	defer span.End()
+
+	rows, err := e.exec.QueryContext(ctx, query, args...)
+	RecordError(span, err)
+	return rows, err
+}
+
+func (e *executor) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	ctx, span := e.startQuerySpan(ctx, query)
+	defer span.End()
+
+	row := e.exec.QueryRowContext(ctx, query, args...)
+	if err := row.Err(); err != sql.ErrNoRows {
+		RecordError(span, err)
+	}
+	return row
+}
+
+func (e *executor) startQuerySpan(ctx context.Context, query string) (context.Context, trace.Span) {
+	return Tracer().Start(ctx, operationName(query),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			e.dbSystem,
+			semconv.DBStatement(query),
+		),
+	)
+}
+
+// operationName はSQL文の先頭のキーワード(SELECT, INSERTなど)をスパン名にする
+func operationName(query string) string {
+	fields := strings.Fields(query)
+	if len(fields) == 0 {
+		return "SQL"
+	}
+	return strings.ToUpper(fields[0])
+}
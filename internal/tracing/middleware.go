@@ -0,0 +1,56 @@
+package tracing
+
+import (
+	"fmt"
+
+	"github.com/labstack/echo/v4"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Middleware はHTTPリクエストごとにサーバースパンを開始する。
+// 受信した traceparent ヘッダーがあれば、そのトレースの子スパンとして記録する。
+func Middleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		req := c.Request()
+		ctx := otel.GetTextMapPropagator().Extract(req.Context(), propagation.HeaderCarrier(req.Header))
+
+		route := c.Path()
+		if route == "" {
+			route = req.URL.Path
+		}
+
+		ctx, span := Tracer().Start(ctx, fmt.Sprintf("%s %s", req.Method, route),
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(
+				semconv.HTTPRequestMethodKey.String(req.Method),
+				semconv.HTTPRoute(route),
+				semconv.URLPath(req.URL.Path),
+			),
+		)
+		defer span.End()
+
+		c.SetRequest(req.WithContext(ctx))
+
+		// 下流のサービスにもトレースを伝播できるようにレスポンスヘッダーに含める
+		otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(c.Response().Header()))
+
+		err := next(c)
+		if err != nil {
+			span.RecordError(err)
+			// エラーハンドラーにステータスコードを確定させる
+			c.Error(err)
+		}
+
+		status := c.Response().Status
+		span.SetAttributes(semconv.HTTPResponseStatusCode(status))
+		if status >= 500 {
+			span.SetStatus(codes.Error, fmt.Sprintf("HTTP %d", status))
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,168 @@
+// Package entity はツイート本文からハッシュタグ・メンション・URLを取り出す。
+// オフセットと文字数は本文の先頭からのUnicodeのコードポイント数で数え、
+// 日本語も絵文字も1文字として扱う
+package entity
+
+import (
+	"strings"
+	"todoapp/internal/tweet/model"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// MaxUsernameLength は users.username の最大長。これより長い @ の後の文字列はメンションにしない
+const MaxUsernameLength = 50
+
+// Normalize は本文をNFCに正規化する。濁点を結合文字で書いた「が」なども1文字として数え、
+// 保存した本文とオフセットが一致するように、文字数の検証と保存の前に正規化する
+func Normalize(content string) string {
+	return norm.NFC.String(content)
+}
+
+// Length は本文の文字数
+func Length(content string) int {
+	return utf8.RuneCountInString(content)
+}
+
+// NormalizeTag はハッシュタグを検索用に正規化する。先頭の # を除き、
+// 全角・半角(ＡＢＣとABC、ｶﾀｶﾅとカタカナ)と大文字・小文字を区別しない
+func NormalizeTag(tag string) string {
+	tag = strings.TrimPrefix(strings.TrimPrefix(tag, "#"), "＃")
+	return strings.ToLower(norm.NFKC.String(tag))
+}
+
+// Parse は本文からエンティティを出現順に取り出す。範囲は # や @ の記号を含み、End は含まない。
+// メンションはユーザーを解決しないので UserID は0になる
+func Parse(content string) *model.Entities {
+	entities := &model.Entities{Hashtags: []model.Hashtag{}, Mentions: []model.Mention{}, URLs: []model.URL{}}
+	text := []rune(content)
+	for i := 0; i < len(text); {
+		if end, ok := scanURL(text, i); ok {
+			entities.URLs = append(entities.URLs, model.URL{URL: string(text[i:end]), Start: i, End: end})
+			i = end
+			continue
+		}
+		if end, ok := scanHashtag(text, i); ok {
+			entities.Hashtags = append(entities.Hashtags, model.Hashtag{Tag: string(text[i+1 : end]), Start: i, End: end})
+			i = end
+			continue
+		}
+		if end, ok := scanMention(text, i); ok {
+			entities.Mentions = append(entities.Mentions, model.Mention{Username: string(text[i+1 : end]), Start: i, End: end})
+			i = end
+			continue
+		}
+		i++
+	}
+	return entities
+}
+
+// scanHashtag は i から始まるハッシュタグの終わりを返す。
+// 「#タグ」の直前が文字や数字の場合(「今日は#晴れ」や「&#39;」)と、数字だけの場合はハッシュタグにしない
+func scanHashtag(text []rune, i int) (int, bool) {
+	if !isHashSign(text[i]) || (i > 0 && (isHashtagChar(text[i-1]) || isHashSign(text[i-1]) || text[i-1] == '&')) {
+		return 0, false
+	}
+	end := i + 1
+	hasNonDigit := false
+	for end < len(text) && isHashtagChar(text[end]) {
+		if !unicode.IsDigit(text[end]) {
+			hasNonDigit = true
+		}
+		end++
+	}
+	// 「#tag#tag」のように # が続くものは1つのハッシュタグにならない
+	if !hasNonDigit || (end < len(text) && isHashSign(text[end])) {
+		return 0, false
+	}
+	return end, true
+}
+
+// scanMention は i から始まるメンションの終わりを返す。
+// メールアドレス(user@example.com)のように直前が英数字の場合はメンションにしない。
+// 日本語の直後(「こんにちは@alice」)はメンションにする
+func scanMention(text []rune, i int) (int, bool) {
+	if !isAtSign(text[i]) || (i > 0 && (isUsernameChar(text[i-1]) || isAtSign(text[i-1]) || strings.ContainsRune("!#$%&*", text[i-1]))) {
+		return 0, false
+	}
+	end := i + 1
+	for end < len(text) && isUsernameChar(text[end]) {
+		end++
+	}
+	if length := end - i - 1; length == 0 || length > MaxUsernameLength {
+		return 0, false
+	}
+	if end < len(text) && isAtSign(text[end]) {
+		return 0, false
+	}
+	return end, true
+}
+
+// scanURL は i から始まる http(s) のURLの終わりを返す。
+// URLはASCIIの文字までとし、「https://example.com/です。」のように続く日本語は含めない。
+// 文末の句読点と、対応する ( のない ) も含めない
+func scanURL(text []rune, i int) (int, bool) {
+	if i > 0 && (isUsernameChar(text[i-1]) || strings.ContainsRune("/@.", text[i-1])) {
+		return 0, false
+	}
+	start := i
+	for _, scheme := range []string{"https://", "http://"} {
+		if hasPrefixFold(text[i:], scheme) {
+			start = i + len(scheme)
+			break
+		}
+	}
+	if start == i || start >= len(text) || !isASCIIAlnum(text[start]) {
+		return 0, false
+	}
+
+	end := start
+	for end < len(text) && isURLChar(text[end]) {
+		end++
+	}
+	for end > start {
+		last := text[end-1]
+		if strings.ContainsRune(".,:;!?'\"", last) {
+			end--
+			continue
+		}
+		if last == ')' && strings.Count(string(text[start:end]), ")") > strings.Count(string(text[start:end]), "(") {
+			end--
+			continue
+		}
+		break
+	}
+	return end, true
+}
+
+func hasPrefixFold(text []rune, prefix string) bool {
+	if len(text) < len(prefix) {
+		return false
+	}
+	return strings.EqualFold(string(text[:len(prefix)]), prefix)
+}
+
+func isHashSign(r rune) bool { return r == '#' || r == '＃' }
+
+func isAtSign(r rune) bool { return r == '@' || r == '＠' }
+
+// isHashtagChar はハッシュタグに含められる文字。中黒(・)は「#ポケモン・ゲーム」のように使われるので含める。
+// U+200C と U+200D は絵文字や一部の文字体系の結合に使われる
+func isHashtagChar(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.Is(unicode.M, r) ||
+		r == '_' || r == '・' || r == '\u200c' || r == '\u200d'
+}
+
+func isUsernameChar(r rune) bool {
+	return isASCIIAlnum(r) || r == '_'
+}
+
+func isASCIIAlnum(r rune) bool {
+	return ('a' <= r && r <= 'z') || ('A' <= r && r <= 'Z') || ('0' <= r && r <= '9')
+}
+
+func isURLChar(r rune) bool {
+	return r > ' ' && r < utf8.RuneSelf && r != 0x7f && !strings.ContainsRune(`<>"`, r)
+}
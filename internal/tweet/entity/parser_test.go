@@ -0,0 +1,131 @@
+package entity
+
+import (
+	"reflect"
+	"testing"
+	"todoapp/internal/tweet/model"
+)
+
+func TestParse(t *testing.T) {
+	type span struct {
+		Text       string
+		Start, End int
+	}
+	tests := []struct {
+		name     string
+		content  string
+		hashtags []span
+		mentions []span
+		urls     []span
+	}{
+		{
+			name:     "ascii",
+			content:  "hello #golang @alice https://example.com/a?b=c",
+			hashtags: []span{{"golang", 6, 13}},
+			mentions: []span{{"alice", 14, 20}},
+			urls:     []span{{"https://example.com/a?b=c", 21, 46}},
+		},
+		{
+			// オフセットはバイトではなく文字で数える
+			name:     "japanese",
+			content:  "今日は晴れ #東京 ＃ラーメン・つけ麺 こんにちは@bob",
+			hashtags: []span{{"東京", 6, 9}, {"ラーメン・つけ麺", 10, 19}},
+			mentions: []span{{"bob", 25, 29}},
+		},
+		{
+			name:     "emoji",
+			content:  "🎉🎉 #party🎉 @carol",
+			hashtags: []span{{"party", 3, 9}},
+			mentions: []span{{"carol", 11, 17}},
+		},
+		{
+			name:    "not hashtags",
+			content: "今日は#晴れ #123 a#b &#39; #a#b",
+		},
+		{
+			name:     "email is not a mention",
+			content:  "mail user@example.com or ＠dave_1!",
+			mentions: []span{{"dave_1", 25, 32}},
+		},
+		{
+			name:     "long username is not a mention",
+			content:  "@abcdefghijabcdefghijabcdefghijabcdefghijabcdefghijk @ok",
+			mentions: []span{{"ok", 53, 56}},
+		},
+		{
+			// 文末の句読点と続く日本語はURLに含めない
+			name:    "url boundaries",
+			content: "見て https://example.com/です。 (https://en.wikipedia.org/wiki/Go_(game)) HTTP://EXAMPLE.COM.",
+			urls: []span{
+				{"https://example.com/", 3, 23},
+				{"https://en.wikipedia.org/wiki/Go_(game)", 28, 67},
+				{"HTTP://EXAMPLE.COM", 69, 87},
+			},
+		},
+		{
+			name:     "hashtag in url is not extracted",
+			content:  "https://example.com/#anchor #real",
+			hashtags: []span{{"real", 28, 33}},
+			urls:     []span{{"https://example.com/#anchor", 0, 27}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Parse(tt.content)
+			var hashtags, mentions, urls []span
+			for _, h := range got.Hashtags {
+				hashtags = append(hashtags, span{h.Tag, h.Start, h.End})
+			}
+			for _, m := range got.Mentions {
+				mentions = append(mentions, span{m.Username, m.Start, m.End})
+			}
+			for _, u := range got.URLs {
+				urls = append(urls, span{u.URL, u.Start, u.End})
+			}
+			if !reflect.DeepEqual(hashtags, tt.hashtags) {
+				t.Errorf("hashtags = %v, want %v", hashtags, tt.hashtags)
+			}
+			if !reflect.DeepEqual(mentions, tt.mentions) {
+				t.Errorf("mentions = %v, want %v", mentions, tt.mentions)
+			}
+			if !reflect.DeepEqual(urls, tt.urls) {
+				t.Errorf("urls = %v, want %v", urls, tt.urls)
+			}
+
+			// 範囲の文字列が本文と一致する
+			text := []rune(tt.content)
+			for _, h := range got.Hashtags {
+				if string(text[h.Start+1:h.End]) != h.Tag {
+					t.Errorf("hashtag %q does not match the content at [%d:%d]", h.Tag, h.Start, h.End)
+				}
+			}
+		})
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	// 「か」と結合文字の濁点(U+3099)は「が」の1文字になる
+	content := Normalize("\u304b\u3099んばる")
+	if content != "がんばる" || Length(content) != 4 {
+		t.Errorf("Normalize = %q (%d characters), want がんばる (4 characters)", content, Length(content))
+	}
+	if got := Length("👍あa"); got != 3 {
+		t.Errorf("Length = %d, want 3", got)
+	}
+
+	for _, tag := range []string{"#GoLang", "＃ＧＯＬＡＮＧ", "golang"} {
+		if got := NormalizeTag(tag); got != "golang" {
+			t.Errorf("NormalizeTag(%q) = %q, want golang", tag, got)
+		}
+	}
+	if got := NormalizeTag("ｶﾀｶﾅ"); got != "カタカナ" {
+		t.Errorf("NormalizeTag(ｶﾀｶﾅ) = %q, want カタカナ", got)
+	}
+}
+
+func TestParseEmpty(t *testing.T) {
+	want := &model.Entities{Hashtags: []model.Hashtag{}, Mentions: []model.Mention{}, URLs: []model.URL{}}
+	if got := Parse("no entities"); !reflect.DeepEqual(got, want) {
+		t.Errorf("Parse = %+v, want empty slices", got)
+	}
+}
@@ -0,0 +1,208 @@
+package handler
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"strconv"
+	"todoapp/internal/domain"
+	"todoapp/internal/tweet/model"
+	"todoapp/internal/tweet/usecase"
+
+	"github.com/labstack/echo/v4"
+)
+
+type TweetHandler struct {
+	usecase usecase.TweetUsecase
+}
+
+func NewTweetHandler(u usecase.TweetUsecase) *TweetHandler {
+	return &TweetHandler{
+		usecase: u,
+	}
+}
+
+func (h *TweetHandler) Create(c echo.Context) error {
+	var req model.CreateTweetRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request"})
+	}
+
+	tweet, err := h.usecase.Create(c.Request().Context(), getUserID(c), &req)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusCreated, tweet)
+}
+
+func (h *TweetHandler) Get(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid tweet ID"})
+	}
+
+	tweet, err := h.usecase.Get(c.Request().Context(), getUserID(c), id)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, tweet)
+}
+
+func (h *TweetHandler) Delete(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid tweet ID"})
+	}
+
+	if err := h.usecase.Delete(c.Request().Context(), getUserID(c), id); err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// Reply は :id へのリプライを作成する。削除済みのツイートへのリプライは 410 を返す
+func (h *TweetHandler) Reply(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid tweet ID"})
+	}
+	var req model.CreateTweetRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request"})
+	}
+
+	tweet, err := h.usecase.Reply(c.Request().Context(), getUserID(c), id, &req)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusCreated, tweet)
+}
+
+// GetThread は :id の会話を返す。クエリパラメーター: cursor(前のページの next_cursor), limit
+func (h *TweetHandler) GetThread(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid tweet ID"})
+	}
+	var req model.ThreadRequest
+	if err := (&echo.DefaultBinder{}).BindQueryParams(c, &req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid query parameters"})
+	}
+
+	thread, err := h.usecase.GetThread(c.Request().Context(), getUserID(c), id, req)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, thread)
+}
+
+// Retweet は :id をリツイートする。既にリツイートしている場合は 409 を返す
+func (h *TweetHandler) Retweet(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid tweet ID"})
+	}
+
+	if err := h.usecase.Retweet(c.Request().Context(), getUserID(c), id); err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.NoContent(http.StatusCreated)
+}
+
+func (h *TweetHandler) Unretweet(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid tweet ID"})
+	}
+
+	if err := h.usecase.Unretweet(c.Request().Context(), getUserID(c), id); err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// Like は :id にいいねする。既にいいねしている場合は 409 を返す
+func (h *TweetHandler) Like(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid tweet ID"})
+	}
+
+	if err := h.usecase.Like(c.Request().Context(), getUserID(c), id); err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.NoContent(http.StatusCreated)
+}
+
+func (h *TweetHandler) Unlike(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid tweet ID"})
+	}
+
+	if err := h.usecase.Unlike(c.Request().Context(), getUserID(c), id); err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// GetTimeline はホームタイムラインを返す。クエリパラメーター: cursor(前のページの next_cursor), limit
+func (h *TweetHandler) GetTimeline(c echo.Context) error {
+	var req model.TimelineRequest
+	if err := (&echo.DefaultBinder{}).BindQueryParams(c, &req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid query parameters"})
+	}
+
+	timeline, err := h.usecase.GetTimeline(c.Request().Context(), getUserID(c), req)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, timeline)
+}
+
+// ListByHashtag は :tag を含むツイートを返す。クエリパラメーター: before_id(前のページの next_before_id), limit
+func (h *TweetHandler) ListByHashtag(c echo.Context) error {
+	var req model.HashtagRequest
+	if err := (&echo.DefaultBinder{}).BindQueryParams(c, &req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid query parameters"})
+	}
+
+	tweets, err := h.usecase.ListByHashtag(c.Request().Context(), getUserID(c), c.Param("tag"), req)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, tweets)
+}
+
+// errorResponse はユースケースのエラーをステータスコードに対応させる
+func errorResponse(c echo.Context, err error) error {
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return c.JSON(http.StatusNotFound, map[string]string{"error": "Tweet not found"})
+	case errors.Is(err, domain.ErrInvalid):
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	case errors.Is(err, domain.ErrConflict):
+		return c.JSON(http.StatusConflict, map[string]string{"error": err.Error()})
+	case errors.Is(err, domain.ErrForbidden):
+		return c.JSON(http.StatusForbidden, map[string]string{"error": err.Error()})
+	case errors.Is(err, domain.ErrGone):
+		return c.JSON(http.StatusGone, map[string]string{"error": err.Error()})
+	}
+	return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+}
+
+func getUserID(c echo.Context) int {
+	userID, _ := c.Get("user_id").(int)
+	return userID
+}
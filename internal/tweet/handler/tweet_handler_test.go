@@ -0,0 +1,461 @@
+package handler_test
+
+import (
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+	"todoapp/internal/router"
+	"todoapp/internal/testutil"
+	"todoapp/internal/tweet/handler"
+	"todoapp/internal/tweet/model"
+	"todoapp/internal/tweet/repository"
+	"todoapp/internal/tweet/usecase"
+	usermodel "todoapp/internal/user/model"
+
+	"github.com/labstack/echo/v4"
+)
+
+func newTestServer(t *testing.T) *echo.Echo {
+	t.Helper()
+	env := testutil.NewEnv(t)
+	tweetUsecase := usecase.NewTweetUsecase(repository.NewTweetRepository(env.DB, env.DB), env.UserRepo, env.TxManager)
+	return env.Server(router.Handlers{Tweet: handler.NewTweetHandler(tweetUsecase)})
+}
+
+// post はツイートまたは replyTo へのリプライを作成する
+func post(t *testing.T, e *echo.Echo, token string, replyTo int, content string) *model.Tweet {
+	t.Helper()
+	path := "/api/tweets"
+	if replyTo != 0 {
+		path += "/" + strconv.Itoa(replyTo) + "/replies"
+	}
+	rec := testutil.Do(t, e, http.MethodPost, path, token, `{"content":"`+content+`"}`)
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("POST %s: status = %d, body = %s", path, rec.Code, rec.Body)
+	}
+	var tweet model.Tweet
+	testutil.Decode(t, rec, &tweet)
+	return &tweet
+}
+
+func TestCreateTweet(t *testing.T) {
+	e := newTestServer(t)
+	alice, token := testutil.RegisterAndLogin(t, e, "alice")
+
+	tweet := post(t, e, token, 0, "hello")
+	if tweet.UserID != alice || tweet.User == nil || tweet.User.Username != "alice" || tweet.ReplyToTweetID != nil {
+		t.Errorf("tweet = %+v", tweet)
+	}
+
+	tests := []struct {
+		name    string
+		content string
+		want    int
+	}{
+		{"empty", "  ", http.StatusBadRequest},
+		// 文字数はバイト数ではなく文字で数える(「あ」は3バイト)
+		{"280 japanese characters", strings.Repeat("あ", 280), http.StatusCreated},
+		{"281 characters", strings.Repeat("a", 281), http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := testutil.Do(t, e, http.MethodPost, "/api/tweets", token, `{"content":"`+tt.content+`"}`)
+			if rec.Code != tt.want {
+				t.Errorf("status = %d, want %d, body = %s", rec.Code, tt.want, rec.Body)
+			}
+		})
+	}
+
+	rec := testutil.Do(t, e, http.MethodGet, "/api/users/"+strconv.Itoa(alice), token, "")
+	var profile usermodel.UserProfile
+	testutil.Decode(t, rec, &profile)
+	if profile.TweetsCount != 2 {
+		t.Errorf("tweets_count = %d, want 2", profile.TweetsCount)
+	}
+}
+
+func TestDeleteTweet(t *testing.T) {
+	e := newTestServer(t)
+	_, alice := testutil.RegisterAndLogin(t, e, "alice")
+	_, bob := testutil.RegisterAndLogin(t, e, "bob")
+
+	tweet := post(t, e, alice, 0, "hello")
+	path := "/api/tweets/" + strconv.Itoa(tweet.ID)
+	if rec := testutil.Do(t, e, http.MethodDelete, path, bob, ""); rec.Code != http.StatusForbidden {
+		t.Errorf("delete by another user: status = %d, want 403", rec.Code)
+	}
+	if rec := testutil.Do(t, e, http.MethodDelete, path, alice, ""); rec.Code != http.StatusNoContent {
+		t.Fatalf("delete: status = %d, body = %s", rec.Code, rec.Body)
+	}
+	if rec := testutil.Do(t, e, http.MethodGet, path, alice, ""); rec.Code != http.StatusNotFound {
+		t.Errorf("get after delete: status = %d, want 404", rec.Code)
+	}
+	if rec := testutil.Do(t, e, http.MethodDelete, path, alice, ""); rec.Code != http.StatusNotFound {
+		t.Errorf("second delete: status = %d, want 404", rec.Code)
+	}
+}
+
+func TestThread(t *testing.T) {
+	e := newTestServer(t)
+	_, alice := testutil.RegisterAndLogin(t, e, "alice")
+	_, bob := testutil.RegisterAndLogin(t, e, "bob")
+
+	root := post(t, e, alice, 0, "root")
+	reply := post(t, e, bob, root.ID, "reply")
+	nested := post(t, e, alice, reply.ID, "nested")
+	deepest := post(t, e, bob, nested.ID, "deepest")
+	post(t, e, alice, deepest.ID, "beyond the tree depth")
+	var others []*model.Tweet
+	for i := 0; i < 3; i++ {
+		others = append(others, post(t, e, bob, root.ID, "other "+strconv.Itoa(i)))
+	}
+
+	if rec := testutil.Do(t, e, http.MethodPost, "/api/tweets/999/replies", bob, `{"content":"x"}`); rec.Code != http.StatusNotFound {
+		t.Errorf("reply to missing tweet: status = %d, want 404", rec.Code)
+	}
+
+	getThread := func(id int, query string) *model.Thread {
+		t.Helper()
+		rec := testutil.Do(t, e, http.MethodGet, "/api/tweets/"+strconv.Itoa(id)+"/thread"+query, bob, "")
+		if rec.Code != http.StatusOK {
+			t.Fatalf("thread: status = %d, body = %s", rec.Code, rec.Body)
+		}
+		var thread model.Thread
+		testutil.Decode(t, rec, &thread)
+		return &thread
+	}
+
+	thread := getThread(root.ID, "?limit=2")
+	if len(thread.Ancestors) != 0 || thread.Tweet.ID != root.ID || thread.Tweet.ReplyCount != 4 {
+		t.Errorf("root = %+v, ancestors = %v", thread.Tweet, thread.Ancestors)
+	}
+	// リプライの多い reply が先頭で、その下に3階層目までが入れ子になる
+	if len(thread.Replies) != 2 || thread.Replies[0].ID != reply.ID || thread.Replies[1].ID != others[0].ID {
+		t.Fatalf("replies = %+v", thread.Replies)
+	}
+	first := thread.Replies[0]
+	if len(first.Replies) != 1 || first.Replies[0].ID != nested.ID ||
+		len(first.Replies[0].Replies) != 1 || first.Replies[0].Replies[0].ID != deepest.ID ||
+		!first.Replies[0].Replies[0].MoreReplies {
+		t.Errorf("nested replies = %+v", first)
+	}
+	if thread.NextCursor == nil || *thread.NextCursor != 2 {
+		t.Fatalf("next_cursor = %v, want 2", thread.NextCursor)
+	}
+	thread = getThread(root.ID, "?limit=2&cursor=2")
+	if len(thread.Replies) != 2 || thread.Replies[0].ID != others[1].ID || thread.NextCursor != nil {
+		t.Errorf("second page = %+v, next_cursor = %v", thread.Replies, thread.NextCursor)
+	}
+
+	// 途中のツイートのスレッドは親を最初のツイートから順に含む
+	thread = getThread(deepest.ID, "")
+	if len(thread.Ancestors) != 3 || thread.Ancestors[0].ID != root.ID || thread.Ancestors[2].ID != nested.ID {
+		t.Errorf("ancestors = %+v", thread.Ancestors)
+	}
+
+	// 削除したツイートにはリプライできないが、既存のリプライはスレッドに残る
+	if rec := testutil.Do(t, e, http.MethodDelete, "/api/tweets/"+strconv.Itoa(reply.ID), bob, ""); rec.Code != http.StatusNoContent {
+		t.Fatalf("delete: status = %d", rec.Code)
+	}
+	rec := testutil.Do(t, e, http.MethodPost, "/api/tweets/"+strconv.Itoa(reply.ID)+"/replies", alice, `{"content":"x"}`)
+	if rec.Code != http.StatusGone {
+		t.Errorf("reply to deleted tweet: status = %d, want 410", rec.Code)
+	}
+	if rec := testutil.Do(t, e, http.MethodGet, "/api/tweets/"+strconv.Itoa(reply.ID)+"/thread", bob, ""); rec.Code != http.StatusNotFound {
+		t.Errorf("thread of deleted tweet: status = %d, want 404", rec.Code)
+	}
+	thread = getThread(nested.ID, "")
+	if len(thread.Ancestors) != 2 || !thread.Ancestors[1].Unavailable || thread.Ancestors[1].Content != "" {
+		t.Errorf("ancestors with a deleted tweet = %+v", thread.Ancestors)
+	}
+	// 削除したリプライはリプライ数に含めず、その先のリプライがあるので削除済みとして残る
+	thread = getThread(root.ID, "")
+	if thread.Tweet.ReplyCount != 3 || len(thread.Replies) != 4 || thread.Replies[0].ID != reply.ID || !thread.Replies[0].Unavailable {
+		t.Errorf("replies after delete = %+v, reply_count = %d", thread.Replies[0], thread.Tweet.ReplyCount)
+	}
+}
+
+func TestRetweetAndQuote(t *testing.T) {
+	e := newTestServer(t)
+	_, alice := testutil.RegisterAndLogin(t, e, "alice")
+	bobID, bob := testutil.RegisterAndLogin(t, e, "bob")
+
+	tweet := post(t, e, alice, 0, "hello")
+	path := "/api/tweets/" + strconv.Itoa(tweet.ID)
+	if rec := testutil.Do(t, e, http.MethodPost, path+"/retweet", bob, ""); rec.Code != http.StatusCreated {
+		t.Fatalf("retweet: status = %d, body = %s", rec.Code, rec.Body)
+	}
+	if rec := testutil.Do(t, e, http.MethodPost, path+"/retweet", bob, ""); rec.Code != http.StatusConflict {
+		t.Errorf("second retweet: status = %d, want 409", rec.Code)
+	}
+	if rec := testutil.Do(t, e, http.MethodPost, "/api/tweets/999/retweet", bob, ""); rec.Code != http.StatusNotFound {
+		t.Errorf("retweet of missing tweet: status = %d, want 404", rec.Code)
+	}
+
+	get := func(token string) *model.Tweet {
+		t.Helper()
+		rec := testutil.Do(t, e, http.MethodGet, path, token, "")
+		if rec.Code != http.StatusOK {
+			t.Fatalf("get: status = %d, body = %s", rec.Code, rec.Body)
+		}
+		var got model.Tweet
+		testutil.Decode(t, rec, &got)
+		return &got
+	}
+	if got := get(bob); got.RetweetCount != 1 || !got.RetweetedByMe {
+		t.Errorf("as bob = %+v, want 1 retweet and retweeted_by_me", got)
+	}
+	if got := get(alice); got.RetweetedByMe {
+		t.Errorf("as alice = %+v, want retweeted_by_me false", got)
+	}
+
+	rec := testutil.Do(t, e, http.MethodPost, "/api/tweets", bob, `{"content":"quote","quote_tweet_id":`+strconv.Itoa(tweet.ID)+`}`)
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("quote: status = %d, body = %s", rec.Code, rec.Body)
+	}
+	var quote model.Tweet
+	testutil.Decode(t, rec, &quote)
+	if quote.QuotedTweet == nil || quote.QuotedTweet.ID != tweet.ID || quote.QuotedTweet.Content != "hello" {
+		t.Errorf("quote = %+v, want alice's tweet embedded", quote)
+	}
+	if got := get(bob); got.QuoteCount != 1 {
+		t.Errorf("quote_count = %d, want 1", got.QuoteCount)
+	}
+	if rec := testutil.Do(t, e, http.MethodPost, "/api/tweets", bob, `{"content":"quote","quote_tweet_id":999}`); rec.Code != http.StatusNotFound {
+		t.Errorf("quote of missing tweet: status = %d, want 404", rec.Code)
+	}
+
+	// 自分のツイートとリツイートが新しい順に並ぶ
+	getTimeline := func(query string) *model.Timeline {
+		t.Helper()
+		rec := testutil.Do(t, e, http.MethodGet, "/api/tweets/timeline"+query, bob, "")
+		if rec.Code != http.StatusOK {
+			t.Fatalf("timeline: status = %d, body = %s", rec.Code, rec.Body)
+		}
+		var timeline model.Timeline
+		testutil.Decode(t, rec, &timeline)
+		return &timeline
+	}
+	timeline := getTimeline("?limit=1")
+	if len(timeline.Items) != 1 || timeline.Items[0].Tweet.ID != quote.ID || timeline.NextCursor == nil {
+		t.Fatalf("first page = %+v", timeline)
+	}
+	timeline = getTimeline("?limit=1&cursor=" + *timeline.NextCursor)
+	if len(timeline.Items) != 1 || timeline.Items[0].Tweet.ID != tweet.ID ||
+		timeline.Items[0].RetweetedBy == nil || timeline.Items[0].RetweetedBy.ID != bobID {
+		t.Errorf("second page = %+v, want alice's tweet retweeted by bob", timeline.Items)
+	}
+	if rec := testutil.Do(t, e, http.MethodGet, "/api/tweets/timeline?cursor=bad", bob, ""); rec.Code != http.StatusBadRequest {
+		t.Errorf("invalid cursor: status = %d, want 400", rec.Code)
+	}
+
+	if rec := testutil.Do(t, e, http.MethodDelete, path+"/retweet", bob, ""); rec.Code != http.StatusNoContent {
+		t.Fatalf("unretweet: status = %d, body = %s", rec.Code, rec.Body)
+	}
+	if rec := testutil.Do(t, e, http.MethodDelete, path+"/retweet", bob, ""); rec.Code != http.StatusNotFound {
+		t.Errorf("second unretweet: status = %d, want 404", rec.Code)
+	}
+	if got := get(bob); got.RetweetCount != 0 || got.RetweetedByMe {
+		t.Errorf("after unretweet = %+v", got)
+	}
+
+	// 削除したツイートは引用できない
+	if rec := testutil.Do(t, e, http.MethodDelete, path, alice, ""); rec.Code != http.StatusNoContent {
+		t.Fatalf("delete: status = %d", rec.Code)
+	}
+	rec = testutil.Do(t, e, http.MethodPost, "/api/tweets", bob, `{"content":"quote","quote_tweet_id":`+strconv.Itoa(tweet.ID)+`}`)
+	if rec.Code != http.StatusGone {
+		t.Errorf("quote of deleted tweet: status = %d, want 410", rec.Code)
+	}
+}
+
+func TestLike(t *testing.T) {
+	e := newTestServer(t)
+	_, alice := testutil.RegisterAndLogin(t, e, "alice")
+	_, bob := testutil.RegisterAndLogin(t, e, "bob")
+
+	tweet := post(t, e, alice, 0, "hello")
+	path := "/api/tweets/" + strconv.Itoa(tweet.ID)
+	for _, token := range []string{alice, bob} {
+		if rec := testutil.Do(t, e, http.MethodPost, path+"/like", token, ""); rec.Code != http.StatusCreated {
+			t.Fatalf("like: status = %d, body = %s", rec.Code, rec.Body)
+		}
+	}
+	if rec := testutil.Do(t, e, http.MethodPost, path+"/like", bob, ""); rec.Code != http.StatusConflict {
+		t.Errorf("second like: status = %d, want 409", rec.Code)
+	}
+	if rec := testutil.Do(t, e, http.MethodPost, "/api/tweets/999/like", bob, ""); rec.Code != http.StatusNotFound {
+		t.Errorf("like of missing tweet: status = %d, want 404", rec.Code)
+	}
+
+	likeCount := func() int {
+		t.Helper()
+		rec := testutil.Do(t, e, http.MethodGet, path, bob, "")
+		if rec.Code != http.StatusOK {
+			t.Fatalf("get: status = %d, body = %s", rec.Code, rec.Body)
+		}
+		var got model.Tweet
+		testutil.Decode(t, rec, &got)
+		return got.LikeCount
+	}
+	if n := likeCount(); n != 2 {
+		t.Errorf("like_count = %d, want 2", n)
+	}
+
+	if rec := testutil.Do(t, e, http.MethodDelete, path+"/like", bob, ""); rec.Code != http.StatusNoContent {
+		t.Fatalf("unlike: status = %d, body = %s", rec.Code, rec.Body)
+	}
+	if rec := testutil.Do(t, e, http.MethodDelete, path+"/like", bob, ""); rec.Code != http.StatusNotFound {
+		t.Errorf("second unlike: status = %d, want 404", rec.Code)
+	}
+	if n := likeCount(); n != 1 {
+		t.Errorf("like_count after unlike = %d, want 1", n)
+	}
+}
+
+func TestBlockAndMute(t *testing.T) {
+	e := newTestServer(t)
+	aliceID, alice := testutil.RegisterAndLogin(t, e, "alice")
+	bobID, bob := testutil.RegisterAndLogin(t, e, "bob")
+	carolID, carol := testutil.RegisterAndLogin(t, e, "carol")
+
+	for _, id := range []int{bobID, carolID} {
+		if rec := testutil.Do(t, e, http.MethodPost, "/api/users/"+strconv.Itoa(id)+"/follow", alice, ""); rec.Code != http.StatusCreated {
+			t.Fatalf("follow: status = %d, body = %s", rec.Code, rec.Body)
+		}
+	}
+	bobTweet := post(t, e, bob, 0, "from bob")
+	carolTweet := post(t, e, carol, 0, "from carol")
+	carolReply := post(t, e, carol, bobTweet.ID, "reply from carol")
+	if rec := testutil.Do(t, e, http.MethodPost, "/api/tweets/"+strconv.Itoa(bobTweet.ID)+"/retweet", carol, ""); rec.Code != http.StatusCreated {
+		t.Fatalf("retweet: status = %d, body = %s", rec.Code, rec.Body)
+	}
+
+	// timelineIDs はタイムラインのツイートのIDを小さい順に返す。carol のリツイートとして表示するものは負にする
+	// (同じ秒に作成したので、表示の順は比べない)
+	timelineIDs := func() []int {
+		t.Helper()
+		rec := testutil.Do(t, e, http.MethodGet, "/api/tweets/timeline", alice, "")
+		if rec.Code != http.StatusOK {
+			t.Fatalf("timeline: status = %d, body = %s", rec.Code, rec.Body)
+		}
+		var timeline model.Timeline
+		testutil.Decode(t, rec, &timeline)
+		ids := make([]int, len(timeline.Items))
+		for i, item := range timeline.Items {
+			ids[i] = item.Tweet.ID
+			if item.RetweetedBy != nil && item.RetweetedBy.ID == carolID {
+				ids[i] = -item.Tweet.ID
+			}
+		}
+		sort.Ints(ids)
+		return ids
+	}
+
+	// ミュートした carol のツイートとリツイートはタイムラインに表示しない
+	carolPath := "/api/users/" + strconv.Itoa(carolID) + "/mute"
+	if rec := testutil.Do(t, e, http.MethodPost, carolPath, alice, ""); rec.Code != http.StatusCreated {
+		t.Fatalf("mute: status = %d, body = %s", rec.Code, rec.Body)
+	}
+	if got := timelineIDs(); len(got) != 1 || got[0] != bobTweet.ID {
+		t.Errorf("timeline after mute = %v, want [%d] without carol's retweet", got, bobTweet.ID)
+	}
+	if rec := testutil.Do(t, e, http.MethodDelete, carolPath, alice, ""); rec.Code != http.StatusNoContent {
+		t.Fatalf("unmute: status = %d, body = %s", rec.Code, rec.Body)
+	}
+	if got := timelineIDs(); len(got) != 3 || got[0] != -bobTweet.ID || got[1] != carolTweet.ID || got[2] != carolReply.ID {
+		t.Errorf("timeline after unmute = %v", got)
+	}
+
+	// bob にブロックされると、carol のリツイートでも bob のツイートは見えず、操作もできない
+	if rec := testutil.Do(t, e, http.MethodPost, "/api/users/"+strconv.Itoa(bobID)+"/block", bob, ""); rec.Code != http.StatusBadRequest {
+		t.Errorf("block self: status = %d, want 400", rec.Code)
+	}
+	rec := testutil.Do(t, e, http.MethodPost, "/api/users/"+strconv.Itoa(aliceID)+"/block", bob, "")
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("block: status = %d, body = %s", rec.Code, rec.Body)
+	}
+	if got := timelineIDs(); len(got) != 2 || got[0] != carolTweet.ID || got[1] != carolReply.ID {
+		t.Errorf("timeline after block = %v, want carol's tweets only", got)
+	}
+	path := "/api/tweets/" + strconv.Itoa(bobTweet.ID)
+	for _, req := range []struct{ method, path, body string }{
+		{http.MethodGet, path, ""},
+		{http.MethodPost, path + "/like", ""},
+		{http.MethodPost, path + "/retweet", ""},
+		{http.MethodPost, path + "/replies", `{"content":"x"}`},
+		{http.MethodPost, "/api/tweets", `{"content":"quote","quote_tweet_id":` + strconv.Itoa(bobTweet.ID) + `}`},
+	} {
+		if rec := testutil.Do(t, e, req.method, req.path, alice, req.body); rec.Code != http.StatusNotFound {
+			t.Errorf("%s %s by blocked user: status = %d, want 404", req.method, req.path, rec.Code)
+		}
+	}
+	// スレッドでは削除済みと同じく表示する
+	rec = testutil.Do(t, e, http.MethodGet, "/api/tweets/"+strconv.Itoa(carolReply.ID)+"/thread", alice, "")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("thread: status = %d, body = %s", rec.Code, rec.Body)
+	}
+	var thread model.Thread
+	testutil.Decode(t, rec, &thread)
+	if len(thread.Ancestors) != 1 || !thread.Ancestors[0].Unavailable || thread.Ancestors[0].Content != "" {
+		t.Errorf("ancestors with a blocked author = %+v", thread.Ancestors)
+	}
+	// ブロックの関係にないユーザーからは見える
+	if rec := testutil.Do(t, e, http.MethodGet, path, carol, ""); rec.Code != http.StatusOK {
+		t.Errorf("get by carol: status = %d, want 200", rec.Code)
+	}
+}
+
+func TestHashtags(t *testing.T) {
+	e := newTestServer(t)
+	_, alice := testutil.RegisterAndLogin(t, e, "alice")
+	bobID, bob := testutil.RegisterAndLogin(t, e, "bob")
+
+	tweet := post(t, e, alice, 0, "ラーメン食べた #東京 #ラーメン @bob")
+	if tweet.Entities == nil || len(tweet.Entities.Hashtags) != 2 || tweet.Entities.Hashtags[0] != (model.Hashtag{Tag: "東京", Start: 8, End: 11}) {
+		t.Errorf("hashtags = %+v", tweet.Entities)
+	}
+	if len(tweet.Entities.Mentions) != 1 || tweet.Entities.Mentions[0] != (model.Mention{UserID: bobID, Username: "bob", Start: 18, End: 22}) {
+		t.Errorf("mentions = %+v", tweet.Entities.Mentions)
+	}
+	for i := 0; i < 2; i++ {
+		post(t, e, bob, 0, "#ﾗｰﾒﾝ "+strconv.Itoa(i))
+	}
+
+	list := func(path string) *model.HashtagTweets {
+		t.Helper()
+		rec := testutil.Do(t, e, http.MethodGet, path, alice, "")
+		if rec.Code != http.StatusOK {
+			t.Fatalf("GET %s: status = %d, body = %s", path, rec.Code, rec.Body)
+		}
+		var resp model.HashtagTweets
+		testutil.Decode(t, rec, &resp)
+		return &resp
+	}
+	// 半角カナのハッシュタグも同じタグとして扱う
+	resp := list("/api/hashtags/" + url.PathEscape("ラーメン") + "/tweets?limit=2")
+	if len(resp.Tweets) != 2 || resp.NextBeforeID == nil || resp.Tweets[0].Entities == nil {
+		t.Fatalf("first page = %+v", resp)
+	}
+	resp = list("/api/hashtags/" + url.PathEscape("#ラーメン") + "/tweets?before_id=" + strconv.Itoa(*resp.NextBeforeID))
+	if len(resp.Tweets) != 1 || resp.Tweets[0].ID != tweet.ID || resp.NextBeforeID != nil {
+		t.Errorf("second page = %+v", resp)
+	}
+	if resp := list("/api/hashtags/" + url.PathEscape("東京") + "/tweets"); len(resp.Tweets) != 1 {
+		t.Errorf("東京 = %+v", resp)
+	}
+
+	// 結合文字で書かれた「が」はNFCに正規化して1文字として数える
+	rec := testutil.Do(t, e, http.MethodPost, "/api/tweets", alice, `{"content":"`+strings.Repeat("\u304b\u3099", 280)+`"}`)
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("280 combined characters: status = %d, body = %s", rec.Code, rec.Body)
+	}
+	var normalized model.Tweet
+	testutil.Decode(t, rec, &normalized)
+	if normalized.Content != strings.Repeat("\u304c", 280) {
+		t.Errorf("content is not normalized: %q", normalized.Content)
+	}
+}
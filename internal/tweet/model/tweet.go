@@ -0,0 +1,156 @@
+package model
+
+import "time"
+
+// MaxContentLength はツイート本文の最大文字数。バイト数ではなく、
+// NFCに正規化した本文のUnicodeのコードポイント数で数える
+const MaxContentLength = 280
+
+type Tweet struct {
+	ID      int     `json:"id"`
+	UserID  int     `json:"user_id,omitempty"`
+	User    *Author `json:"user,omitempty"`
+	Content string  `json:"content,omitempty"`
+	// ImageURL は画像付きのツイートの画像のURL
+	ImageURL *string `json:"image_url,omitempty"`
+	// Entities は本文中のハッシュタグ・メンション・URL
+	Entities *Entities `json:"entities,omitempty"`
+	// ReplyToTweetID はリプライの親ツイート。ConversationID は会話の最初のツイート
+	ReplyToTweetID *int `json:"reply_to_tweet_id,omitempty"`
+	ConversationID *int `json:"conversation_id,omitempty"`
+	// QuoteTweetID は引用ツイートが引用しているツイート。QuotedTweet はその内容(引用の引用は含めない)
+	QuoteTweetID *int   `json:"quote_tweet_id,omitempty"`
+	QuotedTweet  *Tweet `json:"quoted_tweet,omitempty"`
+	// ReplyCount、RetweetCount、QuoteCount は削除されていないリプライ、リツイート、引用ツイートの数。
+	// LikeCount はいいねの数
+	ReplyCount   int `json:"reply_count"`
+	RetweetCount int `json:"retweet_count"`
+	QuoteCount   int `json:"quote_count"`
+	LikeCount    int `json:"like_count"`
+	// RetweetedByMe は閲覧しているユーザーがリツイートしているか
+	RetweetedByMe bool      `json:"retweeted_by_me"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+	// Unavailable は削除済みか、投稿者が退会中か閲覧者とブロックの関係にあるツイート。
+	// スレッドのつながりを表示するためだけに返すので、ID と会話の情報以外は空にする
+	Unavailable bool `json:"unavailable,omitempty"`
+}
+
+// Entities は本文中のハッシュタグ・メンション・URL。Start と End は本文の先頭からの文字数
+// (Unicodeのコードポイント数)で、# や @ の記号を含み End の文字は含まない
+type Entities struct {
+	Hashtags []Hashtag `json:"hashtags"`
+	Mentions []Mention `json:"mentions"`
+	URLs     []URL     `json:"urls"`
+}
+
+// Hashtag の Tag は # を除いた本文での表記
+type Hashtag struct {
+	Tag   string `json:"tag"`
+	Start int    `json:"start"`
+	End   int    `json:"end"`
+}
+
+// Mention は users.username で解決できた(退会中でない)ユーザーへのメンション
+type Mention struct {
+	UserID   int    `json:"user_id"`
+	Username string `json:"username"`
+	Start    int    `json:"start"`
+	End      int    `json:"end"`
+}
+
+type URL struct {
+	URL   string `json:"url"`
+	Start int    `json:"start"`
+	End   int    `json:"end"`
+}
+
+// HashtagRequest はハッシュタグのツイートのページ。
+// BeforeID より古い(ID が小さい)ツイートを返す。前のページの NextBeforeID を指定する
+type HashtagRequest struct {
+	BeforeID int `query:"before_id"`
+	Limit    int `query:"limit"`
+}
+
+// HashtagTweets は新しい順のツイートと、続きがある場合に次のページの BeforeID に指定する値
+type HashtagTweets struct {
+	Tweets       []*Tweet `json:"tweets"`
+	NextBeforeID *int     `json:"next_before_id,omitempty"`
+}
+
+// Author はツイートに埋め込む投稿者の情報
+type Author struct {
+	ID              int     `json:"id"`
+	Username        string  `json:"username"`
+	DisplayName     string  `json:"display_name"`
+	ProfileImageURL *string `json:"profile_image_url,omitempty"`
+}
+
+// UnavailableTweet は削除済みのツイートや物理削除されて存在しないツイートの代わりに返す値
+func UnavailableTweet(id int, replyToTweetID, conversationID *int, replyCount int) *Tweet {
+	return &Tweet{
+		ID:             id,
+		ReplyToTweetID: replyToTweetID,
+		ConversationID: conversationID,
+		ReplyCount:     replyCount,
+		Unavailable:    true,
+	}
+}
+
+type CreateTweetRequest struct {
+	Content  string  `json:"content" validate:"required,max=280"`
+	ImageURL *string `json:"image_url"`
+	// QuoteTweetID を指定すると、そのツイートを引用したツイートになる
+	QuoteTweetID *int `json:"quote_tweet_id"`
+}
+
+// ThreadRequest はスレッドのリプライのページ。Cursor は前のページの NextCursor
+type ThreadRequest struct {
+	Cursor int `query:"cursor"`
+	Limit  int `query:"limit"`
+}
+
+// Thread は tweet の会話。Ancestors は最初のツイートから親までの順で、
+// Replies は tweet へのリプライを順位の高い順に並べ、それぞれのリプライの上位のリプライを入れ子で含む
+type Thread struct {
+	Ancestors  []*Tweet      `json:"ancestors"`
+	Tweet      *Tweet        `json:"tweet"`
+	Replies    []*ThreadNode `json:"replies"`
+	NextCursor *int          `json:"next_cursor,omitempty"`
+}
+
+type ThreadNode struct {
+	*Tweet
+	Replies []*ThreadNode `json:"replies,omitempty"`
+	// MoreReplies は Replies に含めていないリプライがあるか。続きはそのツイートのスレッドで取得する
+	MoreReplies bool `json:"more_replies,omitempty"`
+}
+
+// TimelineRequest はホームタイムラインのページ。Cursor は前のページの NextCursor
+type TimelineRequest struct {
+	Cursor string `query:"cursor"`
+	Limit  int    `query:"limit"`
+}
+
+// TimelineCursor はタイムラインの位置。SortAt が新しい順、同じ場合は TweetID の大きい順に並ぶ
+type TimelineCursor struct {
+	SortAt  time.Time
+	TweetID int
+}
+
+// TimelineItem はホームタイムラインの1件。同じツイートはフォロー中の複数のユーザーが
+// リツイートしても1件にまとめ、最後に投稿またはリツイートされた位置に表示する
+type TimelineItem struct {
+	Tweet *Tweet `json:"tweet"`
+	// RetweetedBy はツイートをリツイートしたフォロー中のユーザー(自分を含む)のうち最も新しい人。
+	// RetweetedByOthers はそれ以外にリツイートしたフォロー中のユーザーの数
+	RetweetedBy       *Author `json:"retweeted_by,omitempty"`
+	RetweetedByOthers int     `json:"retweeted_by_others,omitempty"`
+	// SortAt はタイムラインに表示する日時(投稿またはリツイートの日時)
+	SortAt time.Time `json:"-"`
+}
+
+type Timeline struct {
+	Items      []*TimelineItem `json:"items"`
+	NextCursor *string         `json:"next_cursor,omitempty"`
+}
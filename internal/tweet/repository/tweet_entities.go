@@ -0,0 +1,181 @@
+package repository
+
+import (
+	"context"
+	"todoapp/internal/schema"
+	"todoapp/internal/tweet/entity"
+	"todoapp/internal/tweet/model"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// backfillBatchSize は BackfillEntities が1回に読み込むツイートの数
+const backfillBatchSize = 500
+
+// saveEntities は本文から取り出したエンティティを保存する。
+// メンションは退会中でないユーザーの username と一致するものだけを保存する
+func saveEntities(ctx context.Context, exec boil.ContextExecutor, tweetID int, content string) error {
+	entities := entity.Parse(content)
+
+	for _, h := range entities.Hashtags {
+		row := &schema.TweetHashtag{TweetID: tweetID, StartOffset: h.Start, EndOffset: h.End, Tag: entity.NormalizeTag(h.Tag)}
+		if err := row.Insert(ctx, exec, boil.Infer()); err != nil {
+			return err
+		}
+	}
+
+	if len(entities.Mentions) > 0 {
+		usernames := make([]interface{}, len(entities.Mentions))
+		for i, m := range entities.Mentions {
+			usernames[i] = m.Username
+		}
+		users, err := schema.Users(qm.WhereIn(schema.UserColumns.Username+" IN ?", usernames...)).All(ctx, exec)
+		if err != nil {
+			return err
+		}
+		userIDs := make(map[string]int, len(users))
+		for _, u := range users {
+			userIDs[u.Username] = u.ID
+		}
+		for _, m := range entities.Mentions {
+			userID, ok := userIDs[m.Username]
+			if !ok {
+				continue
+			}
+			row := &schema.TweetMention{TweetID: tweetID, StartOffset: m.Start, EndOffset: m.End, UserID: userID}
+			if err := row.Insert(ctx, exec, boil.Infer()); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, u := range entities.URLs {
+		row := &schema.TweetURL{TweetID: tweetID, StartOffset: u.Start, EndOffset: u.End, URL: u.URL}
+		if err := row.Insert(ctx, exec, boil.Infer()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type mentionRow struct {
+	TweetID     int    `boil:"tweet_id"`
+	StartOffset int    `boil:"start_offset"`
+	EndOffset   int    `boil:"end_offset"`
+	UserID      int    `boil:"user_id"`
+	Username    string `boil:"username"`
+}
+
+// loadEntities は表示できるツイートの Entities を設定する。ハッシュタグの表記は本文から取る
+func loadEntities(ctx context.Context, exec boil.ContextExecutor, tweets []*model.Tweet) error {
+	byID := make(map[int]*model.Tweet, len(tweets))
+	var ids []interface{}
+	for _, tweet := range tweets {
+		if tweet.Unavailable {
+			continue
+		}
+		tweet.Entities = &model.Entities{Hashtags: []model.Hashtag{}, Mentions: []model.Mention{}, URLs: []model.URL{}}
+		byID[tweet.ID] = tweet
+		ids = append(ids, tweet.ID)
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	hashtags, err := schema.TweetHashtags(
+		qm.WhereIn(schema.TweetHashtagColumns.TweetID+" IN ?", ids...),
+		qm.OrderBy(schema.TweetHashtagColumns.TweetID+", "+schema.TweetHashtagColumns.StartOffset),
+	).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+	for _, h := range hashtags {
+		tweet := byID[h.TweetID]
+		text := []rune(tweet.Content)
+		tweet.Entities.Hashtags = append(tweet.Entities.Hashtags, model.Hashtag{
+			Tag: string(text[h.StartOffset+1 : h.EndOffset]), Start: h.StartOffset, End: h.EndOffset,
+		})
+	}
+
+	// 退会中のユーザーへのメンションは表示しない
+	var mentions []*mentionRow
+	err = schema.TweetMentions(
+		qm.Select(
+			"tweet_mentions.tweet_id AS tweet_id",
+			"tweet_mentions.start_offset AS start_offset",
+			"tweet_mentions.end_offset AS end_offset",
+			"users.id AS user_id",
+			"users.username AS username",
+		),
+		qm.InnerJoin("users ON users.id = tweet_mentions.user_id AND users.deleted_at IS NULL"),
+		qm.WhereIn("tweet_mentions.tweet_id IN ?", ids...),
+		qm.OrderBy("tweet_mentions.tweet_id, tweet_mentions.start_offset"),
+	).Bind(ctx, exec, &mentions)
+	if err != nil {
+		return err
+	}
+	for _, m := range mentions {
+		tweet := byID[m.TweetID]
+		tweet.Entities.Mentions = append(tweet.Entities.Mentions, model.Mention{
+			UserID: m.UserID, Username: m.Username, Start: m.StartOffset, End: m.EndOffset,
+		})
+	}
+
+	urls, err := schema.TweetUrls(
+		qm.WhereIn(schema.TweetURLColumns.TweetID+" IN ?", ids...),
+		qm.OrderBy(schema.TweetURLColumns.TweetID+", "+schema.TweetURLColumns.StartOffset),
+	).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+	for _, u := range urls {
+		tweet := byID[u.TweetID]
+		tweet.Entities.URLs = append(tweet.Entities.URLs, model.URL{URL: u.URL, Start: u.StartOffset, End: u.EndOffset})
+	}
+	return nil
+}
+
+// BackfillEntities はすべてのツイート(論理削除したものを含む)のエンティティを本文から作り直し、
+// 処理したツイート数を返す。エンティティのテーブルを追加する前のツイートや、
+// シードのようにリポジトリを通さずに挿入したツイートに使う。
+// メンションはその時点のユーザーで解決し直す
+func BackfillEntities(ctx context.Context, exec boil.ContextExecutor) (int, error) {
+	processed, lastID := 0, 0
+	for {
+		tweets, err := schema.Tweets(
+			qm.WithDeleted(),
+			schema.TweetWhere.ID.GT(lastID),
+			qm.OrderBy(schema.TweetColumns.ID),
+			qm.Limit(backfillBatchSize),
+		).All(ctx, exec)
+		if err != nil {
+			return processed, err
+		}
+		if len(tweets) == 0 {
+			return processed, nil
+		}
+
+		ids := make([]interface{}, len(tweets))
+		for i, t := range tweets {
+			ids[i] = t.ID
+		}
+		if _, err := schema.TweetHashtags(qm.WhereIn(schema.TweetHashtagColumns.TweetID+" IN ?", ids...)).DeleteAll(ctx, exec); err != nil {
+			return processed, err
+		}
+		if _, err := schema.TweetMentions(qm.WhereIn(schema.TweetMentionColumns.TweetID+" IN ?", ids...)).DeleteAll(ctx, exec); err != nil {
+			return processed, err
+		}
+		if _, err := schema.TweetUrls(qm.WhereIn(schema.TweetURLColumns.TweetID+" IN ?", ids...)).DeleteAll(ctx, exec); err != nil {
+			return processed, err
+		}
+		for _, t := range tweets {
+			if err := saveEntities(ctx, exec, t.ID, t.Content); err != nil {
+				return processed, err
+			}
+		}
+
+		processed += len(tweets)
+		lastID = tweets[len(tweets)-1].ID
+	}
+}
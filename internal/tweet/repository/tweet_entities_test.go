@@ -0,0 +1,129 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+	"todoapp/internal/dbtest"
+	"todoapp/internal/schema"
+	"todoapp/internal/tweet/model"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestTweetEntities(t *testing.T) {
+	ctx := context.Background()
+	db := dbtest.New(t)
+	repo := NewTweetRepository(db, db)
+
+	var users []int
+	for _, name := range []string{"alice", "bob", "carol"} {
+		u := &schema.User{Username: name, DisplayName: name, Email: name + "@example.com", PasswordHash: "hash"}
+		if err := u.Insert(ctx, db, boil.Infer()); err != nil {
+			t.Fatal(err)
+		}
+		users = append(users, u.ID)
+	}
+	alice, bob, carol := users[0], users[1], users[2]
+
+	create := func(content string) *model.Tweet {
+		t.Helper()
+		tweet, err := repo.Create(ctx, alice, &model.CreateTweetRequest{Content: content}, nil)
+		if err != nil {
+			t.Fatalf("Create: %v", err)
+		}
+		return tweet
+	}
+
+	// 存在しないユーザー(@nobody)へのメンションは保存しない
+	tweet := create("こんにちは@bob @nobody #東京 #Go https://example.com/ と @carol")
+	want := &model.Entities{
+		Hashtags: []model.Hashtag{{Tag: "東京", Start: 18, End: 21}, {Tag: "Go", Start: 22, End: 25}},
+		Mentions: []model.Mention{{UserID: bob, Username: "bob", Start: 5, End: 9}, {UserID: carol, Username: "carol", Start: 49, End: 55}},
+		URLs:     []model.URL{{URL: "https://example.com/", Start: 26, End: 46}},
+	}
+	assertEntities(t, tweet.Entities, want)
+
+	// 退会中のユーザーへのメンションは表示しない
+	if _, err := schema.Users(schema.UserWhere.ID.EQ(carol)).UpdateAll(ctx, db, schema.M{schema.UserColumns.DeletedAt: null.TimeFrom(time.Now())}); err != nil {
+		t.Fatal(err)
+	}
+	got, err := repo.GetByID(ctx, tweet.ID, alice)
+	if err != nil {
+		t.Fatalf("GetByID: %v", err)
+	}
+	want.Mentions = want.Mentions[:1]
+	assertEntities(t, got.Entities, want)
+
+	// ハッシュタグは正規化した値で検索する
+	second := create("#go again")
+	create("#golang is another tag")
+	tweets, err := repo.ListByHashtag(ctx, "go", 0, 10, alice)
+	if err != nil {
+		t.Fatalf("ListByHashtag: %v", err)
+	}
+	if ids := tweetIDs(tweets); !equalIDs(ids, []int{second.ID, tweet.ID}) {
+		t.Errorf("ListByHashtag = %v, want [%d %d]", ids, second.ID, tweet.ID)
+	}
+	if tweets, err := repo.ListByHashtag(ctx, "go", second.ID, 10, alice); err != nil || !equalIDs(tweetIDs(tweets), []int{tweet.ID}) {
+		t.Errorf("ListByHashtag before %d = %v, %v, want [%d]", second.ID, tweetIDs(tweets), err, tweet.ID)
+	}
+	if err := repo.Delete(ctx, second.ID); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if tweets, err := repo.ListByHashtag(ctx, "go", 0, 10, alice); err != nil || !equalIDs(tweetIDs(tweets), []int{tweet.ID}) {
+		t.Errorf("ListByHashtag after delete = %v, %v, want [%d]", tweetIDs(tweets), err, tweet.ID)
+	}
+
+	// リポジトリを通さずに挿入したツイートはバックフィルで作る
+	direct := &schema.Tweet{UserID: bob, Content: "@alice #東京"}
+	if err := direct.Insert(ctx, db, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	processed, err := BackfillEntities(ctx, db)
+	if err != nil {
+		t.Fatalf("BackfillEntities: %v", err)
+	}
+	if processed != 4 {
+		t.Errorf("BackfillEntities processed %d tweets, want 4", processed)
+	}
+	got, err = repo.GetByID(ctx, direct.ID, alice)
+	if err != nil {
+		t.Fatalf("GetByID: %v", err)
+	}
+	assertEntities(t, got.Entities, &model.Entities{
+		Hashtags: []model.Hashtag{{Tag: "東京", Start: 7, End: 10}},
+		Mentions: []model.Mention{{UserID: alice, Username: "alice", Start: 0, End: 6}},
+		URLs:     []model.URL{},
+	})
+	tweets, err = repo.ListByHashtag(ctx, "東京", 0, 10, alice)
+	if err != nil || !equalIDs(tweetIDs(tweets), []int{direct.ID, tweet.ID}) {
+		t.Errorf("ListByHashtag after backfill = %v, %v, want [%d %d]", tweetIDs(tweets), err, direct.ID, tweet.ID)
+	}
+}
+
+func assertEntities(t *testing.T, got, want *model.Entities) {
+	t.Helper()
+	if got == nil {
+		t.Fatal("entities = nil")
+	}
+	if len(got.Hashtags) != len(want.Hashtags) || len(got.Mentions) != len(want.Mentions) || len(got.URLs) != len(want.URLs) {
+		t.Fatalf("entities = %+v, want %+v", got, want)
+	}
+	for i := range want.Hashtags {
+		if got.Hashtags[i] != want.Hashtags[i] {
+			t.Errorf("hashtags[%d] = %+v, want %+v", i, got.Hashtags[i], want.Hashtags[i])
+		}
+	}
+	for i := range want.Mentions {
+		if got.Mentions[i] != want.Mentions[i] {
+			t.Errorf("mentions[%d] = %+v, want %+v", i, got.Mentions[i], want.Mentions[i])
+		}
+	}
+	for i := range want.URLs {
+		if got.URLs[i] != want.URLs[i] {
+			t.Errorf("urls[%d] = %+v, want %+v", i, got.URLs[i], want.URLs[i])
+		}
+	}
+}
@@ -0,0 +1,645 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+	"todoapp/internal/infrastructure"
+	"todoapp/internal/schema"
+	"todoapp/internal/tweet/model"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type TweetRepository interface {
+	// Create は userID のツイートを作成する。replyTo を指定するとそのツイートへのリプライにする。
+	// 本文のハッシュタグ・メンション・URLも保存する
+	Create(ctx context.Context, userID int, req *model.CreateTweetRequest, replyTo *model.Tweet) (*model.Tweet, error)
+	// GetByID は削除済みか投稿者が退会中のツイートを見つからないものとして sql.ErrNoRows を返す。
+	// 読み取りのメソッドの viewerID は閲覧しているユーザーで、RetweetedByMe の判定に使う。
+	// viewerID とどちらかがブロックしている投稿者のツイートは、投稿者が退会中のツイートと同じに扱う
+	GetByID(ctx context.Context, id, viewerID int) (*model.Tweet, error)
+	// GetWithDeleted は削除済みのツイートも Unavailable として返す。
+	// 投稿者が退会中のツイートと存在しないツイートは sql.ErrNoRows を返す
+	GetWithDeleted(ctx context.Context, id, viewerID int) (*model.Tweet, error)
+	// ListByIDs は ids のツイートを ids の順に返す。
+	// 削除済みか投稿者が退会中のツイートと存在しないツイートは含めない
+	ListByIDs(ctx context.Context, ids []int, viewerID int) ([]*model.Tweet, error)
+	// Delete はツイートを論理削除する。削除済みまたは存在しない場合は sql.ErrNoRows を返す
+	Delete(ctx context.Context, id int) error
+	// ListAncestors は tweet の親から最初のツイートまでを最大 limit 件、最初のツイートから順に返す。
+	// 削除済みのツイートと物理削除されて存在しないツイートは Unavailable として含める
+	ListAncestors(ctx context.Context, tweet *model.Tweet, limit, viewerID int) ([]*model.Tweet, error)
+	// ListReplies は parentIDs のそれぞれへのリプライを順位の高い順に並べ、
+	// offset 件目の次から最大 limit 件を親ツイートのIDごとに返す。
+	// 順位は親ツイートの投稿者自身のリプライ(スレッドの続き)、リプライの多いもの、古いものの順。
+	// 削除済みのリプライは、その先にリプライがある場合だけ Unavailable として含める
+	ListReplies(ctx context.Context, parentIDs []int, offset, limit, viewerID int) (map[int][]*model.Tweet, error)
+	// ListByHashtag は正規化したハッシュタグ tag を含むツイートを新しい順に、
+	// beforeID より古いものから最大 limit 件返す。beforeID が0なら先頭から返す
+	ListByHashtag(ctx context.Context, tag string, beforeID, limit, viewerID int) ([]*model.Tweet, error)
+	// CreateRetweet は userID による tweetID のリツイートを作成する。既にリツイートしている場合は Conflict を返す
+	CreateRetweet(ctx context.Context, userID, tweetID int) error
+	// DeleteRetweet はリツイートを取り消す。リツイートしていない場合は sql.ErrNoRows を返す
+	DeleteRetweet(ctx context.Context, userID, tweetID int) error
+	HasRetweeted(ctx context.Context, userID, tweetID int) (bool, error)
+	// CreateLike は userID による tweetID のいいねを作成する。既にいいねしている場合は Conflict を返す
+	CreateLike(ctx context.Context, userID, tweetID int) error
+	// DeleteLike はいいねを取り消す。いいねしていない場合は sql.ErrNoRows を返す
+	DeleteLike(ctx context.Context, userID, tweetID int) error
+	// ListTimeline は userID とフォロー中のユーザーのツイートとリツイートを新しい順に、
+	// before より後ろから最大 limit 件返す。before が nil なら先頭から返す。
+	// userID がミュートしているユーザーのツイートとリツイートは含めない
+	ListTimeline(ctx context.Context, userID int, before *model.TimelineCursor, limit int) ([]*model.TimelineItem, error)
+}
+
+type tweetRepository struct {
+	db boil.ContextExecutor
+	// readDB は読み取り専用のメソッドで使う(リードレプリカがなければ db と同じ)
+	readDB boil.ContextExecutor
+}
+
+func NewTweetRepository(db, readDB boil.ContextExecutor) TweetRepository {
+	return &tweetRepository{db: db, readDB: readDB}
+}
+
+func (r *tweetRepository) exec(ctx context.Context) boil.ContextExecutor {
+	return infrastructure.Executor(ctx, r.db)
+}
+
+func (r *tweetRepository) readExec(ctx context.Context) boil.ContextExecutor {
+	return infrastructure.Executor(ctx, r.readDB)
+}
+
+// リプライ・リツイート・引用・いいねの数は列に持たず、それぞれのテーブルから数える。
+// 投稿者が物理削除されて ON DELETE CASCADE で行が消えても数がずれないようにするため。
+// それぞれ tweets_reply_to_tweet_id_idx、retweets_tweet_id_idx、tweets_quote_tweet_id_idx、likes_tweet_id_idx で
+// 対象のツイートの行だけを数える。退会中のユーザーの行は数えない
+const (
+	countRepliesQuery = `SELECT COUNT(*) FROM tweets AS replies
+        JOIN users AS repliers ON repliers.id = replies.user_id AND repliers.deleted_at IS NULL
+        WHERE replies.reply_to_tweet_id = tweets.id AND replies.deleted_at IS NULL`
+	countRetweetsQuery = `SELECT COUNT(*) FROM retweets
+        JOIN users AS retweeters ON retweeters.id = retweets.user_id AND retweeters.deleted_at IS NULL
+        WHERE retweets.tweet_id = tweets.id`
+	countQuotesQuery = `SELECT COUNT(*) FROM tweets AS quotes
+        JOIN users AS quoters ON quoters.id = quotes.user_id AND quoters.deleted_at IS NULL
+        WHERE quotes.quote_tweet_id = tweets.id AND quotes.deleted_at IS NULL`
+	countLikesQuery = `SELECT COUNT(*) FROM likes
+        JOIN users AS likers ON likers.id = likes.user_id AND likers.deleted_at IS NULL
+        WHERE likes.tweet_id = tweets.id`
+
+	// tweetColumns はツイートと投稿者(退会中か閲覧者とブロックの関係にあれば NULL)と各件数を選択する。
+	// users は tweetAuthorJoin、my_retweets は tweetViewerJoin で結合する
+	tweetColumns = `tweets.*,
+        users.username AS author_username,
+        users.display_name AS author_display_name,
+        users.profile_image_url AS author_profile_image_url,
+        (` + countRepliesQuery + `) AS reply_count,
+        (` + countRetweetsQuery + `) AS retweet_count,
+        (` + countQuotesQuery + `) AS quote_count,
+        (` + countLikesQuery + `) AS like_count,
+        my_retweets.user_id IS NOT NULL AS retweeted_by_me`
+
+	// tweetAuthorJoin の引数は閲覧しているユーザーのIDを2回。閲覧者がブロックしているユーザーと、
+	// 閲覧者をブロックしているユーザーは結合しない(未ログインの0はどちらにも一致しない)
+	tweetAuthorJoin = `users ON users.id = tweets.user_id AND users.deleted_at IS NULL
+        AND users.id NOT IN (SELECT blocks.blocked_id FROM blocks WHERE blocks.blocker_id = ?)
+        AND users.id NOT IN (SELECT blocks.blocker_id FROM blocks WHERE blocks.blocked_id = ?)`
+	// tweetViewerJoin の引数は閲覧しているユーザーのID(未ログインの0は一致しない)
+	tweetViewerJoin = `retweets AS my_retweets ON my_retweets.tweet_id = tweets.id AND my_retweets.user_id = ?`
+)
+
+// tweetRow は tweetColumns を選択したクエリの1行
+type tweetRow struct {
+	schema.Tweet          `boil:",bind"`
+	AuthorUsername        null.String `boil:"author_username"`
+	AuthorDisplayName     null.String `boil:"author_display_name"`
+	AuthorProfileImageURL null.String `boil:"author_profile_image_url"`
+	ReplyCount            int         `boil:"reply_count"`
+	RetweetCount          int         `boil:"retweet_count"`
+	QuoteCount            int         `boil:"quote_count"`
+	LikeCount             int         `boil:"like_count"`
+	RetweetedByMe         bool        `boil:"retweeted_by_me"`
+}
+
+func (row *tweetRow) convertToModel() *model.Tweet {
+	if row.DeletedAt.Valid || !row.AuthorUsername.Valid {
+		return model.UnavailableTweet(row.ID, row.ReplyToTweetID.Ptr(), row.ConversationID.Ptr(), row.ReplyCount)
+	}
+	return &model.Tweet{
+		ID:     row.ID,
+		UserID: row.UserID,
+		User: &model.Author{
+			ID:              row.UserID,
+			Username:        row.AuthorUsername.String,
+			DisplayName:     row.AuthorDisplayName.String,
+			ProfileImageURL: row.AuthorProfileImageURL.Ptr(),
+		},
+		Content:        row.Content,
+		ImageURL:       row.ImageURL.Ptr(),
+		ReplyToTweetID: row.ReplyToTweetID.Ptr(),
+		ConversationID: row.ConversationID.Ptr(),
+		QuoteTweetID:   row.QuoteTweetID.Ptr(),
+		ReplyCount:     row.ReplyCount,
+		RetweetCount:   row.RetweetCount,
+		QuoteCount:     row.QuoteCount,
+		LikeCount:      row.LikeCount,
+		RetweetedByMe:  row.RetweetedByMe,
+		CreatedAt:      row.CreatedAt.Time,
+		UpdatedAt:      row.UpdatedAt.Time,
+	}
+}
+
+// convertRows は rows を変換し、エンティティと引用しているツイートを読み込んで埋め込む
+func (r *tweetRepository) convertRows(ctx context.Context, exec boil.ContextExecutor, rows []*tweetRow, viewerID int) ([]*model.Tweet, error) {
+	tweets := make([]*model.Tweet, len(rows))
+	var quoteIDs []interface{}
+	for i, row := range rows {
+		tweets[i] = row.convertToModel()
+		if tweets[i].QuoteTweetID != nil {
+			quoteIDs = append(quoteIDs, *tweets[i].QuoteTweetID)
+		}
+	}
+
+	quoted := make(map[int]*model.Tweet, len(quoteIDs))
+	if len(quoteIDs) > 0 {
+		var quotedRows []*tweetRow
+		err := schema.Tweets(
+			qm.WithDeleted(),
+			qm.Select(tweetColumns),
+			qm.LeftOuterJoin(tweetAuthorJoin, viewerID, viewerID),
+			qm.LeftOuterJoin(tweetViewerJoin, viewerID),
+			qm.WhereIn("tweets.id IN ?", quoteIDs...),
+		).Bind(ctx, exec, &quotedRows)
+		if err != nil {
+			return nil, err
+		}
+		for _, row := range quotedRows {
+			quoted[row.ID] = row.convertToModel()
+		}
+	}
+
+	withQuoted := append([]*model.Tweet{}, tweets...)
+	for _, tweet := range tweets {
+		if tweet.QuoteTweetID == nil {
+			continue
+		}
+		// 引用元が物理削除されていれば削除済みとして表示する
+		tweet.QuotedTweet = quoted[*tweet.QuoteTweetID]
+		if tweet.QuotedTweet == nil {
+			tweet.QuotedTweet = model.UnavailableTweet(*tweet.QuoteTweetID, nil, nil, 0)
+		}
+		withQuoted = append(withQuoted, tweet.QuotedTweet)
+	}
+	if err := loadEntities(ctx, exec, withQuoted); err != nil {
+		return nil, err
+	}
+	return tweets, nil
+}
+
+func (r *tweetRepository) Create(ctx context.Context, userID int, req *model.CreateTweetRequest, replyTo *model.Tweet) (*model.Tweet, error) {
+	dbTweet := &schema.Tweet{
+		UserID:       userID,
+		Content:      req.Content,
+		ImageURL:     null.StringFromPtr(req.ImageURL),
+		QuoteTweetID: null.IntFromPtr(req.QuoteTweetID),
+	}
+	if replyTo != nil {
+		dbTweet.ReplyToTweetID = null.IntFrom(replyTo.ID)
+		// 親が会話の最初のツイートなら親のIDが会話のIDになる
+		dbTweet.ConversationID = null.IntFrom(replyTo.ID)
+		if replyTo.ConversationID != nil {
+			dbTweet.ConversationID = null.IntFrom(*replyTo.ConversationID)
+		}
+	}
+	if err := dbTweet.Insert(ctx, r.exec(ctx), boil.Infer()); err != nil {
+		return nil, err
+	}
+	if err := saveEntities(ctx, r.exec(ctx), dbTweet.ID, dbTweet.Content); err != nil {
+		return nil, err
+	}
+
+	// 投稿者の情報を含めて読み直す(トランザクション内なのでプライマリから読む)
+	return r.get(ctx, r.exec(ctx), dbTweet.ID, userID, false)
+}
+
+func (r *tweetRepository) GetByID(ctx context.Context, id, viewerID int) (*model.Tweet, error) {
+	return r.get(ctx, r.readExec(ctx), id, viewerID, false)
+}
+
+func (r *tweetRepository) GetWithDeleted(ctx context.Context, id, viewerID int) (*model.Tweet, error) {
+	return r.get(ctx, r.exec(ctx), id, viewerID, true)
+}
+
+func (r *tweetRepository) get(ctx context.Context, exec boil.ContextExecutor, id, viewerID int, withDeleted bool) (*model.Tweet, error) {
+	mods := []qm.QueryMod{
+		qm.Select(tweetColumns),
+		qm.LeftOuterJoin(tweetAuthorJoin, viewerID, viewerID),
+		qm.LeftOuterJoin(tweetViewerJoin, viewerID),
+		qm.Where("tweets.id = ?", id),
+		qm.Where("users.id IS NOT NULL"),
+	}
+	if withDeleted {
+		mods = append(mods, qm.WithDeleted())
+	}
+
+	var rows []*tweetRow
+	if err := schema.Tweets(mods...).Bind(ctx, exec, &rows); err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, sql.ErrNoRows
+	}
+	tweets, err := r.convertRows(ctx, exec, rows, viewerID)
+	if err != nil {
+		return nil, err
+	}
+	return tweets[0], nil
+}
+
+func (r *tweetRepository) Delete(ctx context.Context, id int) error {
+	dbTweet, err := schema.FindTweet(ctx, r.exec(ctx), id)
+	if err != nil {
+		return err
+	}
+	// 論理削除も BeforeDeleteHook を通るので、監査ログに記録される
+	_, err = dbTweet.Delete(ctx, r.exec(ctx), false)
+	return err
+}
+
+// ancestorsQuery は親をたどる再帰クエリ。depth は起点(親)が0で、最初のツイートに向かって増える
+const ancestorsQuery = `
+WITH RECURSIVE ancestors (id, reply_to_tweet_id, depth) AS (
+    SELECT id, reply_to_tweet_id, 0 FROM tweets WHERE id = ?
+    UNION ALL
+    SELECT tweets.id, tweets.reply_to_tweet_id, ancestors.depth + 1
+    FROM tweets JOIN ancestors ON tweets.id = ancestors.reply_to_tweet_id
+    WHERE ancestors.depth + 1 < ?
+)
+SELECT ` + tweetColumns + `
+FROM ancestors
+JOIN tweets ON tweets.id = ancestors.id
+LEFT JOIN ` + tweetAuthorJoin + `
+LEFT JOIN ` + tweetViewerJoin + `
+ORDER BY ancestors.depth DESC`
+
+func (r *tweetRepository) ListAncestors(ctx context.Context, tweet *model.Tweet, limit, viewerID int) ([]*model.Tweet, error) {
+	if tweet.ReplyToTweetID == nil || limit <= 0 {
+		return []*model.Tweet{}, nil
+	}
+
+	exec := r.readExec(ctx)
+	var rows []*tweetRow
+	if err := queries.Raw(ancestorsQuery, *tweet.ReplyToTweetID, limit, viewerID, viewerID, viewerID).Bind(ctx, exec, &rows); err != nil {
+		return nil, err
+	}
+	tweets, err := r.convertRows(ctx, exec, rows, viewerID)
+	if err != nil {
+		return nil, err
+	}
+
+	ancestors := make([]*model.Tweet, 0, len(rows)+1)
+	// たどった先の親が物理削除されている場合は、存在しない親を削除済みとして先頭に加える
+	missingParent := tweet.ReplyToTweetID
+	if len(rows) > 0 {
+		missingParent = rows[0].ReplyToTweetID.Ptr()
+	}
+	if missingParent != nil && len(rows) < limit {
+		// 会話の最初のツイートでなければ会話のIDは tweet と同じ
+		var conversationID *int
+		if tweet.ConversationID != nil && *tweet.ConversationID != *missingParent {
+			conversationID = tweet.ConversationID
+		}
+		ancestors = append(ancestors, model.UnavailableTweet(*missingParent, nil, conversationID, 0))
+	}
+	return append(ancestors, tweets...), nil
+}
+
+// repliesQuery はリプライを親ごとに順位付けする。
+// 表示しないリプライ(削除済みでその先のリプライもないもの)を除いてから順位を付けるので、
+// 順位は表示する順の通し番号になり、offset によるページ分けに使える
+const repliesQuery = `
+SELECT * FROM (
+    SELECT counted.*, ROW_NUMBER() OVER (
+        PARTITION BY counted.reply_to_tweet_id
+        ORDER BY counted.by_parent_author DESC, counted.reply_count DESC, counted.id
+    ) AS reply_rank
+    FROM (
+        SELECT ` + tweetColumns + `,
+            CASE WHEN tweets.user_id = parents.user_id THEN 1 ELSE 0 END AS by_parent_author
+        FROM tweets
+        JOIN tweets AS parents ON parents.id = tweets.reply_to_tweet_id
+        LEFT JOIN ` + tweetAuthorJoin + `
+        LEFT JOIN ` + tweetViewerJoin + `
+        WHERE tweets.reply_to_tweet_id IN (%s)
+    ) AS counted
+    WHERE (counted.deleted_at IS NULL AND counted.author_username IS NOT NULL) OR counted.reply_count > 0
+) AS ranked
+WHERE ranked.reply_rank > ? AND ranked.reply_rank <= ?
+ORDER BY ranked.reply_to_tweet_id, ranked.reply_rank`
+
+func (r *tweetRepository) ListReplies(ctx context.Context, parentIDs []int, offset, limit, viewerID int) (map[int][]*model.Tweet, error) {
+	replies := make(map[int][]*model.Tweet, len(parentIDs))
+	if len(parentIDs) == 0 || limit <= 0 {
+		return replies, nil
+	}
+
+	args := make([]interface{}, 0, len(parentIDs)+5)
+	args = append(args, viewerID, viewerID, viewerID)
+	for _, id := range parentIDs {
+		args = append(args, id)
+	}
+	args = append(args, offset, offset+limit)
+	query := strings.Replace(repliesQuery, "%s", strings.Repeat(",?", len(parentIDs))[1:], 1)
+
+	exec := r.readExec(ctx)
+	var rows []*tweetRow
+	if err := queries.Raw(query, args...).Bind(ctx, exec, &rows); err != nil {
+		return nil, err
+	}
+	tweets, err := r.convertRows(ctx, exec, rows, viewerID)
+	if err != nil {
+		return nil, err
+	}
+	for _, tweet := range tweets {
+		parentID := *tweet.ReplyToTweetID
+		replies[parentID] = append(replies[parentID], tweet)
+	}
+	return replies, nil
+}
+
+func (r *tweetRepository) ListByIDs(ctx context.Context, ids []int, viewerID int) ([]*model.Tweet, error) {
+	if len(ids) == 0 {
+		return []*model.Tweet{}, nil
+	}
+	args := make([]interface{}, len(ids))
+	for i, id := range ids {
+		args[i] = id
+	}
+
+	exec := r.readExec(ctx)
+	var rows []*tweetRow
+	err := schema.Tweets(
+		qm.Select(tweetColumns),
+		qm.LeftOuterJoin(tweetAuthorJoin, viewerID, viewerID),
+		qm.LeftOuterJoin(tweetViewerJoin, viewerID),
+		qm.WhereIn("tweets.id IN ?", args...),
+		qm.Where("users.id IS NOT NULL"),
+	).Bind(ctx, exec, &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[int]*tweetRow, len(rows))
+	for _, row := range rows {
+		byID[row.ID] = row
+	}
+	ordered := make([]*tweetRow, 0, len(rows))
+	for _, id := range ids {
+		if row, ok := byID[id]; ok {
+			ordered = append(ordered, row)
+		}
+	}
+	return r.convertRows(ctx, exec, ordered, viewerID)
+}
+
+func (r *tweetRepository) ListByHashtag(ctx context.Context, tag string, beforeID, limit, viewerID int) ([]*model.Tweet, error) {
+	mods := []qm.QueryMod{
+		qm.Select(tweetColumns),
+		qm.LeftOuterJoin(tweetAuthorJoin, viewerID, viewerID),
+		qm.LeftOuterJoin(tweetViewerJoin, viewerID),
+		qm.Where("tweets.id IN (SELECT tweet_hashtags.tweet_id FROM tweet_hashtags WHERE tweet_hashtags.tag = ?)", tag),
+		qm.Where("users.id IS NOT NULL"),
+		qm.OrderBy("tweets.id DESC"),
+		qm.Limit(limit),
+	}
+	if beforeID > 0 {
+		mods = append(mods, qm.Where("tweets.id < ?", beforeID))
+	}
+
+	exec := r.readExec(ctx)
+	var rows []*tweetRow
+	if err := schema.Tweets(mods...).Bind(ctx, exec, &rows); err != nil {
+		return nil, err
+	}
+	return r.convertRows(ctx, exec, rows, viewerID)
+}
+
+func (r *tweetRepository) CreateRetweet(ctx context.Context, userID, tweetID int) error {
+	retweet := &schema.Retweet{UserID: userID, TweetID: tweetID}
+	if err := retweet.Insert(ctx, r.exec(ctx), boil.Infer()); err != nil {
+		return infrastructure.TranslateError(err)
+	}
+	return nil
+}
+
+func (r *tweetRepository) DeleteRetweet(ctx context.Context, userID, tweetID int) error {
+	retweet, err := schema.FindRetweet(ctx, r.exec(ctx), userID, tweetID)
+	if err != nil {
+		return err
+	}
+	// フックを実行するため、DeleteAll ではなく行を読んでから削除する
+	_, err = retweet.Delete(ctx, r.exec(ctx))
+	return err
+}
+
+func (r *tweetRepository) HasRetweeted(ctx context.Context, userID, tweetID int) (bool, error) {
+	return schema.Retweets(
+		schema.RetweetWhere.UserID.EQ(userID),
+		schema.RetweetWhere.TweetID.EQ(tweetID),
+	).Exists(ctx, r.exec(ctx))
+}
+
+func (r *tweetRepository) CreateLike(ctx context.Context, userID, tweetID int) error {
+	like := &schema.Like{UserID: userID, TweetID: tweetID}
+	if err := like.Insert(ctx, r.exec(ctx), boil.Infer()); err != nil {
+		return infrastructure.TranslateError(err)
+	}
+	return nil
+}
+
+func (r *tweetRepository) DeleteLike(ctx context.Context, userID, tweetID int) error {
+	like, err := schema.FindLike(ctx, r.exec(ctx), userID, tweetID)
+	if err != nil {
+		return err
+	}
+	// 通知のフックを実行するため、DeleteAll ではなく行を読んでから削除する
+	_, err = like.Delete(ctx, r.exec(ctx))
+	return err
+}
+
+// timelineSourceQuery はタイムラインに表示するユーザー(自分と、退会中でなくミュートしていないフォロー中のユーザー)に
+// col が含まれる条件。引数は自分のIDを3回
+const timelineSourceQuery = `(%[1]s = ? OR %[1]s IN (
+            SELECT follows.following_id FROM follows
+            JOIN users AS followees ON followees.id = follows.following_id AND followees.deleted_at IS NULL
+            WHERE follows.follower_id = ?
+            AND follows.following_id NOT IN (SELECT mutes.muted_id FROM mutes WHERE mutes.muter_id = ?)))`
+
+// timelineQuery は投稿とリツイートを1つの列に並べ、同じツイートを最も新しい位置の1件にまとめる。
+// 削除済みのツイートと、投稿者が退会中かブロックの関係にあるかミュートしているツイートは含めない。%s はカーソルの条件
+var timelineQuery = `SELECT entries.tweet_id, MAX(entries.sort_at) AS sort_at
+FROM (
+    SELECT tweets.id AS tweet_id, tweets.created_at AS sort_at
+    FROM tweets
+    WHERE ` + fmt.Sprintf(timelineSourceQuery, "tweets.user_id") + `
+    UNION ALL
+    SELECT retweets.tweet_id, retweets.created_at
+    FROM retweets
+    WHERE ` + fmt.Sprintf(timelineSourceQuery, "retweets.user_id") + `
+) AS entries
+JOIN tweets ON tweets.id = entries.tweet_id AND tweets.deleted_at IS NULL
+JOIN ` + tweetAuthorJoin + `
+WHERE tweets.user_id NOT IN (SELECT mutes.muted_id FROM mutes WHERE mutes.muter_id = ?)
+GROUP BY entries.tweet_id
+HAVING %s
+ORDER BY sort_at DESC, entries.tweet_id DESC
+LIMIT ?`
+
+type timelineRow struct {
+	TweetID int      `boil:"tweet_id"`
+	SortAt  scanTime `boil:"sort_at"`
+}
+
+// scanTime は集計した日時を読み込む。
+// SQLite では式の結果に型がなく、日時が文字列で返るので解析する
+type scanTime struct {
+	time.Time
+}
+
+var scanTimeLayouts = []string{"2006-01-02 15:04:05.999999999-07:00", "2006-01-02 15:04:05"}
+
+func (t *scanTime) Scan(src interface{}) error {
+	var s string
+	switch v := src.(type) {
+	case time.Time:
+		t.Time = v
+		return nil
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return fmt.Errorf("cannot scan %T into time", src)
+	}
+	for _, layout := range scanTimeLayouts {
+		if parsed, err := time.Parse(layout, s); err == nil {
+			t.Time = parsed.UTC()
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot parse %q as time", s)
+}
+
+type retweeterRow struct {
+	TweetID         int         `boil:"tweet_id"`
+	UserID          int         `boil:"user_id"`
+	Username        string      `boil:"username"`
+	DisplayName     string      `boil:"display_name"`
+	ProfileImageURL null.String `boil:"profile_image_url"`
+}
+
+func (r *tweetRepository) ListTimeline(ctx context.Context, userID int, before *model.TimelineCursor, limit int) ([]*model.TimelineItem, error) {
+	if limit <= 0 {
+		return []*model.TimelineItem{}, nil
+	}
+
+	// 投稿とリツイートの timelineSourceQuery に3回ずつ、tweetAuthorJoin に2回、ミュートの条件に1回
+	args := []interface{}{userID, userID, userID, userID, userID, userID, userID, userID, userID}
+	cursor := "1 = 1"
+	if before != nil {
+		cursor = "MAX(entries.sort_at) < ? OR (MAX(entries.sort_at) = ? AND entries.tweet_id < ?)"
+		args = append(args, before.SortAt, before.SortAt, before.TweetID)
+	}
+	args = append(args, limit)
+	query := strings.Replace(timelineQuery, "%s", cursor, 1)
+
+	exec := r.readExec(ctx)
+	var entries []*timelineRow
+	if err := queries.Raw(query, args...).Bind(ctx, exec, &entries); err != nil {
+		return nil, err
+	}
+	if len(entries) == 0 {
+		return []*model.TimelineItem{}, nil
+	}
+
+	ids := make([]interface{}, len(entries))
+	for i, entry := range entries {
+		ids[i] = entry.TweetID
+	}
+
+	var rows []*tweetRow
+	err := schema.Tweets(
+		qm.Select(tweetColumns),
+		qm.LeftOuterJoin(tweetAuthorJoin, userID, userID),
+		qm.LeftOuterJoin(tweetViewerJoin, userID),
+		qm.WhereIn("tweets.id IN ?", ids...),
+		qm.Where("users.id IS NOT NULL"),
+	).Bind(ctx, exec, &rows)
+	if err != nil {
+		return nil, err
+	}
+	tweets, err := r.convertRows(ctx, exec, rows, userID)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[int]*model.Tweet, len(tweets))
+	for _, tweet := range tweets {
+		byID[tweet.ID] = tweet
+	}
+
+	// 新しい順に読むので、ツイートごとの最初の行が最も新しくリツイートしたユーザー
+	var retweeters []*retweeterRow
+	err = schema.Retweets(
+		qm.Select(
+			"retweets.tweet_id AS tweet_id",
+			"users.id AS user_id",
+			"users.username AS username",
+			"users.display_name AS display_name",
+			"users.profile_image_url AS profile_image_url",
+		),
+		qm.InnerJoin("users ON users.id = retweets.user_id AND users.deleted_at IS NULL"),
+		qm.WhereIn("retweets.tweet_id IN ?", ids...),
+		qm.Where(fmt.Sprintf(timelineSourceQuery, "retweets.user_id"), userID, userID, userID),
+		qm.OrderBy("retweets.created_at DESC, retweets.user_id"),
+	).Bind(ctx, exec, &retweeters)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*model.TimelineItem, 0, len(entries))
+	index := make(map[int]*model.TimelineItem, len(entries))
+	for _, entry := range entries {
+		// 集計の後に削除されたツイートは飛ばす
+		tweet := byID[entry.TweetID]
+		if tweet == nil {
+			continue
+		}
+		item := &model.TimelineItem{Tweet: tweet, SortAt: entry.SortAt.Time}
+		items = append(items, item)
+		index[tweet.ID] = item
+	}
+	for _, row := range retweeters {
+		item := index[row.TweetID]
+		if item == nil {
+			continue
+		}
+		if item.RetweetedBy == nil {
+			item.RetweetedBy = &model.Author{
+				ID:              row.UserID,
+				Username:        row.Username,
+				DisplayName:     row.DisplayName,
+				ProfileImageURL: row.ProfileImageURL.Ptr(),
+			}
+		} else {
+			item.RetweetedByOthers++
+		}
+	}
+	return items, nil
+}
@@ -0,0 +1,241 @@
+package repository
+
+import (
+	"context"
+	"math/rand"
+	"strconv"
+	"todoapp/internal/cache"
+	"todoapp/internal/infrastructure"
+	"todoapp/internal/schema"
+	"todoapp/internal/tweet/model"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// tweetKey は tweetVersion と viewerVersion の時点の、viewerID から見たツイートのキー
+func tweetKey(id int, tweetVersion string, viewerID int, viewerVersion string) string {
+	return cache.KeyPrefix + "tweet:" + strconv.Itoa(id) + ":" + tweetVersion + ":" + strconv.Itoa(viewerID) + ":" + viewerVersion
+}
+
+// tweetVersionKey はツイートの件数や内容が変わるたびに削除するバージョンのキー
+func tweetVersionKey(id int) string {
+	return cache.KeyPrefix + "tweet-version:" + strconv.Itoa(id)
+}
+
+// userVersionKey はユーザーのプロフィールや退会の状態、ブロックの関係が変わるたびに削除するバージョンのキー
+func userVersionKey(id int) string {
+	return cache.KeyPrefix + "tweet-user-version:" + strconv.Itoa(id)
+}
+
+// cachedTweet はキャッシュに保存するツイートと、読み込んだ時点の投稿者と引用しているツイートのバージョン
+type cachedTweet struct {
+	Tweet    *model.Tweet
+	Versions map[string]string
+}
+
+// cachedTweetRepository は GetByID の結果を閲覧者ごとにキャッシュする。
+// ツイートは件数や閲覧者ごとの状態(RetweetedByMe、ブロックの関係)を含むので、削除するキーを列挙できない。
+// そのためツイートとユーザーごとにバージョンを持ち、変更ではバージョンを削除して古い値を読まれないようにする。
+// トランザクション内の読み取りは、未コミットの変更を反映するためキャッシュを使わない
+type cachedTweetRepository struct {
+	TweetRepository
+	rt *cache.ReadThrough
+}
+
+// NewCachedTweetRepository は repo の GetByID を rt でキャッシュする。
+// 自身の書き込み(Create、Delete、リツイートといいね)ではキャッシュを削除するが、
+// 他のリポジトリによる変更(ユーザーの更新やブロックなど)を反映するには RegisterCacheInvalidation も呼ぶこと
+func NewCachedTweetRepository(repo TweetRepository, rt *cache.ReadThrough) TweetRepository {
+	return &cachedTweetRepository{TweetRepository: repo, rt: rt}
+}
+
+func (r *cachedTweetRepository) GetByID(ctx context.Context, id, viewerID int) (*model.Tweet, error) {
+	if infrastructure.InTx(ctx) {
+		return r.TweetRepository.GetByID(ctx, id, viewerID)
+	}
+
+	viewerVersion := ""
+	if viewerID != 0 {
+		viewerVersion = r.version(ctx, userVersionKey(viewerID))
+	}
+	key := tweetKey(id, r.version(ctx, tweetVersionKey(id)), viewerID, viewerVersion)
+	cached, err := cache.Fetch(ctx, r.rt, key, func(ctx context.Context) (*cachedTweet, error) {
+		tweet, err := r.TweetRepository.GetByID(ctx, id, viewerID)
+		if err != nil {
+			return nil, err
+		}
+		return &cachedTweet{Tweet: tweet, Versions: r.dependencyVersions(ctx, tweet)}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// 投稿者や引用しているツイートがキャッシュした後に変わっていれば読み直す
+	for versionKey, version := range cached.Versions {
+		if r.version(ctx, versionKey) != version {
+			_ = r.rt.Invalidate(ctx, key)
+			return r.TweetRepository.GetByID(ctx, id, viewerID)
+		}
+	}
+	restoreEntities(cached.Tweet)
+	restoreEntities(cached.Tweet.QuotedTweet)
+	return cached.Tweet, nil
+}
+
+// dependencyVersions はキーに含めていない、tweet の表示に使うユーザーと引用しているツイートの現在のバージョンを返す
+func (r *cachedTweetRepository) dependencyVersions(ctx context.Context, tweet *model.Tweet) map[string]string {
+	keys := []string{userVersionKey(tweet.UserID)}
+	if tweet.QuoteTweetID != nil {
+		keys = append(keys, tweetVersionKey(*tweet.QuoteTweetID))
+		if tweet.QuotedTweet != nil && !tweet.QuotedTweet.Unavailable {
+			keys = append(keys, userVersionKey(tweet.QuotedTweet.UserID))
+		}
+	}
+
+	versions := make(map[string]string, len(keys))
+	for _, key := range keys {
+		versions[key] = r.version(ctx, key)
+	}
+	return versions
+}
+
+// version は key のバージョンを返す。なければ新しいバージョンを作って保存する。
+// キャッシュの障害時は毎回異なる値になるので、キャッシュした値は使われない
+func (r *cachedTweetRepository) version(ctx context.Context, key string) string {
+	version, err := cache.Fetch(ctx, r.rt, key, func(ctx context.Context) (string, error) {
+		return strconv.FormatInt(rand.Int63(), 36), nil
+	})
+	if err != nil {
+		return ""
+	}
+	return version
+}
+
+// restoreEntities は gob で空のスライスが nil になったエンティティを空のスライスに戻す。
+// JSON で null ではなく [] を返すため
+func restoreEntities(tweet *model.Tweet) {
+	if tweet == nil || tweet.Entities == nil {
+		return
+	}
+	if tweet.Entities.Hashtags == nil {
+		tweet.Entities.Hashtags = []model.Hashtag{}
+	}
+	if tweet.Entities.Mentions == nil {
+		tweet.Entities.Mentions = []model.Mention{}
+	}
+	if tweet.Entities.URLs == nil {
+		tweet.Entities.URLs = []model.URL{}
+	}
+}
+
+func (r *cachedTweetRepository) Create(ctx context.Context, userID int, req *model.CreateTweetRequest, replyTo *model.Tweet) (*model.Tweet, error) {
+	tweet, err := r.TweetRepository.Create(ctx, userID, req, replyTo)
+	if err != nil {
+		return nil, err
+	}
+	r.rt.InvalidateAfterCommit(ctx, tweetChangedKeys(tweet.ID, tweet.ReplyToTweetID, tweet.QuoteTweetID)...)
+	return tweet, nil
+}
+
+func (r *cachedTweetRepository) Delete(ctx context.Context, id int) error {
+	if err := r.TweetRepository.Delete(ctx, id); err != nil {
+		return err
+	}
+	r.rt.InvalidateAfterCommit(ctx, tweetVersionKey(id))
+	return nil
+}
+
+func (r *cachedTweetRepository) CreateRetweet(ctx context.Context, userID, tweetID int) error {
+	if err := r.TweetRepository.CreateRetweet(ctx, userID, tweetID); err != nil {
+		return err
+	}
+	r.rt.InvalidateAfterCommit(ctx, tweetVersionKey(tweetID))
+	return nil
+}
+
+func (r *cachedTweetRepository) DeleteRetweet(ctx context.Context, userID, tweetID int) error {
+	if err := r.TweetRepository.DeleteRetweet(ctx, userID, tweetID); err != nil {
+		return err
+	}
+	r.rt.InvalidateAfterCommit(ctx, tweetVersionKey(tweetID))
+	return nil
+}
+
+func (r *cachedTweetRepository) CreateLike(ctx context.Context, userID, tweetID int) error {
+	if err := r.TweetRepository.CreateLike(ctx, userID, tweetID); err != nil {
+		return err
+	}
+	r.rt.InvalidateAfterCommit(ctx, tweetVersionKey(tweetID))
+	return nil
+}
+
+func (r *cachedTweetRepository) DeleteLike(ctx context.Context, userID, tweetID int) error {
+	if err := r.TweetRepository.DeleteLike(ctx, userID, tweetID); err != nil {
+		return err
+	}
+	r.rt.InvalidateAfterCommit(ctx, tweetVersionKey(tweetID))
+	return nil
+}
+
+// tweetChangedKeys はツイートの作成・削除で変わるバージョンのキー。
+// 親のリプライ数と引用元の引用数も変わる
+func tweetChangedKeys(id int, replyToTweetID, quoteTweetID *int) []string {
+	keys := []string{tweetVersionKey(id)}
+	if replyToTweetID != nil {
+		keys = append(keys, tweetVersionKey(*replyToTweetID))
+	}
+	if quoteTweetID != nil {
+		keys = append(keys, tweetVersionKey(*quoteTweetID))
+	}
+	return keys
+}
+
+// RegisterCacheInvalidation はsqlboilerのフックを登録し、tweets・likes・retweets・users・blocks の
+// 変更で関係するキャッシュを削除する。フックはパッケージ全体で共有されるので、
+// アプリケーションの起動時に1回だけ呼ぶこと。
+// フックは UpdateAll / DeleteAll や生のSQLでは実行されないので、
+// それらで変更する場合は呼び出し側で削除する。
+// リプライ・いいね・リツイートをしたユーザーの退会による件数の変化は、TTLが切れるまで反映されない
+func RegisterCacheInvalidation(rt *cache.ReadThrough) {
+	tweetChanged := func(ctx context.Context, exec boil.ContextExecutor, o *schema.Tweet) error {
+		rt.InvalidateAfterCommit(ctx, tweetChangedKeys(o.ID, o.ReplyToTweetID.Ptr(), o.QuoteTweetID.Ptr())...)
+		return nil
+	}
+	schema.AddTweetHook(boil.AfterInsertHook, tweetChanged)
+	schema.AddTweetHook(boil.AfterUpdateHook, tweetChanged)
+	schema.AddTweetHook(boil.AfterUpsertHook, tweetChanged)
+	schema.AddTweetHook(boil.AfterDeleteHook, tweetChanged)
+
+	// いいね数が変わる
+	likeChanged := func(ctx context.Context, exec boil.ContextExecutor, o *schema.Like) error {
+		rt.InvalidateAfterCommit(ctx, tweetVersionKey(o.TweetID))
+		return nil
+	}
+	schema.AddLikeHook(boil.AfterInsertHook, likeChanged)
+	schema.AddLikeHook(boil.AfterDeleteHook, likeChanged)
+
+	// リツイート数とリツイートしたユーザーの RetweetedByMe が変わる
+	retweetChanged := func(ctx context.Context, exec boil.ContextExecutor, o *schema.Retweet) error {
+		rt.InvalidateAfterCommit(ctx, tweetVersionKey(o.TweetID))
+		return nil
+	}
+	schema.AddRetweetHook(boil.AfterInsertHook, retweetChanged)
+	schema.AddRetweetHook(boil.AfterDeleteHook, retweetChanged)
+
+	// 投稿者の表示名などと、退会による表示・非表示が変わる
+	userChanged := func(ctx context.Context, exec boil.ContextExecutor, o *schema.User) error {
+		rt.InvalidateAfterCommit(ctx, userVersionKey(o.ID))
+		return nil
+	}
+	schema.AddUserHook(boil.AfterUpdateHook, userChanged)
+	schema.AddUserHook(boil.AfterUpsertHook, userChanged)
+	schema.AddUserHook(boil.AfterDeleteHook, userChanged)
+
+	// ブロックした側とされた側のどちらから見ても、相手のツイートの表示・非表示が変わる
+	blockChanged := func(ctx context.Context, exec boil.ContextExecutor, o *schema.Block) error {
+		rt.InvalidateAfterCommit(ctx, userVersionKey(o.BlockerID), userVersionKey(o.BlockedID))
+		return nil
+	}
+	schema.AddBlockHook(boil.AfterInsertHook, blockChanged)
+	schema.AddBlockHook(boil.AfterDeleteHook, blockChanged)
+}
@@ -0,0 +1,150 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+	"todoapp/internal/cache"
+	"todoapp/internal/dbtest"
+	"todoapp/internal/infrastructure"
+	"todoapp/internal/schema"
+	"todoapp/internal/tweet/model"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestCachedTweetRepositoryInvalidation(t *testing.T) {
+	ctx := context.Background()
+	db := dbtest.New(t)
+	rt := cache.NewReadThrough(cache.NewLRU(100), time.Minute)
+	RegisterCacheInvalidation(rt)
+
+	inner := NewTweetRepository(db, db)
+	repo := NewCachedTweetRepository(inner, rt)
+	txManager := infrastructure.NewTxManager(db, nil)
+
+	var users []*schema.User
+	for _, name := range []string{"alice", "bob"} {
+		u := &schema.User{Username: name, DisplayName: name, Email: name + "@example.com", PasswordHash: "hash"}
+		if err := u.Insert(ctx, db, boil.Infer()); err != nil {
+			t.Fatal(err)
+		}
+		users = append(users, u)
+	}
+	alice, bob := users[0], users[1]
+
+	tweet, err := repo.Create(ctx, alice.ID, &model.CreateTweetRequest{Content: "hello"}, nil)
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	get := func(viewerID int) *model.Tweet {
+		t.Helper()
+		got, err := repo.GetByID(ctx, tweet.ID, viewerID)
+		if err != nil {
+			t.Fatalf("GetByID: %v", err)
+		}
+		return got
+	}
+
+	if got := get(bob.ID); got.ReplyCount != 0 || got.Entities == nil || got.Entities.Hashtags == nil {
+		t.Fatalf("GetByID = %+v, want no replies and empty entities", got)
+	}
+	// フックを通らない変更はキャッシュに反映されない
+	if _, err := db.Exec("INSERT INTO tweets (user_id, content, reply_to_tweet_id, conversation_id) VALUES (?, ?, ?, ?)",
+		bob.ID, "raw", tweet.ID, tweet.ID); err != nil {
+		t.Fatal(err)
+	}
+	got := get(bob.ID)
+	if got.ReplyCount != 0 {
+		t.Errorf("GetByID after a raw insert = %d replies, want the cached 0", got.ReplyCount)
+	}
+	if got.Entities.Hashtags == nil || got.Entities.Mentions == nil || got.Entities.URLs == nil {
+		t.Errorf("cached entities = %+v, want empty slices", got.Entities)
+	}
+
+	// リプライのフックで、全ての閲覧者について親のキャッシュが削除される。コミットされるまでは削除しない
+	err = txManager.RunInTx(ctx, func(ctx context.Context) error {
+		reply := &schema.Tweet{UserID: bob.ID, Content: "reply", ReplyToTweetID: null.IntFrom(tweet.ID), ConversationID: null.IntFrom(tweet.ID)}
+		if err := reply.Insert(ctx, infrastructure.Executor(ctx, db), boil.Infer()); err != nil {
+			return err
+		}
+		if got, err := repo.GetByID(context.Background(), tweet.ID, bob.ID); err != nil || got.ReplyCount != 0 {
+			t.Errorf("GetByID before commit = %+v, %v, want the cached value", got, err)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("RunInTx: %v", err)
+	}
+	if got := get(bob.ID); got.ReplyCount != 2 {
+		t.Errorf("GetByID after reply = %d replies, want 2", got.ReplyCount)
+	}
+	if got := get(0); got.ReplyCount != 2 {
+		t.Errorf("GetByID by a guest after reply = %d replies, want 2", got.ReplyCount)
+	}
+
+	// リツイートとその取り消しで、件数とリツイートしたユーザーの RetweetedByMe が変わる。
+	// 取り消しもフックを通るので、デコレーターを通さなくてもキャッシュが削除される
+	if err := repo.CreateRetweet(ctx, bob.ID, tweet.ID); err != nil {
+		t.Fatalf("CreateRetweet: %v", err)
+	}
+	if got := get(bob.ID); !got.RetweetedByMe || got.RetweetCount != 1 {
+		t.Errorf("GetByID after retweet = %+v, want retweeted by me", got)
+	}
+	if err := inner.DeleteRetweet(ctx, bob.ID, tweet.ID); err != nil {
+		t.Fatalf("DeleteRetweet: %v", err)
+	}
+	if got := get(bob.ID); got.RetweetedByMe || got.RetweetCount != 0 {
+		t.Errorf("GetByID after DeleteRetweet = %+v, want not retweeted", got)
+	}
+
+	// 引用すると引用元の引用数が変わる
+	quoteID := tweet.ID
+	if _, err := repo.Create(ctx, bob.ID, &model.CreateTweetRequest{Content: "quote", QuoteTweetID: &quoteID}, nil); err != nil {
+		t.Fatalf("Create quote: %v", err)
+	}
+	if got := get(bob.ID); got.QuoteCount != 1 {
+		t.Errorf("GetByID after quote = %d quotes, want 1", got.QuoteCount)
+	}
+
+	// いいねのフックでいいね数が変わる
+	like := &schema.Like{UserID: bob.ID, TweetID: tweet.ID}
+	if err := like.Insert(ctx, db, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if got := get(bob.ID); got.LikeCount != 1 {
+		t.Errorf("GetByID after like = %d likes, want 1", got.LikeCount)
+	}
+	if got := get(0); got.LikeCount != 1 {
+		t.Errorf("GetByID by a guest after like = %d likes, want 1", got.LikeCount)
+	}
+
+	// 投稿者の変更はキャッシュした全てのツイートに反映される
+	alice.DisplayName = "Alice"
+	if _, err := alice.Update(ctx, db, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if got := get(bob.ID); got.User.DisplayName != "Alice" {
+		t.Errorf("author after update = %+v, want Alice", got.User)
+	}
+
+	// ブロックすると閲覧者からは見えなくなる
+	block := &schema.Block{BlockerID: bob.ID, BlockedID: alice.ID}
+	if err := block.Insert(ctx, db, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := repo.GetByID(ctx, tweet.ID, bob.ID); !errors.Is(err, sql.ErrNoRows) {
+		t.Errorf("GetByID after block err = %v, want sql.ErrNoRows", err)
+	}
+
+	if err := repo.Delete(ctx, tweet.ID); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if _, err := repo.GetByID(ctx, tweet.ID, 0); !errors.Is(err, sql.ErrNoRows) {
+		t.Errorf("GetByID after Delete err = %v, want sql.ErrNoRows", err)
+	}
+}
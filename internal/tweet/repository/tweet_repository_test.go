@@ -0,0 +1,273 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+	"todoapp/internal/dbtest"
+	"todoapp/internal/schema"
+	"todoapp/internal/tweet/model"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+func TestTweetRepositoryThread(t *testing.T) {
+	ctx := context.Background()
+	db := dbtest.New(t)
+	repo := NewTweetRepository(db, db)
+
+	var users []int
+	for _, name := range []string{"alice", "bob", "carol"} {
+		u := &schema.User{Username: name, DisplayName: name, Email: name + "@example.com", PasswordHash: "hash"}
+		if err := u.Insert(ctx, db, boil.Infer()); err != nil {
+			t.Fatal(err)
+		}
+		users = append(users, u.ID)
+	}
+	alice, bob, carol := users[0], users[1], users[2]
+
+	create := func(userID int, replyTo *model.Tweet) *model.Tweet {
+		t.Helper()
+		tweet, err := repo.Create(ctx, userID, &model.CreateTweetRequest{Content: "hello"}, replyTo)
+		if err != nil {
+			t.Fatalf("Create: %v", err)
+		}
+		return tweet
+	}
+
+	// root ─┬ bobReply ─ aliceReply
+	//       ├ carolReply ─ bobReply2 (carolReply は後で削除)
+	//       ├ aliceSelf (投稿者自身のリプライ)
+	//       └ deletedLeaf (削除済みでリプライなし)
+	root := create(alice, nil)
+	bobReply := create(bob, root)
+	aliceReply := create(alice, bobReply)
+	carolReply := create(carol, root)
+	bobReply2 := create(bob, carolReply)
+	aliceSelf := create(alice, root)
+	deletedLeaf := create(bob, root)
+
+	if aliceReply.ConversationID == nil || *aliceReply.ConversationID != root.ID || *aliceReply.ReplyToTweetID != bobReply.ID {
+		t.Errorf("nested reply = %+v, want conversation %d and parent %d", aliceReply, root.ID, bobReply.ID)
+	}
+	if aliceReply.User == nil || aliceReply.User.Username != "alice" {
+		t.Errorf("author = %+v, want alice", aliceReply.User)
+	}
+	for _, id := range []int{carolReply.ID, deletedLeaf.ID} {
+		if err := repo.Delete(ctx, id); err != nil {
+			t.Fatalf("Delete: %v", err)
+		}
+	}
+
+	got, err := repo.GetByID(ctx, root.ID, alice)
+	if err != nil || got.ReplyCount != 2 {
+		t.Errorf("GetByID = %+v, %v, want 2 replies (deleted ones are not counted)", got, err)
+	}
+	if _, err := repo.GetByID(ctx, carolReply.ID, alice); err == nil {
+		t.Error("GetByID of a deleted tweet succeeded")
+	}
+	if deleted, err := repo.GetWithDeleted(ctx, carolReply.ID, 0); err != nil || !deleted.Unavailable || deleted.Content != "" {
+		t.Errorf("GetWithDeleted = %+v, %v, want unavailable", deleted, err)
+	}
+
+	replies, err := repo.ListReplies(ctx, []int{root.ID, bobReply.ID}, 0, 10, alice)
+	if err != nil {
+		t.Fatalf("ListReplies: %v", err)
+	}
+	// 投稿者自身のリプライ、リプライの多いもの、古いものの順。削除済みはリプライがある場合だけ含める
+	wantIDs := []int{aliceSelf.ID, bobReply.ID, carolReply.ID}
+	if ids := tweetIDs(replies[root.ID]); !equalIDs(ids, wantIDs) {
+		t.Errorf("replies to root = %v, want %v", ids, wantIDs)
+	}
+	if placeholder := replies[root.ID][2]; !placeholder.Unavailable || placeholder.ReplyCount != 1 || placeholder.User != nil {
+		t.Errorf("deleted reply = %+v, want unavailable with 1 reply", placeholder)
+	}
+	if ids := tweetIDs(replies[bobReply.ID]); !equalIDs(ids, []int{aliceReply.ID}) {
+		t.Errorf("replies to bobReply = %v, want [%d]", ids, aliceReply.ID)
+	}
+
+	page, err := repo.ListReplies(ctx, []int{root.ID}, 1, 1, alice)
+	if err != nil {
+		t.Fatalf("ListReplies: %v", err)
+	}
+	if ids := tweetIDs(page[root.ID]); !equalIDs(ids, []int{bobReply.ID}) {
+		t.Errorf("second page = %v, want [%d]", ids, bobReply.ID)
+	}
+
+	ancestors, err := repo.ListAncestors(ctx, bobReply2, 10, alice)
+	if err != nil {
+		t.Fatalf("ListAncestors: %v", err)
+	}
+	if ids := tweetIDs(ancestors); !equalIDs(ids, []int{root.ID, carolReply.ID}) || !ancestors[1].Unavailable {
+		t.Errorf("ancestors = %+v, want root and the deleted carolReply", ancestors)
+	}
+	if ancestors, err := repo.ListAncestors(ctx, bobReply2, 1, alice); err != nil || !equalIDs(tweetIDs(ancestors), []int{carolReply.ID}) {
+		t.Errorf("ListAncestors with limit 1 = %v, %v, want only the parent", tweetIDs(ancestors), err)
+	}
+
+	// 物理削除された親は存在しないツイートとして含める
+	if _, err := schema.Tweets(qm.WithDeleted(), schema.TweetWhere.ID.EQ(root.ID)).DeleteAll(ctx, db, true); err != nil {
+		t.Fatal(err)
+	}
+	ancestors, err = repo.ListAncestors(ctx, bobReply2, 10, alice)
+	if err != nil {
+		t.Fatalf("ListAncestors: %v", err)
+	}
+	if ids := tweetIDs(ancestors); !equalIDs(ids, []int{root.ID, carolReply.ID}) || !ancestors[0].Unavailable || ancestors[0].ConversationID != nil {
+		t.Errorf("ancestors after purge = %+v, want a placeholder for the root", ancestors)
+	}
+
+	// 投稿者が退会中のツイートは表示しない
+	if _, err := schema.Users(schema.UserWhere.ID.EQ(bob)).UpdateAll(ctx, db, schema.M{schema.UserColumns.DeletedAt: null.TimeFrom(time.Now())}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := repo.GetByID(ctx, bobReply.ID, alice); err == nil {
+		t.Error("GetByID of a deactivated user's tweet succeeded")
+	}
+}
+
+func TestTweetRepositoryTimeline(t *testing.T) {
+	ctx := context.Background()
+	db := dbtest.New(t)
+	repo := NewTweetRepository(db, db)
+
+	var users []int
+	for _, name := range []string{"alice", "bob", "carol", "dave"} {
+		u := &schema.User{Username: name, DisplayName: name, Email: name + "@example.com", PasswordHash: "hash"}
+		if err := u.Insert(ctx, db, boil.Infer()); err != nil {
+			t.Fatal(err)
+		}
+		users = append(users, u.ID)
+	}
+	alice, bob, carol, dave := users[0], users[1], users[2], users[3]
+	for _, following := range []int{bob, carol} {
+		f := &schema.Follow{FollowerID: alice, FollowingID: following}
+		if err := f.Insert(ctx, db, boil.Infer()); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// 並び順が日時の精度に左右されないように、秒単位でずらした日時を設定する
+	base := time.Now().UTC().Add(-time.Hour).Truncate(time.Second)
+	at := func(minutes int) time.Time { return base.Add(time.Duration(minutes) * time.Minute) }
+	post := func(userID int, req *model.CreateTweetRequest, minutes int) *model.Tweet {
+		t.Helper()
+		tweet, err := repo.Create(ctx, userID, req, nil)
+		if err != nil {
+			t.Fatalf("Create: %v", err)
+		}
+		if _, err := schema.Tweets(schema.TweetWhere.ID.EQ(tweet.ID)).UpdateAll(ctx, db, schema.M{schema.TweetColumns.CreatedAt: at(minutes)}); err != nil {
+			t.Fatal(err)
+		}
+		return tweet
+	}
+	retweet := func(userID, tweetID, minutes int) {
+		t.Helper()
+		if err := repo.CreateRetweet(ctx, userID, tweetID); err != nil {
+			t.Fatalf("CreateRetweet: %v", err)
+		}
+		_, err := schema.Retweets(schema.RetweetWhere.UserID.EQ(userID), schema.RetweetWhere.TweetID.EQ(tweetID)).
+			UpdateAll(ctx, db, schema.M{schema.RetweetColumns.CreatedAt: at(minutes)})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	daveTweet := post(dave, &model.CreateTweetRequest{Content: "dave"}, 0)
+	bobTweet := post(bob, &model.CreateTweetRequest{Content: "bob"}, 1)
+	retweet(bob, daveTweet.ID, 2)
+	retweet(alice, daveTweet.ID, 3)
+	retweet(carol, daveTweet.ID, 4)
+	retweet(dave, bobTweet.ID, 5) // フォローしていないユーザーのリツイートは表示しない
+	quote := post(alice, &model.CreateTweetRequest{Content: "quote", QuoteTweetID: &bobTweet.ID}, 6)
+
+	if err := repo.CreateRetweet(ctx, alice, daveTweet.ID); err == nil {
+		t.Error("CreateRetweet twice succeeded")
+	}
+
+	items, err := repo.ListTimeline(ctx, alice, nil, 10)
+	if err != nil {
+		t.Fatalf("ListTimeline: %v", err)
+	}
+	// 同じツイートは最後にリツイートされた位置に1件だけ表示する
+	if ids := itemIDs(items); !equalIDs(ids, []int{quote.ID, daveTweet.ID, bobTweet.ID}) {
+		t.Fatalf("timeline = %v, want [%d %d %d]", ids, quote.ID, daveTweet.ID, bobTweet.ID)
+	}
+	if q := items[0].Tweet; q.QuotedTweet == nil || q.QuotedTweet.ID != bobTweet.ID || q.QuotedTweet.Content != "bob" || items[0].RetweetedBy != nil {
+		t.Errorf("quote = %+v, want bob's tweet quoted", items[0])
+	}
+	if d := items[1]; d.RetweetedBy == nil || d.RetweetedBy.ID != carol || d.RetweetedByOthers != 2 ||
+		d.Tweet.RetweetCount != 3 || !d.Tweet.RetweetedByMe || !d.SortAt.Equal(at(4)) {
+		t.Errorf("retweeted = %+v (by %+v), want by carol and 2 others, 3 retweets, retweeted by me", d, d.RetweetedBy)
+	}
+	if b := items[2]; b.RetweetedBy != nil || b.Tweet.RetweetCount != 1 || b.Tweet.QuoteCount != 1 || b.Tweet.RetweetedByMe {
+		t.Errorf("bob's tweet = %+v (tweet %+v), want 1 retweet and 1 quote without attribution", b, b.Tweet)
+	}
+
+	next, err := repo.ListTimeline(ctx, alice, &model.TimelineCursor{SortAt: items[1].SortAt, TweetID: items[1].Tweet.ID}, 10)
+	if err != nil {
+		t.Fatalf("ListTimeline: %v", err)
+	}
+	if ids := itemIDs(next); !equalIDs(ids, []int{bobTweet.ID}) {
+		t.Errorf("next page = %v, want [%d]", ids, bobTweet.ID)
+	}
+
+	// リツイートを取り消すと元の位置に戻る
+	if err := repo.DeleteRetweet(ctx, carol, daveTweet.ID); err != nil {
+		t.Fatalf("DeleteRetweet: %v", err)
+	}
+	if err := repo.DeleteRetweet(ctx, carol, daveTweet.ID); !errors.Is(err, sql.ErrNoRows) {
+		t.Errorf("DeleteRetweet twice = %v, want sql.ErrNoRows", err)
+	}
+	items, err = repo.ListTimeline(ctx, alice, nil, 10)
+	if err != nil {
+		t.Fatalf("ListTimeline: %v", err)
+	}
+	if d := items[1]; d.Tweet.ID != daveTweet.ID || d.RetweetedBy == nil || d.RetweetedBy.ID != alice || d.RetweetedByOthers != 1 || !d.SortAt.Equal(at(3)) {
+		t.Errorf("after unretweet = %+v, want retweeted by alice and 1 other", d)
+	}
+
+	// 引用元が削除されても引用ツイートは残り、引用元は削除済みとして表示する
+	if err := repo.Delete(ctx, bobTweet.ID); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	items, err = repo.ListTimeline(ctx, alice, nil, 10)
+	if err != nil {
+		t.Fatalf("ListTimeline: %v", err)
+	}
+	if ids := itemIDs(items); !equalIDs(ids, []int{quote.ID, daveTweet.ID}) || !items[0].Tweet.QuotedTweet.Unavailable {
+		t.Errorf("timeline after delete = %v, quoted %+v, want the quoted tweet unavailable", ids, items[0].Tweet.QuotedTweet)
+	}
+}
+
+func itemIDs(items []*model.TimelineItem) []int {
+	ids := make([]int, len(items))
+	for i, item := range items {
+		ids[i] = item.Tweet.ID
+	}
+	return ids
+}
+
+func tweetIDs(tweets []*model.Tweet) []int {
+	ids := make([]int, len(tweets))
+	for i, tweet := range tweets {
+		ids[i] = tweet.ID
+	}
+	return ids
+}
+
+func equalIDs(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,122 @@
+package usecase
+
+import (
+	"context"
+	"todoapp/internal/tracing"
+	"todoapp/internal/tweet/model"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracedTweetUsecase はユースケースの呼び出しごとにスパンを記録するデコレーター
+type tracedTweetUsecase struct {
+	next TweetUsecase
+}
+
+func NewTracedTweetUsecase(next TweetUsecase) TweetUsecase {
+	return &tracedTweetUsecase{next: next}
+}
+
+func (u *tracedTweetUsecase) Create(ctx context.Context, userID int, req *model.CreateTweetRequest) (*model.Tweet, error) {
+	ctx, span := startSpan(ctx, "TweetUsecase.Create", attribute.Int("user.id", userID))
+	defer span.End()
+
+	tweet, err := u.next.Create(ctx, userID, req)
+	tracing.RecordError(span, err)
+	return tweet, err
+}
+
+func (u *tracedTweetUsecase) Get(ctx context.Context, viewerID, id int) (*model.Tweet, error) {
+	ctx, span := startSpan(ctx, "TweetUsecase.Get", attribute.Int("user.id", viewerID), attribute.Int("tweet.id", id))
+	defer span.End()
+
+	tweet, err := u.next.Get(ctx, viewerID, id)
+	tracing.RecordError(span, err)
+	return tweet, err
+}
+
+func (u *tracedTweetUsecase) Delete(ctx context.Context, userID, id int) error {
+	ctx, span := startSpan(ctx, "TweetUsecase.Delete", attribute.Int("user.id", userID), attribute.Int("tweet.id", id))
+	defer span.End()
+
+	err := u.next.Delete(ctx, userID, id)
+	tracing.RecordError(span, err)
+	return err
+}
+
+func (u *tracedTweetUsecase) Reply(ctx context.Context, userID, parentID int, req *model.CreateTweetRequest) (*model.Tweet, error) {
+	ctx, span := startSpan(ctx, "TweetUsecase.Reply", attribute.Int("user.id", userID), attribute.Int("tweet.id", parentID))
+	defer span.End()
+
+	tweet, err := u.next.Reply(ctx, userID, parentID, req)
+	tracing.RecordError(span, err)
+	return tweet, err
+}
+
+func (u *tracedTweetUsecase) GetThread(ctx context.Context, viewerID, id int, req model.ThreadRequest) (*model.Thread, error) {
+	ctx, span := startSpan(ctx, "TweetUsecase.GetThread", attribute.Int("user.id", viewerID), attribute.Int("tweet.id", id))
+	defer span.End()
+
+	thread, err := u.next.GetThread(ctx, viewerID, id, req)
+	tracing.RecordError(span, err)
+	return thread, err
+}
+
+func (u *tracedTweetUsecase) Retweet(ctx context.Context, userID, id int) error {
+	ctx, span := startSpan(ctx, "TweetUsecase.Retweet", attribute.Int("user.id", userID), attribute.Int("tweet.id", id))
+	defer span.End()
+
+	err := u.next.Retweet(ctx, userID, id)
+	tracing.RecordError(span, err)
+	return err
+}
+
+func (u *tracedTweetUsecase) Unretweet(ctx context.Context, userID, id int) error {
+	ctx, span := startSpan(ctx, "TweetUsecase.Unretweet", attribute.Int("user.id", userID), attribute.Int("tweet.id", id))
+	defer span.End()
+
+	err := u.next.Unretweet(ctx, userID, id)
+	tracing.RecordError(span, err)
+	return err
+}
+
+func (u *tracedTweetUsecase) Like(ctx context.Context, userID, id int) error {
+	ctx, span := startSpan(ctx, "TweetUsecase.Like", attribute.Int("user.id", userID), attribute.Int("tweet.id", id))
+	defer span.End()
+
+	err := u.next.Like(ctx, userID, id)
+	tracing.RecordError(span, err)
+	return err
+}
+
+func (u *tracedTweetUsecase) Unlike(ctx context.Context, userID, id int) error {
+	ctx, span := startSpan(ctx, "TweetUsecase.Unlike", attribute.Int("user.id", userID), attribute.Int("tweet.id", id))
+	defer span.End()
+
+	err := u.next.Unlike(ctx, userID, id)
+	tracing.RecordError(span, err)
+	return err
+}
+
+func (u *tracedTweetUsecase) GetTimeline(ctx context.Context, userID int, req model.TimelineRequest) (*model.Timeline, error) {
+	ctx, span := startSpan(ctx, "TweetUsecase.GetTimeline", attribute.Int("user.id", userID))
+	defer span.End()
+
+	timeline, err := u.next.GetTimeline(ctx, userID, req)
+	tracing.RecordError(span, err)
+	return timeline, err
+}
+
+func (u *tracedTweetUsecase) ListByHashtag(ctx context.Context, viewerID int, tag string, req model.HashtagRequest) (*model.HashtagTweets, error) {
+	ctx, span := startSpan(ctx, "TweetUsecase.ListByHashtag", attribute.Int("user.id", viewerID), attribute.String("hashtag", tag))
+	defer span.End()
+
+	tweets, err := u.next.ListByHashtag(ctx, viewerID, tag, req)
+	tracing.RecordError(span, err)
+	return tweets, err
+}
+
+func startSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return tracing.Tracer().Start(ctx, name, trace.WithAttributes(attrs...))
+}
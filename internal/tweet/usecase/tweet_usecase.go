@@ -0,0 +1,354 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+	"todoapp/internal/domain"
+	"todoapp/internal/infrastructure"
+	"todoapp/internal/tweet/entity"
+	"todoapp/internal/tweet/model"
+	"todoapp/internal/tweet/repository"
+	usermodel "todoapp/internal/user/model"
+)
+
+const (
+	defaultThreadLimit = 20
+	maxThreadLimit     = 100
+	// maxAncestors はスレッドに含める親ツイートの最大数
+	maxAncestors = 50
+	// threadDepth はスレッドに含めるリプライの階層の数(直接のリプライが1階層目)
+	threadDepth = 3
+	// previewReplies は2階層目以降で1つのツイートに含めるリプライの最大数
+	previewReplies = 3
+
+	defaultTimelineLimit = 20
+	maxTimelineLimit     = 100
+
+	defaultHashtagLimit = 20
+	maxHashtagLimit     = 100
+)
+
+// CountUpdater は users のツイート数を更新する(userrepository.UserRepository が満たす)
+type CountUpdater interface {
+	AddCounts(ctx context.Context, userID int, delta usermodel.UserCounts) error
+}
+
+// 読み取りのメソッドの viewerID は閲覧しているユーザーで、retweeted_by_me の判定に使う。
+// 閲覧者(書き込みでは userID)とどちらかがブロックしているユーザーのツイートは存在しないものとして扱い、
+// リプライ・引用・リツイート・いいねもできない
+type TweetUsecase interface {
+	// Create はツイートを作成する。QuoteTweetID を指定すると引用ツイートにする。
+	// 引用元が存在しない場合は sql.ErrNoRows、削除済みの場合は Gone を返す
+	Create(ctx context.Context, userID int, req *model.CreateTweetRequest) (*model.Tweet, error)
+	Get(ctx context.Context, viewerID, id int) (*model.Tweet, error)
+	// Delete は userID のツイートを削除する。他のユーザーのツイートは Forbidden を返す。
+	// リプライは削除されず、スレッドでは削除したツイートが Unavailable として表示される
+	Delete(ctx context.Context, userID, id int) error
+	// Reply は parentID へのリプライを作成する。親が削除済みの場合は Gone を返す
+	Reply(ctx context.Context, userID, parentID int, req *model.CreateTweetRequest) (*model.Tweet, error)
+	// GetThread は id の親ツイートと、順位付けしたリプライのツリーを返す
+	GetThread(ctx context.Context, viewerID, id int, req model.ThreadRequest) (*model.Thread, error)
+	// Retweet は id をリツイートする。既にリツイートしている場合は Conflict を返す
+	Retweet(ctx context.Context, userID, id int) error
+	// Unretweet はリツイートを取り消す。リツイートしていない場合は sql.ErrNoRows を返す
+	Unretweet(ctx context.Context, userID, id int) error
+	// Like は id にいいねする。既にいいねしている場合は Conflict を返す
+	Like(ctx context.Context, userID, id int) error
+	// Unlike はいいねを取り消す。いいねしていない場合は sql.ErrNoRows を返す
+	Unlike(ctx context.Context, userID, id int) error
+	// GetTimeline は userID とフォロー中のユーザーのツイートとリツイートを新しい順に返す
+	GetTimeline(ctx context.Context, userID int, req model.TimelineRequest) (*model.Timeline, error)
+	// ListByHashtag はハッシュタグを含むツイートを新しい順に返す。
+	// tag は # の有無や全角・半角、大文字・小文字を区別しない
+	ListByHashtag(ctx context.Context, viewerID int, tag string, req model.HashtagRequest) (*model.HashtagTweets, error)
+}
+
+type tweetUsecase struct {
+	repo      repository.TweetRepository
+	counts    CountUpdater
+	txManager infrastructure.TxManager
+}
+
+func NewTweetUsecase(repo repository.TweetRepository, counts CountUpdater, txManager infrastructure.TxManager) TweetUsecase {
+	return &tweetUsecase{
+		repo:      repo,
+		counts:    counts,
+		txManager: txManager,
+	}
+}
+
+func (u *tweetUsecase) Create(ctx context.Context, userID int, req *model.CreateTweetRequest) (*model.Tweet, error) {
+	return u.create(ctx, userID, req, nil)
+}
+
+func (u *tweetUsecase) Get(ctx context.Context, viewerID, id int) (*model.Tweet, error) {
+	return u.repo.GetByID(ctx, id, viewerID)
+}
+
+func (u *tweetUsecase) Delete(ctx context.Context, userID, id int) error {
+	return u.txManager.RunInTx(ctx, func(ctx context.Context) error {
+		tweet, err := u.repo.GetByID(ctx, id, userID)
+		if err != nil {
+			return err
+		}
+		if tweet.UserID != userID {
+			return domain.Forbidden("cannot delete another user's tweet")
+		}
+
+		if err := u.repo.Delete(ctx, id); err != nil {
+			return err
+		}
+		return u.counts.AddCounts(ctx, userID, usermodel.UserCounts{Tweets: -1})
+	})
+}
+
+func (u *tweetUsecase) Reply(ctx context.Context, userID, parentID int, req *model.CreateTweetRequest) (*model.Tweet, error) {
+	var tweet *model.Tweet
+	err := u.txManager.RunInTx(ctx, func(ctx context.Context) error {
+		parent, err := u.repo.GetWithDeleted(ctx, parentID, userID)
+		if err != nil {
+			return err
+		}
+		// 削除済みのツイートの下に会話を続けさせない(既存のリプライへのリプライはできる)
+		if parent.Unavailable {
+			return domain.Gone("tweet has been deleted")
+		}
+
+		tweet, err = u.create(ctx, userID, req, parent)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return tweet, nil
+}
+
+func (u *tweetUsecase) create(ctx context.Context, userID int, req *model.CreateTweetRequest, replyTo *model.Tweet) (*model.Tweet, error) {
+	if err := validate(req); err != nil {
+		return nil, err
+	}
+
+	var tweet *model.Tweet
+	err := u.txManager.RunInTx(ctx, func(ctx context.Context) error {
+		if req.QuoteTweetID != nil {
+			quoted, err := u.repo.GetWithDeleted(ctx, *req.QuoteTweetID, userID)
+			if err != nil {
+				return err
+			}
+			if quoted.Unavailable {
+				return domain.Gone("quoted tweet has been deleted")
+			}
+		}
+
+		var err error
+		tweet, err = u.repo.Create(ctx, userID, req, replyTo)
+		if err != nil {
+			return err
+		}
+		return u.counts.AddCounts(ctx, userID, usermodel.UserCounts{Tweets: 1})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return tweet, nil
+}
+
+// validate は本文を正規化してから検証する。エンティティのオフセットは正規化した本文で数える
+func validate(req *model.CreateTweetRequest) error {
+	req.Content = entity.Normalize(req.Content)
+	if strings.TrimSpace(req.Content) == "" {
+		return domain.Invalid("content is required")
+	}
+	// 日本語や絵文字も1文字として数える
+	if entity.Length(req.Content) > model.MaxContentLength {
+		return domain.Invalid("content must be at most " + strconv.Itoa(model.MaxContentLength) + " characters")
+	}
+	return nil
+}
+
+func (u *tweetUsecase) GetThread(ctx context.Context, viewerID, id int, req model.ThreadRequest) (*model.Thread, error) {
+	if req.Limit <= 0 {
+		req.Limit = defaultThreadLimit
+	}
+	if req.Limit > maxThreadLimit {
+		req.Limit = maxThreadLimit
+	}
+	if req.Cursor < 0 {
+		req.Cursor = 0
+	}
+
+	tweet, err := u.repo.GetByID(ctx, id, viewerID)
+	if err != nil {
+		return nil, err
+	}
+	ancestors, err := u.repo.ListAncestors(ctx, tweet, maxAncestors, viewerID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 1件多く読み、続きがあるかを判定する
+	replies, err := u.repo.ListReplies(ctx, []int{id}, req.Cursor, req.Limit+1, viewerID)
+	if err != nil {
+		return nil, err
+	}
+	thread := &model.Thread{Ancestors: ancestors, Tweet: tweet, Replies: newNodes(replies[id])}
+	if len(thread.Replies) > req.Limit {
+		thread.Replies = thread.Replies[:req.Limit]
+		next := req.Cursor + req.Limit
+		thread.NextCursor = &next
+	}
+
+	// 2階層目以降は上位のリプライだけを階層ごとにまとめて読む
+	level := thread.Replies
+	for depth := 2; depth <= threadDepth && len(level) > 0; depth++ {
+		parentIDs := make([]int, len(level))
+		for i, node := range level {
+			parentIDs[i] = node.ID
+		}
+		children, err := u.repo.ListReplies(ctx, parentIDs, 0, previewReplies+1, viewerID)
+		if err != nil {
+			return nil, err
+		}
+
+		var next []*model.ThreadNode
+		for _, node := range level {
+			node.Replies = newNodes(children[node.ID])
+			if len(node.Replies) > previewReplies {
+				node.Replies = node.Replies[:previewReplies]
+				node.MoreReplies = true
+			}
+			next = append(next, node.Replies...)
+		}
+		level = next
+	}
+	// 最後の階層のリプライは読まないので、あるかどうかだけを返す
+	for _, node := range level {
+		node.MoreReplies = node.ReplyCount > 0
+	}
+
+	return thread, nil
+}
+
+func newNodes(tweets []*model.Tweet) []*model.ThreadNode {
+	nodes := make([]*model.ThreadNode, len(tweets))
+	for i, tweet := range tweets {
+		nodes[i] = &model.ThreadNode{Tweet: tweet}
+	}
+	return nodes
+}
+
+func (u *tweetUsecase) Retweet(ctx context.Context, userID, id int) error {
+	return u.txManager.RunInTx(ctx, func(ctx context.Context) error {
+		if _, err := u.repo.GetByID(ctx, id, userID); err != nil {
+			return err
+		}
+		retweeted, err := u.repo.HasRetweeted(ctx, userID, id)
+		if err != nil {
+			return err
+		}
+		if retweeted {
+			return domain.Conflict("already retweeted")
+		}
+		return u.repo.CreateRetweet(ctx, userID, id)
+	})
+}
+
+func (u *tweetUsecase) Unretweet(ctx context.Context, userID, id int) error {
+	// 元のツイートが削除済みでもリツイートは取り消せる
+	return u.repo.DeleteRetweet(ctx, userID, id)
+}
+
+func (u *tweetUsecase) Like(ctx context.Context, userID, id int) error {
+	return u.txManager.RunInTx(ctx, func(ctx context.Context) error {
+		if _, err := u.repo.GetByID(ctx, id, userID); err != nil {
+			return err
+		}
+		err := u.repo.CreateLike(ctx, userID, id)
+		if errors.Is(err, domain.ErrConflict) {
+			return domain.Conflict("already liked")
+		}
+		return err
+	})
+}
+
+func (u *tweetUsecase) Unlike(ctx context.Context, userID, id int) error {
+	// リツイートと同じく、元のツイートが削除済みでもいいねは取り消せる
+	return u.repo.DeleteLike(ctx, userID, id)
+}
+
+func (u *tweetUsecase) GetTimeline(ctx context.Context, userID int, req model.TimelineRequest) (*model.Timeline, error) {
+	if req.Limit <= 0 {
+		req.Limit = defaultTimelineLimit
+	}
+	if req.Limit > maxTimelineLimit {
+		req.Limit = maxTimelineLimit
+	}
+	var before *model.TimelineCursor
+	if req.Cursor != "" {
+		cursor, err := parseTimelineCursor(req.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		before = cursor
+	}
+
+	// 1件多く読み、続きがあるかを判定する
+	items, err := u.repo.ListTimeline(ctx, userID, before, req.Limit+1)
+	if err != nil {
+		return nil, err
+	}
+	timeline := &model.Timeline{Items: items}
+	if len(items) > req.Limit {
+		timeline.Items = items[:req.Limit]
+		last := timeline.Items[req.Limit-1]
+		next := formatTimelineCursor(last.SortAt, last.Tweet.ID)
+		timeline.NextCursor = &next
+	}
+	return timeline, nil
+}
+
+func (u *tweetUsecase) ListByHashtag(ctx context.Context, viewerID int, tag string, req model.HashtagRequest) (*model.HashtagTweets, error) {
+	tag = entity.NormalizeTag(tag)
+	if tag == "" {
+		return nil, domain.Invalid("hashtag is required")
+	}
+	if req.Limit <= 0 {
+		req.Limit = defaultHashtagLimit
+	}
+	if req.Limit > maxHashtagLimit {
+		req.Limit = maxHashtagLimit
+	}
+
+	// 1件多く読み、続きがあるかを判定する
+	tweets, err := u.repo.ListByHashtag(ctx, tag, req.BeforeID, req.Limit+1, viewerID)
+	if err != nil {
+		return nil, err
+	}
+	resp := &model.HashtagTweets{Tweets: tweets}
+	if len(tweets) > req.Limit {
+		resp.Tweets = tweets[:req.Limit]
+		next := resp.Tweets[req.Limit-1].ID
+		resp.NextBeforeID = &next
+	}
+	return resp, nil
+}
+
+// タイムラインのカーソルは "<表示日時のUnixナノ秒>_<ツイートID>"
+func formatTimelineCursor(sortAt time.Time, tweetID int) string {
+	return strconv.FormatInt(sortAt.UnixNano(), 10) + "_" + strconv.Itoa(tweetID)
+}
+
+func parseTimelineCursor(s string) (*model.TimelineCursor, error) {
+	nanos, id, ok := strings.Cut(s, "_")
+	if ok {
+		n, err1 := strconv.ParseInt(nanos, 10, 64)
+		tweetID, err2 := strconv.Atoi(id)
+		if err1 == nil && err2 == nil {
+			return &model.TimelineCursor{SortAt: time.Unix(0, n).UTC(), TweetID: tweetID}, nil
+		}
+	}
+	return nil, domain.Invalid("invalid cursor")
+}
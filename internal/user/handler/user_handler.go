@@ -1,10 +1,13 @@
 package handler
 
 import (
+	"context"
 	"database/sql"
+	"errors"
 	"net/http"
 	"strconv"
 	"strings"
+	"todoapp/internal/domain"
 	"todoapp/internal/user/model"
 	"todoapp/internal/user/usecase"
 
@@ -16,9 +19,9 @@ type UserHandler struct {
 	usecase usecase.UserUsecase
 }
 
-func NewUserHandler(db *sql.DB) *UserHandler {
+func NewUserHandler(u usecase.UserUsecase) *UserHandler {
 	return &UserHandler{
-		usecase: usecase.NewUserUsecase(db, "your-secret-key"), // TODO: 環境変数から取得
+		usecase: u,
 	}
 }
 
@@ -28,9 +31,9 @@ func (h *UserHandler) Register(c echo.Context) error {
 		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request"})
 	}
 
-	user, err := h.usecase.Register(&req)
+	user, err := h.usecase.Register(c.Request().Context(), &req)
 	if err != nil {
-		if err.Error() == "email already exists" {
+		if errors.Is(err, domain.ErrConflict) {
 			return c.JSON(http.StatusConflict, map[string]string{"error": err.Error()})
 		}
 		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
@@ -45,11 +48,35 @@ func (h *UserHandler) Login(c echo.Context) error {
 		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request"})
 	}
 
-	resp, err := h.usecase.Login(&req)
+	resp, err := h.usecase.Login(c.Request().Context(), &req)
 	if err != nil {
 		if err.Error() == "invalid email or password" {
 			return c.JSON(http.StatusUnauthorized, map[string]string{"error": err.Error()})
 		}
+		if errors.Is(err, domain.ErrForbidden) {
+			return c.JSON(http.StatusForbidden, map[string]string{"error": err.Error()})
+		}
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// Reactivate は退会中のユーザーを元に戻してログインする。退会中でなければ通常のログインと同じ
+func (h *UserHandler) Reactivate(c echo.Context) error {
+	var req model.LoginRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request"})
+	}
+
+	resp, err := h.usecase.Reactivate(c.Request().Context(), &req)
+	if err != nil {
+		if err.Error() == "invalid email or password" {
+			return c.JSON(http.StatusUnauthorized, map[string]string{"error": err.Error()})
+		}
+		if errors.Is(err, domain.ErrGone) {
+			return c.JSON(http.StatusGone, map[string]string{"error": err.Error()})
+		}
 		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
 	}
 
@@ -63,7 +90,7 @@ func (h *UserHandler) GetProfile(c echo.Context) error {
 	}
 
 	currentUserID := getUserIDFromToken(c)
-	profile, err := h.usecase.GetProfile(userID, currentUserID)
+	profile, err := h.usecase.GetProfile(c.Request().Context(), userID, currentUserID)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return c.JSON(http.StatusNotFound, map[string]string{"error": "User not found"})
@@ -81,17 +108,127 @@ func (h *UserHandler) UpdateProfile(c echo.Context) error {
 	}
 
 	userID := getUserIDFromToken(c)
-	user, err := h.usecase.UpdateProfile(userID, &req)
+	user, err := h.usecase.UpdateProfile(c.Request().Context(), userID, &req)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return c.JSON(http.StatusNotFound, map[string]string{"error": "User not found"})
 		}
+		if errors.Is(err, domain.ErrConflict) {
+			return c.JSON(http.StatusConflict, map[string]string{"error": err.Error()})
+		}
 		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
 	}
 
 	return c.JSON(http.StatusOK, user)
 }
 
+// Deactivate はログイン中のユーザーを退会させる
+func (h *UserHandler) Deactivate(c echo.Context) error {
+	userID := getUserIDFromToken(c)
+	if err := h.usecase.Deactivate(c.Request().Context(), userID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return c.JSON(http.StatusNotFound, map[string]string{"error": "User not found"})
+		}
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// Follow はログイン中のユーザーが :id のユーザーをフォローする
+func (h *UserHandler) Follow(c echo.Context) error {
+	targetID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid user ID"})
+	}
+
+	if err := h.usecase.Follow(c.Request().Context(), getUserIDFromToken(c), targetID); err != nil {
+		return relationErrorResponse(c, err)
+	}
+
+	return c.NoContent(http.StatusCreated)
+}
+
+func (h *UserHandler) Unfollow(c echo.Context) error {
+	targetID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid user ID"})
+	}
+
+	if err := h.usecase.Unfollow(c.Request().Context(), getUserIDFromToken(c), targetID); err != nil {
+		return relationErrorResponse(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// Block はログイン中のユーザーが :id のユーザーをブロックする。お互いのフォローは取り消される
+func (h *UserHandler) Block(c echo.Context) error {
+	return h.changeRelation(c, h.usecase.Block, http.StatusCreated)
+}
+
+func (h *UserHandler) Unblock(c echo.Context) error {
+	return h.changeRelation(c, h.usecase.Unblock, http.StatusNoContent)
+}
+
+// ListBlocks はログイン中のユーザーがブロックしているユーザーを新しい順に返す
+func (h *UserHandler) ListBlocks(c echo.Context) error {
+	list, err := h.usecase.ListBlocks(c.Request().Context(), getUserIDFromToken(c))
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, list)
+}
+
+// Mute はログイン中のユーザーが :id のユーザーをミュートする
+func (h *UserHandler) Mute(c echo.Context) error {
+	return h.changeRelation(c, h.usecase.Mute, http.StatusCreated)
+}
+
+func (h *UserHandler) Unmute(c echo.Context) error {
+	return h.changeRelation(c, h.usecase.Unmute, http.StatusNoContent)
+}
+
+// ListMutes はログイン中のユーザーがミュートしているユーザーを新しい順に返す
+func (h *UserHandler) ListMutes(c echo.Context) error {
+	list, err := h.usecase.ListMutes(c.Request().Context(), getUserIDFromToken(c))
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, list)
+}
+
+// changeRelation はログイン中のユーザーと :id のユーザーの関係を change で変更し、成功すれば status を返す
+func (h *UserHandler) changeRelation(c echo.Context, change func(ctx context.Context, userID, targetID int) error, status int) error {
+	targetID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid user ID"})
+	}
+
+	if err := change(c.Request().Context(), getUserIDFromToken(c), targetID); err != nil {
+		return relationErrorResponse(c, err)
+	}
+
+	return c.NoContent(status)
+}
+
+// relationErrorResponse はフォロー・ブロック・ミュートのエラーを返す
+func relationErrorResponse(c echo.Context, err error) error {
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return c.JSON(http.StatusNotFound, map[string]string{"error": "User not found"})
+	case errors.Is(err, domain.ErrInvalid):
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	case errors.Is(err, domain.ErrForbidden):
+		return c.JSON(http.StatusForbidden, map[string]string{"error": err.Error()})
+	case errors.Is(err, domain.ErrConflict):
+		return c.JSON(http.StatusConflict, map[string]string{"error": err.Error()})
+	}
+	return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+}
+
 func (h *UserHandler) AuthMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
 	return func(c echo.Context) error {
 		authHeader := c.Request().Header.Get("Authorization")
@@ -118,6 +255,14 @@ func (h *UserHandler) AuthMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
 		}
 
 		userID := int(claims["user_id"].(float64))
+
+		// 退会前に発行されたトークンは、有効期限内でも使えないようにする
+		if err := h.usecase.CheckActive(c.Request().Context(), userID); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Account is not active"})
+			}
+			return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+		}
 		c.Set("user_id", userID)
 
 		return next(c)
@@ -0,0 +1,247 @@
+package handler_test
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	audithandler "todoapp/internal/audit/handler"
+	"todoapp/internal/router"
+	"todoapp/internal/testutil"
+	"todoapp/internal/user/model"
+
+	"github.com/labstack/echo/v4"
+)
+
+// newTestServer はマイグレーション適用済みの独立したデータベースにつながったサーバーを返す
+func newTestServer(t *testing.T) *echo.Echo {
+	t.Helper()
+	env := testutil.NewEnv(t)
+	return env.Server(router.Handlers{Audit: audithandler.NewAuditHandler(env.Audit, nil)})
+}
+
+func TestRegister(t *testing.T) {
+	e := newTestServer(t)
+	testutil.RegisterAndLogin(t, e, "alice")
+
+	tests := []struct {
+		name       string
+		body       string
+		wantStatus int
+	}{
+		{"success", `{"username":"bob","display_name":"Bob","email":"bob@example.com","password":"password123"}`, http.StatusCreated},
+		{"duplicate email", `{"username":"alice2","display_name":"Alice","email":"alice@example.com","password":"password123"}`, http.StatusConflict},
+		{"duplicate username", `{"username":"alice","display_name":"Alice","email":"other@example.com","password":"password123"}`, http.StatusConflict},
+		{"invalid json", `{"username":`, http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := testutil.Do(t, e, http.MethodPost, "/register", "", tt.body)
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d, body = %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+			if rec.Code == http.StatusCreated && strings.Contains(rec.Body.String(), "password") {
+				t.Errorf("response leaks password hash: %s", rec.Body)
+			}
+		})
+	}
+}
+
+func TestLogin(t *testing.T) {
+	e := newTestServer(t)
+	testutil.RegisterAndLogin(t, e, "alice")
+
+	tests := []struct {
+		name       string
+		body       string
+		wantStatus int
+	}{
+		{"success", `{"email":"alice@example.com","password":"password123"}`, http.StatusOK},
+		{"wrong password", `{"email":"alice@example.com","password":"wrong-password"}`, http.StatusUnauthorized},
+		{"unknown email", `{"email":"missing@example.com","password":"password123"}`, http.StatusUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := testutil.Do(t, e, http.MethodPost, "/login", "", tt.body)
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d, body = %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+		})
+	}
+}
+
+func TestGetProfile(t *testing.T) {
+	e := newTestServer(t)
+	aliceID, aliceToken := testutil.RegisterAndLogin(t, e, "alice")
+
+	tests := []struct {
+		name       string
+		path       string
+		token      string
+		wantStatus int
+	}{
+		{"success", "/api/users/" + strconv.Itoa(aliceID), aliceToken, http.StatusOK},
+		{"missing token", "/api/users/" + strconv.Itoa(aliceID), "", http.StatusUnauthorized},
+		{"invalid token", "/api/users/" + strconv.Itoa(aliceID), "invalid", http.StatusUnauthorized},
+		{"not found", "/api/users/" + strconv.Itoa(aliceID+1000), aliceToken, http.StatusNotFound},
+		{"invalid id", "/api/users/abc", aliceToken, http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := testutil.Do(t, e, http.MethodGet, tt.path, tt.token, "")
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d, body = %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+			if rec.Code != http.StatusOK {
+				return
+			}
+			var profile model.UserProfile
+			testutil.Decode(t, rec, &profile)
+			if profile.User.ID != aliceID || profile.User.Username != "alice" {
+				t.Errorf("profile = %+v", profile)
+			}
+		})
+	}
+}
+
+func TestUpdateProfile(t *testing.T) {
+	e := newTestServer(t)
+	aliceID, aliceToken := testutil.RegisterAndLogin(t, e, "alice")
+
+	rec := testutil.Do(t, e, http.MethodPut, "/api/users/me", aliceToken, `{"display_name":"Alice Updated","bio":"hello"}`)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, body = %s", rec.Code, rec.Body)
+	}
+
+	rec = testutil.Do(t, e, http.MethodGet, "/api/users/"+strconv.Itoa(aliceID), aliceToken, "")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, body = %s", rec.Code, rec.Body)
+	}
+	var profile model.UserProfile
+	testutil.Decode(t, rec, &profile)
+	if profile.User.DisplayName != "Alice Updated" || profile.User.Bio == nil || *profile.User.Bio != "hello" {
+		t.Errorf("profile after update = %+v", profile.User)
+	}
+
+	if rec := testutil.Do(t, e, http.MethodPut, "/api/users/me", "", `{"display_name":"x"}`); rec.Code != http.StatusUnauthorized {
+		t.Errorf("without token: status = %d, want %d", rec.Code, http.StatusUnauthorized)
+	}
+}
+
+func TestDeactivateAndReactivate(t *testing.T) {
+	e := newTestServer(t)
+	aliceID, aliceToken := testutil.RegisterAndLogin(t, e, "alice")
+	_, bobToken := testutil.RegisterAndLogin(t, e, "bob")
+	credentials := `{"email":"alice@example.com","password":"password123"}`
+
+	if rec := testutil.Do(t, e, http.MethodDelete, "/api/users/me", aliceToken, ""); rec.Code != http.StatusNoContent {
+		t.Fatalf("deactivate: status = %d, body = %s", rec.Code, rec.Body)
+	}
+
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		token      string
+		body       string
+		wantStatus int
+	}{
+		{"profile is hidden", http.MethodGet, "/api/users/" + strconv.Itoa(aliceID), bobToken, "", http.StatusNotFound},
+		{"token is revoked", http.MethodGet, "/api/users/" + strconv.Itoa(aliceID), aliceToken, "", http.StatusUnauthorized},
+		{"login is blocked", http.MethodPost, "/login", "", credentials, http.StatusForbidden},
+		{"reactivate with wrong password", http.MethodPost, "/reactivate", "", `{"email":"alice@example.com","password":"wrong"}`, http.StatusUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := testutil.Do(t, e, tt.method, tt.path, tt.token, tt.body)
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d, body = %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+		})
+	}
+
+	rec := testutil.Do(t, e, http.MethodPost, "/reactivate", "", credentials)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("reactivate: status = %d, body = %s", rec.Code, rec.Body)
+	}
+	var resp model.LoginResponse
+	testutil.Decode(t, rec, &resp)
+
+	if rec := testutil.Do(t, e, http.MethodGet, "/api/users/"+strconv.Itoa(aliceID), resp.Token, ""); rec.Code != http.StatusOK {
+		t.Errorf("profile after reactivate: status = %d, body = %s", rec.Code, rec.Body)
+	}
+	if rec := testutil.Do(t, e, http.MethodPost, "/login", "", credentials); rec.Code != http.StatusOK {
+		t.Errorf("login after reactivate: status = %d, body = %s", rec.Code, rec.Body)
+	}
+}
+
+func TestBlockAndMute(t *testing.T) {
+	e := newTestServer(t)
+	aliceID, aliceToken := testutil.RegisterAndLogin(t, e, "alice")
+	bobID, bobToken := testutil.RegisterAndLogin(t, e, "bob")
+	alicePath := "/api/users/" + strconv.Itoa(aliceID)
+	bobPath := "/api/users/" + strconv.Itoa(bobID)
+
+	type step struct {
+		name       string
+		method     string
+		path       string
+		token      string
+		wantStatus int
+	}
+	run := func(steps []step) {
+		t.Helper()
+		for _, s := range steps {
+			if rec := testutil.Do(t, e, s.method, s.path, s.token, ""); rec.Code != s.wantStatus {
+				t.Fatalf("%s: status = %d, want %d, body = %s", s.name, rec.Code, s.wantStatus, rec.Body)
+			}
+		}
+	}
+	listIDs := func(path string) []int {
+		t.Helper()
+		rec := testutil.Do(t, e, http.MethodGet, path, aliceToken, "")
+		if rec.Code != http.StatusOK {
+			t.Fatalf("%s: status = %d, body = %s", path, rec.Code, rec.Body)
+		}
+		var resp model.UserList
+		testutil.Decode(t, rec, &resp)
+		ids := []int{}
+		for _, profile := range resp.Users {
+			ids = append(ids, profile.User.ID)
+		}
+		return ids
+	}
+
+	run([]step{
+		{"follow", http.MethodPost, bobPath + "/follow", aliceToken, http.StatusCreated},
+		{"mute", http.MethodPost, bobPath + "/mute", aliceToken, http.StatusCreated},
+		{"mute again", http.MethodPost, bobPath + "/mute", aliceToken, http.StatusConflict},
+		{"muted user still sees the profile", http.MethodGet, alicePath, bobToken, http.StatusOK},
+		{"block", http.MethodPost, bobPath + "/block", aliceToken, http.StatusCreated},
+		{"block again", http.MethodPost, bobPath + "/block", aliceToken, http.StatusConflict},
+		{"block missing user", http.MethodPost, "/api/users/" + strconv.Itoa(aliceID+1000) + "/block", aliceToken, http.StatusNotFound},
+		{"block yourself", http.MethodPost, alicePath + "/block", aliceToken, http.StatusBadRequest},
+		{"blocked profile is hidden", http.MethodGet, bobPath, aliceToken, http.StatusNotFound},
+		{"blocker profile is hidden", http.MethodGet, alicePath, bobToken, http.StatusNotFound},
+		{"follow is removed", http.MethodDelete, bobPath + "/follow", aliceToken, http.StatusNotFound},
+		{"blocked user cannot follow", http.MethodPost, alicePath + "/follow", bobToken, http.StatusForbidden},
+	})
+	for _, path := range []string{"/api/users/me/blocks", "/api/users/me/mutes"} {
+		if got := listIDs(path); len(got) != 1 || got[0] != bobID {
+			t.Errorf("%s = %v, want [%d]", path, got, bobID)
+		}
+	}
+
+	run([]step{
+		{"unblock", http.MethodDelete, bobPath + "/block", aliceToken, http.StatusNoContent},
+		{"unblock again", http.MethodDelete, bobPath + "/block", aliceToken, http.StatusNotFound},
+		{"profile after unblock", http.MethodGet, bobPath, aliceToken, http.StatusOK},
+		{"unmute", http.MethodDelete, bobPath + "/mute", aliceToken, http.StatusNoContent},
+		{"unmute again", http.MethodDelete, bobPath + "/mute", aliceToken, http.StatusNotFound},
+	})
+	for _, path := range []string{"/api/users/me/blocks", "/api/users/me/mutes"} {
+		if got := listIDs(path); len(got) != 0 {
+			t.Errorf("%s after undo = %v, want none", path, got)
+		}
+	}
+}
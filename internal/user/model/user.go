@@ -12,15 +12,30 @@ type User struct {
 	ProfileImageURL *string   `json:"profile_image_url,omitempty"`
 	CreatedAt       time.Time `json:"created_at"`
 	UpdatedAt       time.Time `json:"updated_at"`
+	// DeletedAt は退会した日時。退会中のユーザーは GetByEmail でしか取得できない
+	DeletedAt *time.Time `json:"-"`
 }
 
 type UserProfile struct {
 	User           User `json:"user"`
 	FollowersCount int  `json:"followers_count"`
 	FollowingCount int  `json:"following_count"`
+	TweetsCount    int  `json:"tweets_count"`
 	IsFollowing    bool `json:"is_following"`
 }
 
+// UserList はブロック・ミュートしているユーザーの一覧
+type UserList struct {
+	Users []*UserProfile `json:"users"`
+}
+
+// UserCounts は users に持たせているフォロー・ツイートの件数、またはその増減
+type UserCounts struct {
+	Followers int
+	Following int
+	Tweets    int
+}
+
 type RegisterRequest struct {
 	Username    string  `json:"username" validate:"required,min=3,max=50"`
 	DisplayName string  `json:"display_name" validate:"required,max=100"`
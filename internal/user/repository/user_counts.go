@@ -0,0 +1,41 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// recountUserCountsQuery は users の件数の列を follows と tweets から数え直す。
+// 退会中のユーザーとのフォロー関係と、論理削除したツイートは数えない。
+// 値がずれている行だけを更新するので、影響を受けた行数が修正したユーザー数になる。
+// updated_at = updated_at はMySQLの ON UPDATE CURRENT_TIMESTAMP で更新日時が変わらないようにするため
+const recountUserCountsQuery = `
+UPDATE users SET
+    followers_count = (` + countFollowersQuery + `),
+    following_count = (` + countFollowingQuery + `),
+    tweets_count = (` + countTweetsQuery + `),
+    updated_at = updated_at
+WHERE followers_count <> (` + countFollowersQuery + `)
+    OR following_count <> (` + countFollowingQuery + `)
+    OR tweets_count <> (` + countTweetsQuery + `)`
+
+const (
+	countFollowersQuery = `SELECT COUNT(*) FROM follows JOIN users AS other ON other.id = follows.follower_id
+        WHERE follows.following_id = users.id AND other.deleted_at IS NULL`
+	countFollowingQuery = `SELECT COUNT(*) FROM follows JOIN users AS other ON other.id = follows.following_id
+        WHERE follows.follower_id = users.id AND other.deleted_at IS NULL`
+	countTweetsQuery = `SELECT COUNT(*) FROM tweets WHERE tweets.user_id = users.id AND tweets.deleted_at IS NULL`
+)
+
+// RecountUserCounts は users.followers_count / following_count / tweets_count を
+// 実際の件数で修正し、修正したユーザー数を返す。
+// 件数は通常アプリケーションが AddCounts で更新するが、外部キーの ON DELETE CASCADE や
+// 手作業でのデータ修正でずれた場合の修復に使う
+func RecountUserCounts(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	result, err := exec.ExecContext(ctx, recountUserCountsQuery)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
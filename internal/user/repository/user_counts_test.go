@@ -0,0 +1,117 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"todoapp/internal/dbtest"
+	"todoapp/internal/schema"
+	"todoapp/internal/user/model"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestRecountUserCounts(t *testing.T) {
+	ctx := context.Background()
+	db := dbtest.New(t)
+	repo := NewUserRepository(db, db)
+
+	var ids []int
+	for _, name := range []string{"alice", "bob", "carol"} {
+		user, err := repo.Create(ctx, &model.RegisterRequest{
+			Username: name, DisplayName: name, Email: name + "@example.com", Password: "password123",
+		})
+		if err != nil {
+			t.Fatalf("Create: %v", err)
+		}
+		ids = append(ids, user.ID)
+	}
+	alice, bob, carol := ids[0], ids[1], ids[2]
+
+	// 件数を更新せずにデータを直接挿入し、carol の件数はずらしておく
+	for _, f := range []schema.Follow{{FollowerID: alice, FollowingID: bob}, {FollowerID: carol, FollowingID: bob}} {
+		if err := f.Insert(ctx, db, boil.Infer()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	tweet := &schema.Tweet{UserID: bob, Content: "hello"}
+	if err := tweet.Insert(ctx, db, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err := repo.AddCounts(ctx, carol, model.UserCounts{Tweets: 4}); err != nil {
+		t.Fatal(err)
+	}
+
+	repaired, err := RecountUserCounts(ctx, db)
+	if err != nil {
+		t.Fatalf("RecountUserCounts: %v", err)
+	}
+	if repaired != 3 {
+		t.Errorf("repaired = %d, want 3", repaired)
+	}
+
+	profiles, err := repo.GetProfiles(ctx, ids, 0)
+	if err != nil {
+		t.Fatalf("GetProfiles: %v", err)
+	}
+	want := []model.UserCounts{{Following: 1}, {Followers: 2, Tweets: 1}, {Following: 1}}
+	for i, p := range profiles {
+		got := model.UserCounts{Followers: p.FollowersCount, Following: p.FollowingCount, Tweets: p.TweetsCount}
+		if got != want[i] {
+			t.Errorf("user %d counts = %+v, want %+v", p.User.ID, got, want[i])
+		}
+	}
+
+	// 修正後に数え直しても変更はない
+	if repaired, err := RecountUserCounts(ctx, db); err != nil || repaired != 0 {
+		t.Errorf("second RecountUserCounts = %d, %v, want 0", repaired, err)
+	}
+}
+
+func TestRecountUserCountsSkipsDeleted(t *testing.T) {
+	ctx := context.Background()
+	db := dbtest.New(t)
+	repo := NewUserRepository(db, db)
+
+	var ids []int
+	for _, name := range []string{"alice", "bob"} {
+		user, err := repo.Create(ctx, &model.RegisterRequest{
+			Username: name, DisplayName: name, Email: name + "@example.com", Password: "password123",
+		})
+		if err != nil {
+			t.Fatalf("Create: %v", err)
+		}
+		ids = append(ids, user.ID)
+	}
+	alice, bob := ids[0], ids[1]
+
+	for _, f := range []schema.Follow{{FollowerID: alice, FollowingID: bob}, {FollowerID: bob, FollowingID: alice}} {
+		if err := f.Insert(ctx, db, boil.Infer()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, content := range []string{"kept", "deleted"} {
+		tweet := &schema.Tweet{UserID: bob, Content: content}
+		if err := tweet.Insert(ctx, db, boil.Infer()); err != nil {
+			t.Fatal(err)
+		}
+		if content == "deleted" {
+			if _, err := tweet.Delete(ctx, db, false); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	if err := repo.Deactivate(ctx, alice); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := RecountUserCounts(ctx, db); err != nil {
+		t.Fatalf("RecountUserCounts: %v", err)
+	}
+	profile, err := repo.GetProfile(ctx, bob, 0)
+	if err != nil {
+		t.Fatalf("GetProfile: %v", err)
+	}
+	if profile.FollowersCount != 0 || profile.FollowingCount != 0 || profile.TweetsCount != 1 {
+		t.Errorf("counts = %d / %d / %d, want 0 / 0 / 1", profile.FollowersCount, profile.FollowingCount, profile.TweetsCount)
+	}
+}
@@ -0,0 +1,106 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"todoapp/internal/infrastructure"
+	"todoapp/internal/schema"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// purgeBatchSize は1回のクエリで物理削除の対象として読み込むユーザー数
+const purgeBatchSize = 100
+
+// lastMessageQuery は会話の last_message_id を、残っているメッセージの最後のものにする
+// (なければ NULL)。%s は会話のIDの IN 句
+const lastMessageQuery = `UPDATE dm_conversations
+SET last_message_id = (SELECT MAX(dm_messages.id) FROM dm_messages WHERE dm_messages.conversation_id = dm_conversations.id)
+WHERE dm_conversations.id IN (%s)`
+
+// PurgeDeleted は before より前に論理削除したユーザーとツイートを物理削除し、削除した件数を返す。
+// ユーザーは schema.User.Delete で1人ずつ、それぞれのトランザクションで削除するので、
+// フック(監査ログとキャッシュの削除)が実行され、ツイート・フォロー・いいねは外部キーの
+// ON DELETE CASCADE で一緒に削除される。
+// ユーザーが送ったダイレクトメッセージも一緒に削除されるので、その会話の最後のメッセージを設定し直す。
+// 論理削除したツイートはフックを実行せずにまとめて削除する(削除の監査ログは論理削除の時点で記録済み)
+func PurgeDeleted(ctx context.Context, txManager infrastructure.TxManager, exec boil.ContextExecutor, before time.Time) (users, tweets int64, err error) {
+	expired := func(mods ...qm.QueryMod) []qm.QueryMod {
+		return append([]qm.QueryMod{qm.WithDeleted(), schema.UserWhere.DeletedAt.LT(null.TimeFrom(before))}, mods...)
+	}
+
+	for {
+		dbUsers, err := schema.Users(
+			expired(qm.Select(schema.UserColumns.ID), qm.OrderBy(schema.UserColumns.ID), qm.Limit(purgeBatchSize))...,
+		).All(ctx, exec)
+		if err != nil {
+			return users, tweets, err
+		}
+
+		for _, dbUser := range dbUsers {
+			id := dbUser.ID
+			deleted := false
+			err := txManager.RunInTx(ctx, func(ctx context.Context) error {
+				// 読み込んでから削除するまでに復帰したユーザーは削除しない
+				dbUser, err := schema.Users(expired(schema.UserWhere.ID.EQ(id))...).One(ctx, infrastructure.Executor(ctx, exec))
+				if errors.Is(err, sql.ErrNoRows) {
+					deleted = false
+					return nil
+				}
+				if err != nil {
+					return err
+				}
+				sent, err := schema.DMMessages(
+					qm.Distinct(schema.DMMessageColumns.ConversationID),
+					schema.DMMessageWhere.SenderID.EQ(id),
+				).All(ctx, infrastructure.Executor(ctx, exec))
+				if err != nil {
+					return err
+				}
+				if _, err := dbUser.Delete(ctx, infrastructure.Executor(ctx, exec), true); err != nil {
+					return err
+				}
+				if err := resetLastMessages(ctx, infrastructure.Executor(ctx, exec), sent); err != nil {
+					return err
+				}
+				deleted = true
+				return nil
+			})
+			if err != nil {
+				return users, tweets, err
+			}
+			if deleted {
+				users++
+			}
+		}
+		if len(dbUsers) < purgeBatchSize {
+			break
+		}
+	}
+
+	tweets, err = schema.Tweets(
+		qm.WithDeleted(),
+		schema.TweetWhere.DeletedAt.LT(null.TimeFrom(before)),
+	).DeleteAll(ctx, exec, true)
+	return users, tweets, err
+}
+
+// resetLastMessages は messages の会話の last_message_id を設定し直す
+func resetLastMessages(ctx context.Context, exec boil.ContextExecutor, messages schema.DMMessageSlice) error {
+	if len(messages) == 0 {
+		return nil
+	}
+	ids := make([]interface{}, len(messages))
+	for i, m := range messages {
+		ids[i] = m.ConversationID
+	}
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(ids)), ", ")
+	_, err := exec.ExecContext(ctx, fmt.Sprintf(lastMessageQuery, placeholders), ids...)
+	return err
+}
@@ -0,0 +1,108 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+	"todoapp/internal/dbtest"
+	"todoapp/internal/infrastructure"
+	"todoapp/internal/schema"
+	"todoapp/internal/user/model"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+func TestPurgeDeleted(t *testing.T) {
+	ctx := context.Background()
+	db := dbtest.New(t)
+	repo := NewUserRepository(db, db)
+
+	ids := make(map[string]int)
+	for _, name := range []string{"active", "recent", "expired"} {
+		user, err := repo.Create(ctx, &model.RegisterRequest{
+			Username: name, DisplayName: name, Email: name + "@example.com", Password: "password123",
+		})
+		if err != nil {
+			t.Fatalf("Create: %v", err)
+		}
+		ids[name] = user.ID
+	}
+
+	now := time.Now()
+	setDeletedAt := func(table string, id int, at time.Time) {
+		t.Helper()
+		if _, err := db.ExecContext(ctx, "UPDATE "+table+" SET deleted_at = ? WHERE id = ?", at, id); err != nil {
+			t.Fatal(err)
+		}
+	}
+	setDeletedAt("users", ids["recent"], now.Add(-time.Hour))
+	setDeletedAt("users", ids["expired"], now.Add(-48*time.Hour))
+
+	tweets := make(map[string]*schema.Tweet)
+	for _, content := range []string{"kept", "recent", "expired"} {
+		tweet := &schema.Tweet{UserID: ids["active"], Content: content}
+		if err := tweet.Insert(ctx, db, boil.Infer()); err != nil {
+			t.Fatal(err)
+		}
+		tweets[content] = tweet
+	}
+	setDeletedAt("tweets", tweets["recent"].ID, now.Add(-time.Hour))
+	setDeletedAt("tweets", tweets["expired"].ID, now.Add(-48*time.Hour))
+
+	// 削除するユーザーのツイート・フォロー・いいねは外部キーの ON DELETE CASCADE で一緒に削除される
+	expiredTweet := &schema.Tweet{UserID: ids["expired"], Content: "by expired"}
+	if err := expiredTweet.Insert(ctx, db, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	for _, row := range []interface {
+		Insert(context.Context, boil.ContextExecutor, boil.Columns) error
+	}{
+		&schema.Follow{FollowerID: ids["expired"], FollowingID: ids["active"]},
+		&schema.Follow{FollowerID: ids["active"], FollowingID: ids["expired"]},
+		&schema.Like{UserID: ids["active"], TweetID: expiredTweet.ID},
+		&schema.Like{UserID: ids["expired"], TweetID: tweets["kept"].ID},
+	} {
+		if err := row.Insert(ctx, db, boil.Infer()); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	users, purgedTweets, err := PurgeDeleted(ctx, infrastructure.NewTxManager(db, nil), db, now.Add(-24*time.Hour))
+	if err != nil {
+		t.Fatalf("PurgeDeleted: %v", err)
+	}
+	if users != 1 || purgedTweets != 1 {
+		t.Errorf("PurgeDeleted = %d users, %d tweets, want 1, 1", users, purgedTweets)
+	}
+
+	remainingUsers, err := schema.Users(qm.WithDeleted(), qm.OrderBy(schema.UserColumns.ID)).All(ctx, db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(remainingUsers) != 2 || remainingUsers[0].ID != ids["active"] || remainingUsers[1].ID != ids["recent"] {
+		t.Errorf("remaining users = %v", remainingUsers)
+	}
+	if !remainingUsers[1].DeletedAt.Valid {
+		t.Error("recently deactivated user is reactivated")
+	}
+
+	remainingTweets, err := schema.Tweets(qm.WithDeleted()).Count(ctx, db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if remainingTweets != 2 {
+		t.Errorf("remaining tweets = %d, want 2", remainingTweets)
+	}
+	follows, err := schema.Follows().Count(ctx, db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	likes, err := schema.Likes().Count(ctx, db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if follows != 0 || likes != 0 {
+		t.Errorf("remaining follows = %d, likes = %d, want 0, 0", follows, likes)
+	}
+}
@@ -3,6 +3,10 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"errors"
+	"time"
+	"todoapp/internal/domain"
+	"todoapp/internal/infrastructure"
 	"todoapp/internal/schema"
 	"todoapp/internal/user/model"
 
@@ -13,19 +17,69 @@ import (
 )
 
 type UserRepository interface {
-	Create(user *model.RegisterRequest) (*model.User, error)
-	GetByID(id int) (*model.User, error)
-	GetByEmail(email string) (*model.User, error)
-	Update(id int, user *model.UpdateProfileRequest) (*model.User, error)
-	GetProfile(userID, currentUserID int) (*model.UserProfile, error)
+	Create(ctx context.Context, user *model.RegisterRequest) (*model.User, error)
+	GetByID(ctx context.Context, id int) (*model.User, error)
+	// GetByEmail は退会中のユーザーも返す(ログイン時に退会中であることを判定するため)。
+	// その他の読み取りは退会中のユーザーを見つからないものとして扱う
+	GetByEmail(ctx context.Context, email string) (*model.User, error)
+	Update(ctx context.Context, id int, user *model.UpdateProfileRequest) (*model.User, error)
+	GetProfile(ctx context.Context, userID, currentUserID int) (*model.UserProfile, error)
+	// GetProfiles は userIDs のプロフィールを1回のクエリでまとめて取得する。
+	// 結果は userIDs の順で、存在しないユーザーは含まれない
+	GetProfiles(ctx context.Context, userIDs []int, currentUserID int) ([]*model.UserProfile, error)
+	// AddCounts は users の件数の列に delta を加える。フォローやツイートを
+	// 追加・削除するトランザクションの中で呼び、件数と実データを一致させる
+	AddCounts(ctx context.Context, userID int, delta model.UserCounts) error
+	// Deactivate はユーザーを退会中にする(論理削除)。退会中または存在しない場合は sql.ErrNoRows を返す
+	Deactivate(ctx context.Context, id int) error
+	// Reactivate は退会中のユーザーを元に戻す。退会中でない場合は sql.ErrNoRows を返す
+	Reactivate(ctx context.Context, id int) (*model.User, error)
+	// GetFollowIDs は userID がフォローしているユーザーと、userID をフォローしているユーザーのIDを返す。
+	// 相手が退会中かどうかは問わない
+	GetFollowIDs(ctx context.Context, userID int) (followingIDs, followerIDs []int, err error)
+	// Follow は followerID が followingID をフォローする行を追加する。既にフォローしている場合は Conflict を返す。
+	// 件数は更新しないので、同じトランザクションで AddCounts を呼ぶこと
+	Follow(ctx context.Context, followerID, followingID int) error
+	// Unfollow はフォローを取り消す。フォローしていない場合は sql.ErrNoRows を返す。
+	// Follow と同じく件数は呼び出し側で更新する
+	Unfollow(ctx context.Context, followerID, followingID int) error
+	// Block は blockerID が blockedID をブロックする行を追加する。既にブロックしている場合は Conflict を返す。
+	// フォローは取り消さないので、同じトランザクションで Unfollow と AddCounts を呼ぶこと
+	Block(ctx context.Context, blockerID, blockedID int) error
+	// Unblock はブロックを取り消す。ブロックしていない場合は sql.ErrNoRows を返す
+	Unblock(ctx context.Context, blockerID, blockedID int) error
+	// IsBlocked は userID と otherID のどちらかがもう一方をブロックしていれば true を返す
+	IsBlocked(ctx context.Context, userID, otherID int) (bool, error)
+	// GetBlockIDs は userID がブロックしているユーザー(新しい順)と、userID をブロックしているユーザーのIDを返す。
+	// 相手が退会中かどうかは問わない
+	GetBlockIDs(ctx context.Context, userID int) (blockedIDs, blockerIDs []int, err error)
+	// Mute は muterID が mutedID をミュートする行を追加する。既にミュートしている場合は Conflict を返す
+	Mute(ctx context.Context, muterID, mutedID int) error
+	// Unmute はミュートを取り消す。ミュートしていない場合は sql.ErrNoRows を返す
+	Unmute(ctx context.Context, muterID, mutedID int) error
+	// GetMutedIDs は userID がミュートしているユーザーのIDを新しい順に返す
+	GetMutedIDs(ctx context.Context, userID int) ([]int, error)
 }
 
 type userRepository struct {
-	db *sql.DB
+	db boil.ContextExecutor
+	// readDB は読み取り専用のメソッドで使う(リードレプリカがなければ db と同じ)
+	readDB boil.ContextExecutor
 }
 
-func NewUserRepository(db *sql.DB) UserRepository {
-	return &userRepository{db: db}
+func NewUserRepository(db, readDB boil.ContextExecutor) UserRepository {
+	return &userRepository{db: db, readDB: readDB}
+}
+
+// exec は書き込みと、トランザクション内の読み取りに使うエグゼキューターを返す
+func (r *userRepository) exec(ctx context.Context) boil.ContextExecutor {
+	return infrastructure.Executor(ctx, r.db)
+}
+
+// readExec は読み取り専用のメソッドで使うエグゼキューターを返す。
+// トランザクション内ではトランザクションを優先する
+func (r *userRepository) readExec(ctx context.Context) boil.ContextExecutor {
+	return infrastructure.Executor(ctx, r.readDB)
 }
 
 func (r *userRepository) convertToModel(dbUser *schema.User) *model.User {
@@ -35,14 +89,15 @@ func (r *userRepository) convertToModel(dbUser *schema.User) *model.User {
 		DisplayName:     dbUser.DisplayName,
 		Email:           dbUser.Email,
 		PasswordHash:    dbUser.PasswordHash,
-		Bio:             &dbUser.Bio.String,
-		ProfileImageURL: &dbUser.ProfileImageURL.String,
+		Bio:             dbUser.Bio.Ptr(),
+		ProfileImageURL: dbUser.ProfileImageURL.Ptr(),
 		CreatedAt:       dbUser.CreatedAt.Time,
 		UpdatedAt:       dbUser.UpdatedAt.Time,
+		DeletedAt:       dbUser.DeletedAt.Ptr(),
 	}
 }
 
-func (r *userRepository) Create(req *model.RegisterRequest) (*model.User, error) {
+func (r *userRepository) Create(ctx context.Context, req *model.RegisterRequest) (*model.User, error) {
 	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
 	if err != nil {
 		return nil, err
@@ -57,16 +112,40 @@ func (r *userRepository) Create(req *model.RegisterRequest) (*model.User, error)
 		ProfileImageURL: null.String{},
 	}
 
-	err = dbUser.Insert(context.Background(), r.db, boil.Infer())
+	exec := r.exec(ctx)
+	err = dbUser.Insert(ctx, exec, boil.Infer())
 	if err != nil {
-		return nil, err
+		return nil, translateCreateError(ctx, exec, req, err)
 	}
 
 	return r.convertToModel(dbUser), nil
 }
 
-func (r *userRepository) GetByID(id int) (*model.User, error) {
-	dbUser, err := schema.FindUser(context.Background(), r.db, id)
+// translateCreateError は一意制約違反を、重複した列がわかるメッセージの domain.ErrConflict にする。
+// サーバーのエラーからキー名がわからない場合(go-mysql-server はキー名を返さない)は、
+// username と email のどちらが重複しているかを調べる
+func translateCreateError(ctx context.Context, exec boil.ContextExecutor, req *model.RegisterRequest, err error) error {
+	err = infrastructure.TranslateError(err)
+	if !errors.Is(err, domain.ErrConflict) || err.Error() != "record already exists" {
+		return err
+	}
+	for _, unique := range []struct{ column, value string }{
+		{schema.UserColumns.Username, req.Username},
+		{schema.UserColumns.Email, req.Email},
+	} {
+		exists, qerr := schema.Users(qm.WithDeleted(), qm.Where(unique.column+" = ?", unique.value)).Exists(ctx, exec)
+		if qerr != nil {
+			return err
+		}
+		if exists {
+			return domain.Conflict(unique.column + " already exists")
+		}
+	}
+	return err
+}
+
+func (r *userRepository) GetByID(ctx context.Context, id int) (*model.User, error) {
+	dbUser, err := schema.FindUser(ctx, r.readExec(ctx), id)
 	if err != nil {
 		return nil, err
 	}
@@ -74,8 +153,8 @@ func (r *userRepository) GetByID(id int) (*model.User, error) {
 	return r.convertToModel(dbUser), nil
 }
 
-func (r *userRepository) GetByEmail(email string) (*model.User, error) {
-	dbUser, err := schema.Users(qm.Where("email = ?", email)).One(context.Background(), r.db)
+func (r *userRepository) GetByEmail(ctx context.Context, email string) (*model.User, error) {
+	dbUser, err := schema.Users(qm.WithDeleted(), qm.Where("email = ?", email)).One(ctx, r.exec(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -83,8 +162,8 @@ func (r *userRepository) GetByEmail(email string) (*model.User, error) {
 	return r.convertToModel(dbUser), nil
 }
 
-func (r *userRepository) Update(id int, req *model.UpdateProfileRequest) (*model.User, error) {
-	dbUser, err := schema.FindUser(context.Background(), r.db, id)
+func (r *userRepository) Update(ctx context.Context, id int, req *model.UpdateProfileRequest) (*model.User, error) {
+	dbUser, err := schema.FindUser(ctx, r.exec(ctx), id)
 	if err != nil {
 		return nil, err
 	}
@@ -93,54 +172,240 @@ func (r *userRepository) Update(id int, req *model.UpdateProfileRequest) (*model
 	dbUser.Bio = null.StringFromPtr(req.Bio)
 	dbUser.ProfileImageURL = null.StringFromPtr(req.ProfileImageURL)
 
-	_, err = dbUser.Update(context.Background(), r.db, boil.Infer())
+	// 件数の列は AddCounts が更新するので、読み取った値で上書きしないよう列を指定する
+	_, err = dbUser.Update(ctx, r.exec(ctx), boil.Whitelist(
+		schema.UserColumns.DisplayName,
+		schema.UserColumns.Bio,
+		schema.UserColumns.ProfileImageURL,
+		schema.UserColumns.UpdatedAt,
+	))
 	if err != nil {
-		return nil, err
+		return nil, infrastructure.TranslateError(err)
 	}
 
 	return r.convertToModel(dbUser), nil
 }
 
-func (r *userRepository) GetProfile(userID, currentUserID int) (*model.UserProfile, error) {
-	ctx := context.Background()
+func (r *userRepository) GetProfile(ctx context.Context, userID, currentUserID int) (*model.UserProfile, error) {
+	profiles, err := r.GetProfiles(ctx, []int{userID}, currentUserID)
+	if err != nil {
+		return nil, err
+	}
+	if len(profiles) == 0 {
+		return nil, sql.ErrNoRows
+	}
+
+	return profiles[0], nil
+}
+
+func (r *userRepository) Deactivate(ctx context.Context, id int) error {
+	dbUser, err := schema.FindUser(ctx, r.exec(ctx), id)
+	if err != nil {
+		return err
+	}
+
+	// Delete(ctx, exec, false) でも論理削除できるが、監査ログのフックで
+	// 退会と物理削除を区別できるよう、deleted_at の更新として実行する
+	dbUser.DeletedAt = null.TimeFrom(time.Now())
+	_, err = dbUser.Update(ctx, r.exec(ctx), boil.Whitelist(schema.UserColumns.DeletedAt, schema.UserColumns.UpdatedAt))
+	return err
+}
 
-	dbUser, err := schema.FindUser(ctx, r.db, userID)
+func (r *userRepository) Reactivate(ctx context.Context, id int) (*model.User, error) {
+	dbUser, err := schema.Users(
+		qm.WithDeleted(),
+		schema.UserWhere.ID.EQ(id),
+		schema.UserWhere.DeletedAt.IsNotNull(),
+	).One(ctx, r.exec(ctx))
 	if err != nil {
 		return nil, err
 	}
 
-	// フォロワー数を取得
-	followersCount, err := schema.Follows(
-		qm.Where("following_id = ?", userID),
-	).Count(ctx, r.db)
+	dbUser.DeletedAt = null.Time{}
+	_, err = dbUser.Update(ctx, r.exec(ctx), boil.Whitelist(schema.UserColumns.DeletedAt, schema.UserColumns.UpdatedAt))
 	if err != nil {
 		return nil, err
 	}
 
-	// フォロー数を取得
-	followingCount, err := schema.Follows(
-		qm.Where("follower_id = ?", userID),
-	).Count(ctx, r.db)
+	return r.convertToModel(dbUser), nil
+}
+
+func (r *userRepository) GetFollowIDs(ctx context.Context, userID int) ([]int, []int, error) {
+	follows, err := schema.Follows(
+		qm.Where("follower_id = ? OR following_id = ?", userID, userID),
+	).All(ctx, r.exec(ctx))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var followingIDs, followerIDs []int
+	for _, f := range follows {
+		if f.FollowerID == userID {
+			followingIDs = append(followingIDs, f.FollowingID)
+		}
+		if f.FollowingID == userID {
+			followerIDs = append(followerIDs, f.FollowerID)
+		}
+	}
+	return followingIDs, followerIDs, nil
+}
+
+func (r *userRepository) Follow(ctx context.Context, followerID, followingID int) error {
+	follow := &schema.Follow{FollowerID: followerID, FollowingID: followingID}
+	if err := follow.Insert(ctx, r.exec(ctx), boil.Infer()); err != nil {
+		return infrastructure.TranslateError(err)
+	}
+	return nil
+}
+
+func (r *userRepository) Unfollow(ctx context.Context, followerID, followingID int) error {
+	follow, err := schema.FindFollow(ctx, r.exec(ctx), followerID, followingID)
+	if err != nil {
+		return err
+	}
+	// キャッシュや通知のフックを実行するため、DeleteAll ではなく行を読んでから削除する
+	_, err = follow.Delete(ctx, r.exec(ctx))
+	return err
+}
+
+func (r *userRepository) Block(ctx context.Context, blockerID, blockedID int) error {
+	block := &schema.Block{BlockerID: blockerID, BlockedID: blockedID}
+	if err := block.Insert(ctx, r.exec(ctx), boil.Infer()); err != nil {
+		return infrastructure.TranslateError(err)
+	}
+	return nil
+}
+
+func (r *userRepository) Unblock(ctx context.Context, blockerID, blockedID int) error {
+	block, err := schema.FindBlock(ctx, r.exec(ctx), blockerID, blockedID)
+	if err != nil {
+		return err
+	}
+	_, err = block.Delete(ctx, r.exec(ctx))
+	return err
+}
+
+func (r *userRepository) IsBlocked(ctx context.Context, userID, otherID int) (bool, error) {
+	return schema.Blocks(
+		qm.Where("(blocker_id = ? AND blocked_id = ?) OR (blocker_id = ? AND blocked_id = ?)", userID, otherID, otherID, userID),
+	).Exists(ctx, r.exec(ctx))
+}
+
+func (r *userRepository) GetBlockIDs(ctx context.Context, userID int) ([]int, []int, error) {
+	blocks, err := schema.Blocks(
+		qm.Where("blocker_id = ? OR blocked_id = ?", userID, userID),
+		qm.OrderBy("created_at DESC, blocked_id DESC"),
+	).All(ctx, r.exec(ctx))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var blockedIDs, blockerIDs []int
+	for _, b := range blocks {
+		if b.BlockerID == userID {
+			blockedIDs = append(blockedIDs, b.BlockedID)
+		}
+		if b.BlockedID == userID {
+			blockerIDs = append(blockerIDs, b.BlockerID)
+		}
+	}
+	return blockedIDs, blockerIDs, nil
+}
+
+func (r *userRepository) Mute(ctx context.Context, muterID, mutedID int) error {
+	mute := &schema.Mute{MuterID: muterID, MutedID: mutedID}
+	if err := mute.Insert(ctx, r.exec(ctx), boil.Infer()); err != nil {
+		return infrastructure.TranslateError(err)
+	}
+	return nil
+}
+
+func (r *userRepository) Unmute(ctx context.Context, muterID, mutedID int) error {
+	mute, err := schema.FindMute(ctx, r.exec(ctx), muterID, mutedID)
+	if err != nil {
+		return err
+	}
+	_, err = mute.Delete(ctx, r.exec(ctx))
+	return err
+}
+
+func (r *userRepository) GetMutedIDs(ctx context.Context, userID int) ([]int, error) {
+	mutes, err := schema.Mutes(
+		schema.MuteWhere.MuterID.EQ(userID),
+		qm.OrderBy("created_at DESC, muted_id DESC"),
+	).All(ctx, r.exec(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]int, len(mutes))
+	for i, m := range mutes {
+		ids[i] = m.MutedID
+	}
+	return ids, nil
+}
+
+// addCountsQuery は件数を読み取らずに加算する(同時に更新されても失われない)。
+// updated_at = updated_at はMySQLの ON UPDATE CURRENT_TIMESTAMP で更新日時が変わらないようにするため
+const addCountsQuery = `UPDATE users SET
+    followers_count = followers_count + ?,
+    following_count = following_count + ?,
+    tweets_count = tweets_count + ?,
+    updated_at = updated_at
+WHERE id = ?`
+
+func (r *userRepository) AddCounts(ctx context.Context, userID int, delta model.UserCounts) error {
+	_, err := r.exec(ctx).ExecContext(ctx, addCountsQuery, delta.Followers, delta.Following, delta.Tweets, userID)
+	return err
+}
+
+// profileRow は GetProfiles のクエリの1行
+type profileRow struct {
+	schema.User `boil:",bind"`
+	IsFollowing bool `boil:"is_following"`
+}
+
+func (r *userRepository) GetProfiles(ctx context.Context, userIDs []int, currentUserID int) ([]*model.UserProfile, error) {
+	if len(userIDs) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]interface{}, len(userIDs))
+	for i, id := range userIDs {
+		ids[i] = id
+	}
+
+	// 件数は users の列から読み、フォロー状態は follows との LEFT JOIN で判定する
+	// (未ログインの currentUserID = 0 は一致しないので常に false になる)
+	var rows []*profileRow
+	err := schema.Users(
+		qm.Select("users.*", "follows.follower_id IS NOT NULL AS is_following"),
+		qm.LeftOuterJoin("follows ON follows.follower_id = ? AND follows.following_id = users.id", currentUserID),
+		qm.WhereIn("users.id IN ?", ids...),
+	).Bind(ctx, r.readExec(ctx), &rows)
 	if err != nil {
 		return nil, err
 	}
 
-	// フォロー状態を確認
-	isFollowing := false
-	if currentUserID != 0 {
-		exists, err := schema.Follows(
-			qm.Where("follower_id = ? AND following_id = ?", currentUserID, userID),
-		).Exists(ctx, r.db)
-		if err != nil {
-			return nil, err
+	byID := make(map[int]*profileRow, len(rows))
+	for _, row := range rows {
+		byID[row.ID] = row
+	}
+
+	profiles := make([]*model.UserProfile, 0, len(userIDs))
+	for _, id := range userIDs {
+		row, ok := byID[id]
+		if !ok {
+			continue
 		}
-		isFollowing = exists
+		profiles = append(profiles, &model.UserProfile{
+			User:           *r.convertToModel(&row.User),
+			FollowersCount: row.FollowersCount,
+			FollowingCount: row.FollowingCount,
+			TweetsCount:    row.TweetsCount,
+			IsFollowing:    row.IsFollowing,
+		})
 	}
 
-	return &model.UserProfile{
-		User:           *r.convertToModel(dbUser),
-		FollowersCount: int(followersCount),
-		FollowingCount: int(followingCount),
-		IsFollowing:    isFollowing,
-	}, nil
+	return profiles, nil
 }
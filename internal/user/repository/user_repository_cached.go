@@ -0,0 +1,217 @@
+package repository
+
+import (
+	"context"
+	"strconv"
+	"todoapp/internal/cache"
+	"todoapp/internal/infrastructure"
+	"todoapp/internal/schema"
+	"todoapp/internal/user/model"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func userKey(id int) string {
+	return cache.KeyPrefix + "user:" + strconv.Itoa(id)
+}
+
+// profileKey はフォロー状態を除いたプロフィール(IsFollowing は常に false)のキー
+func profileKey(id int) string {
+	return cache.KeyPrefix + "profile:" + strconv.Itoa(id)
+}
+
+// followingKey は followerID が followingID をフォローしているかのキー
+func followingKey(followerID, followingID int) string {
+	return cache.KeyPrefix + "following:" + strconv.Itoa(followerID) + ":" + strconv.Itoa(followingID)
+}
+
+// cachedUserRepository は GetByID と GetProfile / GetProfiles の結果をキャッシュする。
+// プロフィールは閲覧者によらない部分と、閲覧者ごとのフォロー状態を別々に保存する。
+// トランザクション内の読み取りは、未コミットの変更を反映するためキャッシュを使わない
+type cachedUserRepository struct {
+	UserRepository
+	rt *cache.ReadThrough
+}
+
+// NewCachedUserRepository は repo の読み取りを rt でキャッシュする。
+// 自身の書き込み(Update、AddCounts、Deactivate、Reactivate、Follow、Unfollow)ではキャッシュを削除するが、
+// 他のリポジトリによるフォローやツイートの変更を反映するには RegisterCacheInvalidation も呼ぶこと
+func NewCachedUserRepository(repo UserRepository, rt *cache.ReadThrough) UserRepository {
+	return &cachedUserRepository{UserRepository: repo, rt: rt}
+}
+
+func (r *cachedUserRepository) GetByID(ctx context.Context, id int) (*model.User, error) {
+	if infrastructure.InTx(ctx) {
+		return r.UserRepository.GetByID(ctx, id)
+	}
+	return cache.Fetch(ctx, r.rt, userKey(id), func(ctx context.Context) (*model.User, error) {
+		return r.UserRepository.GetByID(ctx, id)
+	})
+}
+
+func (r *cachedUserRepository) GetProfile(ctx context.Context, userID, currentUserID int) (*model.UserProfile, error) {
+	if infrastructure.InTx(ctx) {
+		return r.UserRepository.GetProfile(ctx, userID, currentUserID)
+	}
+
+	profile, err := cache.Fetch(ctx, r.rt, profileKey(userID), func(ctx context.Context) (*model.UserProfile, error) {
+		return r.UserRepository.GetProfile(ctx, userID, 0)
+	})
+	if err != nil || currentUserID == 0 {
+		return profile, err
+	}
+
+	profile.IsFollowing, err = cache.Fetch(ctx, r.rt, followingKey(currentUserID, userID), func(ctx context.Context) (bool, error) {
+		p, err := r.UserRepository.GetProfile(ctx, userID, currentUserID)
+		if err != nil {
+			return false, err
+		}
+		return p.IsFollowing, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return profile, nil
+}
+
+// GetProfiles はキャッシュにないユーザーだけをまとめて読み込む
+func (r *cachedUserRepository) GetProfiles(ctx context.Context, userIDs []int, currentUserID int) ([]*model.UserProfile, error) {
+	if infrastructure.InTx(ctx) {
+		return r.UserRepository.GetProfiles(ctx, userIDs, currentUserID)
+	}
+
+	found := make(map[int]*model.UserProfile, len(userIDs))
+	var missing []int
+	for _, id := range userIDs {
+		if _, ok := found[id]; ok {
+			continue
+		}
+		profile, ok := cache.Lookup[*model.UserProfile](ctx, r.rt, profileKey(id))
+		if ok && currentUserID != 0 {
+			profile.IsFollowing, ok = cache.Lookup[bool](ctx, r.rt, followingKey(currentUserID, id))
+		}
+		if !ok {
+			missing = append(missing, id)
+			continue
+		}
+		found[id] = profile
+	}
+
+	if len(missing) > 0 {
+		// 読み込み中に削除されたキーには保存しないよう、読み込む前の世代を取っておく
+		gens := make(map[string]uint64, len(missing)*2)
+		for _, id := range missing {
+			gens[profileKey(id)] = r.rt.Generation(profileKey(id))
+			if currentUserID != 0 {
+				gens[followingKey(currentUserID, id)] = r.rt.Generation(followingKey(currentUserID, id))
+			}
+		}
+
+		loaded, err := r.UserRepository.GetProfiles(ctx, missing, currentUserID)
+		if err != nil {
+			return nil, err
+		}
+		for _, profile := range loaded {
+			found[profile.User.ID] = profile
+
+			base := *profile
+			base.IsFollowing = false
+			key := profileKey(profile.User.ID)
+			cache.Store(ctx, r.rt, key, gens[key], &base)
+			if currentUserID != 0 {
+				key := followingKey(currentUserID, profile.User.ID)
+				cache.Store(ctx, r.rt, key, gens[key], profile.IsFollowing)
+			}
+		}
+	}
+
+	profiles := make([]*model.UserProfile, 0, len(userIDs))
+	for _, id := range userIDs {
+		if profile, ok := found[id]; ok {
+			copied := *profile
+			profiles = append(profiles, &copied)
+		}
+	}
+	return profiles, nil
+}
+
+func (r *cachedUserRepository) Update(ctx context.Context, id int, req *model.UpdateProfileRequest) (*model.User, error) {
+	user, err := r.UserRepository.Update(ctx, id, req)
+	if err != nil {
+		return nil, err
+	}
+	r.rt.InvalidateAfterCommit(ctx, userKey(id), profileKey(id))
+	return user, nil
+}
+
+func (r *cachedUserRepository) AddCounts(ctx context.Context, userID int, delta model.UserCounts) error {
+	if err := r.UserRepository.AddCounts(ctx, userID, delta); err != nil {
+		return err
+	}
+	r.rt.InvalidateAfterCommit(ctx, profileKey(userID))
+	return nil
+}
+
+func (r *cachedUserRepository) Deactivate(ctx context.Context, id int) error {
+	if err := r.UserRepository.Deactivate(ctx, id); err != nil {
+		return err
+	}
+	r.rt.InvalidateAfterCommit(ctx, userKey(id), profileKey(id))
+	return nil
+}
+
+func (r *cachedUserRepository) Reactivate(ctx context.Context, id int) (*model.User, error) {
+	user, err := r.UserRepository.Reactivate(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	r.rt.InvalidateAfterCommit(ctx, userKey(id), profileKey(id))
+	return user, nil
+}
+
+func (r *cachedUserRepository) Follow(ctx context.Context, followerID, followingID int) error {
+	if err := r.UserRepository.Follow(ctx, followerID, followingID); err != nil {
+		return err
+	}
+	r.rt.InvalidateAfterCommit(ctx, profileKey(followerID), profileKey(followingID), followingKey(followerID, followingID))
+	return nil
+}
+
+func (r *cachedUserRepository) Unfollow(ctx context.Context, followerID, followingID int) error {
+	if err := r.UserRepository.Unfollow(ctx, followerID, followingID); err != nil {
+		return err
+	}
+	r.rt.InvalidateAfterCommit(ctx, profileKey(followerID), profileKey(followingID), followingKey(followerID, followingID))
+	return nil
+}
+
+// RegisterCacheInvalidation はsqlboilerのフックを登録し、users・follows・tweets の
+// 変更で関係するキャッシュを削除する。フックはパッケージ全体で共有されるので、
+// アプリケーションの起動時に1回だけ呼ぶこと。
+// フックは UpdateAll / DeleteAll や生のSQLでは実行されないので、
+// それらで変更する場合は呼び出し側で削除する
+func RegisterCacheInvalidation(rt *cache.ReadThrough) {
+	userChanged := func(ctx context.Context, exec boil.ContextExecutor, o *schema.User) error {
+		rt.InvalidateAfterCommit(ctx, userKey(o.ID), profileKey(o.ID))
+		return nil
+	}
+	schema.AddUserHook(boil.AfterUpdateHook, userChanged)
+	schema.AddUserHook(boil.AfterUpsertHook, userChanged)
+	schema.AddUserHook(boil.AfterDeleteHook, userChanged)
+
+	// フォロー数と閲覧者のフォロー状態が変わる
+	followChanged := func(ctx context.Context, exec boil.ContextExecutor, o *schema.Follow) error {
+		rt.InvalidateAfterCommit(ctx, profileKey(o.FollowerID), profileKey(o.FollowingID), followingKey(o.FollowerID, o.FollowingID))
+		return nil
+	}
+	schema.AddFollowHook(boil.AfterInsertHook, followChanged)
+	schema.AddFollowHook(boil.AfterDeleteHook, followChanged)
+
+	// ツイート数が変わる
+	tweetChanged := func(ctx context.Context, exec boil.ContextExecutor, o *schema.Tweet) error {
+		rt.InvalidateAfterCommit(ctx, profileKey(o.UserID))
+		return nil
+	}
+	schema.AddTweetHook(boil.AfterInsertHook, tweetChanged)
+	schema.AddTweetHook(boil.AfterDeleteHook, tweetChanged)
+}
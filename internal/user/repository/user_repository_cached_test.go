@@ -0,0 +1,119 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+	"todoapp/internal/cache"
+	"todoapp/internal/dbtest"
+	"todoapp/internal/infrastructure"
+	"todoapp/internal/schema"
+	"todoapp/internal/user/model"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestCachedUserRepository(t *testing.T) {
+	testUserRepositoryContract(t, func(t *testing.T) (UserRepository, func(followerID, followingID int)) {
+		memory := NewMemoryUserRepository().WithPasswordCost(bcrypt.MinCost)
+		rt := cache.NewReadThrough(cache.NewLRU(100), time.Minute)
+
+		// メモリ実装のフォローはフックを通らないので、ここでキャッシュを削除する
+		memoryFollow := followWithCounts(t, memory)
+		follow := func(followerID, followingID int) {
+			memoryFollow(followerID, followingID)
+			rt.Invalidate(context.Background(), profileKey(followerID), profileKey(followingID), followingKey(followerID, followingID))
+		}
+		return NewCachedUserRepository(memory, rt), follow
+	})
+}
+
+func TestCachedUserRepositoryInvalidation(t *testing.T) {
+	ctx := context.Background()
+	db := dbtest.New(t)
+	rt := cache.NewReadThrough(cache.NewLRU(100), time.Minute)
+	RegisterCacheInvalidation(rt)
+
+	inner := NewUserRepository(db, db)
+	repo := NewCachedUserRepository(inner, rt)
+	txManager := infrastructure.NewTxManager(db, nil)
+
+	var ids []int
+	for _, name := range []string{"alice", "bob"} {
+		user, err := repo.Create(ctx, &model.RegisterRequest{
+			Username: name, DisplayName: name, Email: name + "@example.com", Password: "password123",
+		})
+		if err != nil {
+			t.Fatalf("Create: %v", err)
+		}
+		ids = append(ids, user.ID)
+	}
+	alice, bob := ids[0], ids[1]
+
+	follow := func(ctx context.Context, followerID, followingID int) error {
+		f := &schema.Follow{FollowerID: followerID, FollowingID: followingID}
+		if err := f.Insert(ctx, infrastructure.Executor(ctx, db), boil.Infer()); err != nil {
+			return err
+		}
+		if err := inner.AddCounts(ctx, followerID, model.UserCounts{Following: 1}); err != nil {
+			return err
+		}
+		return inner.AddCounts(ctx, followingID, model.UserCounts{Followers: 1})
+	}
+
+	profile := func() *model.UserProfile {
+		t.Helper()
+		p, err := repo.GetProfile(ctx, bob, alice)
+		if err != nil {
+			t.Fatalf("GetProfile: %v", err)
+		}
+		return p
+	}
+
+	if p := profile(); p.FollowersCount != 0 || p.IsFollowing {
+		t.Fatalf("GetProfile = %+v, want no followers", p)
+	}
+
+	// コミットされるまでは削除されず、コミット後にフックで削除される
+	err := txManager.RunInTx(ctx, func(ctx context.Context) error {
+		if err := follow(ctx, alice, bob); err != nil {
+			return err
+		}
+		if p := profile(); p.FollowersCount != 0 {
+			t.Errorf("GetProfile before commit = %+v, want the cached value", p)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("RunInTx: %v", err)
+	}
+	if p := profile(); p.FollowersCount != 1 || !p.IsFollowing {
+		t.Errorf("GetProfile after follow = %+v, want 1 follower and is_following", p)
+	}
+
+	// プロフィールの更新で GetByID と GetProfile の両方が削除される
+	if _, err := repo.GetByID(ctx, bob); err != nil {
+		t.Fatalf("GetByID: %v", err)
+	}
+	if _, err := repo.Update(ctx, bob, &model.UpdateProfileRequest{DisplayName: "Bobby"}); err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+	if user, err := repo.GetByID(ctx, bob); err != nil || user.DisplayName != "Bobby" {
+		t.Errorf("GetByID after Update = %+v, %v, want Bobby", user, err)
+	}
+	if p := profile(); p.User.DisplayName != "Bobby" {
+		t.Errorf("GetProfile after Update = %+v, want Bobby", p)
+	}
+
+	// ロールバックされた変更ではキャッシュを削除しない
+	txManager.RunInTx(ctx, func(ctx context.Context) error {
+		if err := follow(ctx, bob, alice); err != nil {
+			return err
+		}
+		return context.Canceled
+	})
+	if p := profile(); p.FollowingCount != 0 {
+		t.Errorf("GetProfile after rollback = %+v, want 0 following", p)
+	}
+}
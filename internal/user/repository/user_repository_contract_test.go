@@ -0,0 +1,469 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"sort"
+	"testing"
+	"todoapp/internal/domain"
+	"todoapp/internal/user/model"
+)
+
+// userRepositoryFactory は空のリポジトリと、フォロー関係を追加する関数を返す
+type userRepositoryFactory func(t *testing.T) (UserRepository, func(followerID, followingID int))
+
+// followWithCounts は Follow でフォロー関係を追加し、フォロー機能と同じく AddCounts で件数を更新する関数を返す
+func followWithCounts(t *testing.T, repo UserRepository) func(followerID, followingID int) {
+	return func(followerID, followingID int) {
+		t.Helper()
+		ctx := context.Background()
+		if err := repo.Follow(ctx, followerID, followingID); err != nil {
+			t.Fatal(err)
+		}
+		if err := repo.AddCounts(ctx, followerID, model.UserCounts{Following: 1}); err != nil {
+			t.Fatal(err)
+		}
+		if err := repo.AddCounts(ctx, followingID, model.UserCounts{Followers: 1}); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// testUserRepositoryContract は UserRepository の全実装が満たすべき振る舞いを検証する
+func testUserRepositoryContract(t *testing.T, newRepo userRepositoryFactory) {
+	ctx := context.Background()
+	bio := "hello"
+
+	register := func(t *testing.T, repo UserRepository, username string) *model.User {
+		t.Helper()
+		user, err := repo.Create(ctx, &model.RegisterRequest{
+			Username:    username,
+			DisplayName: username + " name",
+			Email:       username + "@example.com",
+			Password:    "password123",
+			Bio:         &bio,
+		})
+		if err != nil {
+			t.Fatalf("Create(%s): %v", username, err)
+		}
+		return user
+	}
+
+	t.Run("Create assigns an ID and hashes the password", func(t *testing.T) {
+		repo, _ := newRepo(t)
+		user := register(t, repo, "alice")
+
+		if user.ID == 0 {
+			t.Error("ID is not assigned")
+		}
+		if user.PasswordHash == "" || user.PasswordHash == "password123" {
+			t.Errorf("password is not hashed: %q", user.PasswordHash)
+		}
+		if user.Bio == nil || *user.Bio != bio {
+			t.Errorf("Bio = %v, want %q", user.Bio, bio)
+		}
+		if user.ProfileImageURL != nil {
+			t.Errorf("ProfileImageURL = %q, want nil", *user.ProfileImageURL)
+		}
+		if user.CreatedAt.IsZero() {
+			t.Error("CreatedAt is not set")
+		}
+	})
+
+	t.Run("Create rejects duplicate email and username", func(t *testing.T) {
+		repo, _ := newRepo(t)
+		register(t, repo, "alice")
+
+		_, err := repo.Create(ctx, &model.RegisterRequest{
+			Username: "alice2", DisplayName: "Alice", Email: "alice@example.com", Password: "password123",
+		})
+		if !errors.Is(err, domain.ErrConflict) || err.Error() != "email already exists" {
+			t.Errorf("duplicate email: err = %v", err)
+		}
+
+		_, err = repo.Create(ctx, &model.RegisterRequest{
+			Username: "alice", DisplayName: "Alice", Email: "other@example.com", Password: "password123",
+		})
+		if !errors.Is(err, domain.ErrConflict) || err.Error() != "username already exists" {
+			t.Errorf("duplicate username: err = %v", err)
+		}
+	})
+
+	t.Run("GetByID and GetByEmail", func(t *testing.T) {
+		repo, _ := newRepo(t)
+		created := register(t, repo, "alice")
+
+		byID, err := repo.GetByID(ctx, created.ID)
+		if err != nil {
+			t.Fatalf("GetByID: %v", err)
+		}
+		if byID.Email != created.Email || byID.PasswordHash != created.PasswordHash {
+			t.Errorf("GetByID = %+v, want %+v", byID, created)
+		}
+
+		byEmail, err := repo.GetByEmail(ctx, "alice@example.com")
+		if err != nil {
+			t.Fatalf("GetByEmail: %v", err)
+		}
+		if byEmail.ID != created.ID {
+			t.Errorf("GetByEmail ID = %d, want %d", byEmail.ID, created.ID)
+		}
+
+		if _, err := repo.GetByID(ctx, created.ID+1000); !errors.Is(err, sql.ErrNoRows) {
+			t.Errorf("GetByID(missing) err = %v, want sql.ErrNoRows", err)
+		}
+		if _, err := repo.GetByEmail(ctx, "missing@example.com"); !errors.Is(err, sql.ErrNoRows) {
+			t.Errorf("GetByEmail(missing) err = %v, want sql.ErrNoRows", err)
+		}
+	})
+
+	t.Run("Update replaces profile fields", func(t *testing.T) {
+		repo, _ := newRepo(t)
+		created := register(t, repo, "alice")
+
+		imageURL := "https://example.com/a.png"
+		updated, err := repo.Update(ctx, created.ID, &model.UpdateProfileRequest{
+			DisplayName:     "Alice Updated",
+			ProfileImageURL: &imageURL,
+		})
+		if err != nil {
+			t.Fatalf("Update: %v", err)
+		}
+		if updated.DisplayName != "Alice Updated" || updated.Bio != nil ||
+			updated.ProfileImageURL == nil || *updated.ProfileImageURL != imageURL {
+			t.Errorf("Update = %+v", updated)
+		}
+
+		got, err := repo.GetByID(ctx, created.ID)
+		if err != nil {
+			t.Fatalf("GetByID: %v", err)
+		}
+		if got.DisplayName != "Alice Updated" || got.Bio != nil {
+			t.Errorf("GetByID after Update = %+v", got)
+		}
+
+		if _, err := repo.Update(ctx, created.ID+1000, &model.UpdateProfileRequest{DisplayName: "x"}); !errors.Is(err, sql.ErrNoRows) {
+			t.Errorf("Update(missing) err = %v, want sql.ErrNoRows", err)
+		}
+	})
+
+	t.Run("GetProfile counts follows", func(t *testing.T) {
+		repo, follow := newRepo(t)
+		alice := register(t, repo, "alice")
+		bob := register(t, repo, "bob")
+		carol := register(t, repo, "carol")
+
+		follow(alice.ID, bob.ID)
+		follow(carol.ID, bob.ID)
+		follow(bob.ID, alice.ID)
+
+		tests := []struct {
+			name          string
+			userID        int
+			currentUserID int
+			want          model.UserProfile
+		}{
+			{"followed by viewer", bob.ID, alice.ID, model.UserProfile{FollowersCount: 2, FollowingCount: 1, IsFollowing: true}},
+			{"not followed by viewer", alice.ID, carol.ID, model.UserProfile{FollowersCount: 1, FollowingCount: 1, IsFollowing: false}},
+			{"anonymous viewer", carol.ID, 0, model.UserProfile{FollowersCount: 0, FollowingCount: 1, IsFollowing: false}},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				got, err := repo.GetProfile(ctx, tt.userID, tt.currentUserID)
+				if err != nil {
+					t.Fatalf("GetProfile: %v", err)
+				}
+				if got.User.ID != tt.userID || got.FollowersCount != tt.want.FollowersCount ||
+					got.FollowingCount != tt.want.FollowingCount || got.IsFollowing != tt.want.IsFollowing {
+					t.Errorf("GetProfile = %+v, want %+v", got, tt.want)
+				}
+			})
+		}
+
+		if _, err := repo.GetProfile(ctx, carol.ID+1000, 0); !errors.Is(err, sql.ErrNoRows) {
+			t.Errorf("GetProfile(missing) err = %v, want sql.ErrNoRows", err)
+		}
+	})
+	t.Run("GetProfiles", func(t *testing.T) {
+		repo, follow := newRepo(t)
+		alice := register(t, repo, "alice")
+		bob := register(t, repo, "bob")
+		carol := register(t, repo, "carol")
+
+		follow(alice.ID, bob.ID)
+		follow(alice.ID, carol.ID)
+		follow(bob.ID, carol.ID)
+
+		// 入力の順に並び、存在しないユーザーは除かれる
+		got, err := repo.GetProfiles(ctx, []int{carol.ID, alice.ID + 1000, bob.ID, alice.ID}, alice.ID)
+		if err != nil {
+			t.Fatalf("GetProfiles: %v", err)
+		}
+		want := []model.UserProfile{
+			{User: model.User{ID: carol.ID}, FollowersCount: 2, FollowingCount: 0, IsFollowing: true},
+			{User: model.User{ID: bob.ID}, FollowersCount: 1, FollowingCount: 1, IsFollowing: true},
+			{User: model.User{ID: alice.ID}, FollowersCount: 0, FollowingCount: 2, IsFollowing: false},
+		}
+		if len(got) != len(want) {
+			t.Fatalf("GetProfiles returned %d profiles, want %d", len(got), len(want))
+		}
+		for i := range want {
+			if got[i].User.ID != want[i].User.ID || got[i].User.Username == "" ||
+				got[i].FollowersCount != want[i].FollowersCount ||
+				got[i].FollowingCount != want[i].FollowingCount || got[i].IsFollowing != want[i].IsFollowing {
+				t.Errorf("GetProfiles[%d] = %+v, want %+v", i, got[i], want[i])
+			}
+		}
+
+		if got, err := repo.GetProfiles(ctx, nil, alice.ID); err != nil || len(got) != 0 {
+			t.Errorf("GetProfiles(nil) = %v, %v, want empty", got, err)
+		}
+	})
+
+	t.Run("Update keeps counts", func(t *testing.T) {
+		repo, follow := newRepo(t)
+		alice := register(t, repo, "alice")
+		bob := register(t, repo, "bob")
+
+		follow(bob.ID, alice.ID)
+		if _, err := repo.Update(ctx, alice.ID, &model.UpdateProfileRequest{DisplayName: "Alice"}); err != nil {
+			t.Fatalf("Update: %v", err)
+		}
+
+		got, err := repo.GetProfile(ctx, alice.ID, 0)
+		if err != nil {
+			t.Fatalf("GetProfile: %v", err)
+		}
+		if got.FollowersCount != 1 || got.User.DisplayName != "Alice" {
+			t.Errorf("GetProfile = %+v, want display_name Alice and 1 follower", got)
+		}
+	})
+	t.Run("AddCounts", func(t *testing.T) {
+		repo, _ := newRepo(t)
+		alice := register(t, repo, "alice")
+		before, err := repo.GetByID(ctx, alice.ID)
+		if err != nil {
+			t.Fatalf("GetByID: %v", err)
+		}
+
+		if err := repo.AddCounts(ctx, alice.ID, model.UserCounts{Followers: 3, Following: 2, Tweets: 5}); err != nil {
+			t.Fatalf("AddCounts: %v", err)
+		}
+		if err := repo.AddCounts(ctx, alice.ID, model.UserCounts{Followers: -1, Tweets: -2}); err != nil {
+			t.Fatalf("AddCounts: %v", err)
+		}
+
+		got, err := repo.GetProfile(ctx, alice.ID, 0)
+		if err != nil {
+			t.Fatalf("GetProfile: %v", err)
+		}
+		if got.FollowersCount != 2 || got.FollowingCount != 2 || got.TweetsCount != 3 {
+			t.Errorf("GetProfile = %+v, want 2 followers, 2 following, 3 tweets", got)
+		}
+		if !got.User.UpdatedAt.Equal(before.UpdatedAt) {
+			t.Errorf("UpdatedAt = %v, want unchanged %v", got.User.UpdatedAt, before.UpdatedAt)
+		}
+	})
+
+	t.Run("Deactivate hides the user until Reactivate", func(t *testing.T) {
+		repo, follow := newRepo(t)
+		alice := register(t, repo, "alice")
+		bob := register(t, repo, "bob")
+		follow(bob.ID, alice.ID)
+
+		if err := repo.Deactivate(ctx, alice.ID); err != nil {
+			t.Fatalf("Deactivate: %v", err)
+		}
+
+		if _, err := repo.GetByID(ctx, alice.ID); !errors.Is(err, sql.ErrNoRows) {
+			t.Errorf("GetByID err = %v, want sql.ErrNoRows", err)
+		}
+		if _, err := repo.GetProfile(ctx, alice.ID, bob.ID); !errors.Is(err, sql.ErrNoRows) {
+			t.Errorf("GetProfile err = %v, want sql.ErrNoRows", err)
+		}
+		if got, err := repo.GetProfiles(ctx, []int{alice.ID, bob.ID}, 0); err != nil || len(got) != 1 || got[0].User.ID != bob.ID {
+			t.Errorf("GetProfiles = %v, %v, want only bob", got, err)
+		}
+		if _, err := repo.Update(ctx, alice.ID, &model.UpdateProfileRequest{DisplayName: "x"}); !errors.Is(err, sql.ErrNoRows) {
+			t.Errorf("Update err = %v, want sql.ErrNoRows", err)
+		}
+		if err := repo.Deactivate(ctx, alice.ID); !errors.Is(err, sql.ErrNoRows) {
+			t.Errorf("second Deactivate err = %v, want sql.ErrNoRows", err)
+		}
+
+		// ログイン時に判定できるよう、メールアドレスでは退会中のユーザーも取得できる
+		byEmail, err := repo.GetByEmail(ctx, "alice@example.com")
+		if err != nil {
+			t.Fatalf("GetByEmail: %v", err)
+		}
+		if byEmail.ID != alice.ID || byEmail.DeletedAt == nil {
+			t.Errorf("GetByEmail = %+v, want DeletedAt set", byEmail)
+		}
+
+		reactivated, err := repo.Reactivate(ctx, alice.ID)
+		if err != nil {
+			t.Fatalf("Reactivate: %v", err)
+		}
+		if reactivated.DeletedAt != nil {
+			t.Errorf("Reactivate DeletedAt = %v, want nil", reactivated.DeletedAt)
+		}
+		got, err := repo.GetProfile(ctx, alice.ID, bob.ID)
+		if err != nil {
+			t.Fatalf("GetProfile after Reactivate: %v", err)
+		}
+		if !got.IsFollowing || got.FollowersCount != 1 {
+			t.Errorf("GetProfile after Reactivate = %+v", got)
+		}
+		if _, err := repo.Reactivate(ctx, alice.ID); !errors.Is(err, sql.ErrNoRows) {
+			t.Errorf("Reactivate(active) err = %v, want sql.ErrNoRows", err)
+		}
+	})
+
+	t.Run("GetFollowIDs", func(t *testing.T) {
+		repo, follow := newRepo(t)
+		alice := register(t, repo, "alice")
+		bob := register(t, repo, "bob")
+		carol := register(t, repo, "carol")
+
+		follow(alice.ID, bob.ID)
+		follow(alice.ID, carol.ID)
+		follow(carol.ID, alice.ID)
+		follow(bob.ID, carol.ID)
+
+		following, followers, err := repo.GetFollowIDs(ctx, alice.ID)
+		if err != nil {
+			t.Fatalf("GetFollowIDs: %v", err)
+		}
+		sort.Ints(following)
+		if len(following) != 2 || following[0] != bob.ID || following[1] != carol.ID {
+			t.Errorf("following = %v, want [%d %d]", following, bob.ID, carol.ID)
+		}
+		if len(followers) != 1 || followers[0] != carol.ID {
+			t.Errorf("followers = %v, want [%d]", followers, carol.ID)
+		}
+	})
+	t.Run("Follow and Unfollow", func(t *testing.T) {
+		repo, _ := newRepo(t)
+		alice := register(t, repo, "alice")
+		bob := register(t, repo, "bob")
+
+		if err := repo.Follow(ctx, alice.ID, bob.ID); err != nil {
+			t.Fatalf("Follow: %v", err)
+		}
+		if err := repo.Follow(ctx, alice.ID, bob.ID); !errors.Is(err, domain.ErrConflict) {
+			t.Errorf("second Follow err = %v, want domain.ErrConflict", err)
+		}
+		profile, err := repo.GetProfile(ctx, bob.ID, alice.ID)
+		if err != nil {
+			t.Fatalf("GetProfile: %v", err)
+		}
+		if !profile.IsFollowing {
+			t.Error("IsFollowing = false after Follow")
+		}
+
+		if err := repo.Unfollow(ctx, alice.ID, bob.ID); err != nil {
+			t.Fatalf("Unfollow: %v", err)
+		}
+		if err := repo.Unfollow(ctx, alice.ID, bob.ID); !errors.Is(err, sql.ErrNoRows) {
+			t.Errorf("second Unfollow err = %v, want sql.ErrNoRows", err)
+		}
+		following, _, err := repo.GetFollowIDs(ctx, alice.ID)
+		if err != nil {
+			t.Fatalf("GetFollowIDs: %v", err)
+		}
+		if len(following) != 0 {
+			t.Errorf("following after Unfollow = %v, want none", following)
+		}
+	})
+	t.Run("Block and Unblock", func(t *testing.T) {
+		repo, _ := newRepo(t)
+		alice := register(t, repo, "alice")
+		bob := register(t, repo, "bob")
+		carol := register(t, repo, "carol")
+
+		for _, id := range []int{bob.ID, carol.ID} {
+			if err := repo.Block(ctx, alice.ID, id); err != nil {
+				t.Fatalf("Block: %v", err)
+			}
+		}
+		if err := repo.Block(ctx, alice.ID, bob.ID); !errors.Is(err, domain.ErrConflict) {
+			t.Errorf("second Block err = %v, want domain.ErrConflict", err)
+		}
+		// ブロックはどちらの向きからも判定できる
+		for _, pair := range [][2]int{{alice.ID, bob.ID}, {bob.ID, alice.ID}} {
+			blocked, err := repo.IsBlocked(ctx, pair[0], pair[1])
+			if err != nil {
+				t.Fatalf("IsBlocked: %v", err)
+			}
+			if !blocked {
+				t.Errorf("IsBlocked(%d, %d) = false, want true", pair[0], pair[1])
+			}
+		}
+		if blocked, err := repo.IsBlocked(ctx, bob.ID, carol.ID); err != nil || blocked {
+			t.Errorf("IsBlocked(bob, carol) = %v, %v, want false", blocked, err)
+		}
+
+		blockedIDs, blockerIDs, err := repo.GetBlockIDs(ctx, alice.ID)
+		if err != nil {
+			t.Fatalf("GetBlockIDs: %v", err)
+		}
+		// 同じ秒にブロックしたのでIDの大きい順になる
+		if len(blockedIDs) != 2 || blockedIDs[0] != carol.ID || blockedIDs[1] != bob.ID || len(blockerIDs) != 0 {
+			t.Errorf("GetBlockIDs(alice) = %v, %v, want [%d %d], []", blockedIDs, blockerIDs, carol.ID, bob.ID)
+		}
+		blockedIDs, blockerIDs, err = repo.GetBlockIDs(ctx, bob.ID)
+		if err != nil {
+			t.Fatalf("GetBlockIDs: %v", err)
+		}
+		if len(blockedIDs) != 0 || len(blockerIDs) != 1 || blockerIDs[0] != alice.ID {
+			t.Errorf("GetBlockIDs(bob) = %v, %v, want [], [%d]", blockedIDs, blockerIDs, alice.ID)
+		}
+
+		if err := repo.Unblock(ctx, alice.ID, bob.ID); err != nil {
+			t.Fatalf("Unblock: %v", err)
+		}
+		if err := repo.Unblock(ctx, alice.ID, bob.ID); !errors.Is(err, sql.ErrNoRows) {
+			t.Errorf("second Unblock err = %v, want sql.ErrNoRows", err)
+		}
+		if blocked, err := repo.IsBlocked(ctx, bob.ID, alice.ID); err != nil || blocked {
+			t.Errorf("IsBlocked after Unblock = %v, %v, want false", blocked, err)
+		}
+	})
+	t.Run("Mute and Unmute", func(t *testing.T) {
+		repo, _ := newRepo(t)
+		alice := register(t, repo, "alice")
+		bob := register(t, repo, "bob")
+		carol := register(t, repo, "carol")
+
+		for _, id := range []int{bob.ID, carol.ID} {
+			if err := repo.Mute(ctx, alice.ID, id); err != nil {
+				t.Fatalf("Mute: %v", err)
+			}
+		}
+		if err := repo.Mute(ctx, alice.ID, bob.ID); !errors.Is(err, domain.ErrConflict) {
+			t.Errorf("second Mute err = %v, want domain.ErrConflict", err)
+		}
+		muted, err := repo.GetMutedIDs(ctx, alice.ID)
+		if err != nil {
+			t.Fatalf("GetMutedIDs: %v", err)
+		}
+		if len(muted) != 2 || muted[0] != carol.ID || muted[1] != bob.ID {
+			t.Errorf("GetMutedIDs = %v, want [%d %d]", muted, carol.ID, bob.ID)
+		}
+		// ミュートは片方向で、ミュートされた側からは見えない
+		if muted, err := repo.GetMutedIDs(ctx, bob.ID); err != nil || len(muted) != 0 {
+			t.Errorf("GetMutedIDs(bob) = %v, %v, want none", muted, err)
+		}
+
+		if err := repo.Unmute(ctx, alice.ID, bob.ID); err != nil {
+			t.Fatalf("Unmute: %v", err)
+		}
+		if err := repo.Unmute(ctx, alice.ID, bob.ID); !errors.Is(err, sql.ErrNoRows) {
+			t.Errorf("second Unmute err = %v, want sql.ErrNoRows", err)
+		}
+		if muted, err := repo.GetMutedIDs(ctx, alice.ID); err != nil || len(muted) != 1 || muted[0] != carol.ID {
+			t.Errorf("GetMutedIDs after Unmute = %v, %v, want [%d]", muted, err, carol.ID)
+		}
+	})
+}
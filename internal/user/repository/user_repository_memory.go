@@ -0,0 +1,365 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"sort"
+	"sync"
+	"time"
+	"todoapp/internal/domain"
+	"todoapp/internal/user/model"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// MemoryUserRepository はテストやDBなしの開発用のインメモリ実装。
+// MySQL 実装と同じく、見つからない場合は sql.ErrNoRows を、
+// 一意制約違反の場合は domain.ErrConflict を返す
+type MemoryUserRepository struct {
+	mu      sync.RWMutex
+	nextID  int
+	users   map[int]model.User
+	follows map[[2]int]time.Time
+	blocks  map[[2]int]time.Time
+	mutes   map[[2]int]time.Time
+	counts  map[int]model.UserCounts
+	// passwordCost はテストを速くするために bcrypt のコストを下げられるようにする
+	passwordCost int
+}
+
+func NewMemoryUserRepository() *MemoryUserRepository {
+	return &MemoryUserRepository{
+		nextID:       1,
+		users:        make(map[int]model.User),
+		follows:      make(map[[2]int]time.Time),
+		blocks:       make(map[[2]int]time.Time),
+		mutes:        make(map[[2]int]time.Time),
+		counts:       make(map[int]model.UserCounts),
+		passwordCost: bcrypt.DefaultCost,
+	}
+}
+
+// WithPasswordCost は bcrypt のコストを変更する
+func (r *MemoryUserRepository) WithPasswordCost(cost int) *MemoryUserRepository {
+	r.passwordCost = cost
+	return r
+}
+
+func (r *MemoryUserRepository) Create(ctx context.Context, req *model.RegisterRequest) (*model.User, error) {
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.Password), r.passwordCost)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, u := range r.users {
+		if u.Username == req.Username {
+			return nil, domain.Conflict("username already exists")
+		}
+		if u.Email == req.Email {
+			return nil, domain.Conflict("email already exists")
+		}
+	}
+
+	now := time.Now().UTC().Truncate(time.Second)
+	user := model.User{
+		ID:           r.nextID,
+		Username:     req.Username,
+		DisplayName:  req.DisplayName,
+		Email:        req.Email,
+		PasswordHash: string(hashedPassword),
+		Bio:          copyString(req.Bio),
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+	r.users[user.ID] = user
+	r.nextID++
+
+	return copyUser(user), nil
+}
+
+func (r *MemoryUserRepository) GetByID(ctx context.Context, id int) (*model.User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	user, ok := r.users[id]
+	if !ok || user.DeletedAt != nil {
+		return nil, sql.ErrNoRows
+	}
+	return copyUser(user), nil
+}
+
+func (r *MemoryUserRepository) GetByEmail(ctx context.Context, email string) (*model.User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, user := range r.users {
+		if user.Email == email {
+			return copyUser(user), nil
+		}
+	}
+	return nil, sql.ErrNoRows
+}
+
+func (r *MemoryUserRepository) Update(ctx context.Context, id int, req *model.UpdateProfileRequest) (*model.User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	user, ok := r.users[id]
+	if !ok || user.DeletedAt != nil {
+		return nil, sql.ErrNoRows
+	}
+
+	user.DisplayName = req.DisplayName
+	user.Bio = copyString(req.Bio)
+	user.ProfileImageURL = copyString(req.ProfileImageURL)
+	user.UpdatedAt = time.Now().UTC().Truncate(time.Second)
+	r.users[id] = user
+
+	return copyUser(user), nil
+}
+
+func (r *MemoryUserRepository) GetProfile(ctx context.Context, userID, currentUserID int) (*model.UserProfile, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	profile, ok := r.profile(userID, currentUserID)
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	return profile, nil
+}
+
+func (r *MemoryUserRepository) GetProfiles(ctx context.Context, userIDs []int, currentUserID int) ([]*model.UserProfile, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	profiles := make([]*model.UserProfile, 0, len(userIDs))
+	for _, id := range userIDs {
+		if profile, ok := r.profile(id, currentUserID); ok {
+			profiles = append(profiles, profile)
+		}
+	}
+	return profiles, nil
+}
+
+// profile は userID のプロフィールを組み立てる。呼び出し側でロックを取ること
+func (r *MemoryUserRepository) profile(userID, currentUserID int) (*model.UserProfile, bool) {
+	user, ok := r.users[userID]
+	if !ok || user.DeletedAt != nil {
+		return nil, false
+	}
+
+	counts := r.counts[userID]
+	profile := &model.UserProfile{
+		User:           *copyUser(user),
+		FollowersCount: counts.Followers,
+		FollowingCount: counts.Following,
+		TweetsCount:    counts.Tweets,
+	}
+	if currentUserID != 0 {
+		_, profile.IsFollowing = r.follows[[2]int{currentUserID, userID}]
+	}
+
+	return profile, true
+}
+
+func (r *MemoryUserRepository) AddCounts(ctx context.Context, userID int, delta model.UserCounts) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.addCounts(userID, delta)
+	return nil
+}
+
+// addCounts は件数を加算する。呼び出し側でロックを取ること
+func (r *MemoryUserRepository) addCounts(userID int, delta model.UserCounts) {
+	if _, ok := r.users[userID]; !ok {
+		return
+	}
+	counts := r.counts[userID]
+	counts.Followers += delta.Followers
+	counts.Following += delta.Following
+	counts.Tweets += delta.Tweets
+	r.counts[userID] = counts
+}
+
+func (r *MemoryUserRepository) Deactivate(ctx context.Context, id int) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	user, ok := r.users[id]
+	if !ok || user.DeletedAt != nil {
+		return sql.ErrNoRows
+	}
+
+	now := time.Now().UTC().Truncate(time.Second)
+	user.DeletedAt = &now
+	user.UpdatedAt = now
+	r.users[id] = user
+	return nil
+}
+
+func (r *MemoryUserRepository) Reactivate(ctx context.Context, id int) (*model.User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	user, ok := r.users[id]
+	if !ok || user.DeletedAt == nil {
+		return nil, sql.ErrNoRows
+	}
+
+	user.DeletedAt = nil
+	user.UpdatedAt = time.Now().UTC().Truncate(time.Second)
+	r.users[id] = user
+	return copyUser(user), nil
+}
+
+func (r *MemoryUserRepository) GetFollowIDs(ctx context.Context, userID int) ([]int, []int, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var followingIDs, followerIDs []int
+	for key := range r.follows {
+		if key[0] == userID {
+			followingIDs = append(followingIDs, key[1])
+		}
+		if key[1] == userID {
+			followerIDs = append(followerIDs, key[0])
+		}
+	}
+	return followingIDs, followerIDs, nil
+}
+
+func (r *MemoryUserRepository) Follow(ctx context.Context, followerID, followingID int) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := [2]int{followerID, followingID}
+	if _, ok := r.follows[key]; ok {
+		return domain.Conflict("record already exists")
+	}
+	r.follows[key] = time.Now().UTC()
+	return nil
+}
+
+func (r *MemoryUserRepository) Unfollow(ctx context.Context, followerID, followingID int) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := [2]int{followerID, followingID}
+	if _, ok := r.follows[key]; !ok {
+		return sql.ErrNoRows
+	}
+	delete(r.follows, key)
+	return nil
+}
+
+func (r *MemoryUserRepository) Block(ctx context.Context, blockerID, blockedID int) error {
+	return r.addRelation(r.blocks, blockerID, blockedID)
+}
+
+func (r *MemoryUserRepository) Unblock(ctx context.Context, blockerID, blockedID int) error {
+	return r.removeRelation(r.blocks, blockerID, blockedID)
+}
+
+func (r *MemoryUserRepository) IsBlocked(ctx context.Context, userID, otherID int) (bool, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	_, blocked := r.blocks[[2]int{userID, otherID}]
+	_, blockedBy := r.blocks[[2]int{otherID, userID}]
+	return blocked || blockedBy, nil
+}
+
+func (r *MemoryUserRepository) GetBlockIDs(ctx context.Context, userID int) ([]int, []int, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var blockerIDs []int
+	for key := range r.blocks {
+		if key[1] == userID {
+			blockerIDs = append(blockerIDs, key[0])
+		}
+	}
+	return r.related(r.blocks, userID), blockerIDs, nil
+}
+
+func (r *MemoryUserRepository) Mute(ctx context.Context, muterID, mutedID int) error {
+	return r.addRelation(r.mutes, muterID, mutedID)
+}
+
+func (r *MemoryUserRepository) Unmute(ctx context.Context, muterID, mutedID int) error {
+	return r.removeRelation(r.mutes, muterID, mutedID)
+}
+
+func (r *MemoryUserRepository) GetMutedIDs(ctx context.Context, userID int) ([]int, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.related(r.mutes, userID), nil
+}
+
+func (r *MemoryUserRepository) addRelation(relations map[[2]int]time.Time, fromID, toID int) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := [2]int{fromID, toID}
+	if _, ok := relations[key]; ok {
+		return domain.Conflict("record already exists")
+	}
+	// MySQL の DATETIME と同じく秒単位にし、同じ秒の行の並び順も揃える
+	relations[key] = time.Now().UTC().Truncate(time.Second)
+	return nil
+}
+
+func (r *MemoryUserRepository) removeRelation(relations map[[2]int]time.Time, fromID, toID int) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := [2]int{fromID, toID}
+	if _, ok := relations[key]; !ok {
+		return sql.ErrNoRows
+	}
+	delete(relations, key)
+	return nil
+}
+
+// related は relations のうち fromID からの相手のIDを、追加した日時の新しい順
+// (同じ日時ならIDの大きい順)に返す。呼び出し側でロックを取ること
+func (r *MemoryUserRepository) related(relations map[[2]int]time.Time, fromID int) []int {
+	var ids []int
+	for key := range relations {
+		if key[0] == fromID {
+			ids = append(ids, key[1])
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		ti, tj := relations[[2]int{fromID, ids[i]}], relations[[2]int{fromID, ids[j]}]
+		if !ti.Equal(tj) {
+			return ti.After(tj)
+		}
+		return ids[i] > ids[j]
+	})
+	return ids
+}
+
+func copyUser(user model.User) *model.User {
+	user.Bio = copyString(user.Bio)
+	user.ProfileImageURL = copyString(user.ProfileImageURL)
+	if user.DeletedAt != nil {
+		deletedAt := *user.DeletedAt
+		user.DeletedAt = &deletedAt
+	}
+	return &user
+}
+
+func copyString(s *string) *string {
+	if s == nil {
+		return nil
+	}
+	v := *s
+	return &v
+}
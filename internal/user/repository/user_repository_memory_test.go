@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"testing"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestMemoryUserRepository(t *testing.T) {
+	testUserRepositoryContract(t, func(t *testing.T) (UserRepository, func(followerID, followingID int)) {
+		repo := NewMemoryUserRepository().WithPasswordCost(bcrypt.MinCost)
+		return repo, followWithCounts(t, repo)
+	})
+}
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"testing"
+	"todoapp/internal/dbtest"
+)
+
+func TestMySQLUserRepository(t *testing.T) {
+	testUserRepositoryContract(t, func(t *testing.T) (UserRepository, func(followerID, followingID int)) {
+		db := dbtest.New(t)
+
+		repo := NewUserRepository(db, db)
+		return repo, followWithCounts(t, repo)
+	})
+}
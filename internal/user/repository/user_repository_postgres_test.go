@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"testing"
+	"todoapp/internal/dbtest"
+	"todoapp/internal/infrastructure"
+)
+
+func TestPostgresUserRepository(t *testing.T) {
+	testUserRepositoryContract(t, func(t *testing.T) (UserRepository, func(followerID, followingID int)) {
+		exec := infrastructure.DialectExecutor(infrastructure.DriverPostgres, dbtest.NewPostgres(t))
+
+		repo := NewUserRepository(exec, exec)
+		return repo, followWithCounts(t, repo)
+	})
+}
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"testing"
+	"todoapp/internal/dbtest"
+)
+
+func TestSQLiteUserRepository(t *testing.T) {
+	testUserRepositoryContract(t, func(t *testing.T) (UserRepository, func(followerID, followingID int)) {
+		db := dbtest.NewSQLite(t)
+
+		repo := NewUserRepository(db, db)
+		return repo, followWithCounts(t, repo)
+	})
+}
@@ -0,0 +1,23 @@
+package usecase
+
+import (
+	"fmt"
+	"os"
+	"time"
+)
+
+// DefaultGracePeriod は退会してから元に戻せる期間の既定値
+const DefaultGracePeriod = 30 * 24 * time.Hour
+
+// GracePeriodFromEnv は ACCOUNT_GRACE_PERIOD(例: 720h)から退会を元に戻せる期間を読み込む
+func GracePeriodFromEnv() (time.Duration, error) {
+	v := os.Getenv("ACCOUNT_GRACE_PERIOD")
+	if v == "" {
+		return DefaultGracePeriod, nil
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil || d < 0 {
+		return 0, fmt.Errorf("invalid ACCOUNT_GRACE_PERIOD: %q", v)
+	}
+	return d, nil
+}
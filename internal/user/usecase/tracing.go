@@ -0,0 +1,154 @@
+package usecase
+
+import (
+	"context"
+	"todoapp/internal/tracing"
+	"todoapp/internal/user/model"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracedUserUsecase はユースケースの呼び出しごとにスパンを記録するデコレーター
+type tracedUserUsecase struct {
+	next UserUsecase
+}
+
+func NewTracedUserUsecase(next UserUsecase) UserUsecase {
+	return &tracedUserUsecase{next: next}
+}
+
+func (u *tracedUserUsecase) Register(ctx context.Context, req *model.RegisterRequest) (*model.User, error) {
+	ctx, span := startSpan(ctx, "UserUsecase.Register")
+	defer span.End()
+
+	user, err := u.next.Register(ctx, req)
+	tracing.RecordError(span, err)
+	return user, err
+}
+
+func (u *tracedUserUsecase) Login(ctx context.Context, req *model.LoginRequest) (*model.LoginResponse, error) {
+	ctx, span := startSpan(ctx, "UserUsecase.Login")
+	defer span.End()
+
+	resp, err := u.next.Login(ctx, req)
+	tracing.RecordError(span, err)
+	return resp, err
+}
+
+func (u *tracedUserUsecase) GetProfile(ctx context.Context, userID, currentUserID int) (*model.UserProfile, error) {
+	ctx, span := startSpan(ctx, "UserUsecase.GetProfile", attribute.Int("user.id", userID))
+	defer span.End()
+
+	profile, err := u.next.GetProfile(ctx, userID, currentUserID)
+	tracing.RecordError(span, err)
+	return profile, err
+}
+
+func (u *tracedUserUsecase) UpdateProfile(ctx context.Context, userID int, req *model.UpdateProfileRequest) (*model.User, error) {
+	ctx, span := startSpan(ctx, "UserUsecase.UpdateProfile", attribute.Int("user.id", userID))
+	defer span.End()
+
+	user, err := u.next.UpdateProfile(ctx, userID, req)
+	tracing.RecordError(span, err)
+	return user, err
+}
+
+func (u *tracedUserUsecase) Deactivate(ctx context.Context, userID int) error {
+	ctx, span := startSpan(ctx, "UserUsecase.Deactivate", attribute.Int("user.id", userID))
+	defer span.End()
+
+	err := u.next.Deactivate(ctx, userID)
+	tracing.RecordError(span, err)
+	return err
+}
+
+func (u *tracedUserUsecase) Reactivate(ctx context.Context, req *model.LoginRequest) (*model.LoginResponse, error) {
+	ctx, span := startSpan(ctx, "UserUsecase.Reactivate")
+	defer span.End()
+
+	resp, err := u.next.Reactivate(ctx, req)
+	tracing.RecordError(span, err)
+	return resp, err
+}
+
+// CheckActive は認証のたびに呼ばれるので、スパンは記録しない(リポジトリのクエリのスパンは残る)
+func (u *tracedUserUsecase) CheckActive(ctx context.Context, userID int) error {
+	return u.next.CheckActive(ctx, userID)
+}
+
+func (u *tracedUserUsecase) Follow(ctx context.Context, userID, targetID int) error {
+	ctx, span := startSpan(ctx, "UserUsecase.Follow", attribute.Int("user.id", userID), attribute.Int("target.id", targetID))
+	defer span.End()
+
+	err := u.next.Follow(ctx, userID, targetID)
+	tracing.RecordError(span, err)
+	return err
+}
+
+func (u *tracedUserUsecase) Unfollow(ctx context.Context, userID, targetID int) error {
+	ctx, span := startSpan(ctx, "UserUsecase.Unfollow", attribute.Int("user.id", userID), attribute.Int("target.id", targetID))
+	defer span.End()
+
+	err := u.next.Unfollow(ctx, userID, targetID)
+	tracing.RecordError(span, err)
+	return err
+}
+
+func (u *tracedUserUsecase) Block(ctx context.Context, userID, targetID int) error {
+	ctx, span := startSpan(ctx, "UserUsecase.Block", attribute.Int("user.id", userID), attribute.Int("target.id", targetID))
+	defer span.End()
+
+	err := u.next.Block(ctx, userID, targetID)
+	tracing.RecordError(span, err)
+	return err
+}
+
+func (u *tracedUserUsecase) Unblock(ctx context.Context, userID, targetID int) error {
+	ctx, span := startSpan(ctx, "UserUsecase.Unblock", attribute.Int("user.id", userID), attribute.Int("target.id", targetID))
+	defer span.End()
+
+	err := u.next.Unblock(ctx, userID, targetID)
+	tracing.RecordError(span, err)
+	return err
+}
+
+func (u *tracedUserUsecase) ListBlocks(ctx context.Context, userID int) (*model.UserList, error) {
+	ctx, span := startSpan(ctx, "UserUsecase.ListBlocks", attribute.Int("user.id", userID))
+	defer span.End()
+
+	list, err := u.next.ListBlocks(ctx, userID)
+	tracing.RecordError(span, err)
+	return list, err
+}
+
+func (u *tracedUserUsecase) Mute(ctx context.Context, userID, targetID int) error {
+	ctx, span := startSpan(ctx, "UserUsecase.Mute", attribute.Int("user.id", userID), attribute.Int("target.id", targetID))
+	defer span.End()
+
+	err := u.next.Mute(ctx, userID, targetID)
+	tracing.RecordError(span, err)
+	return err
+}
+
+func (u *tracedUserUsecase) Unmute(ctx context.Context, userID, targetID int) error {
+	ctx, span := startSpan(ctx, "UserUsecase.Unmute", attribute.Int("user.id", userID), attribute.Int("target.id", targetID))
+	defer span.End()
+
+	err := u.next.Unmute(ctx, userID, targetID)
+	tracing.RecordError(span, err)
+	return err
+}
+
+func (u *tracedUserUsecase) ListMutes(ctx context.Context, userID int) (*model.UserList, error) {
+	ctx, span := startSpan(ctx, "UserUsecase.ListMutes", attribute.Int("user.id", userID))
+	defer span.End()
+
+	list, err := u.next.ListMutes(ctx, userID)
+	tracing.RecordError(span, err)
+	return list, err
+}
+
+func startSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return tracing.Tracer().Start(ctx, name, trace.WithAttributes(attrs...))
+}
@@ -1,9 +1,14 @@
 package usecase
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"time"
+	auditmodel "todoapp/internal/audit/model"
+	auditusecase "todoapp/internal/audit/usecase"
+	"todoapp/internal/domain"
+	"todoapp/internal/infrastructure"
 	"todoapp/internal/user/model"
 	"todoapp/internal/user/repository"
 
@@ -12,42 +17,104 @@ import (
 )
 
 type UserUsecase interface {
-	Register(req *model.RegisterRequest) (*model.User, error)
-	Login(req *model.LoginRequest) (*model.LoginResponse, error)
-	GetProfile(userID, currentUserID int) (*model.UserProfile, error)
-	UpdateProfile(userID int, req *model.UpdateProfileRequest) (*model.User, error)
+	Register(ctx context.Context, req *model.RegisterRequest) (*model.User, error)
+	Login(ctx context.Context, req *model.LoginRequest) (*model.LoginResponse, error)
+	// GetProfile は userID のプロフィールを返す。currentUserID とどちらかがブロックしていれば sql.ErrNoRows を返す
+	GetProfile(ctx context.Context, userID, currentUserID int) (*model.UserProfile, error)
+	UpdateProfile(ctx context.Context, userID int, req *model.UpdateProfileRequest) (*model.User, error)
+	// Deactivate はユーザーを退会させる。退会中のユーザーはプロフィールなどから見えなくなり、
+	// 猶予期間の間は Reactivate で元に戻せる。猶予期間を過ぎると repository.PurgeDeleted で物理削除される
+	Deactivate(ctx context.Context, userID int) error
+	// Reactivate はメールアドレスとパスワードで退会中のユーザーを元に戻し、ログインする
+	Reactivate(ctx context.Context, req *model.LoginRequest) (*model.LoginResponse, error)
+	// CheckActive はユーザーが存在して退会中でなければ nil を、そうでなければ sql.ErrNoRows を返す
+	CheckActive(ctx context.Context, userID int) error
+	// Follow は userID が targetID をフォローする。自分自身はフォローできない。
+	// 相手が存在しないか退会中なら sql.ErrNoRows、既にフォローしていれば Conflict、
+	// どちらかがブロックしていれば Forbidden を返す
+	Follow(ctx context.Context, userID, targetID int) error
+	// Unfollow はフォローを取り消す。フォローしていないか相手が退会中なら sql.ErrNoRows を返す
+	Unfollow(ctx context.Context, userID, targetID int) error
+	// Block は userID が targetID をブロックし、お互いのフォローを取り消す。
+	// ブロックした側とされた側は、お互いのプロフィールとツイートが見えなくなり、
+	// フォロー・いいね・リプライ・ダイレクトメッセージもできなくなる。
+	// 相手が存在しないか退会中なら sql.ErrNoRows、既にブロックしていれば Conflict を返す
+	Block(ctx context.Context, userID, targetID int) error
+	// Unblock はブロックを取り消す。取り消したフォローは元に戻さない。ブロックしていなければ sql.ErrNoRows を返す
+	Unblock(ctx context.Context, userID, targetID int) error
+	// ListBlocks は userID がブロックしているユーザーを新しい順に返す(退会中のユーザーは含めない)
+	ListBlocks(ctx context.Context, userID int) (*model.UserList, error)
+	// Mute は userID が targetID をミュートする。ミュートしたユーザーのツイートとリツイートはタイムラインに、
+	// ミュートしたユーザーからの通知は通知の一覧に表示しない。ミュートされた側には知らせない。
+	// 相手が存在しないか退会中なら sql.ErrNoRows、既にミュートしていれば Conflict を返す
+	Mute(ctx context.Context, userID, targetID int) error
+	// Unmute はミュートを取り消す。ミュートしていなければ sql.ErrNoRows を返す
+	Unmute(ctx context.Context, userID, targetID int) error
+	// ListMutes は userID がミュートしているユーザーを新しい順に返す(退会中のユーザーは含めない)
+	ListMutes(ctx context.Context, userID int) (*model.UserList, error)
 }
 
 type userUsecase struct {
 	repo      repository.UserRepository
+	txManager infrastructure.TxManager
+	auditor   auditusecase.Recorder
 	jwtSecret string
+	// gracePeriod は退会してから元に戻せる期間
+	gracePeriod time.Duration
 }
 
-func NewUserUsecase(db *sql.DB, jwtSecret string) UserUsecase {
+func NewUserUsecase(repo repository.UserRepository, txManager infrastructure.TxManager, auditor auditusecase.Recorder, jwtSecret string, gracePeriod time.Duration) UserUsecase {
 	return &userUsecase{
-		repo:      repository.NewUserRepository(db),
-		jwtSecret: jwtSecret,
+		repo:        repo,
+		txManager:   txManager,
+		auditor:     auditor,
+		jwtSecret:   jwtSecret,
+		gracePeriod: gracePeriod,
 	}
 }
 
-func (u *userUsecase) Register(req *model.RegisterRequest) (*model.User, error) {
-	// メールアドレスの重複チェック
-	existingUser, err := u.repo.GetByEmail(req.Email)
-	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+func (u *userUsecase) Register(ctx context.Context, req *model.RegisterRequest) (*model.User, error) {
+	var user *model.User
+	err := u.txManager.RunInTx(ctx, func(ctx context.Context) error {
+		// メールアドレスの重複チェック
+		// 同時登録で重複チェックをすり抜けた場合も一意制約違反が Conflict として返る
+		existingUser, err := u.repo.GetByEmail(ctx, req.Email)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+		if existingUser != nil {
+			return domain.Conflict("email already exists")
+		}
+
+		user, err = u.repo.Create(ctx, req)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+func (u *userUsecase) Login(ctx context.Context, req *model.LoginRequest) (*model.LoginResponse, error) {
+	user, err := u.authenticate(ctx, req)
+	if err != nil {
 		return nil, err
 	}
-	if existingUser != nil {
-		return nil, errors.New("email already exists")
+	// 退会中のユーザーは Reactivate でしかログインできない
+	if user.DeletedAt != nil {
+		return nil, u.loginFailed(ctx, &user.ID, req.Email, "deactivated", domain.Forbidden("account is deactivated"))
 	}
 
-	return u.repo.Create(req)
+	return u.loginSucceeded(ctx, user)
 }
 
-func (u *userUsecase) Login(req *model.LoginRequest) (*model.LoginResponse, error) {
-	user, err := u.repo.GetByEmail(req.Email)
+// authenticate はメールアドレスとパスワードを検証してユーザーを返す。退会中のユーザーも返す
+func (u *userUsecase) authenticate(ctx context.Context, req *model.LoginRequest) (*model.User, error) {
+	user, err := u.repo.GetByEmail(ctx, req.Email)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return nil, errors.New("invalid email or password")
+			return nil, u.loginFailed(ctx, nil, req.Email, "unknown_email", errInvalidCredentials)
 		}
 		return nil, err
 	}
@@ -55,7 +122,22 @@ func (u *userUsecase) Login(req *model.LoginRequest) (*model.LoginResponse, erro
 	// パスワードの検証
 	err = bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(req.Password))
 	if err != nil {
-		return nil, errors.New("invalid email or password")
+		return nil, u.loginFailed(ctx, &user.ID, req.Email, "wrong_password", errInvalidCredentials)
+	}
+
+	return user, nil
+}
+
+// loginSucceeded はログインの成功を記録し、トークンを発行する
+func (u *userUsecase) loginSucceeded(ctx context.Context, user *model.User) (*model.LoginResponse, error) {
+	err := u.auditor.Record(ctx, &auditmodel.Event{
+		Type:       auditmodel.EventLoginSucceeded,
+		ActorID:    &user.ID,
+		TargetType: auditmodel.TargetUser,
+		TargetID:   &user.ID,
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	// JWTトークンの生成
@@ -75,10 +157,242 @@ func (u *userUsecase) Login(req *model.LoginRequest) (*model.LoginResponse, erro
 	}, nil
 }
 
-func (u *userUsecase) GetProfile(userID, currentUserID int) (*model.UserProfile, error) {
-	return u.repo.GetProfile(userID, currentUserID)
+// errInvalidCredentials はメールアドレスかパスワードが違う場合のエラー。
+// メールアドレスが存在するかどうかはクライアントに区別させない
+var errInvalidCredentials = errors.New("invalid email or password")
+
+// loginFailed はログインの失敗を記録し、クライアントに返すエラー clientErr を返す
+func (u *userUsecase) loginFailed(ctx context.Context, userID *int, email, reason string, clientErr error) error {
+	event := &auditmodel.Event{
+		Type:    auditmodel.EventLoginFailed,
+		Details: map[string]string{"email": email, "reason": reason},
+	}
+	if userID != nil {
+		event.TargetType = auditmodel.TargetUser
+		event.TargetID = userID
+	}
+	if err := u.auditor.Record(ctx, event); err != nil {
+		return err
+	}
+	return clientErr
+}
+
+func (u *userUsecase) GetProfile(ctx context.Context, userID, currentUserID int) (*model.UserProfile, error) {
+	// プロフィールは閲覧者によらずキャッシュするので、ブロックは読み込む前に確認する
+	if currentUserID != 0 && currentUserID != userID {
+		blocked, err := u.repo.IsBlocked(ctx, currentUserID, userID)
+		if err != nil {
+			return nil, err
+		}
+		if blocked {
+			return nil, sql.ErrNoRows
+		}
+	}
+	return u.repo.GetProfile(ctx, userID, currentUserID)
+}
+
+func (u *userUsecase) UpdateProfile(ctx context.Context, userID int, req *model.UpdateProfileRequest) (*model.User, error) {
+	var user *model.User
+	err := u.txManager.RunInTx(ctx, func(ctx context.Context) error {
+		var err error
+		user, err = u.repo.Update(ctx, userID, req)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+func (u *userUsecase) Deactivate(ctx context.Context, userID int) error {
+	return u.txManager.RunInTx(ctx, func(ctx context.Context) error {
+		if err := u.repo.Deactivate(ctx, userID); err != nil {
+			return err
+		}
+		return u.addFollowCounts(ctx, userID, -1)
+	})
+}
+
+func (u *userUsecase) Reactivate(ctx context.Context, req *model.LoginRequest) (*model.LoginResponse, error) {
+	user, err := u.authenticate(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.DeletedAt != nil {
+		if time.Since(*user.DeletedAt) > u.gracePeriod {
+			return nil, domain.Gone("account can no longer be reactivated")
+		}
+		err = u.txManager.RunInTx(ctx, func(ctx context.Context) error {
+			user, err = u.repo.Reactivate(ctx, user.ID)
+			if err != nil {
+				return err
+			}
+			return u.addFollowCounts(ctx, user.ID, 1)
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return u.loginSucceeded(ctx, user)
+}
+
+// addFollowCounts は userID とフォロー関係にあるユーザーの件数に sign(1 または -1)を加える。
+// 件数は退会中のユーザーとのフォロー関係を数えないので、退会と復帰のときに相手の件数を増減する
+// (userID 自身の件数は相手が退会中かどうかで決まるので変わらない)
+func (u *userUsecase) addFollowCounts(ctx context.Context, userID, sign int) error {
+	followingIDs, followerIDs, err := u.repo.GetFollowIDs(ctx, userID)
+	if err != nil {
+		return err
+	}
+	for _, id := range followingIDs {
+		if err := u.repo.AddCounts(ctx, id, model.UserCounts{Followers: sign}); err != nil {
+			return err
+		}
+	}
+	for _, id := range followerIDs {
+		if err := u.repo.AddCounts(ctx, id, model.UserCounts{Following: sign}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (u *userUsecase) Follow(ctx context.Context, userID, targetID int) error {
+	if userID == targetID {
+		return domain.Invalid("cannot follow yourself")
+	}
+	return u.txManager.RunInTx(ctx, func(ctx context.Context) error {
+		if _, err := u.repo.GetByID(ctx, targetID); err != nil {
+			return err
+		}
+		blocked, err := u.repo.IsBlocked(ctx, userID, targetID)
+		if err != nil {
+			return err
+		}
+		if blocked {
+			return domain.Forbidden("cannot follow this user")
+		}
+		if err := u.repo.Follow(ctx, userID, targetID); err != nil {
+			if errors.Is(err, domain.ErrConflict) {
+				return domain.Conflict("already following")
+			}
+			return err
+		}
+		return u.addFollow(ctx, userID, targetID, 1)
+	})
+}
+
+func (u *userUsecase) Unfollow(ctx context.Context, userID, targetID int) error {
+	return u.txManager.RunInTx(ctx, func(ctx context.Context) error {
+		// 退会中の相手とのフォローは件数に含めていないので、件数を減らさないよう取り消せないことにする
+		if _, err := u.repo.GetByID(ctx, targetID); err != nil {
+			return err
+		}
+		if err := u.repo.Unfollow(ctx, userID, targetID); err != nil {
+			return err
+		}
+		return u.addFollow(ctx, userID, targetID, -1)
+	})
+}
+
+// addFollow は followerID のフォロー数と followingID のフォロワー数に sign(1 または -1)を加える
+func (u *userUsecase) addFollow(ctx context.Context, followerID, followingID, sign int) error {
+	if err := u.repo.AddCounts(ctx, followerID, model.UserCounts{Following: sign}); err != nil {
+		return err
+	}
+	return u.repo.AddCounts(ctx, followingID, model.UserCounts{Followers: sign})
+}
+
+func (u *userUsecase) Block(ctx context.Context, userID, targetID int) error {
+	if userID == targetID {
+		return domain.Invalid("cannot block yourself")
+	}
+	return u.txManager.RunInTx(ctx, func(ctx context.Context) error {
+		if _, err := u.repo.GetByID(ctx, targetID); err != nil {
+			return err
+		}
+		if err := u.repo.Block(ctx, userID, targetID); err != nil {
+			if errors.Is(err, domain.ErrConflict) {
+				return domain.Conflict("already blocking")
+			}
+			return err
+		}
+
+		// お互いのフォローを取り消す。どちらも退会中でないので、件数も減らす
+		for _, pair := range [][2]int{{userID, targetID}, {targetID, userID}} {
+			err := u.repo.Unfollow(ctx, pair[0], pair[1])
+			if errors.Is(err, sql.ErrNoRows) {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			if err := u.addFollow(ctx, pair[0], pair[1], -1); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (u *userUsecase) Unblock(ctx context.Context, userID, targetID int) error {
+	return u.repo.Unblock(ctx, userID, targetID)
+}
+
+func (u *userUsecase) ListBlocks(ctx context.Context, userID int) (*model.UserList, error) {
+	blockedIDs, _, err := u.repo.GetBlockIDs(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return u.listProfiles(ctx, blockedIDs, userID)
+}
+
+func (u *userUsecase) Mute(ctx context.Context, userID, targetID int) error {
+	if userID == targetID {
+		return domain.Invalid("cannot mute yourself")
+	}
+	return u.txManager.RunInTx(ctx, func(ctx context.Context) error {
+		if _, err := u.repo.GetByID(ctx, targetID); err != nil {
+			return err
+		}
+		err := u.repo.Mute(ctx, userID, targetID)
+		if errors.Is(err, domain.ErrConflict) {
+			return domain.Conflict("already muting")
+		}
+		return err
+	})
+}
+
+func (u *userUsecase) Unmute(ctx context.Context, userID, targetID int) error {
+	return u.repo.Unmute(ctx, userID, targetID)
+}
+
+func (u *userUsecase) ListMutes(ctx context.Context, userID int) (*model.UserList, error) {
+	mutedIDs, err := u.repo.GetMutedIDs(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return u.listProfiles(ctx, mutedIDs, userID)
+}
+
+// listProfiles は ids のユーザーのプロフィールを ids の順に返す
+func (u *userUsecase) listProfiles(ctx context.Context, ids []int, currentUserID int) (*model.UserList, error) {
+	list := &model.UserList{Users: []*model.UserProfile{}}
+	if len(ids) == 0 {
+		return list, nil
+	}
+	profiles, err := u.repo.GetProfiles(ctx, ids, currentUserID)
+	if err != nil {
+		return nil, err
+	}
+	list.Users = profiles
+	return list, nil
 }
 
-func (u *userUsecase) UpdateProfile(userID int, req *model.UpdateProfileRequest) (*model.User, error) {
-	return u.repo.Update(userID, req)
+func (u *userUsecase) CheckActive(ctx context.Context, userID int) error {
+	_, err := u.repo.GetByID(ctx, userID)
+	return err
 }
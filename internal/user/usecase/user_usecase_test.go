@@ -0,0 +1,502 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+	auditmodel "todoapp/internal/audit/model"
+	"todoapp/internal/domain"
+	"todoapp/internal/user/model"
+	"todoapp/internal/user/repository"
+
+	"github.com/golang-jwt/jwt"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const testJWTSecret = "test-secret"
+
+// fakeTxManager はトランザクションを張らずに fn をそのまま実行する
+type fakeTxManager struct{}
+
+func (fakeTxManager) RunInTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+// fakeRecorder は記録された監査ログを保持する
+type fakeRecorder struct {
+	events []*auditmodel.Event
+}
+
+func (r *fakeRecorder) Record(ctx context.Context, event *auditmodel.Event) error {
+	r.events = append(r.events, event)
+	return nil
+}
+
+func newTestUsecase(t *testing.T) (UserUsecase, *repository.MemoryUserRepository) {
+	t.Helper()
+	repo := repository.NewMemoryUserRepository().WithPasswordCost(bcrypt.MinCost)
+	return NewUserUsecase(repo, fakeTxManager{}, &fakeRecorder{}, testJWTSecret, DefaultGracePeriod), repo
+}
+
+func registerUser(t *testing.T, u UserUsecase, username string) *model.User {
+	t.Helper()
+	user, err := u.Register(context.Background(), &model.RegisterRequest{
+		Username:    username,
+		DisplayName: username,
+		Email:       username + "@example.com",
+		Password:    "password123",
+	})
+	if err != nil {
+		t.Fatalf("Register(%s): %v", username, err)
+	}
+	return user
+}
+
+func follow(t *testing.T, u UserUsecase, userID, targetID int) {
+	t.Helper()
+	if err := u.Follow(context.Background(), userID, targetID); err != nil {
+		t.Fatalf("Follow(%d, %d): %v", userID, targetID, err)
+	}
+}
+
+func TestRegister(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     model.RegisterRequest
+		wantErr error
+	}{
+		{
+			name: "success",
+			req:  model.RegisterRequest{Username: "bob", DisplayName: "Bob", Email: "bob@example.com", Password: "password123"},
+		},
+		{
+			name:    "duplicate email",
+			req:     model.RegisterRequest{Username: "alice2", DisplayName: "Alice", Email: "alice@example.com", Password: "password123"},
+			wantErr: domain.ErrConflict,
+		},
+		{
+			name:    "duplicate username",
+			req:     model.RegisterRequest{Username: "alice", DisplayName: "Alice", Email: "other@example.com", Password: "password123"},
+			wantErr: domain.ErrConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u, _ := newTestUsecase(t)
+			registerUser(t, u, "alice")
+
+			user, err := u.Register(context.Background(), &tt.req)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("err = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if user.Email != tt.req.Email || user.Username != tt.req.Username {
+				t.Errorf("user = %+v", user)
+			}
+			if bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(tt.req.Password)) != nil {
+				t.Error("password hash does not match")
+			}
+		})
+	}
+}
+
+func TestLogin(t *testing.T) {
+	tests := []struct {
+		name       string
+		req        model.LoginRequest
+		wantErr    string
+		wantEvent  string
+		wantReason string
+	}{
+		{name: "success", req: model.LoginRequest{Email: "alice@example.com", Password: "password123"}, wantEvent: auditmodel.EventLoginSucceeded},
+		{name: "wrong password", req: model.LoginRequest{Email: "alice@example.com", Password: "wrong-password"}, wantErr: "invalid email or password", wantEvent: auditmodel.EventLoginFailed, wantReason: "wrong_password"},
+		{name: "unknown email", req: model.LoginRequest{Email: "missing@example.com", Password: "password123"}, wantErr: "invalid email or password", wantEvent: auditmodel.EventLoginFailed, wantReason: "unknown_email"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := &fakeRecorder{}
+			repo := repository.NewMemoryUserRepository().WithPasswordCost(bcrypt.MinCost)
+			u := NewUserUsecase(repo, fakeTxManager{}, recorder, testJWTSecret, DefaultGracePeriod)
+			alice := registerUser(t, u, "alice")
+
+			resp, err := u.Login(context.Background(), &tt.req)
+			if len(recorder.events) != 1 || recorder.events[0].Type != tt.wantEvent ||
+				recorder.events[0].Details["reason"] != tt.wantReason {
+				t.Errorf("recorded events = %+v, want one %s (reason %q)", recorder.events, tt.wantEvent, tt.wantReason)
+			}
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("err = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if resp.User.ID != alice.ID {
+				t.Errorf("User.ID = %d, want %d", resp.User.ID, alice.ID)
+			}
+			if actor := recorder.events[0].ActorID; actor == nil || *actor != alice.ID {
+				t.Errorf("ActorID = %v, want %d", actor, alice.ID)
+			}
+
+			claims := jwt.MapClaims{}
+			_, err = jwt.ParseWithClaims(resp.Token, claims, func(*jwt.Token) (interface{}, error) {
+				return []byte(testJWTSecret), nil
+			})
+			if err != nil {
+				t.Fatalf("invalid token: %v", err)
+			}
+			if int(claims["user_id"].(float64)) != alice.ID {
+				t.Errorf("user_id claim = %v, want %d", claims["user_id"], alice.ID)
+			}
+		})
+	}
+}
+
+func TestGetProfile(t *testing.T) {
+	u, _ := newTestUsecase(t)
+	alice := registerUser(t, u, "alice")
+	bob := registerUser(t, u, "bob")
+	follow(t, u, alice.ID, bob.ID)
+
+	tests := []struct {
+		name          string
+		userID        int
+		currentUserID int
+		wantFollowers int
+		wantFollowing bool
+		wantErr       error
+	}{
+		{name: "followed by viewer", userID: bob.ID, currentUserID: alice.ID, wantFollowers: 1, wantFollowing: true},
+		{name: "not followed by viewer", userID: alice.ID, currentUserID: bob.ID, wantFollowers: 0, wantFollowing: false},
+		{name: "not found", userID: bob.ID + 1000, wantErr: sql.ErrNoRows},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			profile, err := u.GetProfile(context.Background(), tt.userID, tt.currentUserID)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("err = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if profile.User.ID != tt.userID || profile.FollowersCount != tt.wantFollowers || profile.IsFollowing != tt.wantFollowing {
+				t.Errorf("profile = %+v", profile)
+			}
+		})
+	}
+}
+
+func TestUpdateProfile(t *testing.T) {
+	bio := "updated bio"
+
+	tests := []struct {
+		name    string
+		missing bool
+		req     model.UpdateProfileRequest
+		wantErr error
+	}{
+		{name: "success", req: model.UpdateProfileRequest{DisplayName: "Alice Updated", Bio: &bio}},
+		{name: "not found", missing: true, req: model.UpdateProfileRequest{DisplayName: "x"}, wantErr: sql.ErrNoRows},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u, _ := newTestUsecase(t)
+			alice := registerUser(t, u, "alice")
+			userID := alice.ID
+			if tt.missing {
+				userID += 1000
+			}
+
+			user, err := u.UpdateProfile(context.Background(), userID, &tt.req)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("err = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if user.DisplayName != tt.req.DisplayName || user.Bio == nil || *user.Bio != bio {
+				t.Errorf("user = %+v", user)
+			}
+		})
+	}
+}
+
+func TestDeactivate(t *testing.T) {
+	u, _ := newTestUsecase(t)
+	alice := registerUser(t, u, "alice")
+	bob := registerUser(t, u, "bob")
+	follow(t, u, alice.ID, bob.ID)
+	follow(t, u, bob.ID, alice.ID)
+
+	if err := u.Deactivate(context.Background(), alice.ID); err != nil {
+		t.Fatalf("Deactivate: %v", err)
+	}
+
+	// 退会したユーザーとのフォロー関係は相手の件数から除かれる
+	profile, err := u.GetProfile(context.Background(), bob.ID, 0)
+	if err != nil {
+		t.Fatalf("GetProfile: %v", err)
+	}
+	if profile.FollowersCount != 0 || profile.FollowingCount != 0 {
+		t.Errorf("bob's profile = %+v, want no follows", profile)
+	}
+	if err := u.CheckActive(context.Background(), alice.ID); !errors.Is(err, sql.ErrNoRows) {
+		t.Errorf("CheckActive err = %v, want sql.ErrNoRows", err)
+	}
+	if err := u.Deactivate(context.Background(), alice.ID); !errors.Is(err, sql.ErrNoRows) {
+		t.Errorf("second Deactivate err = %v, want sql.ErrNoRows", err)
+	}
+
+	_, err = u.Login(context.Background(), &model.LoginRequest{Email: "alice@example.com", Password: "password123"})
+	if !errors.Is(err, domain.ErrForbidden) {
+		t.Errorf("Login err = %v, want domain.ErrForbidden", err)
+	}
+}
+
+func TestReactivate(t *testing.T) {
+	tests := []struct {
+		name        string
+		gracePeriod time.Duration
+		password    string
+		wantErr     error
+	}{
+		{name: "within grace period", gracePeriod: time.Hour, password: "password123"},
+		{name: "grace period expired", gracePeriod: -time.Hour, password: "password123", wantErr: domain.ErrGone},
+		{name: "wrong password", gracePeriod: time.Hour, password: "wrong-password", wantErr: errInvalidCredentials},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := &fakeRecorder{}
+			repo := repository.NewMemoryUserRepository().WithPasswordCost(bcrypt.MinCost)
+			u := NewUserUsecase(repo, fakeTxManager{}, recorder, testJWTSecret, tt.gracePeriod)
+			alice := registerUser(t, u, "alice")
+			bob := registerUser(t, u, "bob")
+			follow(t, u, bob.ID, alice.ID)
+			if err := u.Deactivate(context.Background(), alice.ID); err != nil {
+				t.Fatalf("Deactivate: %v", err)
+			}
+
+			resp, err := u.Reactivate(context.Background(), &model.LoginRequest{Email: "alice@example.com", Password: tt.password})
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("err = %v, want %v", err, tt.wantErr)
+				}
+				if err := u.CheckActive(context.Background(), alice.ID); !errors.Is(err, sql.ErrNoRows) {
+					t.Errorf("CheckActive err = %v, want sql.ErrNoRows", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if resp.Token == "" || resp.User.ID != alice.ID || resp.User.DeletedAt != nil {
+				t.Errorf("resp = %+v", resp)
+			}
+
+			profile, err := u.GetProfile(context.Background(), bob.ID, 0)
+			if err != nil {
+				t.Fatalf("GetProfile: %v", err)
+			}
+			if profile.FollowingCount != 1 {
+				t.Errorf("bob's following count = %d, want 1", profile.FollowingCount)
+			}
+			if last := recorder.events[len(recorder.events)-1]; last.Type != auditmodel.EventLoginSucceeded {
+				t.Errorf("last recorded event = %+v, want %s", last, auditmodel.EventLoginSucceeded)
+			}
+
+			// 復帰した後は通常どおりログインできる
+			if _, err := u.Login(context.Background(), &model.LoginRequest{Email: "alice@example.com", Password: "password123"}); err != nil {
+				t.Errorf("Login after Reactivate: %v", err)
+			}
+		})
+	}
+}
+
+func TestFollow(t *testing.T) {
+	ctx := context.Background()
+	u, _ := newTestUsecase(t)
+	alice := registerUser(t, u, "alice")
+	bob := registerUser(t, u, "bob")
+
+	follow(t, u, alice.ID, bob.ID)
+	if err := u.Follow(ctx, alice.ID, bob.ID); !errors.Is(err, domain.ErrConflict) {
+		t.Errorf("second Follow err = %v, want domain.ErrConflict", err)
+	}
+	if err := u.Follow(ctx, alice.ID, alice.ID); !errors.Is(err, domain.ErrInvalid) {
+		t.Errorf("Follow(self) err = %v, want domain.ErrInvalid", err)
+	}
+	if err := u.Follow(ctx, alice.ID, bob.ID+1000); !errors.Is(err, sql.ErrNoRows) {
+		t.Errorf("Follow(missing) err = %v, want sql.ErrNoRows", err)
+	}
+
+	profile, err := u.GetProfile(ctx, bob.ID, alice.ID)
+	if err != nil {
+		t.Fatalf("GetProfile: %v", err)
+	}
+	if profile.FollowersCount != 1 || !profile.IsFollowing {
+		t.Errorf("bob's profile after Follow = %+v", profile)
+	}
+
+	if err := u.Unfollow(ctx, alice.ID, bob.ID); err != nil {
+		t.Fatalf("Unfollow: %v", err)
+	}
+	if err := u.Unfollow(ctx, alice.ID, bob.ID); !errors.Is(err, sql.ErrNoRows) {
+		t.Errorf("second Unfollow err = %v, want sql.ErrNoRows", err)
+	}
+	for _, id := range []int{alice.ID, bob.ID} {
+		profile, err := u.GetProfile(ctx, id, 0)
+		if err != nil {
+			t.Fatalf("GetProfile: %v", err)
+		}
+		if profile.FollowersCount != 0 || profile.FollowingCount != 0 {
+			t.Errorf("profile after Unfollow = %+v, want no follows", profile)
+		}
+	}
+}
+
+func TestBlock(t *testing.T) {
+	ctx := context.Background()
+	u, _ := newTestUsecase(t)
+	alice := registerUser(t, u, "alice")
+	bob := registerUser(t, u, "bob")
+	carol := registerUser(t, u, "carol")
+
+	follow(t, u, alice.ID, bob.ID)
+	follow(t, u, bob.ID, alice.ID)
+	follow(t, u, carol.ID, alice.ID)
+
+	if err := u.Block(ctx, alice.ID, bob.ID); err != nil {
+		t.Fatalf("Block: %v", err)
+	}
+	if err := u.Block(ctx, alice.ID, bob.ID); !errors.Is(err, domain.ErrConflict) {
+		t.Errorf("second Block err = %v, want domain.ErrConflict", err)
+	}
+	if err := u.Block(ctx, alice.ID, alice.ID); !errors.Is(err, domain.ErrInvalid) {
+		t.Errorf("Block(self) err = %v, want domain.ErrInvalid", err)
+	}
+	if err := u.Block(ctx, alice.ID, bob.ID+1000); !errors.Is(err, sql.ErrNoRows) {
+		t.Errorf("Block(missing) err = %v, want sql.ErrNoRows", err)
+	}
+
+	// お互いのフォローが取り消され、件数も減る(ブロックと関係のない carol のフォローは残る)
+	profile, err := u.GetProfile(ctx, alice.ID, carol.ID)
+	if err != nil {
+		t.Fatalf("GetProfile: %v", err)
+	}
+	if profile.FollowersCount != 1 || profile.FollowingCount != 0 {
+		t.Errorf("alice's profile after Block = %+v, want 1 follower and no following", profile)
+	}
+	profile, err = u.GetProfile(ctx, bob.ID, 0)
+	if err != nil {
+		t.Fatalf("GetProfile: %v", err)
+	}
+	if profile.FollowersCount != 0 || profile.FollowingCount != 0 {
+		t.Errorf("bob's profile after Block = %+v, want no follows", profile)
+	}
+
+	// どちらからもプロフィールが見えず、フォローできない
+	for _, pair := range [][2]int{{alice.ID, bob.ID}, {bob.ID, alice.ID}} {
+		if _, err := u.GetProfile(ctx, pair[1], pair[0]); !errors.Is(err, sql.ErrNoRows) {
+			t.Errorf("GetProfile(%d) by %d err = %v, want sql.ErrNoRows", pair[1], pair[0], err)
+		}
+		if err := u.Follow(ctx, pair[0], pair[1]); !errors.Is(err, domain.ErrForbidden) {
+			t.Errorf("Follow(%d, %d) err = %v, want domain.ErrForbidden", pair[0], pair[1], err)
+		}
+	}
+
+	list, err := u.ListBlocks(ctx, alice.ID)
+	if err != nil {
+		t.Fatalf("ListBlocks: %v", err)
+	}
+	if len(list.Users) != 1 || list.Users[0].User.ID != bob.ID {
+		t.Errorf("ListBlocks = %+v, want [bob]", list.Users)
+	}
+
+	// ブロックを取り消してもフォローは戻らない
+	if err := u.Unblock(ctx, alice.ID, bob.ID); err != nil {
+		t.Fatalf("Unblock: %v", err)
+	}
+	if err := u.Unblock(ctx, alice.ID, bob.ID); !errors.Is(err, sql.ErrNoRows) {
+		t.Errorf("second Unblock err = %v, want sql.ErrNoRows", err)
+	}
+	profile, err = u.GetProfile(ctx, bob.ID, alice.ID)
+	if err != nil {
+		t.Fatalf("GetProfile after Unblock: %v", err)
+	}
+	if profile.IsFollowing || profile.FollowersCount != 0 {
+		t.Errorf("bob's profile after Unblock = %+v, want no follows", profile)
+	}
+	follow(t, u, alice.ID, bob.ID)
+}
+
+func TestMute(t *testing.T) {
+	ctx := context.Background()
+	u, _ := newTestUsecase(t)
+	alice := registerUser(t, u, "alice")
+	bob := registerUser(t, u, "bob")
+
+	follow(t, u, alice.ID, bob.ID)
+	if err := u.Mute(ctx, alice.ID, bob.ID); err != nil {
+		t.Fatalf("Mute: %v", err)
+	}
+	if err := u.Mute(ctx, alice.ID, bob.ID); !errors.Is(err, domain.ErrConflict) {
+		t.Errorf("second Mute err = %v, want domain.ErrConflict", err)
+	}
+	if err := u.Mute(ctx, alice.ID, alice.ID); !errors.Is(err, domain.ErrInvalid) {
+		t.Errorf("Mute(self) err = %v, want domain.ErrInvalid", err)
+	}
+
+	// ミュートしてもフォローは残り、ミュートされた側からは何も変わらない
+	profile, err := u.GetProfile(ctx, bob.ID, alice.ID)
+	if err != nil {
+		t.Fatalf("GetProfile: %v", err)
+	}
+	if !profile.IsFollowing {
+		t.Error("IsFollowing = false after Mute")
+	}
+	if _, err := u.GetProfile(ctx, alice.ID, bob.ID); err != nil {
+		t.Errorf("GetProfile by muted user: %v", err)
+	}
+	list, err := u.ListMutes(ctx, bob.ID)
+	if err != nil {
+		t.Fatalf("ListMutes: %v", err)
+	}
+	if len(list.Users) != 0 {
+		t.Errorf("bob's ListMutes = %+v, want none", list.Users)
+	}
+
+	list, err = u.ListMutes(ctx, alice.ID)
+	if err != nil {
+		t.Fatalf("ListMutes: %v", err)
+	}
+	if len(list.Users) != 1 || list.Users[0].User.ID != bob.ID {
+		t.Errorf("ListMutes = %+v, want [bob]", list.Users)
+	}
+
+	if err := u.Unmute(ctx, alice.ID, bob.ID); err != nil {
+		t.Fatalf("Unmute: %v", err)
+	}
+	if err := u.Unmute(ctx, alice.ID, bob.ID); !errors.Is(err, sql.ErrNoRows) {
+		t.Errorf("second Unmute err = %v, want sql.ErrNoRows", err)
+	}
+}
@@ -1,57 +1,334 @@
 package main
 
 import (
+	"context"
+	"io"
 	"log"
 	"net/http"
 	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
 
+	"todoapp/internal/audit"
+	audithandler "todoapp/internal/audit/handler"
+	auditrepository "todoapp/internal/audit/repository"
+	auditusecase "todoapp/internal/audit/usecase"
+	"todoapp/internal/blob"
+	"todoapp/internal/cache"
+	dmhandler "todoapp/internal/dm/handler"
+	dmrepository "todoapp/internal/dm/repository"
+	dmusecase "todoapp/internal/dm/usecase"
+	exporthandler "todoapp/internal/export/handler"
+	exportrepository "todoapp/internal/export/repository"
+	exportusecase "todoapp/internal/export/usecase"
 	"todoapp/internal/infrastructure"
+	"todoapp/internal/migration"
+	notificationhandler "todoapp/internal/notification/handler"
+	notificationrepository "todoapp/internal/notification/repository"
+	notificationusecase "todoapp/internal/notification/usecase"
+	"todoapp/internal/router"
+	"todoapp/internal/search"
+	searchhandler "todoapp/internal/search/handler"
+	searchusecase "todoapp/internal/search/usecase"
+	"todoapp/internal/stream"
+	streamhandler "todoapp/internal/stream/handler"
+	"todoapp/internal/tracing"
+	tweethandler "todoapp/internal/tweet/handler"
+	tweetrepository "todoapp/internal/tweet/repository"
+	tweetusecase "todoapp/internal/tweet/usecase"
 	"todoapp/internal/user/handler"
+	"todoapp/internal/user/repository"
+	"todoapp/internal/user/usecase"
+	"todoapp/migrations"
 
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
+	"github.com/volatiletech/sqlboiler/v4/boil"
 )
 
 func main() {
+	// トレーシングの初期化
+	shutdownTracing, err := tracing.Setup(context.Background(), tracing.ConfigFromEnv())
+	if err != nil {
+		log.Fatal("トレーシング初期化エラー: ", err)
+	}
+	defer func() {
+		if err := shutdownTracing(context.Background()); err != nil {
+			log.Println("トレーシング終了エラー: ", err)
+		}
+	}()
+
 	// データベース接続
-	db, err := infrastructure.NewDB(
-		os.Getenv("DB_HOST"),
-		os.Getenv("DB_PORT"),
-		os.Getenv("DB_USER"),
-		os.Getenv("DB_PASSWORD"),
-		os.Getenv("DB_NAME"),
-	)
+	dbConfig, err := infrastructure.DBConfigFromEnv()
+	if err != nil {
+		log.Fatal("データベース設定エラー: ", err)
+	}
+
+	// 起動時のマイグレーション(複数のレプリカが同時に起動してもロックで直列化される)
+	if os.Getenv("DB_MIGRATE_ON_START") == "true" {
+		if err := runMigrations(dbConfig); err != nil {
+			log.Fatal("マイグレーションエラー: ", err)
+		}
+	}
+
+	cluster, err := infrastructure.NewDBCluster(dbConfig)
 	if err != nil {
 		log.Fatal("データベース接続エラー: ", err)
 	}
-	defer db.Close()
+	defer cluster.Close()
 
 	// ハンドラーの初期化
-	userHandler := handler.NewUserHandler(db)
+	// MySQL方言のモデルを各ドライバーで実行できるようにしてから、SQL文ごとのスパンを記録する
+	traceExecutor := tracing.WrapExecutorFor(dbConfig.Driver)
+	wrapExecutor := func(exec boil.ContextExecutor) boil.ContextExecutor {
+		return infrastructure.DialectExecutor(dbConfig.Driver, traceExecutor(exec))
+	}
+	userRepo := repository.NewUserRepository(
+		wrapExecutor(cluster.Primary),
+		wrapExecutor(cluster.Reader()),
+	)
+
+	// 読み取りキャッシュ(CACHE_DRIVER=none の場合は使わない)
+	cacheConfig, err := cache.ConfigFromEnv()
+	if err != nil {
+		log.Fatal("キャッシュ設定エラー: ", err)
+	}
+	readCache, err := cache.New(context.Background(), cacheConfig)
+	if err != nil {
+		log.Fatal("キャッシュ接続エラー: ", err)
+	}
+	var readThrough *cache.ReadThrough
+	if readCache != nil {
+		if closer, ok := readCache.(io.Closer); ok {
+			defer closer.Close()
+		}
+		readThrough = cache.NewReadThrough(readCache, cacheConfig.TTL)
+		repository.RegisterCacheInvalidation(readThrough)
+		userRepo = repository.NewCachedUserRepository(userRepo, readThrough)
+	}
+	txManager := infrastructure.NewTxManager(cluster.Primary, wrapExecutor)
+
+	// 監査ログ(users と tweets の変更はフックで記録する)
+	auditRepo := auditrepository.NewAuditRepository(
+		wrapExecutor(cluster.Primary),
+		wrapExecutor(cluster.Reader()),
+	)
+	auditrepository.RegisterModelHooks()
+	auditUsecase := auditusecase.NewAuditUsecase(auditRepo)
+	adminUserIDs, err := audit.AdminUserIDsFromEnv()
+	if err != nil {
+		log.Fatal("監査ログ設定エラー: ", err)
+	}
+	auditHandler := audithandler.NewAuditHandler(auditUsecase, adminUserIDs)
+	ipExtractor, err := audit.IPExtractorFromEnv()
+	if err != nil {
+		log.Fatal("プロキシ設定エラー: ", err)
+	}
+
+	gracePeriod, err := usecase.GracePeriodFromEnv()
+	if err != nil {
+		log.Fatal("退会設定エラー: ", err)
+	}
+	userUsecase := usecase.NewTracedUserUsecase(
+		usecase.NewUserUsecase(userRepo, txManager, auditUsecase, "your-secret-key", gracePeriod), // TODO: 環境変数から取得
+	)
+	userHandler := handler.NewUserHandler(userUsecase)
+
+	tweetRepo := tweetrepository.NewTweetRepository(
+		wrapExecutor(cluster.Primary),
+		wrapExecutor(cluster.Reader()),
+	)
+	if readThrough != nil {
+		// 配信のフックはこのリポジトリでツイートを読み直すので、キャッシュの削除を先に登録しておく
+		tweetrepository.RegisterCacheInvalidation(readThrough)
+		tweetRepo = tweetrepository.NewCachedTweetRepository(tweetRepo, readThrough)
+	}
+	tweetHandler := tweethandler.NewTweetHandler(
+		tweetusecase.NewTracedTweetUsecase(tweetusecase.NewTweetUsecase(tweetRepo, userRepo, txManager)),
+	)
+
+	// 通知(フォロー・いいね・リプライ・メンションの通知はフックで作成する)
+	notificationrepository.RegisterModelHooks()
+	notificationHandler := notificationhandler.NewNotificationHandler(
+		notificationusecase.NewNotificationUsecase(
+			notificationrepository.NewNotificationRepository(wrapExecutor(cluster.Primary), wrapExecutor(cluster.Reader())),
+			txManager,
+		),
+	)
+
+	// ダイレクトメッセージ
+	dmHandler := dmhandler.NewDMHandler(
+		dmusecase.NewDMUsecase(
+			dmrepository.NewDMRepository(wrapExecutor(cluster.Primary), wrapExecutor(cluster.Reader())),
+			txManager,
+		),
+	)
+
+	// リアルタイム配信(新しいツイート・通知・いいね数・ダイレクトメッセージはフックで配る)
+	streamConfig, err := stream.ConfigFromEnv()
+	if err != nil {
+		log.Fatal("配信設定エラー: ", err)
+	}
+	broker, err := stream.NewBroker(context.Background(), streamConfig)
+	if err != nil {
+		log.Fatal("配信の初期化エラー: ", err)
+	}
+	hub, err := stream.NewHub(broker, streamConfig)
+	if err != nil {
+		log.Fatal("配信の初期化エラー: ", err)
+	}
+	defer hub.Close()
+	stream.RegisterPublishHooks(hub, tweetRepo)
+	streamHandler := streamhandler.NewStreamHandler(hub, streamConfig.Heartbeat)
+
+	// 全文検索(プロセス内のインデックスは起動時にデータベースから作り、フックで変更に追従させる)
+	searchConfig, err := search.ConfigFromEnv()
+	if err != nil {
+		log.Fatal("検索設定エラー: ", err)
+	}
+	searchIndex := search.New(searchConfig, wrapExecutor(cluster.Reader()))
+	if local, ok := searchIndex.(*search.Local); ok {
+		search.RegisterIndexHooks(local)
+		if err := local.Rebuild(context.Background(), wrapExecutor(cluster.Primary)); err != nil {
+			log.Fatal("検索インデックス作成エラー: ", err)
+		}
+	}
+	searchHandler := searchhandler.NewSearchHandler(
+		searchusecase.NewSearchUsecase(searchIndex, tweetRepo, userRepo),
+	)
+
+	// 個人データのエクスポート(作成したZIPはストアに保存し、署名付きURLでダウンロードさせる。
+	// 完了は通知として作成し、通知のフックでストリームにも配る)
+	blobConfig, err := blob.ConfigFromEnv()
+	if err != nil {
+		log.Fatal("ストア設定エラー: ", err)
+	}
+	blobStore, err := blob.New(blobConfig)
+	if err != nil {
+		log.Fatal("ストア初期化エラー: ", err)
+	}
+	exportConfig, err := exportusecase.ConfigFromEnv()
+	if err != nil {
+		log.Fatal("エクスポート設定エラー: ", err)
+	}
+	exportRepo := exportrepository.NewExportRepository(
+		wrapExecutor(cluster.Primary),
+		wrapExecutor(cluster.Reader()),
+	)
+	exportUsecase := exportusecase.NewExportUsecase(
+		exportRepo, auditRepo, txManager, auditUsecase, blobStore,
+		notificationrepository.NewExportNotifier(wrapExecutor(cluster.Primary)), exportConfig,
+	)
+	exportHandler := exporthandler.NewExportHandler(exportUsecase)
+	// オブジェクトストレージのように自分でURLを提供するストアでは登録しない
+	blobHandler, _ := blobStore.(http.Handler)
 
 	// Echoの初期化
 	e := echo.New()
+	e.IPExtractor = ipExtractor
 
 	// ミドルウェアの設定
 	e.Use(middleware.Logger())
 	e.Use(middleware.Recover())
 	e.Use(middleware.CORS())
+	e.Use(middleware.RequestID())
+	e.Use(tracing.Middleware)
+	e.Use(audit.Middleware)
 
-	// 認証不要のエンドポイント
-	e.POST("/register", userHandler.Register)
-	e.POST("/login", userHandler.Login)
-
-	// 認証が必要なエンドポイント
-	api := e.Group("/api")
-	api.Use(userHandler.AuthMiddleware)
+	// ルーティング
+	router.Register(e, router.Handlers{
+		User:         userHandler,
+		Audit:        auditHandler,
+		Export:       exportHandler,
+		Tweet:        tweetHandler,
+		Search:       searchHandler,
+		Notification: notificationHandler,
+		DM:           dmHandler,
+		Stream:       streamHandler,
+		Blobs:        blobHandler,
+	})
 
-	// ユーザー関連
-	users := api.Group("/users")
-	users.GET("/:id", userHandler.GetProfile)
-	users.PUT("/me", userHandler.UpdateProfile)
+	// SIGTERM と SIGINT で停止する。バックグラウンドの処理が終わるのを待ってから、
+	// defer でデータベースなどの接続を閉じる
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGTERM, os.Interrupt)
+	defer stop()
+	var workers sync.WaitGroup
+	workers.Add(2)
+	// 猶予期間を過ぎた退会ユーザーと削除したツイートの物理削除
+	go func() {
+		defer workers.Done()
+		runPurge(ctx, txManager, wrapExecutor(cluster.Primary), gracePeriod)
+	}()
+	// データエクスポートの作成と保存期間を過ぎたファイルの削除
+	go func() {
+		defer workers.Done()
+		exportUsecase.Run(ctx)
+	}()
 
 	// サーバー起動
-	if err := e.Start(":8080"); err != nil && err != http.ErrServerClosed {
-		log.Fatal("サーバーのシャットダウン中にエラーが発生しました: ", err)
+	go func() {
+		if err := e.Start(":8080"); err != nil && err != http.ErrServerClosed {
+			log.Fatal("サーバーのシャットダウン中にエラーが発生しました: ", err)
+		}
+	}()
+
+	<-ctx.Done()
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	if err := e.Shutdown(shutdownCtx); err != nil {
+		log.Println("サーバーの停止エラー: ", err)
+		_ = e.Close()
+	}
+	workers.Wait()
+}
+
+// shutdownTimeout は停止時に処理中のリクエストを待つ時間。過ぎたら残りの接続(ストリームなど)を切る
+const shutdownTimeout = 10 * time.Second
+
+// purgeInterval は退会ユーザーと削除したツイートを物理削除する間隔
+const purgeInterval = time.Hour
+
+// runPurge は猶予期間を過ぎた論理削除の行を purgeInterval ごとに物理削除する。
+// 複数のインスタンスで同時に実行しても、同じ行を二重に削除することはない
+func runPurge(ctx context.Context, txManager infrastructure.TxManager, exec boil.ContextExecutor, gracePeriod time.Duration) {
+	ticker := time.NewTicker(purgeInterval)
+	defer ticker.Stop()
+
+	for {
+		users, tweets, err := repository.PurgeDeleted(ctx, txManager, exec, time.Now().Add(-gracePeriod))
+		if err != nil {
+			log.Println("物理削除エラー: ", err)
+		} else if users > 0 || tweets > 0 {
+			log.Printf("物理削除: ユーザー %d件、ツイート %d件", users, tweets)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
 	}
 }
+
+func runMigrations(cfg infrastructure.DBConfig) error {
+	cfg.MultiStatements = true
+	db, err := infrastructure.NewDB(cfg)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	fsys, err := migrations.ForDriver(cfg.Driver)
+	if err != nil {
+		return err
+	}
+	migrator, err := migration.New(db, cfg.Driver, fsys)
+	if err != nil {
+		return err
+	}
+	migrator.Logf = log.Printf
+
+	return migrator.Up(context.Background())
+}
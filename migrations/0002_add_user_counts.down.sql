@@ -0,0 +1,4 @@
+ALTER TABLE users
+    DROP COLUMN tweets_count,
+    DROP COLUMN following_count,
+    DROP COLUMN followers_count;
@@ -0,0 +1,14 @@
+-- プロフィール表示のたびに follows と tweets を数えないよう、件数を users に持たせる。
+-- 件数はフォローやツイートの追加・削除と同じトランザクションでアプリケーションが更新する
+ALTER TABLE users
+    ADD COLUMN followers_count INT NOT NULL DEFAULT 0,
+    ADD COLUMN following_count INT NOT NULL DEFAULT 0,
+    ADD COLUMN tweets_count INT NOT NULL DEFAULT 0;
+
+-- 既存のデータから件数を設定する。
+-- updated_at = updated_at は ON UPDATE CURRENT_TIMESTAMP で更新日時が変わらないようにするため
+UPDATE users SET
+    followers_count = (SELECT COUNT(*) FROM follows WHERE follows.following_id = users.id),
+    following_count = (SELECT COUNT(*) FROM follows WHERE follows.follower_id = users.id),
+    tweets_count = (SELECT COUNT(*) FROM tweets WHERE tweets.user_id = users.id),
+    updated_at = updated_at;
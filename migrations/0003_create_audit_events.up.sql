@@ -0,0 +1,20 @@
+-- 監査ログ。追記のみで、アプリケーションは更新・削除しない。
+-- ユーザーやツイートが削除された後も残すため、actor_id / target_id に外部キーは付けない
+CREATE TABLE audit_events (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    event_type VARCHAR(64) NOT NULL,
+    actor_id INT,
+    target_type VARCHAR(32),
+    target_id INT,
+    ip VARCHAR(45),
+    user_agent VARCHAR(255),
+    request_id VARCHAR(64),
+    changes JSON,
+    details JSON,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX audit_events_event_type_idx ON audit_events (event_type, id);
+CREATE INDEX audit_events_actor_id_idx ON audit_events (actor_id, id);
+CREATE INDEX audit_events_target_idx ON audit_events (target_type, target_id, id);
+CREATE INDEX audit_events_created_at_idx ON audit_events (created_at);
@@ -0,0 +1,5 @@
+DROP INDEX tweets_deleted_at_idx ON tweets;
+DROP INDEX users_deleted_at_idx ON users;
+
+ALTER TABLE tweets DROP COLUMN deleted_at;
+ALTER TABLE users DROP COLUMN deleted_at;
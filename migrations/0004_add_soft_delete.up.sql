@@ -0,0 +1,9 @@
+-- 退会したユーザーと削除したツイートを猶予期間のあいだ残すための論理削除の列。
+-- deleted_at が設定された行はsqlboilerのクエリから自動的に除外され、
+-- 猶予期間を過ぎるとアプリケーションが物理削除する
+ALTER TABLE users ADD COLUMN deleted_at DATETIME NULL;
+ALTER TABLE tweets ADD COLUMN deleted_at DATETIME NULL;
+
+-- 物理削除の対象を探すためのインデックス
+CREATE INDEX users_deleted_at_idx ON users (deleted_at);
+CREATE INDEX tweets_deleted_at_idx ON tweets (deleted_at);
@@ -0,0 +1,18 @@
+-- 個人データのエクスポート。ユーザーが依頼するとバックグラウンドのワーカーが
+-- ZIPを作成してBLOBストアに保存し、expires_at を過ぎると削除する
+CREATE TABLE data_exports (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    user_id INT NOT NULL,
+    status VARCHAR(16) NOT NULL DEFAULT 'pending',
+    blob_key VARCHAR(255),
+    error_message VARCHAR(255),
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    started_at DATETIME NULL,
+    completed_at DATETIME NULL,
+    expires_at DATETIME NULL,
+    CONSTRAINT data_exports_ibfk_1 FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX data_exports_status_idx ON data_exports (status, id);
+CREATE INDEX data_exports_user_id_idx ON data_exports (user_id, id);
+CREATE INDEX data_exports_expires_at_idx ON data_exports (expires_at);
@@ -0,0 +1,9 @@
+-- リプライの親ツイートと、会話(リプライの連鎖)の最初のツイート。
+-- 最初のツイート自体は両方とも NULL。
+-- 外部キーにしないのは、親が猶予期間を過ぎて物理削除された後も
+-- リプライであることと会話のつながりを残すため(親は削除済みとして表示する)
+ALTER TABLE tweets ADD COLUMN reply_to_tweet_id INT NULL;
+ALTER TABLE tweets ADD COLUMN conversation_id INT NULL;
+
+CREATE INDEX tweets_reply_to_tweet_id_idx ON tweets (reply_to_tweet_id);
+CREATE INDEX tweets_conversation_id_idx ON tweets (conversation_id);
@@ -0,0 +1,19 @@
+-- リツイート。1人のユーザーは同じツイートを1回だけリツイートできる
+CREATE TABLE retweets (
+    user_id INT NOT NULL,
+    tweet_id INT NOT NULL,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (user_id, tweet_id),
+    CONSTRAINT retweets_ibfk_1 FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT retweets_ibfk_2 FOREIGN KEY (tweet_id) REFERENCES tweets(id) ON DELETE CASCADE
+);
+
+-- タイムラインでフォロー中のユーザーのリツイートを読むためと、ツイートごとのリツイート数を数えるため
+CREATE INDEX retweets_user_id_created_at_idx ON retweets (user_id, created_at);
+CREATE INDEX retweets_tweet_id_idx ON retweets (tweet_id);
+
+-- 引用ツイートが引用しているツイート。
+-- reply_to_tweet_id と同じく、引用元が物理削除された後も引用であることを残すため外部キーにしない
+ALTER TABLE tweets ADD COLUMN quote_tweet_id INT NULL;
+
+CREATE INDEX tweets_quote_tweet_id_idx ON tweets (quote_tweet_id);
@@ -0,0 +1,37 @@
+-- ツイート本文から取り出したエンティティ。本文から作り直せる派生データで、
+-- ハッシュタグでの検索とメンションの解決のために保存する。
+-- start_offset / end_offset は本文の先頭からの文字数(Unicodeのコードポイント数)で、end_offset は含まない
+
+-- tag は検索用に正規化した(全角・半角と大文字・小文字を揃えた)ハッシュタグで、先頭の # は含まない
+CREATE TABLE tweet_hashtags (
+    tweet_id INT NOT NULL,
+    start_offset INT NOT NULL,
+    end_offset INT NOT NULL,
+    tag VARCHAR(280) NOT NULL,
+    PRIMARY KEY (tweet_id, start_offset),
+    CONSTRAINT tweet_hashtags_ibfk_1 FOREIGN KEY (tweet_id) REFERENCES tweets(id) ON DELETE CASCADE
+);
+
+CREATE INDEX tweet_hashtags_tag_tweet_id_idx ON tweet_hashtags (tag, tweet_id);
+
+-- 投稿時に users.username で解決できたメンションだけを保存する
+CREATE TABLE tweet_mentions (
+    tweet_id INT NOT NULL,
+    start_offset INT NOT NULL,
+    end_offset INT NOT NULL,
+    user_id INT NOT NULL,
+    PRIMARY KEY (tweet_id, start_offset),
+    CONSTRAINT tweet_mentions_ibfk_1 FOREIGN KEY (tweet_id) REFERENCES tweets(id) ON DELETE CASCADE,
+    CONSTRAINT tweet_mentions_ibfk_2 FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX tweet_mentions_user_id_tweet_id_idx ON tweet_mentions (user_id, tweet_id);
+
+CREATE TABLE tweet_urls (
+    tweet_id INT NOT NULL,
+    start_offset INT NOT NULL,
+    end_offset INT NOT NULL,
+    url VARCHAR(280) NOT NULL,
+    PRIMARY KEY (tweet_id, start_offset),
+    CONSTRAINT tweet_urls_ibfk_1 FOREIGN KEY (tweet_id) REFERENCES tweets(id) ON DELETE CASCADE
+);
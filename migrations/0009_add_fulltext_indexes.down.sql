@@ -0,0 +1,2 @@
+ALTER TABLE users DROP INDEX users_profile_ft;
+ALTER TABLE tweets DROP INDEX tweets_content_ft;
@@ -0,0 +1,6 @@
+-- ツイートとユーザーの全文検索(SEARCH_DRIVER=mysql)用のインデックス。
+-- 日本語は単語を空白で区切らないので、ngram パーサーで連続する文字ごとのトークンに分けて索引する
+-- (トークンの文字数はサーバーの ngram_token_size で、既定は2)
+CREATE FULLTEXT INDEX tweets_content_ft ON tweets (content) WITH PARSER ngram;
+
+CREATE FULLTEXT INDEX users_profile_ft ON users (username, display_name, bio) WITH PARSER ngram;
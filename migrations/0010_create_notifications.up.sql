@@ -0,0 +1,28 @@
+-- 通知。フォロー・いいね・リプライ・メンションのたびに、受け取るユーザー(user_id)ごとに1行作る。
+-- 一覧では type と tweet_id が同じ行を1件にまとめる(「Aliceさんと他4人がいいねしました」)。
+-- tweet_id はいいねされたツイート、リプライ、メンションしたツイートで、フォローでは NULL
+CREATE TABLE notifications (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    user_id INT NOT NULL,
+    actor_id INT NOT NULL,
+    type VARCHAR(16) NOT NULL,
+    tweet_id INT NULL,
+    read_at DATETIME NULL,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT notifications_ibfk_1 FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT notifications_ibfk_2 FOREIGN KEY (actor_id) REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT notifications_ibfk_3 FOREIGN KEY (tweet_id) REFERENCES tweets(id) ON DELETE CASCADE
+);
+
+-- 一覧と未読数のためと、フォローやいいねの取り消しで通知を削除するため
+CREATE INDEX notifications_user_id_idx ON notifications (user_id, id);
+CREATE INDEX notifications_actor_id_idx ON notifications (actor_id, type);
+
+-- 受け取らない通知の種類。行がなければすべての種類を受け取る
+CREATE TABLE notification_opt_outs (
+    user_id INT NOT NULL,
+    type VARCHAR(16) NOT NULL,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (user_id, type),
+    CONSTRAINT notification_opt_outs_ibfk_1 FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
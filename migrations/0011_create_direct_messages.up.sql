@@ -0,0 +1,49 @@
+-- ダイレクトメッセージの会話。1対1と少人数のグループがある。
+-- direct_key は1対1の会話の2人のIDを小さい順に "1:2" のようにつないだもので、
+-- 同じ2人の会話が1つだけになるようにする。グループでは NULL。
+-- last_message_id は最後のメッセージで、会話の一覧を新しい順に並べるために使う(メッセージがなければ NULL)
+CREATE TABLE dm_conversations (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    direct_key VARCHAR(32) NULL,
+    last_message_id INT NULL,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT dm_conversations_direct_key UNIQUE (direct_key)
+);
+
+CREATE INDEX dm_conversations_last_message_id_idx ON dm_conversations (last_message_id);
+
+-- 会話の参加者。last_read_message_id は既読にした最後のメッセージで、
+-- 未読の数と、ほかの参加者に見せる既読の表示に使う(何も読んでいなければ0)
+CREATE TABLE dm_participants (
+    conversation_id INT NOT NULL,
+    user_id INT NOT NULL,
+    last_read_message_id INT NOT NULL DEFAULT 0,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (conversation_id, user_id),
+    CONSTRAINT dm_participants_ibfk_1 FOREIGN KEY (conversation_id) REFERENCES dm_conversations(id) ON DELETE CASCADE,
+    CONSTRAINT dm_participants_ibfk_2 FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+-- ユーザーの会話の一覧のため
+CREATE INDEX dm_participants_user_id_idx ON dm_participants (user_id);
+
+CREATE TABLE dm_messages (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    conversation_id INT NOT NULL,
+    sender_id INT NOT NULL,
+    content TEXT NOT NULL,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT dm_messages_ibfk_1 FOREIGN KEY (conversation_id) REFERENCES dm_conversations(id) ON DELETE CASCADE,
+    CONSTRAINT dm_messages_ibfk_2 FOREIGN KEY (sender_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+-- 会話のメッセージを新しい順に読むためと、未読の数を数えるため
+CREATE INDEX dm_messages_conversation_id_idx ON dm_messages (conversation_id, id);
+
+-- フォローしていないユーザーからのメッセージも受け取るユーザー。
+-- 行がなければ、相互にフォローしているユーザーからのメッセージだけを受け取る
+CREATE TABLE dm_opt_ins (
+    user_id INT NOT NULL PRIMARY KEY,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT dm_opt_ins_ibfk_1 FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
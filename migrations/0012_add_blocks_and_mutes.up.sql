@@ -0,0 +1,24 @@
+-- ブロック。ブロックした側とされた側は、お互いのツイートやプロフィールを見られず、
+-- フォロー・いいね・リプライ・ダイレクトメッセージもできない
+CREATE TABLE blocks (
+    blocker_id INT NOT NULL,
+    blocked_id INT NOT NULL,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (blocker_id, blocked_id),
+    CONSTRAINT blocks_ibfk_1 FOREIGN KEY (blocker_id) REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT blocks_ibfk_2 FOREIGN KEY (blocked_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+-- ブロックされているかを、された側から調べるため
+CREATE INDEX blocks_blocked_id_idx ON blocks (blocked_id);
+
+-- ミュート。ミュートしたユーザーのツイートと通知を、ミュートした側にだけ表示しない。
+-- ミュートされた側には知らせない
+CREATE TABLE mutes (
+    muter_id INT NOT NULL,
+    muted_id INT NOT NULL,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (muter_id, muted_id),
+    CONSTRAINT mutes_ibfk_1 FOREIGN KEY (muter_id) REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT mutes_ibfk_2 FOREIGN KEY (muted_id) REFERENCES users(id) ON DELETE CASCADE
+);
@@ -0,0 +1,32 @@
+// Package migrations はマイグレーションのSQLファイルをバイナリに埋め込む。
+// 直下がMySQL用、postgres/ がPostgreSQL用、sqlite/ がSQLite用で、バージョンと構造は揃えておく
+package migrations
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"todoapp/internal/infrastructure"
+)
+
+//go:embed *.sql
+var FS embed.FS
+
+//go:embed postgres/*.sql
+var postgresFS embed.FS
+
+//go:embed sqlite/*.sql
+var sqliteFS embed.FS
+
+// ForDriver は DB_DRIVER に対応するマイグレーションを返す
+func ForDriver(driver string) (fs.FS, error) {
+	switch driver {
+	case "", infrastructure.DriverMySQL:
+		return FS, nil
+	case infrastructure.DriverPostgres:
+		return fs.Sub(postgresFS, "postgres")
+	case infrastructure.DriverSQLite:
+		return fs.Sub(sqliteFS, "sqlite")
+	}
+	return nil, fmt.Errorf("no migrations for driver %q", driver)
+}
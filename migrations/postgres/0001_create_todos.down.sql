@@ -0,0 +1,6 @@
+-- テーブルの削除(作成の逆順)
+DROP TABLE IF EXISTS likes;
+DROP TABLE IF EXISTS follows;
+DROP TABLE IF EXISTS tweets;
+DROP TABLE IF EXISTS users;
+DROP FUNCTION IF EXISTS set_updated_at();
@@ -0,0 +1,9 @@
+DROP TRIGGER users_set_updated_at ON users;
+
+CREATE TRIGGER users_set_updated_at BEFORE UPDATE ON users
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+
+ALTER TABLE users
+    DROP COLUMN tweets_count,
+    DROP COLUMN following_count,
+    DROP COLUMN followers_count;
@@ -0,0 +1,20 @@
+-- MySQL版(../0002_add_user_counts.up.sql)と同じ内容のPostgreSQL版
+ALTER TABLE users
+    ADD COLUMN followers_count INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN following_count INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN tweets_count INTEGER NOT NULL DEFAULT 0;
+
+-- 件数の更新で updated_at が変わらないよう、件数が変わらない UPDATE のときだけ set_updated_at を実行する
+DROP TRIGGER users_set_updated_at ON users;
+
+CREATE TRIGGER users_set_updated_at BEFORE UPDATE ON users
+    FOR EACH ROW
+    WHEN (NEW.followers_count = OLD.followers_count
+        AND NEW.following_count = OLD.following_count
+        AND NEW.tweets_count = OLD.tweets_count)
+    EXECUTE FUNCTION set_updated_at();
+
+UPDATE users SET
+    followers_count = (SELECT COUNT(*) FROM follows WHERE follows.following_id = users.id),
+    following_count = (SELECT COUNT(*) FROM follows WHERE follows.follower_id = users.id),
+    tweets_count = (SELECT COUNT(*) FROM tweets WHERE tweets.user_id = users.id);
@@ -0,0 +1,19 @@
+-- MySQL版(../0003_create_audit_events.up.sql)と同じ構造のPostgreSQL版
+CREATE TABLE audit_events (
+    id BIGSERIAL PRIMARY KEY,
+    event_type VARCHAR(64) NOT NULL,
+    actor_id INTEGER,
+    target_type VARCHAR(32),
+    target_id INTEGER,
+    ip VARCHAR(45),
+    user_agent VARCHAR(255),
+    request_id VARCHAR(64),
+    changes JSONB,
+    details JSONB,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX audit_events_event_type_idx ON audit_events (event_type, id);
+CREATE INDEX audit_events_actor_id_idx ON audit_events (actor_id, id);
+CREATE INDEX audit_events_target_idx ON audit_events (target_type, target_id, id);
+CREATE INDEX audit_events_created_at_idx ON audit_events (created_at);
@@ -0,0 +1,5 @@
+DROP INDEX tweets_deleted_at_idx;
+DROP INDEX users_deleted_at_idx;
+
+ALTER TABLE tweets DROP COLUMN deleted_at;
+ALTER TABLE users DROP COLUMN deleted_at;
@@ -0,0 +1,6 @@
+-- MySQL版(../0004_add_soft_delete.up.sql)と同じ内容のPostgreSQL版
+ALTER TABLE users ADD COLUMN deleted_at TIMESTAMP NULL;
+ALTER TABLE tweets ADD COLUMN deleted_at TIMESTAMP NULL;
+
+CREATE INDEX users_deleted_at_idx ON users (deleted_at);
+CREATE INDEX tweets_deleted_at_idx ON tweets (deleted_at);
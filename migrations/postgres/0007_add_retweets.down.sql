@@ -0,0 +1,4 @@
+DROP INDEX tweets_quote_tweet_id_idx;
+ALTER TABLE tweets DROP COLUMN quote_tweet_id;
+
+DROP TABLE IF EXISTS retweets;
@@ -0,0 +1,32 @@
+-- MySQL版(../0008_add_tweet_entities.up.sql)と同じ構造のPostgreSQL版
+CREATE TABLE tweet_hashtags (
+    tweet_id INTEGER NOT NULL,
+    start_offset INTEGER NOT NULL,
+    end_offset INTEGER NOT NULL,
+    tag VARCHAR(280) NOT NULL,
+    PRIMARY KEY (tweet_id, start_offset),
+    CONSTRAINT tweet_hashtags_ibfk_1 FOREIGN KEY (tweet_id) REFERENCES tweets(id) ON DELETE CASCADE
+);
+
+CREATE INDEX tweet_hashtags_tag_tweet_id_idx ON tweet_hashtags (tag, tweet_id);
+
+CREATE TABLE tweet_mentions (
+    tweet_id INTEGER NOT NULL,
+    start_offset INTEGER NOT NULL,
+    end_offset INTEGER NOT NULL,
+    user_id INTEGER NOT NULL,
+    PRIMARY KEY (tweet_id, start_offset),
+    CONSTRAINT tweet_mentions_ibfk_1 FOREIGN KEY (tweet_id) REFERENCES tweets(id) ON DELETE CASCADE,
+    CONSTRAINT tweet_mentions_ibfk_2 FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX tweet_mentions_user_id_tweet_id_idx ON tweet_mentions (user_id, tweet_id);
+
+CREATE TABLE tweet_urls (
+    tweet_id INTEGER NOT NULL,
+    start_offset INTEGER NOT NULL,
+    end_offset INTEGER NOT NULL,
+    url VARCHAR(280) NOT NULL,
+    PRIMARY KEY (tweet_id, start_offset),
+    CONSTRAINT tweet_urls_ibfk_1 FOREIGN KEY (tweet_id) REFERENCES tweets(id) ON DELETE CASCADE
+);
@@ -0,0 +1,4 @@
+-- MySQL版(../0009_add_fulltext_indexes.up.sql)と同じバージョンのPostgreSQL版。
+-- 全文検索のインデックスはMySQL用で、PostgreSQLでは SEARCH_DRIVER=local の
+-- プロセス内のインデックスを使うので、バージョンを揃えるだけで何もしない
+SELECT 1;
@@ -0,0 +1,24 @@
+-- MySQL版(../0010_create_notifications.up.sql)と同じ構造のPostgreSQL版
+CREATE TABLE notifications (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL,
+    actor_id INTEGER NOT NULL,
+    type VARCHAR(16) NOT NULL,
+    tweet_id INTEGER NULL,
+    read_at TIMESTAMP NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT notifications_ibfk_1 FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT notifications_ibfk_2 FOREIGN KEY (actor_id) REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT notifications_ibfk_3 FOREIGN KEY (tweet_id) REFERENCES tweets(id) ON DELETE CASCADE
+);
+
+CREATE INDEX notifications_user_id_idx ON notifications (user_id, id);
+CREATE INDEX notifications_actor_id_idx ON notifications (actor_id, type);
+
+CREATE TABLE notification_opt_outs (
+    user_id INTEGER NOT NULL,
+    type VARCHAR(16) NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (user_id, type),
+    CONSTRAINT notification_opt_outs_ibfk_1 FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
@@ -0,0 +1,20 @@
+-- MySQL版(../0012_add_blocks_and_mutes.up.sql)と同じ構造のPostgreSQL版
+CREATE TABLE blocks (
+    blocker_id INTEGER NOT NULL,
+    blocked_id INTEGER NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (blocker_id, blocked_id),
+    CONSTRAINT blocks_ibfk_1 FOREIGN KEY (blocker_id) REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT blocks_ibfk_2 FOREIGN KEY (blocked_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX blocks_blocked_id_idx ON blocks (blocked_id);
+
+CREATE TABLE mutes (
+    muter_id INTEGER NOT NULL,
+    muted_id INTEGER NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (muter_id, muted_id),
+    CONSTRAINT mutes_ibfk_1 FOREIGN KEY (muter_id) REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT mutes_ibfk_2 FOREIGN KEY (muted_id) REFERENCES users(id) ON DELETE CASCADE
+);
@@ -0,0 +1,46 @@
+-- MySQL版(../0001_create_todos.up.sql)と同じ構造のSQLite版。
+-- SQLiteには ON UPDATE CURRENT_TIMESTAMP がないが、updated_at はsqlboilerが更新時に設定する
+
+-- ユーザーテーブル
+CREATE TABLE users (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    username VARCHAR(50) NOT NULL UNIQUE,
+    display_name VARCHAR(100) NOT NULL,
+    email VARCHAR(255) NOT NULL UNIQUE,
+    password_hash VARCHAR(255) NOT NULL,
+    bio TEXT,
+    profile_image_url VARCHAR(255),
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME DEFAULT CURRENT_TIMESTAMP
+);
+
+-- ツイートテーブル
+CREATE TABLE tweets (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    user_id INTEGER NOT NULL,
+    content TEXT NOT NULL,
+    image_url VARCHAR(255),
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT tweets_ibfk_1 FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+-- フォローテーブル
+CREATE TABLE follows (
+    follower_id INTEGER NOT NULL,
+    following_id INTEGER NOT NULL,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (follower_id, following_id),
+    CONSTRAINT follows_ibfk_1 FOREIGN KEY (follower_id) REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT follows_ibfk_2 FOREIGN KEY (following_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+-- いいねテーブル
+CREATE TABLE likes (
+    user_id INTEGER NOT NULL,
+    tweet_id INTEGER NOT NULL,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (user_id, tweet_id),
+    CONSTRAINT likes_ibfk_1 FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT likes_ibfk_2 FOREIGN KEY (tweet_id) REFERENCES tweets(id) ON DELETE CASCADE
+);
@@ -0,0 +1,9 @@
+-- MySQL版(../0002_add_user_counts.up.sql)と同じ内容のSQLite版
+ALTER TABLE users ADD COLUMN followers_count INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE users ADD COLUMN following_count INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE users ADD COLUMN tweets_count INTEGER NOT NULL DEFAULT 0;
+
+UPDATE users SET
+    followers_count = (SELECT COUNT(*) FROM follows WHERE follows.following_id = users.id),
+    following_count = (SELECT COUNT(*) FROM follows WHERE follows.follower_id = users.id),
+    tweets_count = (SELECT COUNT(*) FROM tweets WHERE tweets.user_id = users.id);
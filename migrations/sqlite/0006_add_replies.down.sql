@@ -0,0 +1,5 @@
+DROP INDEX tweets_conversation_id_idx;
+DROP INDEX tweets_reply_to_tweet_id_idx;
+
+ALTER TABLE tweets DROP COLUMN conversation_id;
+ALTER TABLE tweets DROP COLUMN reply_to_tweet_id;
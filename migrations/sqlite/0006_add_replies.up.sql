@@ -0,0 +1,6 @@
+-- MySQL版(../0006_add_replies.up.sql)と同じ内容のSQLite版
+ALTER TABLE tweets ADD COLUMN reply_to_tweet_id INTEGER NULL;
+ALTER TABLE tweets ADD COLUMN conversation_id INTEGER NULL;
+
+CREATE INDEX tweets_reply_to_tweet_id_idx ON tweets (reply_to_tweet_id);
+CREATE INDEX tweets_conversation_id_idx ON tweets (conversation_id);
@@ -0,0 +1,16 @@
+-- MySQL版(../0007_add_retweets.up.sql)と同じ構造のSQLite版
+CREATE TABLE retweets (
+    user_id INTEGER NOT NULL,
+    tweet_id INTEGER NOT NULL,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (user_id, tweet_id),
+    CONSTRAINT retweets_ibfk_1 FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT retweets_ibfk_2 FOREIGN KEY (tweet_id) REFERENCES tweets(id) ON DELETE CASCADE
+);
+
+CREATE INDEX retweets_user_id_created_at_idx ON retweets (user_id, created_at);
+CREATE INDEX retweets_tweet_id_idx ON retweets (tweet_id);
+
+ALTER TABLE tweets ADD COLUMN quote_tweet_id INTEGER NULL;
+
+CREATE INDEX tweets_quote_tweet_id_idx ON tweets (quote_tweet_id);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS notification_opt_outs;
+DROP TABLE IF EXISTS notifications;
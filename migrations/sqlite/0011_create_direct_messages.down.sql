@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS dm_opt_ins;
+DROP TABLE IF EXISTS dm_messages;
+DROP TABLE IF EXISTS dm_participants;
+DROP TABLE IF EXISTS dm_conversations;
@@ -0,0 +1,40 @@
+-- MySQL版(../0011_create_direct_messages.up.sql)と同じ構造のSQLite版
+CREATE TABLE dm_conversations (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    direct_key VARCHAR(32) NULL,
+    last_message_id INTEGER NULL,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT dm_conversations_direct_key UNIQUE (direct_key)
+);
+
+CREATE INDEX dm_conversations_last_message_id_idx ON dm_conversations (last_message_id);
+
+CREATE TABLE dm_participants (
+    conversation_id INTEGER NOT NULL,
+    user_id INTEGER NOT NULL,
+    last_read_message_id INTEGER NOT NULL DEFAULT 0,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (conversation_id, user_id),
+    CONSTRAINT dm_participants_ibfk_1 FOREIGN KEY (conversation_id) REFERENCES dm_conversations(id) ON DELETE CASCADE,
+    CONSTRAINT dm_participants_ibfk_2 FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX dm_participants_user_id_idx ON dm_participants (user_id);
+
+CREATE TABLE dm_messages (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    conversation_id INTEGER NOT NULL,
+    sender_id INTEGER NOT NULL,
+    content TEXT NOT NULL,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT dm_messages_ibfk_1 FOREIGN KEY (conversation_id) REFERENCES dm_conversations(id) ON DELETE CASCADE,
+    CONSTRAINT dm_messages_ibfk_2 FOREIGN KEY (sender_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX dm_messages_conversation_id_idx ON dm_messages (conversation_id, id);
+
+CREATE TABLE dm_opt_ins (
+    user_id INTEGER NOT NULL PRIMARY KEY,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT dm_opt_ins_ibfk_1 FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
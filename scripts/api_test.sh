@@ -97,6 +97,53 @@ update_profile() {
     print_response $? "$response"
 }
 
+# 退会(猶予期間の間は reactivate で元に戻せる)
+deactivate() {
+    print_header "退会"
+    token=$(get_token)
+    response=$(curl -s -o /dev/null -w '{"status": %{http_code}}' -X DELETE "$API_URL/api/users/me" \
+        -H "Authorization: Bearer $token")
+    print_response $? "$response"
+}
+
+# 退会の取り消し
+reactivate() {
+    print_header "退会の取り消し"
+    response=$(curl -s -X POST "$API_URL/reactivate" \
+        -H "Content-Type: application/json" \
+        -d '{
+            "email": "test@example.com",
+            "password": "password123"
+        }')
+    print_response $? "$response"
+
+    # トークンを保存
+    token=$(echo "$response" | jq -r '.token')
+    if [ "$token" != "null" ]; then
+        save_token "$token"
+        echo "Token saved successfully"
+    fi
+}
+
+# 個人データのエクスポート(作成は非同期なので get-export で状況とダウンロードURLを確認する)
+request_export() {
+    print_header "データエクスポート"
+    token=$(get_token)
+    response=$(curl -s -X POST "$API_URL/api/users/me/export" \
+        -H "Authorization: Bearer $token")
+    print_response $? "$response"
+}
+
+# データエクスポートの状況
+get_export() {
+    export_id=$1
+    print_header "データエクスポートの状況"
+    token=$(get_token)
+    response=$(curl -s -X GET "$API_URL/api/users/me/exports/$export_id" \
+        -H "Authorization: Bearer $token")
+    print_response $? "$response"
+}
+
 # ツイート投稿
 create_tweet() {
     print_header "ツイート投稿"
@@ -105,7 +152,7 @@ create_tweet() {
         -H "Authorization: Bearer $token" \
         -H "Content-Type: application/json" \
         -d '{
-            "content": "This is a test tweet",
+            "content": "This is a test tweet #golang",
             "image_url": "https://example.com/tweet-image.jpg"
         }')
     print_response $? "$response"
@@ -121,6 +168,65 @@ get_tweet() {
     print_response $? "$response"
 }
 
+# リプライ
+reply_tweet() {
+    local tweet_id=${1:-1}
+    print_header "リプライ (ID: $tweet_id)"
+    token=$(get_token)
+    response=$(curl -s -X POST "$API_URL/api/tweets/$tweet_id/replies" \
+        -H "Authorization: Bearer $token" \
+        -H "Content-Type: application/json" \
+        -d '{
+            "content": "This is a test reply"
+        }')
+    print_response $? "$response"
+}
+
+# スレッド取得
+get_thread() {
+    local tweet_id=${1:-1}
+    print_header "スレッド取得 (ID: $tweet_id)"
+    token=$(get_token)
+    response=$(curl -s -X GET "$API_URL/api/tweets/$tweet_id/thread" \
+        -H "Authorization: Bearer $token")
+    print_response $? "$response"
+}
+
+# リツイート
+retweet_tweet() {
+    local tweet_id=${1:-1}
+    print_header "リツイート (ID: $tweet_id)"
+    token=$(get_token)
+    response=$(curl -s -X POST "$API_URL/api/tweets/$tweet_id/retweet" \
+        -H "Authorization: Bearer $token")
+    print_response $? "$response"
+}
+
+# リツイートの取り消し
+unretweet_tweet() {
+    local tweet_id=${1:-1}
+    print_header "リツイートの取り消し (ID: $tweet_id)"
+    token=$(get_token)
+    response=$(curl -s -X DELETE "$API_URL/api/tweets/$tweet_id/retweet" \
+        -H "Authorization: Bearer $token")
+    print_response $? "$response"
+}
+
+# 引用ツイート
+quote_tweet() {
+    local tweet_id=${1:-1}
+    print_header "引用ツイート (ID: $tweet_id)"
+    token=$(get_token)
+    response=$(curl -s -X POST "$API_URL/api/tweets" \
+        -H "Authorization: Bearer $token" \
+        -H "Content-Type: application/json" \
+        -d '{
+            "content": "This is a test quote",
+            "quote_tweet_id": '"$tweet_id"'
+        }')
+    print_response $? "$response"
+}
+
 # タイムライン取得
 get_timeline() {
     print_header "タイムライン取得"
@@ -130,6 +236,29 @@ get_timeline() {
     print_response $? "$response"
 }
 
+# ハッシュタグのツイート取得
+get_hashtag_tweets() {
+    local tag=${1:-golang}
+    print_header "ハッシュタグのツイート取得 (#$tag)"
+    token=$(get_token)
+    response=$(curl -s -X GET "$API_URL/api/hashtags/$(printf '%s' "$tag" | jq -sRr @uri)/tweets" \
+        -H "Authorization: Bearer $token")
+    print_response $? "$response"
+}
+
+# 検索
+search() {
+    local query=${1:-golang}
+    local type=${2:-tweets}
+    print_header "検索 ($type: $query)"
+    token=$(get_token)
+    response=$(curl -s -G "$API_URL/api/search" \
+        --data-urlencode "q=$query" \
+        --data-urlencode "type=$type" \
+        -H "Authorization: Bearer $token")
+    print_response $? "$response"
+}
+
 # フォロー
 follow_user() {
     local user_id=${1:-2}
@@ -150,6 +279,63 @@ unfollow_user() {
     print_response $? "$response"
 }
 
+# ユーザーをブロック
+block_user() {
+    local user_id=${1:-2}
+    print_header "ユーザーをブロック (ID: $user_id)"
+    token=$(get_token)
+    response=$(curl -s -X POST "$API_URL/api/users/$user_id/block" \
+        -H "Authorization: Bearer $token")
+    print_response $? "$response"
+}
+
+# ブロックを解除
+unblock_user() {
+    local user_id=${1:-2}
+    print_header "ブロックを解除 (ID: $user_id)"
+    token=$(get_token)
+    response=$(curl -s -X DELETE "$API_URL/api/users/$user_id/block" \
+        -H "Authorization: Bearer $token")
+    print_response $? "$response"
+}
+
+# ユーザーをミュート
+mute_user() {
+    local user_id=${1:-2}
+    print_header "ユーザーをミュート (ID: $user_id)"
+    token=$(get_token)
+    response=$(curl -s -X POST "$API_URL/api/users/$user_id/mute" \
+        -H "Authorization: Bearer $token")
+    print_response $? "$response"
+}
+
+# ミュートを解除
+unmute_user() {
+    local user_id=${1:-2}
+    print_header "ミュートを解除 (ID: $user_id)"
+    token=$(get_token)
+    response=$(curl -s -X DELETE "$API_URL/api/users/$user_id/mute" \
+        -H "Authorization: Bearer $token")
+    print_response $? "$response"
+}
+
+# ブロック・ミュートしているユーザーの一覧
+get_blocks() {
+    print_header "ブロックしているユーザー一覧"
+    token=$(get_token)
+    response=$(curl -s -X GET "$API_URL/api/users/me/blocks" \
+        -H "Authorization: Bearer $token")
+    print_response $? "$response"
+}
+
+get_mutes() {
+    print_header "ミュートしているユーザー一覧"
+    token=$(get_token)
+    response=$(curl -s -X GET "$API_URL/api/users/me/mutes" \
+        -H "Authorization: Bearer $token")
+    print_response $? "$response"
+}
+
 # いいね
 like_tweet() {
     local tweet_id=${1:-1}
@@ -170,6 +356,114 @@ unlike_tweet() {
     print_response $? "$response"
 }
 
+# 通知一覧
+get_notifications() {
+    print_header "通知一覧"
+    token=$(get_token)
+    response=$(curl -s -X GET "$API_URL/api/notifications" \
+        -H "Authorization: Bearer $token")
+    print_response $? "$response"
+}
+
+# 通知を既読にする(ID を省略するとすべて)
+read_notifications() {
+    local up_to_id=${1:-0}
+    print_header "通知を既読にする (ID: $up_to_id まで)"
+    token=$(get_token)
+    response=$(curl -s -X POST "$API_URL/api/notifications/read" \
+        -H "Authorization: Bearer $token" \
+        -H "Content-Type: application/json" \
+        -d '{"up_to_id": '"$up_to_id"'}')
+    print_response $? "$response"
+}
+
+# 通知の設定(種類を指定するとその種類の通知を受け取らないようにする)
+notification_preferences() {
+    local type=$1
+    print_header "通知の設定"
+    token=$(get_token)
+    if [ -n "$type" ]; then
+        response=$(curl -s -X PUT "$API_URL/api/notifications/preferences" \
+            -H "Authorization: Bearer $token" \
+            -H "Content-Type: application/json" \
+            -d '{"'"$type"'": false}')
+    else
+        response=$(curl -s -X GET "$API_URL/api/notifications/preferences" \
+            -H "Authorization: Bearer $token")
+    fi
+    print_response $? "$response"
+}
+
+# ダイレクトメッセージの会話一覧
+get_conversations() {
+    print_header "ダイレクトメッセージの会話一覧"
+    token=$(get_token)
+    response=$(curl -s -X GET "$API_URL/api/dm/conversations" \
+        -H "Authorization: Bearer $token")
+    print_response $? "$response"
+}
+
+# ダイレクトメッセージの会話を作成(同じ相手との1対1の会話があればそれを返す)
+create_conversation() {
+    local user_ids=${1:-2}
+    print_header "会話を作成 (user_ids: $user_ids)"
+    token=$(get_token)
+    response=$(curl -s -X POST "$API_URL/api/dm/conversations" \
+        -H "Authorization: Bearer $token" \
+        -H "Content-Type: application/json" \
+        -d '{"user_ids": ['"$user_ids"']}')
+    print_response $? "$response"
+}
+
+# 会話のメッセージ一覧
+get_messages() {
+    local conversation_id=${1:-1}
+    print_header "メッセージ一覧 (会話ID: $conversation_id)"
+    token=$(get_token)
+    response=$(curl -s -X GET "$API_URL/api/dm/conversations/$conversation_id/messages" \
+        -H "Authorization: Bearer $token")
+    print_response $? "$response"
+}
+
+# メッセージを送信
+send_message() {
+    local conversation_id=${1:-1}
+    print_header "メッセージを送信 (会話ID: $conversation_id)"
+    token=$(get_token)
+    response=$(curl -s -X POST "$API_URL/api/dm/conversations/$conversation_id/messages" \
+        -H "Authorization: Bearer $token" \
+        -H "Content-Type: application/json" \
+        -d '{"content": "こんにちは！"}')
+    print_response $? "$response"
+}
+
+# 会話のメッセージをすべて既読にする
+read_conversation() {
+    local conversation_id=${1:-1}
+    print_header "会話を既読にする (会話ID: $conversation_id)"
+    token=$(get_token)
+    response=$(curl -s -X POST "$API_URL/api/dm/conversations/$conversation_id/read" \
+        -H "Authorization: Bearer $token")
+    print_response $? "$response"
+}
+
+# イベントストリームを受信する(Server-Sent Events、Ctrl+C で終了)
+stream_events() {
+    local last_event_id=$1
+    print_header "イベントストリーム"
+    token=$(get_token)
+    if [ -n "$last_event_id" ]; then
+        curl -s -N "$API_URL/api/stream" \
+            -H "Authorization: Bearer $token" \
+            -H "Accept: text/event-stream" \
+            -H "Last-Event-ID: $last_event_id"
+    else
+        curl -s -N "$API_URL/api/stream" \
+            -H "Authorization: Bearer $token" \
+            -H "Accept: text/event-stream"
+    fi
+}
+
 # メイン処理
 case "$1" in
     "register")
@@ -184,39 +478,143 @@ case "$1" in
     "update-profile")
         update_profile
         ;;
+    "deactivate")
+        deactivate
+        ;;
+    "reactivate")
+        reactivate
+        ;;
+    "export")
+        request_export
+        ;;
+    "get-export")
+        get_export $2
+        ;;
     "tweet")
         create_tweet
         ;;
     "get-tweet")
         get_tweet $2
         ;;
+    "reply")
+        reply_tweet $2
+        ;;
+    "thread")
+        get_thread $2
+        ;;
+    "retweet")
+        retweet_tweet $2
+        ;;
+    "unretweet")
+        unretweet_tweet $2
+        ;;
+    "quote")
+        quote_tweet $2
+        ;;
     "timeline")
         get_timeline
         ;;
+    "hashtag")
+        get_hashtag_tweets $2
+        ;;
+    "search")
+        search "$2" $3
+        ;;
     "follow")
         follow_user $2
         ;;
     "unfollow")
         unfollow_user $2
         ;;
+    "block")
+        block_user $2
+        ;;
+    "unblock")
+        unblock_user $2
+        ;;
+    "blocks")
+        get_blocks
+        ;;
+    "mute")
+        mute_user $2
+        ;;
+    "unmute")
+        unmute_user $2
+        ;;
+    "mutes")
+        get_mutes
+        ;;
     "like")
         like_tweet $2
         ;;
     "unlike")
         unlike_tweet $2
         ;;
+    "notifications")
+        get_notifications
+        ;;
+    "read-notifications")
+        read_notifications $2
+        ;;
+    "notification-preferences")
+        notification_preferences $2
+        ;;
+    "stream")
+        stream_events $2
+        ;;
+    "conversations")
+        get_conversations
+        ;;
+    "create-conversation")
+        create_conversation $2
+        ;;
+    "messages")
+        get_messages $2
+        ;;
+    "send-message")
+        send_message $2
+        ;;
+    "read-conversation")
+        read_conversation $2
+        ;;
     *)
         echo "使用方法:"
         echo "  $0 register                # 新規ユーザー登録"
         echo "  $0 login                   # ログイン"
         echo "  $0 profile [id]            # プロフィール取得"
         echo "  $0 update-profile          # プロフィール更新"
+        echo "  $0 deactivate              # 退会"
+        echo "  $0 reactivate              # 退会の取り消し"
+        echo "  $0 export                  # 個人データのエクスポート"
+        echo "  $0 get-export [id]         # エクスポートの状況とダウンロードURL"
         echo "  $0 tweet                   # ツイート投稿"
         echo "  $0 get-tweet [id]          # ツイート取得"
+        echo "  $0 reply [tweet_id]        # ツイートにリプライ"
+        echo "  $0 thread [tweet_id]       # スレッド取得"
+        echo "  $0 retweet [tweet_id]      # リツイート"
+        echo "  $0 unretweet [tweet_id]    # リツイートの取り消し"
+        echo "  $0 quote [tweet_id]        # 引用ツイート"
         echo "  $0 timeline                # タイムライン取得"
+        echo "  $0 hashtag [tag]           # ハッシュタグのツイート取得"
+        echo "  $0 search [q] [type]       # ツイート(tweets)またはユーザー(users)を検索"
         echo "  $0 follow [user_id]        # ユーザーをフォロー"
         echo "  $0 unfollow [user_id]      # ユーザーをアンフォロー"
+        echo "  $0 block [user_id]         # ユーザーをブロック(お互いのフォローも解除)"
+        echo "  $0 unblock [user_id]       # ブロックを解除"
+        echo "  $0 blocks                  # ブロックしているユーザー一覧"
+        echo "  $0 mute [user_id]          # ユーザーをミュート"
+        echo "  $0 unmute [user_id]        # ミュートを解除"
+        echo "  $0 mutes                   # ミュートしているユーザー一覧"
         echo "  $0 like [tweet_id]         # ツイートにいいね"
         echo "  $0 unlike [tweet_id]       # いいねを解除"
+        echo "  $0 notifications           # 通知一覧"
+        echo "  $0 read-notifications [id] # 通知を既読にする(省略するとすべて)"
+        echo "  $0 notification-preferences [type] # 通知の設定(type の通知を受け取らない)"
+        echo "  $0 stream [last_event_id]  # イベントストリームを受信(指定したIDの後から再送)"
+        echo "  $0 conversations           # ダイレクトメッセージの会話一覧"
+        echo "  $0 create-conversation [user_ids] # 会話を作成(カンマ区切りで複数指定するとグループ)"
+        echo "  $0 messages [id]           # 会話のメッセージ一覧"
+        echo "  $0 send-message [id]       # メッセージを送信"
+        echo "  $0 read-conversation [id]  # 会話を既読にする"
         ;;
 esac
\ No newline at end of file